@@ -0,0 +1,205 @@
+package beacon
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"path"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/api/client"
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/interfaces"
+	lightclient "github.com/prysmaticlabs/prysm/v5/consensus-types/light-client"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v5/network/forks"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/runtime/version"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	getGenesisPath                     = "/eth/v1/beacon/genesis"
+	getLightClientBootstrapPath        = "/eth/v1/beacon/light_client/bootstrap"
+	getLightClientUpdatesPath          = "/eth/v1/beacon/light_client/updates"
+	getLightClientFinalityUpdatePath   = "/eth/v1/beacon/light_client/finality_update"
+	getLightClientOptimisticUpdatePath = "/eth/v1/beacon/light_client/optimistic_update"
+)
+
+// GetGenesis retrieves the genesis details of the chain followed by the beacon node.
+func (c *Client) GetGenesis(ctx context.Context) (*structs.Genesis, error) {
+	body, err := c.Get(ctx, getGenesisPath)
+	if err != nil {
+		return nil, errors.Wrap(err, "error requesting genesis")
+	}
+	resp := &structs.GetGenesisResponse{}
+	if err := json.Unmarshal(body, resp); err != nil {
+		return nil, errors.Wrap(err, "error decoding json response in GetGenesis")
+	}
+	if resp.Data == nil {
+		return nil, errors.New("empty genesis response")
+	}
+	return resp.Data, nil
+}
+
+// GetLightClientBootstrap retrieves the light client bootstrap for the given block root.
+func (c *Client) GetLightClientBootstrap(ctx context.Context, blockRoot [32]byte) (interfaces.LightClientBootstrap, error) {
+	p := path.Join(getLightClientBootstrapPath, hexutil.Encode(blockRoot[:]))
+	b, v, err := c.GetVersioned(ctx, p, client.WithSSZEncoding())
+	if err != nil {
+		return nil, errors.Wrapf(err, "error requesting light client bootstrap for block root %#x", blockRoot)
+	}
+	ver, err := version.FromString(v)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid light client bootstrap version")
+	}
+	var m interface {
+		proto.Message
+		UnmarshalSSZ([]byte) error
+	}
+	switch {
+	case ver >= version.Electra:
+		m = &ethpb.LightClientBootstrapElectra{}
+	case ver >= version.Deneb:
+		m = &ethpb.LightClientBootstrapDeneb{}
+	case ver >= version.Capella:
+		m = &ethpb.LightClientBootstrapCapella{}
+	case ver >= version.Altair:
+		m = &ethpb.LightClientBootstrapAltair{}
+	default:
+		return nil, errors.Errorf("light client bootstrap not supported for version %s", v)
+	}
+	if err := m.UnmarshalSSZ(b); err != nil {
+		return nil, errors.Wrap(err, "could not unmarshal light client bootstrap")
+	}
+	return lightclient.NewWrappedBootstrap(m)
+}
+
+// GetLightClientUpdatesByRange retrieves the best light client update of each sync committee period starting at
+// startPeriod, for at most count periods. The genesis validators root is used to identify the fork of each update.
+func (c *Client) GetLightClientUpdatesByRange(ctx context.Context, startPeriod, count uint64, genesisValidatorsRoot [32]byte) ([]interfaces.LightClientUpdate, error) {
+	query := url.Values{}
+	query.Set("start_period", strconv.FormatUint(startPeriod, 10))
+	query.Set("count", strconv.FormatUint(count, 10))
+	b, err := c.Get(ctx, getLightClientUpdatesPath, client.WithSSZEncoding(), withQuery(query))
+	if err != nil {
+		return nil, errors.Wrapf(err, "error requesting light client updates, start_period=%d, count=%d", startPeriod, count)
+	}
+
+	// The response is a sequence of chunks, each made of the little-endian uint64 length of the rest of the chunk,
+	// the fork digest of the update and the update itself.
+	updates := make([]interfaces.LightClientUpdate, 0, count)
+	for len(b) > 0 {
+		if len(b) < 8 {
+			return nil, errors.New("truncated light client updates response")
+		}
+		size := binary.LittleEndian.Uint64(b[:8])
+		b = b[8:]
+		if size < 4 || uint64(len(b)) < size {
+			return nil, errors.New("truncated light client updates response")
+		}
+		chunk := b[:size]
+		b = b[size:]
+
+		_, epoch, err := forks.RetrieveForkDataFromDigest(bytesutil.ToBytes4(chunk[:4]), genesisValidatorsRoot[:])
+		if err != nil {
+			return nil, errors.Wrap(err, "unknown light client update fork digest")
+		}
+		update, err := unmarshalLightClientUpdate(slots.ToForkVersion(slots.UnsafeEpochStart(epoch)), chunk[4:])
+		if err != nil {
+			return nil, err
+		}
+		updates = append(updates, update)
+	}
+	return updates, nil
+}
+
+// GetLightClientFinalityUpdate retrieves the latest light client finality update known to the beacon node.
+func (c *Client) GetLightClientFinalityUpdate(ctx context.Context) (interfaces.LightClientFinalityUpdate, error) {
+	b, v, err := c.GetVersioned(ctx, getLightClientFinalityUpdatePath, client.WithSSZEncoding())
+	if err != nil {
+		return nil, errors.Wrap(err, "error requesting light client finality update")
+	}
+	ver, err := version.FromString(v)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid light client finality update version")
+	}
+	var update interfaces.LightClientFinalityUpdate
+	switch {
+	case ver >= version.Electra:
+		update = lightclient.NewEmptyFinalityUpdateElectra()
+	case ver >= version.Deneb:
+		update = lightclient.NewEmptyFinalityUpdateDeneb()
+	case ver >= version.Capella:
+		update = lightclient.NewEmptyFinalityUpdateCapella()
+	case ver >= version.Altair:
+		update = lightclient.NewEmptyFinalityUpdateAltair()
+	default:
+		return nil, errors.Errorf("light client finality update not supported for version %s", v)
+	}
+	if err := update.UnmarshalSSZ(b); err != nil {
+		return nil, errors.Wrap(err, "could not unmarshal light client finality update")
+	}
+	return update, nil
+}
+
+// GetLightClientOptimisticUpdate retrieves the latest light client optimistic update known to the beacon node.
+func (c *Client) GetLightClientOptimisticUpdate(ctx context.Context) (interfaces.LightClientOptimisticUpdate, error) {
+	b, v, err := c.GetVersioned(ctx, getLightClientOptimisticUpdatePath, client.WithSSZEncoding())
+	if err != nil {
+		return nil, errors.Wrap(err, "error requesting light client optimistic update")
+	}
+	ver, err := version.FromString(v)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid light client optimistic update version")
+	}
+	var update interfaces.LightClientOptimisticUpdate
+	switch {
+	case ver >= version.Deneb:
+		update = lightclient.NewEmptyOptimisticUpdateDeneb()
+	case ver >= version.Capella:
+		update = lightclient.NewEmptyOptimisticUpdateCapella()
+	case ver >= version.Altair:
+		update = lightclient.NewEmptyOptimisticUpdateAltair()
+	default:
+		return nil, errors.Errorf("light client optimistic update not supported for version %s", v)
+	}
+	if err := update.UnmarshalSSZ(b); err != nil {
+		return nil, errors.Wrap(err, "could not unmarshal light client optimistic update")
+	}
+	return update, nil
+}
+
+func unmarshalLightClientUpdate(v int, b []byte) (interfaces.LightClientUpdate, error) {
+	var m interface {
+		proto.Message
+		UnmarshalSSZ([]byte) error
+	}
+	switch {
+	case v >= version.Electra:
+		m = &ethpb.LightClientUpdateElectra{}
+	case v >= version.Deneb:
+		m = &ethpb.LightClientUpdateDeneb{}
+	case v >= version.Capella:
+		m = &ethpb.LightClientUpdateCapella{}
+	case v >= version.Altair:
+		m = &ethpb.LightClientUpdateAltair{}
+	default:
+		return nil, errors.Errorf("light client update not supported for version %s", version.String(v))
+	}
+	if err := m.UnmarshalSSZ(b); err != nil {
+		return nil, errors.Wrap(err, "could not unmarshal light client update")
+	}
+	return lightclient.NewWrappedUpdate(m)
+}
+
+func withQuery(query url.Values) client.ReqOption {
+	return func(req *http.Request) {
+		req.URL.RawQuery = query.Encode()
+	}
+}
@@ -0,0 +1,115 @@
+package beacon
+
+import (
+	"context"
+	"encoding/binary"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/api"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	lightclient "github.com/prysmaticlabs/prysm/v5/consensus-types/light-client"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/network/forks"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/runtime/version"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+)
+
+func TestGetLightClientOptimisticUpdate(t *testing.T) {
+	update, err := lightclient.NewWrappedOptimisticUpdateAltair(&ethpb.LightClientOptimisticUpdateAltair{
+		AttestedHeader: &ethpb.LightClientHeaderAltair{Beacon: util.HydrateBeaconHeader(&ethpb.BeaconBlockHeader{Slot: 10})},
+		SyncAggregate:  &ethpb.SyncAggregate{SyncCommitteeBits: make([]byte, 64), SyncCommitteeSignature: make([]byte, fieldparams.BLSSignatureLength)},
+		SignatureSlot:  11,
+	})
+	require.NoError(t, err)
+	b, err := update.MarshalSSZ()
+	require.NoError(t, err)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, getLightClientOptimisticUpdatePath, r.URL.Path)
+		require.Equal(t, api.OctetStreamMediaType, r.Header.Get("Accept"))
+		w.Header().Set(api.VersionHeader, version.String(version.Altair))
+		_, err := w.Write(b)
+		require.NoError(t, err)
+	}))
+	defer srv.Close()
+	c, err := NewClient(srv.URL)
+	require.NoError(t, err)
+
+	got, err := c.GetLightClientOptimisticUpdate(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, version.Altair, got.Version())
+	require.DeepSSZEqual(t, update.Proto(), got.Proto())
+}
+
+func TestGetLightClientUpdatesByRange(t *testing.T) {
+	gvr := [32]byte{'a'}
+	epoch := params.BeaconConfig().AltairForkEpoch
+	digest, err := forks.ForkDigestFromEpoch(epoch, gvr[:])
+	require.NoError(t, err)
+
+	var body []byte
+	var expected [][]byte
+	for i := 0; i < 2; i++ {
+		update := testLightClientUpdateAltair(slots.UnsafeEpochStart(epoch) + primitives.Slot(i))
+		b, err := update.MarshalSSZ()
+		require.NoError(t, err)
+		expected = append(expected, b)
+		body = binary.LittleEndian.AppendUint64(body, uint64(len(digest)+len(b)))
+		body = append(body, digest[:]...)
+		body = append(body, b...)
+	}
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, getLightClientUpdatesPath, r.URL.Path)
+		require.Equal(t, "1", r.URL.Query().Get("start_period"))
+		require.Equal(t, "2", r.URL.Query().Get("count"))
+		_, err := w.Write(body)
+		require.NoError(t, err)
+	}))
+	defer srv.Close()
+	c, err := NewClient(srv.URL)
+	require.NoError(t, err)
+
+	updates, err := c.GetLightClientUpdatesByRange(context.Background(), 1, 2, gvr)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(updates))
+	for i, update := range updates {
+		require.Equal(t, version.Altair, update.Version())
+		b, err := update.MarshalSSZ()
+		require.NoError(t, err)
+		require.DeepEqual(t, expected[i], b)
+	}
+
+	_, err = c.GetLightClientUpdatesByRange(context.Background(), 1, 2, [32]byte{'b'})
+	require.ErrorContains(t, "unknown light client update fork digest", err)
+}
+
+func testLightClientUpdateAltair(attestedSlot primitives.Slot) *ethpb.LightClientUpdateAltair {
+	pubkeys := make([][]byte, params.BeaconConfig().SyncCommitteeSize)
+	for i := range pubkeys {
+		pubkeys[i] = make([]byte, fieldparams.BLSPubkeyLength)
+	}
+	syncCommitteeBranch := make([][]byte, fieldparams.SyncCommitteeBranchDepth)
+	for i := range syncCommitteeBranch {
+		syncCommitteeBranch[i] = make([]byte, fieldparams.RootLength)
+	}
+	finalityBranch := make([][]byte, fieldparams.FinalityBranchDepth)
+	for i := range finalityBranch {
+		finalityBranch[i] = make([]byte, fieldparams.RootLength)
+	}
+	return &ethpb.LightClientUpdateAltair{
+		AttestedHeader:          &ethpb.LightClientHeaderAltair{Beacon: util.HydrateBeaconHeader(&ethpb.BeaconBlockHeader{Slot: attestedSlot})},
+		NextSyncCommittee:       &ethpb.SyncCommittee{Pubkeys: pubkeys, AggregatePubkey: make([]byte, fieldparams.BLSPubkeyLength)},
+		NextSyncCommitteeBranch: syncCommitteeBranch,
+		FinalizedHeader:         &ethpb.LightClientHeaderAltair{Beacon: util.HydrateBeaconHeader(&ethpb.BeaconBlockHeader{})},
+		FinalityBranch:          finalityBranch,
+		SyncAggregate:           &ethpb.SyncAggregate{SyncCommitteeBits: make([]byte, 64), SyncCommitteeSignature: make([]byte, fieldparams.BLSSignatureLength)},
+		SignatureSlot:           attestedSlot + 1,
+	}
+}
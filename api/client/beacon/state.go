@@ -0,0 +1,69 @@
+package beacon
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+)
+
+const (
+	getValidatorsPath                = "/eth/v1/beacon/states/{{.Id}}/validators"
+	getPendingPartialWithdrawalsPath = "/eth/v1/beacon/states/{{.Id}}/pending_partial_withdrawals"
+	getPendingConsolidationsPath     = "/eth/v1/beacon/states/{{.Id}}/pending_consolidations"
+)
+
+var (
+	getValidatorsTpl                = idTemplate(getValidatorsPath)
+	getPendingPartialWithdrawalsTpl = idTemplate(getPendingPartialWithdrawalsPath)
+	getPendingConsolidationsTpl     = idTemplate(getPendingConsolidationsPath)
+)
+
+// GetValidators retrieves the validators of the given state. Validators can be filtered by index or hex encoded
+// public key with ids, and by status with statuses. All the validators are returned when no filter is given.
+func (c *Client) GetValidators(ctx context.Context, stateId StateOrBlockId, ids []string, statuses []string) ([]*structs.ValidatorContainer, error) {
+	query := url.Values{}
+	if len(ids) > 0 {
+		query["id"] = ids
+	}
+	if len(statuses) > 0 {
+		query["status"] = statuses
+	}
+	body, err := c.Get(ctx, getValidatorsTpl(stateId), withQuery(query))
+	if err != nil {
+		return nil, errors.Wrapf(err, "error requesting validators of state %s", stateId)
+	}
+	resp := &structs.GetValidatorsResponse{}
+	if err := json.Unmarshal(body, resp); err != nil {
+		return nil, errors.Wrap(err, "error decoding json response in GetValidators")
+	}
+	return resp.Data, nil
+}
+
+// GetPendingPartialWithdrawals retrieves the pending partial withdrawals queue of the given state.
+func (c *Client) GetPendingPartialWithdrawals(ctx context.Context, stateId StateOrBlockId) ([]*structs.PendingPartialWithdrawal, error) {
+	body, err := c.Get(ctx, getPendingPartialWithdrawalsTpl(stateId))
+	if err != nil {
+		return nil, errors.Wrapf(err, "error requesting pending partial withdrawals of state %s", stateId)
+	}
+	resp := &structs.GetPendingPartialWithdrawalsResponse{}
+	if err := json.Unmarshal(body, resp); err != nil {
+		return nil, errors.Wrap(err, "error decoding json response in GetPendingPartialWithdrawals")
+	}
+	return resp.Data, nil
+}
+
+// GetPendingConsolidations retrieves the pending consolidations queue of the given state.
+func (c *Client) GetPendingConsolidations(ctx context.Context, stateId StateOrBlockId) ([]*structs.PendingConsolidation, error) {
+	body, err := c.Get(ctx, getPendingConsolidationsTpl(stateId))
+	if err != nil {
+		return nil, errors.Wrapf(err, "error requesting pending consolidations of state %s", stateId)
+	}
+	resp := &structs.GetPendingConsolidationsResponse{}
+	if err := json.Unmarshal(body, resp); err != nil {
+		return nil, errors.Wrap(err, "error decoding json response in GetPendingConsolidations")
+	}
+	return resp.Data, nil
+}
@@ -0,0 +1,47 @@
+package beacon
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func TestGetValidators(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/eth/v1/beacon/states/head/validators", r.URL.Path)
+		require.DeepEqual(t, []string{"1", "0xabcd"}, r.URL.Query()["id"])
+		require.DeepEqual(t, []string{"active"}, r.URL.Query()["status"])
+		require.NoError(t, json.NewEncoder(w).Encode(&structs.GetValidatorsResponse{
+			Data: []*structs.ValidatorContainer{{Index: "1", Status: "active_ongoing"}},
+		}))
+	}))
+	defer srv.Close()
+	c, err := NewClient(srv.URL)
+	require.NoError(t, err)
+
+	vals, err := c.GetValidators(context.Background(), IdHead, []string{"1", "0xabcd"}, []string{"active"})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(vals))
+	require.Equal(t, "active_ongoing", vals[0].Status)
+}
+
+func TestGetPendingConsolidations(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/eth/v1/beacon/states/finalized/pending_consolidations", r.URL.Path)
+		require.NoError(t, json.NewEncoder(w).Encode(&structs.GetPendingConsolidationsResponse{
+			Data: []*structs.PendingConsolidation{{SourceIndex: "1", TargetIndex: "2"}},
+		}))
+	}))
+	defer srv.Close()
+	c, err := NewClient(srv.URL)
+	require.NoError(t, err)
+
+	pc, err := c.GetPendingConsolidations(context.Background(), IdFinalized)
+	require.NoError(t, err)
+	require.DeepEqual(t, []*structs.PendingConsolidation{{SourceIndex: "1", TargetIndex: "2"}}, pc)
+}
@@ -8,6 +8,7 @@ import (
 	"net/url"
 
 	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/api"
 )
 
 const (
@@ -79,27 +80,42 @@ func (c *Client) NodeURL() string {
 
 // Get is a generic, opinionated GET function to reduce boilerplate amongst the getters in this package.
 func (c *Client) Get(ctx context.Context, path string, opts ...ReqOption) ([]byte, error) {
+	b, _, err := c.get(ctx, path, opts...)
+	return b, err
+}
+
+// GetVersioned is like Get, and additionally returns the consensus version of the response body,
+// as given by the Eth-Consensus-Version header.
+func (c *Client) GetVersioned(ctx context.Context, path string, opts ...ReqOption) ([]byte, string, error) {
+	b, h, err := c.get(ctx, path, opts...)
+	if err != nil {
+		return nil, "", err
+	}
+	return b, h.Get(api.VersionHeader), nil
+}
+
+func (c *Client) get(ctx context.Context, path string, opts ...ReqOption) ([]byte, http.Header, error) {
 	u := c.baseURL.ResolveReference(&url.URL{Path: path})
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), http.NoBody)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	for _, o := range opts {
 		o(req)
 	}
 	r, err := c.hc.Do(req)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	defer func() {
 		err = r.Body.Close()
 	}()
 	if r.StatusCode != http.StatusOK {
-		return nil, Non200Err(r)
+		return nil, nil, Non200Err(r)
 	}
 	b, err := io.ReadAll(io.LimitReader(r.Body, c.maxBodySize))
 	if err != nil {
-		return nil, errors.Wrap(err, "error reading http response body")
+		return nil, nil, errors.Wrap(err, "error reading http response body")
 	}
-	return b, nil
+	return b, r.Header, nil
 }
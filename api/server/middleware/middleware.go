@@ -5,6 +5,7 @@ import (
 	"net/http"
 	"strings"
 
+	"github.com/prysmaticlabs/prysm/v5/network/httputil"
 	"github.com/rs/cors"
 )
 
@@ -45,7 +46,7 @@ func ContentTypeHandler(acceptedMediaTypes []string) Middleware {
 			}
 			contentType := r.Header.Get("Content-Type")
 			if contentType == "" {
-				http.Error(w, "Content-Type header is missing", http.StatusUnsupportedMediaType)
+				httputil.HandleError(w, "Content-Type header is missing", http.StatusUnsupportedMediaType)
 				return
 			}
 
@@ -58,7 +59,7 @@ func ContentTypeHandler(acceptedMediaTypes []string) Middleware {
 			}
 
 			if !accepted {
-				http.Error(w, fmt.Sprintf("Unsupported media type: %s", contentType), http.StatusUnsupportedMediaType)
+				httputil.HandleError(w, fmt.Sprintf("Unsupported media type: %s", contentType), http.StatusUnsupportedMediaType)
 				return
 			}
 
@@ -67,46 +68,15 @@ func ContentTypeHandler(acceptedMediaTypes []string) Middleware {
 	}
 }
 
-// AcceptHeaderHandler checks if the client's response preference is handled
+// AcceptHeaderHandler checks if the client's response preference is handled by the endpoint,
+// otherwise returning a http.StatusNotAcceptable error.
 func AcceptHeaderHandler(serverAcceptedTypes []string) Middleware {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-			acceptHeader := r.Header.Get("Accept")
-			// header is optional and should skip if not provided
-			if acceptHeader == "" {
-				next.ServeHTTP(w, r)
+			if _, ok := httputil.NegotiateContentType(r, serverAcceptedTypes...); !ok {
+				httputil.HandleNotAcceptable(w, r, serverAcceptedTypes)
 				return
 			}
-
-			accepted := false
-			acceptTypes := strings.Split(acceptHeader, ",")
-			// follows rules defined in https://datatracker.ietf.org/doc/html/rfc2616#section-14.1
-			for _, acceptType := range acceptTypes {
-				acceptType = strings.TrimSpace(acceptType)
-				if acceptType == "*/*" {
-					accepted = true
-					break
-				}
-				for _, serverAcceptedType := range serverAcceptedTypes {
-					if strings.HasPrefix(acceptType, serverAcceptedType) {
-						accepted = true
-						break
-					}
-					if acceptType != "/*" && strings.HasSuffix(acceptType, "/*") && strings.HasPrefix(serverAcceptedType, acceptType[:len(acceptType)-2]) {
-						accepted = true
-						break
-					}
-				}
-				if accepted {
-					break
-				}
-			}
-
-			if !accepted {
-				http.Error(w, fmt.Sprintf("Not Acceptable: %s", acceptHeader), http.StatusNotAcceptable)
-				return
-			}
-
 			next.ServeHTTP(w, r)
 		})
 	}
@@ -184,6 +184,11 @@ func TestAcceptHeaderHandler(t *testing.T) {
 			acceptHeader:       "application/",
 			expectedStatusCode: http.StatusNotAcceptable,
 		},
+		{
+			name:               "Types excluded with q=0 are unsupported",
+			acceptHeader:       "application/json;q=0, application/octet-stream;q=0",
+			expectedStatusCode: http.StatusNotAcceptable,
+		},
 	}
 
 	for _, tt := range tests {
@@ -199,6 +204,9 @@ func TestAcceptHeaderHandler(t *testing.T) {
 			if status := rr.Code; status != tt.expectedStatusCode {
 				t.Errorf("handler returned wrong status code: got %v want %v", status, tt.expectedStatusCode)
 			}
+			if tt.expectedStatusCode == http.StatusNotAcceptable {
+				require.Equal(t, api.JsonMediaType, rr.Header().Get("Content-Type"))
+			}
 		})
 	}
 }
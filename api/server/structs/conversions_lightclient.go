@@ -12,11 +12,11 @@ import (
 )
 
 func LightClientUpdateFromConsensus(update interfaces.LightClientUpdate) (*LightClientUpdate, error) {
-	attestedHeader, err := lightClientHeaderToJSON(update.AttestedHeader())
+	attestedHeader, err := LightClientHeaderToJSON(update.AttestedHeader())
 	if err != nil {
 		return nil, errors.Wrap(err, "could not marshal attested light client header")
 	}
-	finalizedHeader, err := lightClientHeaderToJSON(update.FinalizedHeader())
+	finalizedHeader, err := LightClientHeaderToJSON(update.FinalizedHeader())
 	if err != nil {
 		return nil, errors.Wrap(err, "could not marshal finalized light client header")
 	}
@@ -59,11 +59,11 @@ func LightClientUpdateFromConsensus(update interfaces.LightClientUpdate) (*Light
 }
 
 func LightClientFinalityUpdateFromConsensus(update interfaces.LightClientFinalityUpdate) (*LightClientFinalityUpdate, error) {
-	attestedHeader, err := lightClientHeaderToJSON(update.AttestedHeader())
+	attestedHeader, err := LightClientHeaderToJSON(update.AttestedHeader())
 	if err != nil {
 		return nil, errors.Wrap(err, "could not marshal attested light client header")
 	}
-	finalizedHeader, err := lightClientHeaderToJSON(update.FinalizedHeader())
+	finalizedHeader, err := LightClientHeaderToJSON(update.FinalizedHeader())
 	if err != nil {
 		return nil, errors.Wrap(err, "could not marshal finalized light client header")
 	}
@@ -93,7 +93,7 @@ func LightClientFinalityUpdateFromConsensus(update interfaces.LightClientFinalit
 }
 
 func LightClientOptimisticUpdateFromConsensus(update interfaces.LightClientOptimisticUpdate) (*LightClientOptimisticUpdate, error) {
-	attestedHeader, err := lightClientHeaderToJSON(update.AttestedHeader())
+	attestedHeader, err := LightClientHeaderToJSON(update.AttestedHeader())
 	if err != nil {
 		return nil, errors.Wrap(err, "could not marshal attested light client header")
 	}
@@ -116,7 +116,8 @@ func branchToJSON[S [][32]byte](branchBytes S) []string {
 	return branch
 }
 
-func lightClientHeaderToJSON(header interfaces.LightClientHeader) (json.RawMessage, error) {
+// LightClientHeaderToJSON converts a light client header of any fork to its JSON representation.
+func LightClientHeaderToJSON(header interfaces.LightClientHeader) (json.RawMessage, error) {
 	// In the case that a finalizedHeader is nil.
 	if header == nil {
 		return nil, nil
@@ -201,7 +202,7 @@ func lightClientHeaderToJSON(header interfaces.LightClientHeader) (json.RawMessa
 }
 
 func LightClientBootstrapFromConsensus(bootstrap interfaces.LightClientBootstrap) (*LightClientBootstrap, error) {
-	header, err := lightClientHeaderToJSON(bootstrap.Header())
+	header, err := LightClientHeaderToJSON(bootstrap.Header())
 	if err != nil {
 		return nil, errors.Wrap(err, "could not marshal light client header")
 	}
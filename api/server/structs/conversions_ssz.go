@@ -0,0 +1,297 @@
+package structs
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/api/server"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/validator"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+)
+
+func (c *ValidatorContainer) ToSsz() (*ValidatorContainerSsz, error) {
+	if c.Validator == nil {
+		return nil, server.NewDecodeError(errNilValue, "Validator")
+	}
+	index, err := strconv.ParseUint(c.Index, 10, 64)
+	if err != nil {
+		return nil, server.NewDecodeError(err, "Index")
+	}
+	balance, err := strconv.ParseUint(c.Balance, 10, 64)
+	if err != nil {
+		return nil, server.NewDecodeError(err, "Balance")
+	}
+	ok, status := validator.StatusFromString(c.Status)
+	if !ok {
+		return nil, server.NewDecodeError(fmt.Errorf("unknown status %s", c.Status), "Status")
+	}
+	pubkey, err := bytesutil.DecodeHexWithLength(c.Validator.Pubkey, fieldparams.BLSPubkeyLength)
+	if err != nil {
+		return nil, server.NewDecodeError(err, "Validator.Pubkey")
+	}
+	withdrawalCredentials, err := bytesutil.DecodeHexWithLength(c.Validator.WithdrawalCredentials, fieldparams.RootLength)
+	if err != nil {
+		return nil, server.NewDecodeError(err, "Validator.WithdrawalCredentials")
+	}
+	effectiveBalance, err := strconv.ParseUint(c.Validator.EffectiveBalance, 10, 64)
+	if err != nil {
+		return nil, server.NewDecodeError(err, "Validator.EffectiveBalance")
+	}
+	activationEligibilityEpoch, err := strconv.ParseUint(c.Validator.ActivationEligibilityEpoch, 10, 64)
+	if err != nil {
+		return nil, server.NewDecodeError(err, "Validator.ActivationEligibilityEpoch")
+	}
+	activationEpoch, err := strconv.ParseUint(c.Validator.ActivationEpoch, 10, 64)
+	if err != nil {
+		return nil, server.NewDecodeError(err, "Validator.ActivationEpoch")
+	}
+	exitEpoch, err := strconv.ParseUint(c.Validator.ExitEpoch, 10, 64)
+	if err != nil {
+		return nil, server.NewDecodeError(err, "Validator.ExitEpoch")
+	}
+	withdrawableEpoch, err := strconv.ParseUint(c.Validator.WithdrawableEpoch, 10, 64)
+	if err != nil {
+		return nil, server.NewDecodeError(err, "Validator.WithdrawableEpoch")
+	}
+	return &ValidatorContainerSsz{
+		Index:                      index,
+		Balance:                    balance,
+		Status:                     uint8(status), // lint:ignore uintcast -- Statuses are small non-negative constants.
+		Pubkey:                     pubkey,
+		WithdrawalCredentials:      withdrawalCredentials,
+		EffectiveBalance:           effectiveBalance,
+		Slashed:                    c.Validator.Slashed,
+		ActivationEligibilityEpoch: activationEligibilityEpoch,
+		ActivationEpoch:            activationEpoch,
+		ExitEpoch:                  exitEpoch,
+		WithdrawableEpoch:          withdrawableEpoch,
+	}, nil
+}
+
+func (b *ValidatorBalance) ToSsz() (*ValidatorBalanceSsz, error) {
+	index, err := strconv.ParseUint(b.Index, 10, 64)
+	if err != nil {
+		return nil, server.NewDecodeError(err, "Index")
+	}
+	balance, err := strconv.ParseUint(b.Balance, 10, 64)
+	if err != nil {
+		return nil, server.NewDecodeError(err, "Balance")
+	}
+	return &ValidatorBalanceSsz{Index: index, Balance: balance}, nil
+}
+
+func (c *Committee) ToSsz() (*CommitteeSsz, error) {
+	index, err := strconv.ParseUint(c.Index, 10, 64)
+	if err != nil {
+		return nil, server.NewDecodeError(err, "Index")
+	}
+	slot, err := strconv.ParseUint(c.Slot, 10, 64)
+	if err != nil {
+		return nil, server.NewDecodeError(err, "Slot")
+	}
+	validators, err := uint64sFromStrings(c.Validators, "Validators")
+	if err != nil {
+		return nil, err
+	}
+	return &CommitteeSsz{Index: index, Slot: slot, Validators: validators}, nil
+}
+
+func (d *AttesterDuty) ToSsz() (*AttesterDutySsz, error) {
+	pubkey, err := bytesutil.DecodeHexWithLength(d.Pubkey, fieldparams.BLSPubkeyLength)
+	if err != nil {
+		return nil, server.NewDecodeError(err, "Pubkey")
+	}
+	valIndex, err := strconv.ParseUint(d.ValidatorIndex, 10, 64)
+	if err != nil {
+		return nil, server.NewDecodeError(err, "ValidatorIndex")
+	}
+	committeeIndex, err := strconv.ParseUint(d.CommitteeIndex, 10, 64)
+	if err != nil {
+		return nil, server.NewDecodeError(err, "CommitteeIndex")
+	}
+	committeeLength, err := strconv.ParseUint(d.CommitteeLength, 10, 64)
+	if err != nil {
+		return nil, server.NewDecodeError(err, "CommitteeLength")
+	}
+	committeesAtSlot, err := strconv.ParseUint(d.CommitteesAtSlot, 10, 64)
+	if err != nil {
+		return nil, server.NewDecodeError(err, "CommitteesAtSlot")
+	}
+	valCommitteeIndex, err := strconv.ParseUint(d.ValidatorCommitteeIndex, 10, 64)
+	if err != nil {
+		return nil, server.NewDecodeError(err, "ValidatorCommitteeIndex")
+	}
+	slot, err := strconv.ParseUint(d.Slot, 10, 64)
+	if err != nil {
+		return nil, server.NewDecodeError(err, "Slot")
+	}
+	return &AttesterDutySsz{
+		Pubkey:                  pubkey,
+		ValidatorIndex:          valIndex,
+		CommitteeIndex:          committeeIndex,
+		CommitteeLength:         committeeLength,
+		CommitteesAtSlot:        committeesAtSlot,
+		ValidatorCommitteeIndex: valCommitteeIndex,
+		Slot:                    slot,
+	}, nil
+}
+
+func (d *ProposerDuty) ToSsz() (*ProposerDutySsz, error) {
+	pubkey, err := bytesutil.DecodeHexWithLength(d.Pubkey, fieldparams.BLSPubkeyLength)
+	if err != nil {
+		return nil, server.NewDecodeError(err, "Pubkey")
+	}
+	valIndex, err := strconv.ParseUint(d.ValidatorIndex, 10, 64)
+	if err != nil {
+		return nil, server.NewDecodeError(err, "ValidatorIndex")
+	}
+	slot, err := strconv.ParseUint(d.Slot, 10, 64)
+	if err != nil {
+		return nil, server.NewDecodeError(err, "Slot")
+	}
+	return &ProposerDutySsz{Pubkey: pubkey, ValidatorIndex: valIndex, Slot: slot}, nil
+}
+
+func (d *SyncCommitteeDuty) ToSsz() (*SyncCommitteeDutySsz, error) {
+	pubkey, err := bytesutil.DecodeHexWithLength(d.Pubkey, fieldparams.BLSPubkeyLength)
+	if err != nil {
+		return nil, server.NewDecodeError(err, "Pubkey")
+	}
+	valIndex, err := strconv.ParseUint(d.ValidatorIndex, 10, 64)
+	if err != nil {
+		return nil, server.NewDecodeError(err, "ValidatorIndex")
+	}
+	indices, err := uint64sFromStrings(d.ValidatorSyncCommitteeIndices, "ValidatorSyncCommitteeIndices")
+	if err != nil {
+		return nil, err
+	}
+	return &SyncCommitteeDutySsz{Pubkey: pubkey, ValidatorIndex: valIndex, ValidatorSyncCommitteeIndices: indices}, nil
+}
+
+func (r *BlockRewards) ToSsz() (*BlockRewardsSsz, error) {
+	proposerIndex, err := strconv.ParseUint(r.ProposerIndex, 10, 64)
+	if err != nil {
+		return nil, server.NewDecodeError(err, "ProposerIndex")
+	}
+	total, err := strconv.ParseUint(r.Total, 10, 64)
+	if err != nil {
+		return nil, server.NewDecodeError(err, "Total")
+	}
+	attestations, err := strconv.ParseUint(r.Attestations, 10, 64)
+	if err != nil {
+		return nil, server.NewDecodeError(err, "Attestations")
+	}
+	syncAggregate, err := strconv.ParseUint(r.SyncAggregate, 10, 64)
+	if err != nil {
+		return nil, server.NewDecodeError(err, "SyncAggregate")
+	}
+	proposerSlashings, err := strconv.ParseUint(r.ProposerSlashings, 10, 64)
+	if err != nil {
+		return nil, server.NewDecodeError(err, "ProposerSlashings")
+	}
+	attesterSlashings, err := strconv.ParseUint(r.AttesterSlashings, 10, 64)
+	if err != nil {
+		return nil, server.NewDecodeError(err, "AttesterSlashings")
+	}
+	return &BlockRewardsSsz{
+		ProposerIndex:     proposerIndex,
+		Total:             total,
+		Attestations:      attestations,
+		SyncAggregate:     syncAggregate,
+		ProposerSlashings: proposerSlashings,
+		AttesterSlashings: attesterSlashings,
+	}, nil
+}
+
+func (r *AttestationRewards) ToSsz() (*AttestationRewardsSsz, error) {
+	ideal := make([]*IdealAttestationRewardSsz, len(r.IdealRewards))
+	for i := range r.IdealRewards {
+		var err error
+		ideal[i], err = r.IdealRewards[i].ToSsz()
+		if err != nil {
+			return nil, server.NewDecodeError(err, fmt.Sprintf("IdealRewards[%d]", i))
+		}
+	}
+	total := make([]*TotalAttestationRewardSsz, len(r.TotalRewards))
+	for i := range r.TotalRewards {
+		var err error
+		total[i], err = r.TotalRewards[i].ToSsz()
+		if err != nil {
+			return nil, server.NewDecodeError(err, fmt.Sprintf("TotalRewards[%d]", i))
+		}
+	}
+	return &AttestationRewardsSsz{IdealRewards: ideal, TotalRewards: total}, nil
+}
+
+func (r *IdealAttestationReward) ToSsz() (*IdealAttestationRewardSsz, error) {
+	effectiveBalance, err := strconv.ParseUint(r.EffectiveBalance, 10, 64)
+	if err != nil {
+		return nil, server.NewDecodeError(err, "EffectiveBalance")
+	}
+	rewards, err := rewardsFromStrings(map[string]string{
+		"Head":       r.Head,
+		"Target":     r.Target,
+		"Source":     r.Source,
+		"Inactivity": r.Inactivity,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &IdealAttestationRewardSsz{
+		EffectiveBalance: effectiveBalance,
+		Head:             rewards["Head"],
+		Target:           rewards["Target"],
+		Source:           rewards["Source"],
+		Inactivity:       rewards["Inactivity"],
+	}, nil
+}
+
+func (r *TotalAttestationReward) ToSsz() (*TotalAttestationRewardSsz, error) {
+	valIndex, err := strconv.ParseUint(r.ValidatorIndex, 10, 64)
+	if err != nil {
+		return nil, server.NewDecodeError(err, "ValidatorIndex")
+	}
+	rewards, err := rewardsFromStrings(map[string]string{
+		"Head":       r.Head,
+		"Target":     r.Target,
+		"Source":     r.Source,
+		"Inactivity": r.Inactivity,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &TotalAttestationRewardSsz{
+		ValidatorIndex: valIndex,
+		Head:           rewards["Head"],
+		Target:         rewards["Target"],
+		Source:         rewards["Source"],
+		Inactivity:     rewards["Inactivity"],
+	}, nil
+}
+
+func uint64sFromStrings(values []string, field string) ([]uint64, error) {
+	result := make([]uint64, len(values))
+	for i, v := range values {
+		var err error
+		result[i], err = strconv.ParseUint(v, 10, 64)
+		if err != nil {
+			return nil, server.NewDecodeError(err, fmt.Sprintf("%s[%d]", field, i))
+		}
+	}
+	return result, nil
+}
+
+// rewardsFromStrings parses signed rewards into the two's complement of their value, keyed by field name.
+func rewardsFromStrings(values map[string]string) (map[string]uint64, error) {
+	result := make(map[string]uint64, len(values))
+	for field, v := range values {
+		reward, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return nil, server.NewDecodeError(errors.Wrap(err, "invalid reward"), field)
+		}
+		result[field] = uint64(reward)
+	}
+	return result, nil
+}
@@ -71,3 +71,8 @@ type LightClientOptimisticUpdateResponse struct {
 type LightClientUpdatesByRangeResponse struct {
 	Updates []*LightClientUpdateResponse `json:"updates"`
 }
+
+type LightClientHeaderResponse struct {
+	Version string          `json:"version"`
+	Data    json.RawMessage `json:"data"`
+}
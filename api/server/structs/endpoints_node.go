@@ -71,3 +71,143 @@ type AddrRequest struct {
 type PeersResponse struct {
 	Peers []*Peer `json:"peers"`
 }
+
+type GetPeerDetailsResponse struct {
+	Data *PeerDetails `json:"data"`
+}
+
+type ListPeerDetailsResponse struct {
+	Data []*PeerDetails `json:"data"`
+}
+
+type PeerDetails struct {
+	PeerId           string           `json:"peer_id"`
+	Enr              string           `json:"enr"`
+	Addresses        []string         `json:"addresses"`
+	State            string           `json:"state"`
+	Direction        string           `json:"direction"`
+	AgentVersion     string           `json:"agent_version"`
+	Trusted          bool             `json:"trusted"`
+	LastSeen         string           `json:"last_seen"`
+	NextValidTime    string           `json:"next_valid_time"`
+	DisconnectReason string           `json:"disconnect_reason"`
+	BadPeerReason    string           `json:"bad_peer_reason"`
+	Metadata         *PeerMetadata    `json:"metadata"`
+	ChainStatus      *PeerChainStatus `json:"chain_status"`
+	Scores           *PeerScores      `json:"scores"`
+	RateLimits       []*PeerRateLimit `json:"rate_limits"`
+}
+
+type PeerMetadata struct {
+	SeqNumber            string   `json:"seq_number"`
+	AttestationSubnets   []string `json:"attestation_subnets"`
+	SyncCommitteeSubnets []string `json:"sync_committee_subnets"`
+}
+
+type PeerChainStatus struct {
+	ForkDigest      string `json:"fork_digest"`
+	FinalizedRoot   string `json:"finalized_root"`
+	FinalizedEpoch  string `json:"finalized_epoch"`
+	HeadRoot        string `json:"head_root"`
+	HeadSlot        string `json:"head_slot"`
+	LastUpdated     string `json:"last_updated"`
+	ValidationError string `json:"validation_error"`
+}
+
+type PeerScores struct {
+	Overall       float64                 `json:"overall"`
+	BadResponses  *PeerBadResponsesScore  `json:"bad_responses"`
+	BlockProvider *PeerBlockProviderScore `json:"block_provider"`
+	PeerStatus    float64                 `json:"peer_status"`
+	Gossip        *PeerGossipScore        `json:"gossip"`
+}
+
+type PeerBadResponsesScore struct {
+	Count     int     `json:"count"`
+	Threshold int     `json:"threshold"`
+	Score     float64 `json:"score"`
+}
+
+type PeerBlockProviderScore struct {
+	ProcessedBlocks string  `json:"processed_blocks"`
+	Score           float64 `json:"score"`
+}
+
+type PeerGossipScore struct {
+	Score            float64           `json:"score"`
+	BehaviourPenalty float64           `json:"behaviour_penalty"`
+	Topics           []*PeerTopicScore `json:"topics"`
+}
+
+type PeerTopicScore struct {
+	Topic                    string                 `json:"topic"`
+	TimeInMesh               string                 `json:"time_in_mesh"`
+	FirstMessageDeliveries   float64                `json:"first_message_deliveries"`
+	MeshMessageDeliveries    float64                `json:"mesh_message_deliveries"`
+	InvalidMessageDeliveries float64                `json:"invalid_message_deliveries"`
+	Components               *GossipScoreComponents `json:"components,omitempty"`
+}
+
+type GossipScoreComponents struct {
+	TopicWeight              float64 `json:"topic_weight"`
+	TimeInMesh               float64 `json:"time_in_mesh"`
+	FirstMessageDeliveries   float64 `json:"first_message_deliveries"`
+	MeshMessageDeliveries    float64 `json:"mesh_message_deliveries"`
+	InvalidMessageDeliveries float64 `json:"invalid_message_deliveries"`
+	Score                    float64 `json:"score"`
+}
+
+type PeerRateLimit struct {
+	Topic     string `json:"topic"`
+	Capacity  string `json:"capacity"`
+	Remaining string `json:"remaining"`
+	TillEmpty string `json:"till_empty"`
+}
+
+type GetRateLimitsResponse struct {
+	Data *RateLimits `json:"data"`
+}
+
+type RateLimits struct {
+	Adaptive       bool              `json:"adaptive"`
+	LoadFactor     float64           `json:"load_factor"`
+	CpuUsage       float64           `json:"cpu_usage"`
+	DbReadLatency  string            `json:"db_read_latency"`
+	Quotas         []*RateLimitQuota `json:"quotas"`
+	ThrottledPeers []*ThrottledPeer  `json:"throttled_peers"`
+}
+
+type RateLimitQuota struct {
+	Class  string  `json:"class"`
+	Factor float64 `json:"factor"`
+}
+
+type ThrottledPeer struct {
+	PeerId        string `json:"peer_id"`
+	Class         string `json:"class"`
+	Topic         string `json:"topic"`
+	Count         string `json:"count"`
+	LastThrottled string `json:"last_throttled"`
+}
+
+type AddBanRequest struct {
+	Target   string `json:"target"`
+	Reason   string `json:"reason"`
+	Duration string `json:"duration,omitempty"`
+}
+
+type AddBanResponse struct {
+	Data *Ban `json:"data"`
+}
+
+type ListBansResponse struct {
+	Data []*Ban `json:"data"`
+}
+
+type Ban struct {
+	Target  string `json:"target"`
+	Kind    string `json:"kind"`
+	Reason  string `json:"reason"`
+	Created string `json:"created"`
+	Expiry  string `json:"expiry,omitempty"`
+}
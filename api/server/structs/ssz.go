@@ -0,0 +1,101 @@
+package structs
+
+//go:generate sszgen -path . -objs ValidatorContainerSsz,ValidatorBalanceSsz,CommitteeSsz,AttesterDutySsz,ProposerDutySsz,SyncCommitteeDutySsz,BlockRewardsSsz,AttestationRewardsSsz,IdealAttestationRewardSsz,TotalAttestationRewardSsz,SyncCommitteeRewardSsz -output ssz.ssz.go
+
+// The types below are the SSZ encodings of the data of REST API responses which have no consensus type, returned
+// when the client accepts application/octet-stream. Responses holding a list of them are encoded as an SSZ list.
+// Rewards can be negative, they are encoded as the two's complement of their int64 value.
+
+// ValidatorContainerSsz is the SSZ encoding of ValidatorContainer. The status is a validator.Status. The fields of
+// the validator are inlined, which encodes the same way as a nested Validator container.
+type ValidatorContainerSsz struct {
+	Index                      uint64
+	Balance                    uint64
+	Status                     uint8
+	Pubkey                     []byte `ssz-size:"48"`
+	WithdrawalCredentials      []byte `ssz-size:"32"`
+	EffectiveBalance           uint64
+	Slashed                    bool
+	ActivationEligibilityEpoch uint64
+	ActivationEpoch            uint64
+	ExitEpoch                  uint64
+	WithdrawableEpoch          uint64
+}
+
+// ValidatorBalanceSsz is the SSZ encoding of ValidatorBalance.
+type ValidatorBalanceSsz struct {
+	Index   uint64
+	Balance uint64
+}
+
+// CommitteeSsz is the SSZ encoding of Committee.
+type CommitteeSsz struct {
+	Index      uint64
+	Slot       uint64
+	Validators []uint64 `ssz-max:"2048"`
+}
+
+// AttesterDutySsz is the SSZ encoding of AttesterDuty.
+type AttesterDutySsz struct {
+	Pubkey                  []byte `ssz-size:"48"`
+	ValidatorIndex          uint64
+	CommitteeIndex          uint64
+	CommitteeLength         uint64
+	CommitteesAtSlot        uint64
+	ValidatorCommitteeIndex uint64
+	Slot                    uint64
+}
+
+// ProposerDutySsz is the SSZ encoding of ProposerDuty.
+type ProposerDutySsz struct {
+	Pubkey         []byte `ssz-size:"48"`
+	ValidatorIndex uint64
+	Slot           uint64
+}
+
+// SyncCommitteeDutySsz is the SSZ encoding of SyncCommitteeDuty.
+type SyncCommitteeDutySsz struct {
+	Pubkey                        []byte `ssz-size:"48"`
+	ValidatorIndex                uint64
+	ValidatorSyncCommitteeIndices []uint64 `ssz-max:"512"`
+}
+
+// BlockRewardsSsz is the SSZ encoding of BlockRewards.
+type BlockRewardsSsz struct {
+	ProposerIndex     uint64
+	Total             uint64
+	Attestations      uint64
+	SyncAggregate     uint64
+	ProposerSlashings uint64
+	AttesterSlashings uint64
+}
+
+// AttestationRewardsSsz is the SSZ encoding of AttestationRewards.
+type AttestationRewardsSsz struct {
+	IdealRewards []*IdealAttestationRewardSsz `ssz-max:"2048"`
+	TotalRewards []*TotalAttestationRewardSsz `ssz-max:"1099511627776"`
+}
+
+// IdealAttestationRewardSsz is the SSZ encoding of IdealAttestationReward.
+type IdealAttestationRewardSsz struct {
+	EffectiveBalance uint64
+	Head             uint64
+	Target           uint64
+	Source           uint64
+	Inactivity       uint64
+}
+
+// TotalAttestationRewardSsz is the SSZ encoding of TotalAttestationReward.
+type TotalAttestationRewardSsz struct {
+	ValidatorIndex uint64
+	Head           uint64
+	Target         uint64
+	Source         uint64
+	Inactivity     uint64
+}
+
+// SyncCommitteeRewardSsz is the SSZ encoding of SyncCommitteeReward.
+type SyncCommitteeRewardSsz struct {
+	ValidatorIndex uint64
+	Reward         uint64
+}
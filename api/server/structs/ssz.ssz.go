@@ -0,0 +1,1169 @@
+// Code generated by fastssz. DO NOT EDIT.
+package structs
+
+import (
+	ssz "github.com/prysmaticlabs/fastssz"
+)
+
+// MarshalSSZ ssz marshals the ValidatorContainerSsz object
+func (v *ValidatorContainerSsz) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(v)
+}
+
+// MarshalSSZTo ssz marshals the ValidatorContainerSsz object to a target array
+func (v *ValidatorContainerSsz) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'Index'
+	dst = ssz.MarshalUint64(dst, v.Index)
+
+	// Field (1) 'Balance'
+	dst = ssz.MarshalUint64(dst, v.Balance)
+
+	// Field (2) 'Status'
+	dst = ssz.MarshalUint8(dst, v.Status)
+
+	// Field (3) 'Pubkey'
+	if size := len(v.Pubkey); size != 48 {
+		err = ssz.ErrBytesLengthFn("--.Pubkey", size, 48)
+		return
+	}
+	dst = append(dst, v.Pubkey...)
+
+	// Field (4) 'WithdrawalCredentials'
+	if size := len(v.WithdrawalCredentials); size != 32 {
+		err = ssz.ErrBytesLengthFn("--.WithdrawalCredentials", size, 32)
+		return
+	}
+	dst = append(dst, v.WithdrawalCredentials...)
+
+	// Field (5) 'EffectiveBalance'
+	dst = ssz.MarshalUint64(dst, v.EffectiveBalance)
+
+	// Field (6) 'Slashed'
+	dst = ssz.MarshalBool(dst, v.Slashed)
+
+	// Field (7) 'ActivationEligibilityEpoch'
+	dst = ssz.MarshalUint64(dst, v.ActivationEligibilityEpoch)
+
+	// Field (8) 'ActivationEpoch'
+	dst = ssz.MarshalUint64(dst, v.ActivationEpoch)
+
+	// Field (9) 'ExitEpoch'
+	dst = ssz.MarshalUint64(dst, v.ExitEpoch)
+
+	// Field (10) 'WithdrawableEpoch'
+	dst = ssz.MarshalUint64(dst, v.WithdrawableEpoch)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the ValidatorContainerSsz object
+func (v *ValidatorContainerSsz) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 138 {
+		return ssz.ErrSize
+	}
+
+	// Field (0) 'Index'
+	v.Index = ssz.UnmarshallUint64(buf[0:8])
+
+	// Field (1) 'Balance'
+	v.Balance = ssz.UnmarshallUint64(buf[8:16])
+
+	// Field (2) 'Status'
+	v.Status = ssz.UnmarshallUint8(buf[16:17])
+
+	// Field (3) 'Pubkey'
+	if cap(v.Pubkey) == 0 {
+		v.Pubkey = make([]byte, 0, len(buf[17:65]))
+	}
+	v.Pubkey = append(v.Pubkey, buf[17:65]...)
+
+	// Field (4) 'WithdrawalCredentials'
+	if cap(v.WithdrawalCredentials) == 0 {
+		v.WithdrawalCredentials = make([]byte, 0, len(buf[65:97]))
+	}
+	v.WithdrawalCredentials = append(v.WithdrawalCredentials, buf[65:97]...)
+
+	// Field (5) 'EffectiveBalance'
+	v.EffectiveBalance = ssz.UnmarshallUint64(buf[97:105])
+
+	// Field (6) 'Slashed'
+	v.Slashed, err = ssz.DecodeBool(buf[105:106])
+	if err != nil {
+		return err
+	}
+
+	// Field (7) 'ActivationEligibilityEpoch'
+	v.ActivationEligibilityEpoch = ssz.UnmarshallUint64(buf[106:114])
+
+	// Field (8) 'ActivationEpoch'
+	v.ActivationEpoch = ssz.UnmarshallUint64(buf[114:122])
+
+	// Field (9) 'ExitEpoch'
+	v.ExitEpoch = ssz.UnmarshallUint64(buf[122:130])
+
+	// Field (10) 'WithdrawableEpoch'
+	v.WithdrawableEpoch = ssz.UnmarshallUint64(buf[130:138])
+
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the ValidatorContainerSsz object
+func (v *ValidatorContainerSsz) SizeSSZ() (size int) {
+	size = 138
+	return
+}
+
+// HashTreeRoot ssz hashes the ValidatorContainerSsz object
+func (v *ValidatorContainerSsz) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(v)
+}
+
+// HashTreeRootWith ssz hashes the ValidatorContainerSsz object with a hasher
+func (v *ValidatorContainerSsz) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Index'
+	hh.PutUint64(v.Index)
+
+	// Field (1) 'Balance'
+	hh.PutUint64(v.Balance)
+
+	// Field (2) 'Status'
+	hh.PutUint8(v.Status)
+
+	// Field (3) 'Pubkey'
+	if size := len(v.Pubkey); size != 48 {
+		err = ssz.ErrBytesLengthFn("--.Pubkey", size, 48)
+		return
+	}
+	hh.PutBytes(v.Pubkey)
+
+	// Field (4) 'WithdrawalCredentials'
+	if size := len(v.WithdrawalCredentials); size != 32 {
+		err = ssz.ErrBytesLengthFn("--.WithdrawalCredentials", size, 32)
+		return
+	}
+	hh.PutBytes(v.WithdrawalCredentials)
+
+	// Field (5) 'EffectiveBalance'
+	hh.PutUint64(v.EffectiveBalance)
+
+	// Field (6) 'Slashed'
+	hh.PutBool(v.Slashed)
+
+	// Field (7) 'ActivationEligibilityEpoch'
+	hh.PutUint64(v.ActivationEligibilityEpoch)
+
+	// Field (8) 'ActivationEpoch'
+	hh.PutUint64(v.ActivationEpoch)
+
+	// Field (9) 'ExitEpoch'
+	hh.PutUint64(v.ExitEpoch)
+
+	// Field (10) 'WithdrawableEpoch'
+	hh.PutUint64(v.WithdrawableEpoch)
+
+	hh.Merkleize(indx)
+	return
+}
+
+// MarshalSSZ ssz marshals the ValidatorBalanceSsz object
+func (v *ValidatorBalanceSsz) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(v)
+}
+
+// MarshalSSZTo ssz marshals the ValidatorBalanceSsz object to a target array
+func (v *ValidatorBalanceSsz) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'Index'
+	dst = ssz.MarshalUint64(dst, v.Index)
+
+	// Field (1) 'Balance'
+	dst = ssz.MarshalUint64(dst, v.Balance)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the ValidatorBalanceSsz object
+func (v *ValidatorBalanceSsz) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 16 {
+		return ssz.ErrSize
+	}
+
+	// Field (0) 'Index'
+	v.Index = ssz.UnmarshallUint64(buf[0:8])
+
+	// Field (1) 'Balance'
+	v.Balance = ssz.UnmarshallUint64(buf[8:16])
+
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the ValidatorBalanceSsz object
+func (v *ValidatorBalanceSsz) SizeSSZ() (size int) {
+	size = 16
+	return
+}
+
+// HashTreeRoot ssz hashes the ValidatorBalanceSsz object
+func (v *ValidatorBalanceSsz) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(v)
+}
+
+// HashTreeRootWith ssz hashes the ValidatorBalanceSsz object with a hasher
+func (v *ValidatorBalanceSsz) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Index'
+	hh.PutUint64(v.Index)
+
+	// Field (1) 'Balance'
+	hh.PutUint64(v.Balance)
+
+	hh.Merkleize(indx)
+	return
+}
+
+// MarshalSSZ ssz marshals the CommitteeSsz object
+func (c *CommitteeSsz) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(c)
+}
+
+// MarshalSSZTo ssz marshals the CommitteeSsz object to a target array
+func (c *CommitteeSsz) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(20)
+
+	// Field (0) 'Index'
+	dst = ssz.MarshalUint64(dst, c.Index)
+
+	// Field (1) 'Slot'
+	dst = ssz.MarshalUint64(dst, c.Slot)
+
+	// Offset (2) 'Validators'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(c.Validators) * 8
+
+	// Field (2) 'Validators'
+	if size := len(c.Validators); size > 2048 {
+		err = ssz.ErrListTooBigFn("--.Validators", size, 2048)
+		return
+	}
+	for ii := 0; ii < len(c.Validators); ii++ {
+		dst = ssz.MarshalUint64(dst, c.Validators[ii])
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the CommitteeSsz object
+func (c *CommitteeSsz) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 20 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o2 uint64
+
+	// Field (0) 'Index'
+	c.Index = ssz.UnmarshallUint64(buf[0:8])
+
+	// Field (1) 'Slot'
+	c.Slot = ssz.UnmarshallUint64(buf[8:16])
+
+	// Offset (2) 'Validators'
+	if o2 = ssz.ReadOffset(buf[16:20]); o2 > size {
+		return ssz.ErrOffset
+	}
+
+	if o2 != 20 {
+		return ssz.ErrInvalidVariableOffset
+	}
+
+	// Field (2) 'Validators'
+	{
+		buf = tail[o2:]
+		num, err := ssz.DivideInt2(len(buf), 8, 2048)
+		if err != nil {
+			return err
+		}
+		c.Validators = ssz.ExtendUint64(c.Validators, num)
+		for ii := 0; ii < num; ii++ {
+			c.Validators[ii] = ssz.UnmarshallUint64(buf[ii*8 : (ii+1)*8])
+		}
+	}
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the CommitteeSsz object
+func (c *CommitteeSsz) SizeSSZ() (size int) {
+	size = 20
+
+	// Field (2) 'Validators'
+	size += len(c.Validators) * 8
+
+	return
+}
+
+// HashTreeRoot ssz hashes the CommitteeSsz object
+func (c *CommitteeSsz) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(c)
+}
+
+// HashTreeRootWith ssz hashes the CommitteeSsz object with a hasher
+func (c *CommitteeSsz) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Index'
+	hh.PutUint64(c.Index)
+
+	// Field (1) 'Slot'
+	hh.PutUint64(c.Slot)
+
+	// Field (2) 'Validators'
+	{
+		if size := len(c.Validators); size > 2048 {
+			err = ssz.ErrListTooBigFn("--.Validators", size, 2048)
+			return
+		}
+		subIndx := hh.Index()
+		for _, i := range c.Validators {
+			hh.AppendUint64(i)
+		}
+		hh.FillUpTo32()
+
+		numItems := uint64(len(c.Validators))
+		hh.MerkleizeWithMixin(subIndx, numItems, ssz.CalculateLimit(2048, numItems, 8))
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// MarshalSSZ ssz marshals the AttesterDutySsz object
+func (a *AttesterDutySsz) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(a)
+}
+
+// MarshalSSZTo ssz marshals the AttesterDutySsz object to a target array
+func (a *AttesterDutySsz) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'Pubkey'
+	if size := len(a.Pubkey); size != 48 {
+		err = ssz.ErrBytesLengthFn("--.Pubkey", size, 48)
+		return
+	}
+	dst = append(dst, a.Pubkey...)
+
+	// Field (1) 'ValidatorIndex'
+	dst = ssz.MarshalUint64(dst, a.ValidatorIndex)
+
+	// Field (2) 'CommitteeIndex'
+	dst = ssz.MarshalUint64(dst, a.CommitteeIndex)
+
+	// Field (3) 'CommitteeLength'
+	dst = ssz.MarshalUint64(dst, a.CommitteeLength)
+
+	// Field (4) 'CommitteesAtSlot'
+	dst = ssz.MarshalUint64(dst, a.CommitteesAtSlot)
+
+	// Field (5) 'ValidatorCommitteeIndex'
+	dst = ssz.MarshalUint64(dst, a.ValidatorCommitteeIndex)
+
+	// Field (6) 'Slot'
+	dst = ssz.MarshalUint64(dst, a.Slot)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the AttesterDutySsz object
+func (a *AttesterDutySsz) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 96 {
+		return ssz.ErrSize
+	}
+
+	// Field (0) 'Pubkey'
+	if cap(a.Pubkey) == 0 {
+		a.Pubkey = make([]byte, 0, len(buf[0:48]))
+	}
+	a.Pubkey = append(a.Pubkey, buf[0:48]...)
+
+	// Field (1) 'ValidatorIndex'
+	a.ValidatorIndex = ssz.UnmarshallUint64(buf[48:56])
+
+	// Field (2) 'CommitteeIndex'
+	a.CommitteeIndex = ssz.UnmarshallUint64(buf[56:64])
+
+	// Field (3) 'CommitteeLength'
+	a.CommitteeLength = ssz.UnmarshallUint64(buf[64:72])
+
+	// Field (4) 'CommitteesAtSlot'
+	a.CommitteesAtSlot = ssz.UnmarshallUint64(buf[72:80])
+
+	// Field (5) 'ValidatorCommitteeIndex'
+	a.ValidatorCommitteeIndex = ssz.UnmarshallUint64(buf[80:88])
+
+	// Field (6) 'Slot'
+	a.Slot = ssz.UnmarshallUint64(buf[88:96])
+
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the AttesterDutySsz object
+func (a *AttesterDutySsz) SizeSSZ() (size int) {
+	size = 96
+	return
+}
+
+// HashTreeRoot ssz hashes the AttesterDutySsz object
+func (a *AttesterDutySsz) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(a)
+}
+
+// HashTreeRootWith ssz hashes the AttesterDutySsz object with a hasher
+func (a *AttesterDutySsz) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Pubkey'
+	if size := len(a.Pubkey); size != 48 {
+		err = ssz.ErrBytesLengthFn("--.Pubkey", size, 48)
+		return
+	}
+	hh.PutBytes(a.Pubkey)
+
+	// Field (1) 'ValidatorIndex'
+	hh.PutUint64(a.ValidatorIndex)
+
+	// Field (2) 'CommitteeIndex'
+	hh.PutUint64(a.CommitteeIndex)
+
+	// Field (3) 'CommitteeLength'
+	hh.PutUint64(a.CommitteeLength)
+
+	// Field (4) 'CommitteesAtSlot'
+	hh.PutUint64(a.CommitteesAtSlot)
+
+	// Field (5) 'ValidatorCommitteeIndex'
+	hh.PutUint64(a.ValidatorCommitteeIndex)
+
+	// Field (6) 'Slot'
+	hh.PutUint64(a.Slot)
+
+	hh.Merkleize(indx)
+	return
+}
+
+// MarshalSSZ ssz marshals the ProposerDutySsz object
+func (p *ProposerDutySsz) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(p)
+}
+
+// MarshalSSZTo ssz marshals the ProposerDutySsz object to a target array
+func (p *ProposerDutySsz) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'Pubkey'
+	if size := len(p.Pubkey); size != 48 {
+		err = ssz.ErrBytesLengthFn("--.Pubkey", size, 48)
+		return
+	}
+	dst = append(dst, p.Pubkey...)
+
+	// Field (1) 'ValidatorIndex'
+	dst = ssz.MarshalUint64(dst, p.ValidatorIndex)
+
+	// Field (2) 'Slot'
+	dst = ssz.MarshalUint64(dst, p.Slot)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the ProposerDutySsz object
+func (p *ProposerDutySsz) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 64 {
+		return ssz.ErrSize
+	}
+
+	// Field (0) 'Pubkey'
+	if cap(p.Pubkey) == 0 {
+		p.Pubkey = make([]byte, 0, len(buf[0:48]))
+	}
+	p.Pubkey = append(p.Pubkey, buf[0:48]...)
+
+	// Field (1) 'ValidatorIndex'
+	p.ValidatorIndex = ssz.UnmarshallUint64(buf[48:56])
+
+	// Field (2) 'Slot'
+	p.Slot = ssz.UnmarshallUint64(buf[56:64])
+
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the ProposerDutySsz object
+func (p *ProposerDutySsz) SizeSSZ() (size int) {
+	size = 64
+	return
+}
+
+// HashTreeRoot ssz hashes the ProposerDutySsz object
+func (p *ProposerDutySsz) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(p)
+}
+
+// HashTreeRootWith ssz hashes the ProposerDutySsz object with a hasher
+func (p *ProposerDutySsz) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Pubkey'
+	if size := len(p.Pubkey); size != 48 {
+		err = ssz.ErrBytesLengthFn("--.Pubkey", size, 48)
+		return
+	}
+	hh.PutBytes(p.Pubkey)
+
+	// Field (1) 'ValidatorIndex'
+	hh.PutUint64(p.ValidatorIndex)
+
+	// Field (2) 'Slot'
+	hh.PutUint64(p.Slot)
+
+	hh.Merkleize(indx)
+	return
+}
+
+// MarshalSSZ ssz marshals the SyncCommitteeDutySsz object
+func (s *SyncCommitteeDutySsz) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(s)
+}
+
+// MarshalSSZTo ssz marshals the SyncCommitteeDutySsz object to a target array
+func (s *SyncCommitteeDutySsz) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(60)
+
+	// Field (0) 'Pubkey'
+	if size := len(s.Pubkey); size != 48 {
+		err = ssz.ErrBytesLengthFn("--.Pubkey", size, 48)
+		return
+	}
+	dst = append(dst, s.Pubkey...)
+
+	// Field (1) 'ValidatorIndex'
+	dst = ssz.MarshalUint64(dst, s.ValidatorIndex)
+
+	// Offset (2) 'ValidatorSyncCommitteeIndices'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(s.ValidatorSyncCommitteeIndices) * 8
+
+	// Field (2) 'ValidatorSyncCommitteeIndices'
+	if size := len(s.ValidatorSyncCommitteeIndices); size > 512 {
+		err = ssz.ErrListTooBigFn("--.ValidatorSyncCommitteeIndices", size, 512)
+		return
+	}
+	for ii := 0; ii < len(s.ValidatorSyncCommitteeIndices); ii++ {
+		dst = ssz.MarshalUint64(dst, s.ValidatorSyncCommitteeIndices[ii])
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the SyncCommitteeDutySsz object
+func (s *SyncCommitteeDutySsz) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 60 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o2 uint64
+
+	// Field (0) 'Pubkey'
+	if cap(s.Pubkey) == 0 {
+		s.Pubkey = make([]byte, 0, len(buf[0:48]))
+	}
+	s.Pubkey = append(s.Pubkey, buf[0:48]...)
+
+	// Field (1) 'ValidatorIndex'
+	s.ValidatorIndex = ssz.UnmarshallUint64(buf[48:56])
+
+	// Offset (2) 'ValidatorSyncCommitteeIndices'
+	if o2 = ssz.ReadOffset(buf[56:60]); o2 > size {
+		return ssz.ErrOffset
+	}
+
+	if o2 != 60 {
+		return ssz.ErrInvalidVariableOffset
+	}
+
+	// Field (2) 'ValidatorSyncCommitteeIndices'
+	{
+		buf = tail[o2:]
+		num, err := ssz.DivideInt2(len(buf), 8, 512)
+		if err != nil {
+			return err
+		}
+		s.ValidatorSyncCommitteeIndices = ssz.ExtendUint64(s.ValidatorSyncCommitteeIndices, num)
+		for ii := 0; ii < num; ii++ {
+			s.ValidatorSyncCommitteeIndices[ii] = ssz.UnmarshallUint64(buf[ii*8 : (ii+1)*8])
+		}
+	}
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the SyncCommitteeDutySsz object
+func (s *SyncCommitteeDutySsz) SizeSSZ() (size int) {
+	size = 60
+
+	// Field (2) 'ValidatorSyncCommitteeIndices'
+	size += len(s.ValidatorSyncCommitteeIndices) * 8
+
+	return
+}
+
+// HashTreeRoot ssz hashes the SyncCommitteeDutySsz object
+func (s *SyncCommitteeDutySsz) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(s)
+}
+
+// HashTreeRootWith ssz hashes the SyncCommitteeDutySsz object with a hasher
+func (s *SyncCommitteeDutySsz) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Pubkey'
+	if size := len(s.Pubkey); size != 48 {
+		err = ssz.ErrBytesLengthFn("--.Pubkey", size, 48)
+		return
+	}
+	hh.PutBytes(s.Pubkey)
+
+	// Field (1) 'ValidatorIndex'
+	hh.PutUint64(s.ValidatorIndex)
+
+	// Field (2) 'ValidatorSyncCommitteeIndices'
+	{
+		if size := len(s.ValidatorSyncCommitteeIndices); size > 512 {
+			err = ssz.ErrListTooBigFn("--.ValidatorSyncCommitteeIndices", size, 512)
+			return
+		}
+		subIndx := hh.Index()
+		for _, i := range s.ValidatorSyncCommitteeIndices {
+			hh.AppendUint64(i)
+		}
+		hh.FillUpTo32()
+
+		numItems := uint64(len(s.ValidatorSyncCommitteeIndices))
+		hh.MerkleizeWithMixin(subIndx, numItems, ssz.CalculateLimit(512, numItems, 8))
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// MarshalSSZ ssz marshals the BlockRewardsSsz object
+func (b *BlockRewardsSsz) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(b)
+}
+
+// MarshalSSZTo ssz marshals the BlockRewardsSsz object to a target array
+func (b *BlockRewardsSsz) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'ProposerIndex'
+	dst = ssz.MarshalUint64(dst, b.ProposerIndex)
+
+	// Field (1) 'Total'
+	dst = ssz.MarshalUint64(dst, b.Total)
+
+	// Field (2) 'Attestations'
+	dst = ssz.MarshalUint64(dst, b.Attestations)
+
+	// Field (3) 'SyncAggregate'
+	dst = ssz.MarshalUint64(dst, b.SyncAggregate)
+
+	// Field (4) 'ProposerSlashings'
+	dst = ssz.MarshalUint64(dst, b.ProposerSlashings)
+
+	// Field (5) 'AttesterSlashings'
+	dst = ssz.MarshalUint64(dst, b.AttesterSlashings)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the BlockRewardsSsz object
+func (b *BlockRewardsSsz) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 48 {
+		return ssz.ErrSize
+	}
+
+	// Field (0) 'ProposerIndex'
+	b.ProposerIndex = ssz.UnmarshallUint64(buf[0:8])
+
+	// Field (1) 'Total'
+	b.Total = ssz.UnmarshallUint64(buf[8:16])
+
+	// Field (2) 'Attestations'
+	b.Attestations = ssz.UnmarshallUint64(buf[16:24])
+
+	// Field (3) 'SyncAggregate'
+	b.SyncAggregate = ssz.UnmarshallUint64(buf[24:32])
+
+	// Field (4) 'ProposerSlashings'
+	b.ProposerSlashings = ssz.UnmarshallUint64(buf[32:40])
+
+	// Field (5) 'AttesterSlashings'
+	b.AttesterSlashings = ssz.UnmarshallUint64(buf[40:48])
+
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the BlockRewardsSsz object
+func (b *BlockRewardsSsz) SizeSSZ() (size int) {
+	size = 48
+	return
+}
+
+// HashTreeRoot ssz hashes the BlockRewardsSsz object
+func (b *BlockRewardsSsz) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(b)
+}
+
+// HashTreeRootWith ssz hashes the BlockRewardsSsz object with a hasher
+func (b *BlockRewardsSsz) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'ProposerIndex'
+	hh.PutUint64(b.ProposerIndex)
+
+	// Field (1) 'Total'
+	hh.PutUint64(b.Total)
+
+	// Field (2) 'Attestations'
+	hh.PutUint64(b.Attestations)
+
+	// Field (3) 'SyncAggregate'
+	hh.PutUint64(b.SyncAggregate)
+
+	// Field (4) 'ProposerSlashings'
+	hh.PutUint64(b.ProposerSlashings)
+
+	// Field (5) 'AttesterSlashings'
+	hh.PutUint64(b.AttesterSlashings)
+
+	hh.Merkleize(indx)
+	return
+}
+
+// MarshalSSZ ssz marshals the AttestationRewardsSsz object
+func (a *AttestationRewardsSsz) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(a)
+}
+
+// MarshalSSZTo ssz marshals the AttestationRewardsSsz object to a target array
+func (a *AttestationRewardsSsz) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(8)
+
+	// Offset (0) 'IdealRewards'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(a.IdealRewards) * 40
+
+	// Offset (1) 'TotalRewards'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(a.TotalRewards) * 40
+
+	// Field (0) 'IdealRewards'
+	if size := len(a.IdealRewards); size > 2048 {
+		err = ssz.ErrListTooBigFn("--.IdealRewards", size, 2048)
+		return
+	}
+	for ii := 0; ii < len(a.IdealRewards); ii++ {
+		if dst, err = a.IdealRewards[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
+	// Field (1) 'TotalRewards'
+	if size := len(a.TotalRewards); size > 1099511627776 {
+		err = ssz.ErrListTooBigFn("--.TotalRewards", size, 1099511627776)
+		return
+	}
+	for ii := 0; ii < len(a.TotalRewards); ii++ {
+		if dst, err = a.TotalRewards[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the AttestationRewardsSsz object
+func (a *AttestationRewardsSsz) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 8 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o0, o1 uint64
+
+	// Offset (0) 'IdealRewards'
+	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
+		return ssz.ErrOffset
+	}
+
+	if o0 != 8 {
+		return ssz.ErrInvalidVariableOffset
+	}
+
+	// Offset (1) 'TotalRewards'
+	if o1 = ssz.ReadOffset(buf[4:8]); o1 > size || o0 > o1 {
+		return ssz.ErrOffset
+	}
+
+	// Field (0) 'IdealRewards'
+	{
+		buf = tail[o0:o1]
+		num, err := ssz.DivideInt2(len(buf), 40, 2048)
+		if err != nil {
+			return err
+		}
+		a.IdealRewards = make([]*IdealAttestationRewardSsz, num)
+		for ii := 0; ii < num; ii++ {
+			if a.IdealRewards[ii] == nil {
+				a.IdealRewards[ii] = new(IdealAttestationRewardSsz)
+			}
+			if err = a.IdealRewards[ii].UnmarshalSSZ(buf[ii*40 : (ii+1)*40]); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Field (1) 'TotalRewards'
+	{
+		buf = tail[o1:]
+		num, err := ssz.DivideInt2(len(buf), 40, 1099511627776)
+		if err != nil {
+			return err
+		}
+		a.TotalRewards = make([]*TotalAttestationRewardSsz, num)
+		for ii := 0; ii < num; ii++ {
+			if a.TotalRewards[ii] == nil {
+				a.TotalRewards[ii] = new(TotalAttestationRewardSsz)
+			}
+			if err = a.TotalRewards[ii].UnmarshalSSZ(buf[ii*40 : (ii+1)*40]); err != nil {
+				return err
+			}
+		}
+	}
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the AttestationRewardsSsz object
+func (a *AttestationRewardsSsz) SizeSSZ() (size int) {
+	size = 8
+
+	// Field (0) 'IdealRewards'
+	size += len(a.IdealRewards) * 40
+
+	// Field (1) 'TotalRewards'
+	size += len(a.TotalRewards) * 40
+
+	return
+}
+
+// HashTreeRoot ssz hashes the AttestationRewardsSsz object
+func (a *AttestationRewardsSsz) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(a)
+}
+
+// HashTreeRootWith ssz hashes the AttestationRewardsSsz object with a hasher
+func (a *AttestationRewardsSsz) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'IdealRewards'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(a.IdealRewards))
+		if num > 2048 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for _, elem := range a.IdealRewards {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		hh.MerkleizeWithMixin(subIndx, num, 2048)
+	}
+
+	// Field (1) 'TotalRewards'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(a.TotalRewards))
+		if num > 1099511627776 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for _, elem := range a.TotalRewards {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		hh.MerkleizeWithMixin(subIndx, num, 1099511627776)
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// MarshalSSZ ssz marshals the IdealAttestationRewardSsz object
+func (i *IdealAttestationRewardSsz) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(i)
+}
+
+// MarshalSSZTo ssz marshals the IdealAttestationRewardSsz object to a target array
+func (i *IdealAttestationRewardSsz) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'EffectiveBalance'
+	dst = ssz.MarshalUint64(dst, i.EffectiveBalance)
+
+	// Field (1) 'Head'
+	dst = ssz.MarshalUint64(dst, i.Head)
+
+	// Field (2) 'Target'
+	dst = ssz.MarshalUint64(dst, i.Target)
+
+	// Field (3) 'Source'
+	dst = ssz.MarshalUint64(dst, i.Source)
+
+	// Field (4) 'Inactivity'
+	dst = ssz.MarshalUint64(dst, i.Inactivity)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the IdealAttestationRewardSsz object
+func (i *IdealAttestationRewardSsz) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 40 {
+		return ssz.ErrSize
+	}
+
+	// Field (0) 'EffectiveBalance'
+	i.EffectiveBalance = ssz.UnmarshallUint64(buf[0:8])
+
+	// Field (1) 'Head'
+	i.Head = ssz.UnmarshallUint64(buf[8:16])
+
+	// Field (2) 'Target'
+	i.Target = ssz.UnmarshallUint64(buf[16:24])
+
+	// Field (3) 'Source'
+	i.Source = ssz.UnmarshallUint64(buf[24:32])
+
+	// Field (4) 'Inactivity'
+	i.Inactivity = ssz.UnmarshallUint64(buf[32:40])
+
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the IdealAttestationRewardSsz object
+func (i *IdealAttestationRewardSsz) SizeSSZ() (size int) {
+	size = 40
+	return
+}
+
+// HashTreeRoot ssz hashes the IdealAttestationRewardSsz object
+func (i *IdealAttestationRewardSsz) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(i)
+}
+
+// HashTreeRootWith ssz hashes the IdealAttestationRewardSsz object with a hasher
+func (i *IdealAttestationRewardSsz) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'EffectiveBalance'
+	hh.PutUint64(i.EffectiveBalance)
+
+	// Field (1) 'Head'
+	hh.PutUint64(i.Head)
+
+	// Field (2) 'Target'
+	hh.PutUint64(i.Target)
+
+	// Field (3) 'Source'
+	hh.PutUint64(i.Source)
+
+	// Field (4) 'Inactivity'
+	hh.PutUint64(i.Inactivity)
+
+	hh.Merkleize(indx)
+	return
+}
+
+// MarshalSSZ ssz marshals the TotalAttestationRewardSsz object
+func (t *TotalAttestationRewardSsz) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(t)
+}
+
+// MarshalSSZTo ssz marshals the TotalAttestationRewardSsz object to a target array
+func (t *TotalAttestationRewardSsz) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'ValidatorIndex'
+	dst = ssz.MarshalUint64(dst, t.ValidatorIndex)
+
+	// Field (1) 'Head'
+	dst = ssz.MarshalUint64(dst, t.Head)
+
+	// Field (2) 'Target'
+	dst = ssz.MarshalUint64(dst, t.Target)
+
+	// Field (3) 'Source'
+	dst = ssz.MarshalUint64(dst, t.Source)
+
+	// Field (4) 'Inactivity'
+	dst = ssz.MarshalUint64(dst, t.Inactivity)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the TotalAttestationRewardSsz object
+func (t *TotalAttestationRewardSsz) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 40 {
+		return ssz.ErrSize
+	}
+
+	// Field (0) 'ValidatorIndex'
+	t.ValidatorIndex = ssz.UnmarshallUint64(buf[0:8])
+
+	// Field (1) 'Head'
+	t.Head = ssz.UnmarshallUint64(buf[8:16])
+
+	// Field (2) 'Target'
+	t.Target = ssz.UnmarshallUint64(buf[16:24])
+
+	// Field (3) 'Source'
+	t.Source = ssz.UnmarshallUint64(buf[24:32])
+
+	// Field (4) 'Inactivity'
+	t.Inactivity = ssz.UnmarshallUint64(buf[32:40])
+
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the TotalAttestationRewardSsz object
+func (t *TotalAttestationRewardSsz) SizeSSZ() (size int) {
+	size = 40
+	return
+}
+
+// HashTreeRoot ssz hashes the TotalAttestationRewardSsz object
+func (t *TotalAttestationRewardSsz) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(t)
+}
+
+// HashTreeRootWith ssz hashes the TotalAttestationRewardSsz object with a hasher
+func (t *TotalAttestationRewardSsz) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'ValidatorIndex'
+	hh.PutUint64(t.ValidatorIndex)
+
+	// Field (1) 'Head'
+	hh.PutUint64(t.Head)
+
+	// Field (2) 'Target'
+	hh.PutUint64(t.Target)
+
+	// Field (3) 'Source'
+	hh.PutUint64(t.Source)
+
+	// Field (4) 'Inactivity'
+	hh.PutUint64(t.Inactivity)
+
+	hh.Merkleize(indx)
+	return
+}
+
+// MarshalSSZ ssz marshals the SyncCommitteeRewardSsz object
+func (s *SyncCommitteeRewardSsz) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(s)
+}
+
+// MarshalSSZTo ssz marshals the SyncCommitteeRewardSsz object to a target array
+func (s *SyncCommitteeRewardSsz) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'ValidatorIndex'
+	dst = ssz.MarshalUint64(dst, s.ValidatorIndex)
+
+	// Field (1) 'Reward'
+	dst = ssz.MarshalUint64(dst, s.Reward)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the SyncCommitteeRewardSsz object
+func (s *SyncCommitteeRewardSsz) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 16 {
+		return ssz.ErrSize
+	}
+
+	// Field (0) 'ValidatorIndex'
+	s.ValidatorIndex = ssz.UnmarshallUint64(buf[0:8])
+
+	// Field (1) 'Reward'
+	s.Reward = ssz.UnmarshallUint64(buf[8:16])
+
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the SyncCommitteeRewardSsz object
+func (s *SyncCommitteeRewardSsz) SizeSSZ() (size int) {
+	size = 16
+	return
+}
+
+// HashTreeRoot ssz hashes the SyncCommitteeRewardSsz object
+func (s *SyncCommitteeRewardSsz) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(s)
+}
+
+// HashTreeRootWith ssz hashes the SyncCommitteeRewardSsz object with a hasher
+func (s *SyncCommitteeRewardSsz) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'ValidatorIndex'
+	hh.PutUint64(s.ValidatorIndex)
+
+	// Field (1) 'Reward'
+	hh.PutUint64(s.Reward)
+
+	hh.Merkleize(indx)
+	return
+}
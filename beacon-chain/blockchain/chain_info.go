@@ -119,6 +119,12 @@ type OptimisticModeFetcher interface {
 	IsOptimisticForRoot(ctx context.Context, root [32]byte) (bool, error)
 }
 
+// LightClientUpdateFetcher retrieves the latest light client updates computed from the blocks processed by the node.
+type LightClientUpdateFetcher interface {
+	LightClientFinalityUpdate() interfaces.LightClientFinalityUpdate
+	LightClientOptimisticUpdate() interfaces.LightClientOptimisticUpdate
+}
+
 // FinalizedCheckpt returns the latest finalized checkpoint from chain store.
 func (s *Service) FinalizedCheckpt() *ethpb.Checkpoint {
 	s.cfg.ForkChoiceStore.RLock()
@@ -543,6 +549,20 @@ func (s *Service) RecentBlockSlot(root [32]byte) (primitives.Slot, error) {
 	return s.cfg.ForkChoiceStore.Slot(root)
 }
 
+// LightClientFinalityUpdate returns the latest light client finality update computed by the node, or nil if there is none.
+func (s *Service) LightClientFinalityUpdate() interfaces.LightClientFinalityUpdate {
+	s.lcUpdatesLock.RLock()
+	defer s.lcUpdatesLock.RUnlock()
+	return s.lcFinalityUpdate
+}
+
+// LightClientOptimisticUpdate returns the latest light client optimistic update computed by the node, or nil if there is none.
+func (s *Service) LightClientOptimisticUpdate() interfaces.LightClientOptimisticUpdate {
+	s.lcUpdatesLock.RLock()
+	defer s.lcUpdatesLock.RUnlock()
+	return s.lcOptimisticUpdate
+}
+
 // inRegularSync queries the initial sync service to
 // determine if the node is in regular sync or is still
 // syncing to the head of the chain.
@@ -0,0 +1,117 @@
+package kzg
+
+import (
+	ckzg4844 "github.com/ethereum/c-kzg-4844/v2/bindings/go"
+	"github.com/pkg/errors"
+)
+
+const (
+	// BytesPerCell is the size of a cell of an extended blob, as defined by BYTES_PER_CELL in the spec.
+	BytesPerCell = ckzg4844.BytesPerCell
+	// CellsPerExtBlob is the number of cells of an extended blob, as defined by CELLS_PER_EXT_BLOB in the spec.
+	CellsPerExtBlob = ckzg4844.CellsPerExtBlob
+)
+
+var errCellSize = errors.New("cell has an invalid size")
+
+// CellsAndProofs holds the cells of an extended blob, along with the KZG proof of each cell.
+type CellsAndProofs struct {
+	Cells  [][]byte
+	Proofs [][]byte
+}
+
+// BlobToKZGCommitment computes the KZG commitment of a blob.
+func BlobToKZGCommitment(blob []byte) ([]byte, error) {
+	if len(blob) != ckzg4844.BytesPerBlob {
+		return nil, errors.Errorf("blob has an invalid size %d", len(blob))
+	}
+	commitment, err := kzgContext.BlobToKZGCommitment(bytesToBlob(blob), 0)
+	if err != nil {
+		return nil, err
+	}
+	return commitment[:], nil
+}
+
+// ComputeCellsAndKZGProofs extends a blob and computes its cells along with their KZG proofs.
+func ComputeCellsAndKZGProofs(blob []byte) (CellsAndProofs, error) {
+	if len(blob) != ckzg4844.BytesPerBlob {
+		return CellsAndProofs{}, errors.Errorf("blob has an invalid size %d", len(blob))
+	}
+	var b ckzg4844.Blob
+	copy(b[:], blob)
+	cells, proofs, err := ckzg4844.ComputeCellsAndKZGProofs(&b)
+	if err != nil {
+		return CellsAndProofs{}, errors.Wrap(err, "could not compute cells")
+	}
+	return toCellsAndProofs(cells, proofs), nil
+}
+
+// RecoverCellsAndKZGProofs recovers every cell of an extended blob, along with their KZG proofs,
+// from at least half of its cells. cellIndices holds the index of each of the given cells.
+func RecoverCellsAndKZGProofs(cellIndices []uint64, cells [][]byte) (CellsAndProofs, error) {
+	ckzgCells, err := toCells(cells)
+	if err != nil {
+		return CellsAndProofs{}, err
+	}
+	recovered, proofs, err := ckzg4844.RecoverCellsAndKZGProofs(cellIndices, ckzgCells)
+	if err != nil {
+		return CellsAndProofs{}, errors.Wrap(err, "could not recover cells")
+	}
+	return toCellsAndProofs(recovered, proofs), nil
+}
+
+// VerifyCellKZGProofBatch verifies the KZG proofs of a batch of cells. The cell at position i is the cell
+// cellIndices[i] of the blob committed to by commitments[i], and proofs[i] is its proof.
+func VerifyCellKZGProofBatch(commitments [][]byte, cellIndices []uint64, cells [][]byte, proofs [][]byte) (bool, error) {
+	if len(commitments) != len(cells) || len(cellIndices) != len(cells) || len(proofs) != len(cells) {
+		return false, errors.Errorf("mismatched batch lengths, %d commitments, %d indices, %d cells and %d proofs",
+			len(commitments), len(cellIndices), len(cells), len(proofs))
+	}
+	ckzgCells, err := toCells(cells)
+	if err != nil {
+		return false, err
+	}
+	ckzgCommitments, err := toBytes48(commitments)
+	if err != nil {
+		return false, errors.Wrap(err, "invalid commitment")
+	}
+	ckzgProofs, err := toBytes48(proofs)
+	if err != nil {
+		return false, errors.Wrap(err, "invalid proof")
+	}
+	return ckzg4844.VerifyCellKZGProofBatch(ckzgCommitments, cellIndices, ckzgCells, ckzgProofs)
+}
+
+func toCells(cells [][]byte) ([]ckzg4844.Cell, error) {
+	ckzgCells := make([]ckzg4844.Cell, len(cells))
+	for i, cell := range cells {
+		if len(cell) != BytesPerCell {
+			return nil, errors.Wrapf(errCellSize, "cell %d has %d bytes", i, len(cell))
+		}
+		copy(ckzgCells[i][:], cell)
+	}
+	return ckzgCells, nil
+}
+
+func toBytes48(values [][]byte) ([]ckzg4844.Bytes48, error) {
+	ret := make([]ckzg4844.Bytes48, len(values))
+	for i, v := range values {
+		if len(v) != len(ret[i]) {
+			return nil, errors.Errorf("value %d has %d bytes", i, len(v))
+		}
+		copy(ret[i][:], v)
+	}
+	return ret, nil
+}
+
+func toCellsAndProofs(cells [CellsPerExtBlob]ckzg4844.Cell, proofs [CellsPerExtBlob]ckzg4844.KZGProof) CellsAndProofs {
+	ret := CellsAndProofs{
+		Cells:  make([][]byte, CellsPerExtBlob),
+		Proofs: make([][]byte, CellsPerExtBlob),
+	}
+	for i := range cells {
+		ret.Cells[i] = cells[i][:]
+		ret.Proofs[i] = proofs[i][:]
+	}
+	return ret
+}
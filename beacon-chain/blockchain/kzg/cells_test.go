@@ -0,0 +1,89 @@
+package kzg
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
+	GoKZG "github.com/crate-crypto/go-kzg-4844"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+// getRandBlob returns a random blob using the passed seed as entropy. It mirrors util.GetRandBlob, which can't be
+// used here since the test utilities depend on this package.
+func getRandBlob(seed int64) GoKZG.Blob {
+	var blob GoKZG.Blob
+	for i := 0; i < len(blob); i += GoKZG.SerializedScalarSize {
+		var buf [8]byte
+		binary.BigEndian.PutUint64(buf[:], uint64(seed+int64(i)))
+		h := sha256.Sum256(buf[:])
+		var r fr.Element
+		r.SetBytes(h[:])
+		element := GoKZG.SerializeScalar(r)
+		copy(blob[i:i+GoKZG.SerializedScalarSize], element[:])
+	}
+	return blob
+}
+
+func TestCells(t *testing.T) {
+	require.NoError(t, Start())
+	blobs := []GoKZG.Blob{getRandBlob(1), getRandBlob(2)}
+	commitments := make([][]byte, len(blobs))
+	extended := make([]CellsAndProofs, len(blobs))
+	for i := range blobs {
+		var err error
+		commitments[i], err = BlobToKZGCommitment(blobs[i][:])
+		require.NoError(t, err)
+		extended[i], err = ComputeCellsAndKZGProofs(blobs[i][:])
+		require.NoError(t, err)
+		require.Equal(t, CellsPerExtBlob, len(extended[i].Cells))
+		require.Equal(t, CellsPerExtBlob, len(extended[i].Proofs))
+	}
+
+	t.Run("verify", func(t *testing.T) {
+		var cmts, cells, proofs [][]byte
+		var indices []uint64
+		for i := range blobs {
+			for _, idx := range []uint64{0, 5, 127} {
+				cmts = append(cmts, commitments[i])
+				indices = append(indices, idx)
+				cells = append(cells, extended[i].Cells[idx])
+				proofs = append(proofs, extended[i].Proofs[idx])
+			}
+		}
+		ok, err := VerifyCellKZGProofBatch(cmts, indices, cells, proofs)
+		require.NoError(t, err)
+		require.Equal(t, true, ok)
+
+		// A cell verified against the wrong index is rejected.
+		indices[0] = 1
+		ok, err = VerifyCellKZGProofBatch(cmts, indices, cells, proofs)
+		require.NoError(t, err)
+		require.Equal(t, false, ok)
+	})
+	t.Run("verify mismatched lengths", func(t *testing.T) {
+		_, err := VerifyCellKZGProofBatch(commitments, []uint64{0}, extended[0].Cells[:1], extended[0].Proofs[:1])
+		require.ErrorContains(t, "mismatched batch lengths", err)
+	})
+	t.Run("verify invalid cell size", func(t *testing.T) {
+		_, err := VerifyCellKZGProofBatch(commitments[:1], []uint64{0}, [][]byte{{1}}, extended[0].Proofs[:1])
+		require.ErrorIs(t, err, errCellSize)
+	})
+	t.Run("recover", func(t *testing.T) {
+		// Half of the cells, the odd ones, are enough to recover every cell.
+		var indices []uint64
+		var cells [][]byte
+		for i := uint64(1); i < CellsPerExtBlob; i += 2 {
+			indices = append(indices, i)
+			cells = append(cells, extended[0].Cells[i])
+		}
+		recovered, err := RecoverCellsAndKZGProofs(indices, cells)
+		require.NoError(t, err)
+		require.DeepEqual(t, extended[0], recovered)
+	})
+	t.Run("recover too few cells", func(t *testing.T) {
+		_, err := RecoverCellsAndKZGProofs([]uint64{0, 1}, extended[0].Cells[:2])
+		require.ErrorContains(t, "could not recover cells", err)
+	})
+}
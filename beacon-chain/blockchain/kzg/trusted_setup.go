@@ -2,9 +2,13 @@ package kzg
 
 import (
 	_ "embed"
+	"encoding/hex"
 	"encoding/json"
+	"strings"
+	"sync"
 
 	GoKZG "github.com/crate-crypto/go-kzg-4844"
+	ckzg4844 "github.com/ethereum/c-kzg-4844/v2/bindings/go"
 	"github.com/pkg/errors"
 )
 
@@ -12,8 +16,18 @@ var (
 	//go:embed trusted_setup.json
 	embeddedTrustedSetup []byte // 1.2Mb
 	kzgContext           *GoKZG.Context
+	// The cell KZG library keeps a single global trusted setup, which can only be loaded once.
+	cellSetupOnce sync.Once
+	errCellSetup  error
 )
 
+// trustedSetup is the trusted setup, including the monomial form of its G1 points used for cell proofs.
+type trustedSetup struct {
+	G1Monomial []string `json:"g1_monomial"`
+	G1Lagrange []string `json:"g1_lagrange"`
+	G2Monomial []string `json:"g2_monomial"`
+}
+
 func Start() error {
 	parsedSetup := GoKZG.JSONTrustedSetup{}
 	err := json.Unmarshal(embeddedTrustedSetup, &parsedSetup)
@@ -24,5 +38,44 @@ func Start() error {
 	if err != nil {
 		return errors.Wrap(err, "could not initialize go-kzg context")
 	}
+	cellSetupOnce.Do(func() {
+		errCellSetup = loadCellTrustedSetup()
+	})
+	return errCellSetup
+}
+
+func loadCellTrustedSetup() error {
+	setup := trustedSetup{}
+	if err := json.Unmarshal(embeddedTrustedSetup, &setup); err != nil {
+		return errors.Wrap(err, "could not parse trusted setup JSON")
+	}
+	g1Monomial, err := decodePoints(setup.G1Monomial)
+	if err != nil {
+		return errors.Wrap(err, "could not decode G1 monomial points")
+	}
+	g1Lagrange, err := decodePoints(setup.G1Lagrange)
+	if err != nil {
+		return errors.Wrap(err, "could not decode G1 lagrange points")
+	}
+	g2Monomial, err := decodePoints(setup.G2Monomial)
+	if err != nil {
+		return errors.Wrap(err, "could not decode G2 monomial points")
+	}
+	if err := ckzg4844.LoadTrustedSetup(g1Monomial, g1Lagrange, g2Monomial, 0); err != nil {
+		return errors.Wrap(err, "could not load cell KZG trusted setup")
+	}
 	return nil
 }
+
+// decodePoints concatenates hex encoded points.
+func decodePoints(points []string) ([]byte, error) {
+	var ret []byte
+	for _, p := range points {
+		b, err := hex.DecodeString(strings.TrimPrefix(p, "0x"))
+		if err != nil {
+			return nil, err
+		}
+		ret = append(ret, b...)
+	}
+	return ret, nil
+}
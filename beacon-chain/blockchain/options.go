@@ -207,6 +207,23 @@ func WithBlobStorage(b *filesystem.BlobStorage) Option {
 	}
 }
 
+// WithDataColumnStorage sets the data column storage backend for the blockchain service.
+func WithDataColumnStorage(b *filesystem.DataColumnStorage) Option {
+	return func(s *Service) error {
+		s.dataColumnStorage = b
+		return nil
+	}
+}
+
+// WithCustodyColumns sets the indices of the data columns the node custodies. The data availability
+// check of blocks from the Fulu fork on waits for these columns.
+func WithCustodyColumns(columns map[uint64]bool) Option {
+	return func(s *Service) error {
+		s.custodyColumns = columns
+		return nil
+	}
+}
+
 func WithSyncChecker(checker Checker) Option {
 	return func(s *Service) error {
 		s.cfg.SyncChecker = checker
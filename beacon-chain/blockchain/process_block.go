@@ -8,6 +8,7 @@ import (
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/blocks"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/peerdas"
 	coreTime "github.com/prysmaticlabs/prysm/v5/beacon-chain/core/time"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/transition"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/das"
@@ -599,6 +600,122 @@ func (s *Service) isDataAvailable(ctx context.Context, root [32]byte, signed int
 	}
 }
 
+// areDataColumnsAvailable blocks until all the data columns custodied by the node are available for the block,
+// or an error or context cancellation occurs. A nil result means that the data availability check is successful.
+// It works like isDataAvailable, reading from the dataColumnNotifiers channel the indices of the data columns
+// saved by ReceiveDataColumn.
+func (s *Service) areDataColumnsAvailable(ctx context.Context, root [32]byte, signed interfaces.ReadOnlySignedBeaconBlock) error {
+	block := signed.Block()
+	if block == nil {
+		return errors.New("invalid nil beacon block")
+	}
+	// We are only required to check within MIN_EPOCHS_FOR_DATA_COLUMN_SIDECARS_REQUESTS
+	if !params.WithinDataColumnDAPeriod(slots.ToEpoch(block.Slot()), slots.ToEpoch(s.CurrentSlot())) {
+		return nil
+	}
+
+	body := block.Body()
+	if body == nil {
+		return errors.New("invalid nil beacon block body")
+	}
+	kzgCommitments, err := body.BlobKzgCommitments()
+	if err != nil {
+		return errors.Wrap(err, "could not get KZG commitments")
+	}
+	// A block without any commitment has no data column.
+	if len(kzgCommitments) == 0 || len(s.custodyColumns) == 0 {
+		return nil
+	}
+	if s.dataColumnStorage == nil {
+		return errors.New("data column storage is not configured")
+	}
+	// get a map of the custody columns that are not currently available.
+	summary := s.dataColumnStorage.Summary(root)
+	missing := make(map[uint64]bool, len(s.custodyColumns))
+	for idx := range s.custodyColumns {
+		if !summary.HasIndex(idx) {
+			missing[idx] = true
+		}
+	}
+	if len(missing) == 0 {
+		return nil
+	}
+	// Once at least half of all the columns are saved, the missing custody columns are reconstructed from them.
+	reconstructed, err := s.reconstructDataColumns(ctx, root, signed, summary.Count())
+	if err != nil || reconstructed {
+		return err
+	}
+
+	// The gossip handler for data columns writes the index of each verified data column referencing the given
+	// root to the channel returned by dataColumnNotifiers.forRoot.
+	nc := s.dataColumnNotifiers.forRoot(root, block.Slot())
+
+	// Log for DA checks that cross over into the next slot; helpful for debugging.
+	nextSlot := slots.BeginsAt(block.Slot()+1, s.genesisTime)
+	// Avoid logging if DA check is called after next slot start.
+	if nextSlot.After(time.Now()) {
+		nst := time.AfterFunc(time.Until(nextSlot), func() {
+			if len(missing) == 0 {
+				return
+			}
+			log.WithFields(logrus.Fields{
+				"slot":            block.Slot(),
+				"root":            fmt.Sprintf("%#x", root),
+				"columnsExpected": len(s.custodyColumns),
+				"columnsWaiting":  len(missing),
+			}).Error("Still waiting for data columns DA check at slot end.")
+		})
+		defer nst.Stop()
+	}
+	for {
+		select {
+		case idx := <-nc:
+			delete(missing, idx)
+			if len(missing) > 0 {
+				reconstructed, err := s.reconstructDataColumns(ctx, root, signed, s.dataColumnStorage.Summary(root).Count())
+				if err != nil {
+					return err
+				}
+				if !reconstructed {
+					continue
+				}
+			}
+			s.dataColumnNotifiers.delete(root)
+			return nil
+		case <-ctx.Done():
+			return errors.Wrapf(ctx.Err(), "context deadline waiting for data column sidecars slot: %d, BlockRoot: %#x", block.Slot(), root)
+		}
+	}
+}
+
+// reconstructDataColumns reconstructs and saves the missing custody columns of the block, given the number of its
+// columns already saved. It returns false without doing anything while fewer than half of all the columns are saved.
+func (s *Service) reconstructDataColumns(ctx context.Context, root [32]byte, signed interfaces.ReadOnlySignedBeaconBlock, saved uint64) (bool, error) {
+	if saved < peerdas.MinimumColumnsCountToReconstruct() {
+		return false, nil
+	}
+	rob, err := consensusblocks.NewROBlockWithRoot(signed, root)
+	if err != nil {
+		return false, err
+	}
+	avs := das.NewLazilyPersistentStoreColumn(s.dataColumnStorage, savedDataColumnsVerifier{}, s.custodyColumns)
+	if err := avs.IsDataAvailable(ctx, s.CurrentSlot(), rob); err != nil {
+		return false, errors.Wrap(err, "could not reconstruct data columns")
+	}
+	return true, nil
+}
+
+// savedDataColumnsVerifier is the das.DataColumnBatchVerifier of availability checks relying only on the data columns
+// saved by ReceiveDataColumn, which were verified before being saved. It rejects any other data column.
+type savedDataColumnsVerifier struct{}
+
+func (savedDataColumnsVerifier) VerifiedRODataColumns(_ context.Context, _ consensusblocks.ROBlock, sidecars []consensusblocks.RODataColumn) ([]consensusblocks.VerifiedRODataColumn, error) {
+	if len(sidecars) > 0 {
+		return nil, errors.Errorf("%d data columns are not verified", len(sidecars))
+	}
+	return nil, nil
+}
+
 func daCheckLogFields(root [32]byte, slot primitives.Slot, expected, missing int) logrus.Fields {
 	return logrus.Fields{
 		"slot":          slot,
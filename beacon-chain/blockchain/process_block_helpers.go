@@ -29,6 +29,7 @@ import (
 	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v5/time/slots"
 	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/proto"
 )
 
 // CurrentSlot returns the current slot based on time.
@@ -272,6 +273,16 @@ func (s *Service) processLightClientFinalityUpdate(
 		Type: statefeed.LightClientFinalityUpdate,
 		Data: update,
 	})
+
+	s.lcUpdatesLock.Lock()
+	isNewer := lightclient.IsNewerFinalityUpdate(update, s.lcFinalityUpdate)
+	if isNewer {
+		s.lcFinalityUpdate = update
+	}
+	s.lcUpdatesLock.Unlock()
+	if isNewer {
+		go s.broadcastLightClientUpdate(update.SignatureSlot(), update.Proto())
+	}
 	return nil
 }
 
@@ -309,9 +320,37 @@ func (s *Service) processLightClientOptimisticUpdate(ctx context.Context, signed
 		Data: update,
 	})
 
+	s.lcUpdatesLock.Lock()
+	isNewer := lightclient.IsNewerOptimisticUpdate(update, s.lcOptimisticUpdate)
+	if isNewer {
+		s.lcOptimisticUpdate = update
+	}
+	s.lcUpdatesLock.Unlock()
+	if isNewer {
+		go s.broadcastLightClientUpdate(update.SignatureSlot(), update.Proto())
+	}
 	return nil
 }
 
+// broadcastLightClientUpdate publishes a light client finality or optimistic update to the p2p network. Peers ignore
+// the updates received before one third of their signature slot has transpired, so the update is held until then.
+// Updates of blocks from past slots, like the ones processed while syncing, are not published.
+func (s *Service) broadcastLightClientUpdate(signatureSlot primitives.Slot, update proto.Message) {
+	if s.cfg.P2p == nil || signatureSlot != s.CurrentSlot() {
+		return
+	}
+	slotStart := slots.StartTime(uint64(s.genesisTime.Unix()), signatureSlot)
+	publishTime := slotStart.Add(time.Duration(params.BeaconConfig().SecondsPerSlot/params.BeaconConfig().IntervalsPerSlot) * time.Second)
+	select {
+	case <-s.ctx.Done():
+		return
+	case <-time.After(time.Until(publishTime)):
+	}
+	if err := s.cfg.P2p.Broadcast(s.ctx, update); err != nil {
+		log.WithError(err).Debug("Could not broadcast light client update")
+	}
+}
+
 // updateCachesPostBlockProcessing updates the next slot cache and handles the epoch
 // boundary in order to compute the right proposer indices after processing
 // state transition. This function is called on late blocks while still locked,
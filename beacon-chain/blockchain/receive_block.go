@@ -52,6 +52,12 @@ type BlobReceiver interface {
 	ReceiveBlob(context.Context, blocks.VerifiedROBlob) error
 }
 
+// DataColumnReceiver interface defines the methods of chain service for receiving new
+// data column sidecars
+type DataColumnReceiver interface {
+	ReceiveDataColumn(context.Context, blocks.VerifiedRODataColumn) error
+}
+
 // SlashingReceiver interface defines the methods of chain service for receiving validated slashing over the wire.
 type SlashingReceiver interface {
 	ReceiveAttesterSlashing(ctx context.Context, slashing ethpb.AttSlashing)
@@ -235,6 +241,10 @@ func (s *Service) handleDA(
 		if err := avs.IsDataAvailable(ctx, s.CurrentSlot(), rob); err != nil {
 			return 0, errors.Wrap(err, "could not validate blob data availability (AvailabilityStore.IsDataAvailable)")
 		}
+	} else if block.Version() >= version.Fulu {
+		if err := s.areDataColumnsAvailable(ctx, blockRoot, block); err != nil {
+			return 0, errors.Wrap(err, "could not validate data column availability")
+		}
 	} else {
 		if err := s.isDataAvailable(ctx, blockRoot, block); err != nil {
 			return 0, errors.Wrap(err, "could not validate blob data availability")
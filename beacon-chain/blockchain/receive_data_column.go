@@ -0,0 +1,22 @@
+package blockchain
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+)
+
+// ReceiveDataColumn saves the data column sidecar to the data column storage and notifies
+// the data availability check of its block that it is available.
+func (s *Service) ReceiveDataColumn(_ context.Context, dc blocks.VerifiedRODataColumn) error {
+	if s.dataColumnStorage == nil {
+		return errors.New("data column storage is not configured")
+	}
+	if err := s.dataColumnStorage.Save(dc); err != nil {
+		return err
+	}
+
+	s.dataColumnNotifiers.notifyIndex(dc.BlockRoot(), dc.ColumnIndex, dc.Slot())
+	return nil
+}
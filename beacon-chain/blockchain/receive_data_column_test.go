@@ -0,0 +1,85 @@
+package blockchain
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/peerdas"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/filesystem"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/verification"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+)
+
+func TestAreDataColumnsAvailable(t *testing.T) {
+	ctx := context.Background()
+	store := filesystem.NewEphemeralDataColumnStorage(t)
+	s, _ := minimalTestService(t, WithDataColumnStorage(store), WithCustodyColumns(map[uint64]bool{1: true, 3: true}))
+	s.SetGenesisTime(time.Now())
+
+	t.Run("no commitments", func(t *testing.T) {
+		blk, _ := util.GenerateTestFuluBlockWithColumns(t, [32]byte{}, 0, 0)
+		require.NoError(t, s.areDataColumnsAvailable(ctx, blk.Root(), blk))
+	})
+
+	blk, columns := util.GenerateTestFuluBlockWithColumns(t, [32]byte{}, 0, 2)
+	vcs := verification.FakeVerifyDataColumnSliceForTest(t, columns)
+	require.NoError(t, s.ReceiveDataColumn(ctx, vcs[1]))
+	require.Equal(t, true, store.Summary(blk.Root()).HasIndex(1))
+
+	// Custody column 3 is missing.
+	cctx, cancel := context.WithTimeout(ctx, 100*time.Millisecond)
+	defer cancel()
+	require.ErrorIs(t, s.areDataColumnsAvailable(cctx, blk.Root(), blk), context.DeadlineExceeded)
+
+	done := make(chan error, 1)
+	go func() {
+		done <- s.areDataColumnsAvailable(ctx, blk.Root(), blk)
+	}()
+	// Columns that are not custodied don't complete the check.
+	require.NoError(t, s.ReceiveDataColumn(ctx, vcs[5]))
+	require.NoError(t, s.ReceiveDataColumn(ctx, vcs[3]))
+	select {
+	case err := <-done:
+		require.NoError(t, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("data availability check did not complete")
+	}
+
+	// Everything is on disk now.
+	require.NoError(t, s.areDataColumnsAvailable(ctx, blk.Root(), blk))
+}
+
+func TestAreDataColumnsAvailable_Reconstruction(t *testing.T) {
+	ctx := context.Background()
+	store := filesystem.NewEphemeralDataColumnStorage(t)
+	s, _ := minimalTestService(t, WithDataColumnStorage(store), WithCustodyColumns(map[uint64]bool{0: true, 100: true}))
+	s.SetGenesisTime(time.Now())
+
+	blk, columns := util.GenerateTestFuluBlockWithColumns(t, [32]byte{}, 0, 1)
+	vcs := verification.FakeVerifyDataColumnSliceForTest(t, columns)
+	minimum := int(peerdas.MinimumColumnsCountToReconstruct())
+	for i := 1; i < minimum; i++ {
+		require.NoError(t, s.ReceiveDataColumn(ctx, vcs[i]))
+	}
+
+	done := make(chan error, 1)
+	go func() {
+		done <- s.areDataColumnsAvailable(ctx, blk.Root(), blk)
+	}()
+	// With half of the columns saved, the custody columns are reconstructed rather than waited for.
+	require.NoError(t, s.ReceiveDataColumn(ctx, vcs[minimum]))
+	select {
+	case err := <-done:
+		require.NoError(t, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("data availability check did not complete")
+	}
+	summary := store.Summary(blk.Root())
+	require.Equal(t, true, summary.HasIndex(0))
+	require.Equal(t, true, summary.HasIndex(100))
+	saved, err := store.Get(blk.Root(), 100)
+	require.NoError(t, err)
+	require.DeepSSZEqual(t, columns[100].DataColumnSidecar, saved.DataColumnSidecar)
+}
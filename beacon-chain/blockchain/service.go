@@ -63,8 +63,14 @@ type Service struct {
 	clockWaiter          startup.ClockWaiter
 	syncComplete         chan struct{}
 	blobNotifiers        *blobNotifierMap
+	dataColumnNotifiers  *blobNotifierMap
 	blockBeingSynced     *currentlySyncingBlock
 	blobStorage          *filesystem.BlobStorage
+	dataColumnStorage    *filesystem.DataColumnStorage
+	custodyColumns       map[uint64]bool
+	lcFinalityUpdate     interfaces.LightClientFinalityUpdate
+	lcOptimisticUpdate   interfaces.LightClientOptimisticUpdate
+	lcUpdatesLock        sync.RWMutex
 }
 
 // config options for the service.
@@ -106,12 +112,22 @@ type blobNotifierMap struct {
 	sync.RWMutex
 	notifiers map[[32]byte]chan uint64
 	seenIndex map[[32]byte][]bool
+	// maxIndices returns the number of sidecars a block at the given slot can have. It defaults to
+	// MaxBlobsPerBlock, data column notifiers use NUMBER_OF_COLUMNS instead.
+	maxIndices func(primitives.Slot) int
+}
+
+func (bn *blobNotifierMap) maxIndex(slot primitives.Slot) int {
+	if bn.maxIndices == nil {
+		return params.BeaconConfig().MaxBlobsPerBlock(slot)
+	}
+	return bn.maxIndices(slot)
 }
 
 // notifyIndex notifies a blob by its index for a given root.
 // It uses internal maps to keep track of seen indices and notifier channels.
 func (bn *blobNotifierMap) notifyIndex(root [32]byte, idx uint64, slot primitives.Slot) {
-	maxBlobsPerBlock := params.BeaconConfig().MaxBlobsPerBlock(slot)
+	maxBlobsPerBlock := bn.maxIndex(slot)
 	if idx >= uint64(maxBlobsPerBlock) {
 		return
 	}
@@ -141,7 +157,7 @@ func (bn *blobNotifierMap) notifyIndex(root [32]byte, idx uint64, slot primitive
 }
 
 func (bn *blobNotifierMap) forRoot(root [32]byte, slot primitives.Slot) chan uint64 {
-	maxBlobsPerBlock := params.BeaconConfig().MaxBlobsPerBlock(slot)
+	maxBlobsPerBlock := bn.maxIndex(slot)
 	bn.Lock()
 	defer bn.Unlock()
 	c, ok := bn.notifiers[root]
@@ -174,6 +190,13 @@ func NewService(ctx context.Context, opts ...Option) (*Service, error) {
 		notifiers: make(map[[32]byte]chan uint64),
 		seenIndex: make(map[[32]byte][]bool),
 	}
+	dcn := &blobNotifierMap{
+		notifiers: make(map[[32]byte]chan uint64),
+		seenIndex: make(map[[32]byte][]bool),
+		maxIndices: func(primitives.Slot) int {
+			return int(params.BeaconConfig().NumberOfColumns)
+		},
+	}
 	srv := &Service{
 		ctx:                  ctx,
 		cancel:               cancel,
@@ -181,6 +204,7 @@ func NewService(ctx context.Context, opts ...Option) (*Service, error) {
 		checkpointStateCache: cache.NewCheckpointStateCache(),
 		initSyncBlocks:       make(map[[32]byte]interfaces.ReadOnlySignedBeaconBlock),
 		blobNotifiers:        bn,
+		dataColumnNotifiers:  dcn,
 		cfg:                  &config{},
 		blockBeingSynced:     &currentlySyncingBlock{roots: make(map[[32]byte]struct{})},
 	}
@@ -220,6 +244,10 @@ func (s *Service) Start() {
 // Stop the blockchain service's main event loop and associated goroutines.
 func (s *Service) Stop() error {
 	defer s.cancel()
+	if s.cfg.StateGen != nil {
+		// The state diffs are generated in the background, the routine must not outlive the db.
+		defer s.cfg.StateGen.Stop()
+	}
 
 	// lock before accessing s.head, s.head.state, s.head.state.FinalizedCheckpoint().Root
 	s.headLock.RLock()
@@ -74,7 +74,10 @@ type ChainService struct {
 	BlockSlot                   primitives.Slot
 	SyncingRoot                 [32]byte
 	Blobs                       []blocks.VerifiedROBlob
+	DataColumns                 []blocks.VerifiedRODataColumn
 	TargetRoot                  [32]byte
+	LCFinalityUpdate            interfaces.LightClientFinalityUpdate
+	LCOptimisticUpdate          interfaces.LightClientOptimisticUpdate
 }
 
 func (s *ChainService) Ancestor(ctx context.Context, root []byte, slot primitives.Slot) ([]byte, error) {
@@ -702,6 +705,22 @@ func (c *ChainService) ReceiveBlob(_ context.Context, b blocks.VerifiedROBlob) e
 	return nil
 }
 
+// ReceiveDataColumn implements the same method in the chain service
+func (c *ChainService) ReceiveDataColumn(_ context.Context, dc blocks.VerifiedRODataColumn) error {
+	c.DataColumns = append(c.DataColumns, dc)
+	return nil
+}
+
+// LightClientFinalityUpdate mocks the same method in the chain service
+func (c *ChainService) LightClientFinalityUpdate() interfaces.LightClientFinalityUpdate {
+	return c.LCFinalityUpdate
+}
+
+// LightClientOptimisticUpdate mocks the same method in the chain service
+func (c *ChainService) LightClientOptimisticUpdate() interfaces.LightClientOptimisticUpdate {
+	return c.LCOptimisticUpdate
+}
+
 // TargetRootForEpoch mocks the same method in the chain service
 func (c *ChainService) TargetRootForEpoch(_ [32]byte, _ primitives.Epoch) ([32]byte, error) {
 	return c.TargetRoot, nil
@@ -1013,3 +1013,33 @@ func createDefaultLightClientBootstrap(currentSlot primitives.Slot) (interfaces.
 
 	return light_client.NewWrappedBootstrap(m)
 }
+
+// IsNewerFinalityUpdate returns true when newUpdate supersedes oldUpdate for the purpose of gossip, as defined
+// in the p2p spec: its finalized header is more recent, or it is for the same finalized header and its sync
+// aggregate indicates a supermajority participation while the one of oldUpdate does not.
+func IsNewerFinalityUpdate(newUpdate, oldUpdate interfaces.LightClientFinalityUpdate) bool {
+	if oldUpdate == nil {
+		return true
+	}
+	newSlot := newUpdate.FinalizedHeader().Beacon().Slot
+	oldSlot := oldUpdate.FinalizedHeader().Beacon().Slot
+	if newSlot != oldSlot {
+		return newSlot > oldSlot
+	}
+	return hasSupermajority(newUpdate.SyncAggregate()) && !hasSupermajority(oldUpdate.SyncAggregate())
+}
+
+// IsNewerOptimisticUpdate returns true when the attested header of newUpdate is more recent than the one of oldUpdate.
+func IsNewerOptimisticUpdate(newUpdate, oldUpdate interfaces.LightClientOptimisticUpdate) bool {
+	if oldUpdate == nil {
+		return true
+	}
+	return newUpdate.AttestedHeader().Beacon().Slot > oldUpdate.AttestedHeader().Beacon().Slot
+}
+
+// hasSupermajority returns true when more than two thirds of the sync committee participated in the sync aggregate.
+func hasSupermajority(syncAggregate *pb.SyncAggregate) bool {
+	maxActiveParticipants := syncAggregate.SyncCommitteeBits.Len()
+	numActiveParticipants := syncAggregate.SyncCommitteeBits.Count()
+	return numActiveParticipants*3 > maxActiveParticipants*2
+}
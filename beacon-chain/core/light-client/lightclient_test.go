@@ -10,10 +10,12 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
 
 	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/go-bitfield"
 	lightClient "github.com/prysmaticlabs/prysm/v5/beacon-chain/core/light-client"
 	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
 	consensustypes "github.com/prysmaticlabs/prysm/v5/consensus-types"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/interfaces"
 	"github.com/prysmaticlabs/prysm/v5/encoding/ssz"
 	v11 "github.com/prysmaticlabs/prysm/v5/proto/engine/v1"
 	pb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
@@ -1821,3 +1823,58 @@ func TestIsBetterUpdate(t *testing.T) {
 		require.Equal(t, false, result)
 	})
 }
+
+func TestIsNewerFinalityUpdate(t *testing.T) {
+	old := newTestFinalityUpdate(t, 10, 32, 300)
+	require.Equal(t, true, lightClient.IsNewerFinalityUpdate(old, nil))
+
+	t.Run("more recent finalized header", func(t *testing.T) {
+		require.Equal(t, true, lightClient.IsNewerFinalityUpdate(newTestFinalityUpdate(t, 11, 33, 300), old))
+		require.Equal(t, false, lightClient.IsNewerFinalityUpdate(newTestFinalityUpdate(t, 11, 31, 512), old))
+	})
+	t.Run("same finalized header", func(t *testing.T) {
+		// Only a new supermajority makes the update newer.
+		require.Equal(t, true, lightClient.IsNewerFinalityUpdate(newTestFinalityUpdate(t, 11, 32, 400), old))
+		require.Equal(t, false, lightClient.IsNewerFinalityUpdate(newTestFinalityUpdate(t, 11, 32, 301), old))
+		supermajority := newTestFinalityUpdate(t, 10, 32, 400)
+		require.Equal(t, false, lightClient.IsNewerFinalityUpdate(newTestFinalityUpdate(t, 11, 32, 512), supermajority))
+	})
+}
+
+func TestIsNewerOptimisticUpdate(t *testing.T) {
+	old := newTestOptimisticUpdate(t, 10)
+	require.Equal(t, true, lightClient.IsNewerOptimisticUpdate(old, nil))
+	require.Equal(t, true, lightClient.IsNewerOptimisticUpdate(newTestOptimisticUpdate(t, 11), old))
+	require.Equal(t, false, lightClient.IsNewerOptimisticUpdate(newTestOptimisticUpdate(t, 10), old))
+	require.Equal(t, false, lightClient.IsNewerOptimisticUpdate(newTestOptimisticUpdate(t, 9), old))
+}
+
+func newTestFinalityUpdate(t *testing.T, attestedSlot, finalizedSlot primitives.Slot, participants uint64) interfaces.LightClientFinalityUpdate {
+	bits := bitfield.NewBitvector512()
+	for i := uint64(0); i < participants; i++ {
+		bits.SetBitAt(i, true)
+	}
+	branch := make([][]byte, fieldparams.FinalityBranchDepth)
+	for i := range branch {
+		branch[i] = make([]byte, fieldparams.RootLength)
+	}
+	update, err := light_client.NewWrappedFinalityUpdateAltair(&pb.LightClientFinalityUpdateAltair{
+		AttestedHeader:  &pb.LightClientHeaderAltair{Beacon: &pb.BeaconBlockHeader{Slot: attestedSlot}},
+		FinalizedHeader: &pb.LightClientHeaderAltair{Beacon: &pb.BeaconBlockHeader{Slot: finalizedSlot}},
+		FinalityBranch:  branch,
+		SyncAggregate:   &pb.SyncAggregate{SyncCommitteeBits: bits},
+		SignatureSlot:   attestedSlot + 1,
+	})
+	require.NoError(t, err)
+	return update
+}
+
+func newTestOptimisticUpdate(t *testing.T, attestedSlot primitives.Slot) interfaces.LightClientOptimisticUpdate {
+	update, err := light_client.NewWrappedOptimisticUpdateAltair(&pb.LightClientOptimisticUpdateAltair{
+		AttestedHeader: &pb.LightClientHeaderAltair{Beacon: &pb.BeaconBlockHeader{Slot: attestedSlot}},
+		SyncAggregate:  &pb.SyncAggregate{SyncCommitteeBits: bitfield.NewBitvector512()},
+		SignatureSlot:  attestedSlot + 1,
+	})
+	require.NoError(t, err)
+	return update
+}
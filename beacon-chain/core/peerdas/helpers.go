@@ -0,0 +1,122 @@
+package peerdas
+
+import (
+	"encoding/binary"
+	"math"
+	"slices"
+
+	"github.com/ethereum/go-ethereum/p2p/enode"
+	"github.com/holiman/uint256"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/crypto/hash"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+)
+
+var (
+	// ErrCustodyGroupTooLarge is returned when a custody group index is not lower than NUMBER_OF_CUSTODY_GROUPS.
+	ErrCustodyGroupTooLarge = errors.New("custody group too large")
+	// ErrCustodyGroupCountTooLarge is returned when more than NUMBER_OF_CUSTODY_GROUPS groups are requested.
+	ErrCustodyGroupCountTooLarge = errors.New("custody group count too large")
+
+	maxUint256 = &uint256.Int{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}
+)
+
+// CustodyGroups computes the custody groups the node with the given ID should custody.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/fulu/das-core.md#get_custody_groups
+func CustodyGroups(nodeId enode.ID, custodyGroupCount uint64) (map[uint64]bool, error) {
+	numberOfCustodyGroups := params.BeaconConfig().NumberOfCustodyGroups
+	if custodyGroupCount > numberOfCustodyGroups {
+		return nil, ErrCustodyGroupCountTooLarge
+	}
+
+	custodyGroups := make(map[uint64]bool, custodyGroupCount)
+	// Skip the computation if all groups are custodied.
+	if custodyGroupCount == numberOfCustodyGroups {
+		for group := range numberOfCustodyGroups {
+			custodyGroups[group] = true
+		}
+		return custodyGroups, nil
+	}
+
+	one := uint256.NewInt(1)
+	currentId := new(uint256.Int).SetBytes(nodeId.Bytes())
+	for uint64(len(custodyGroups)) < custodyGroupCount {
+		// uint_to_bytes uses the little endian representation of the uint256 node ID.
+		currentIdBytesBigEndian := currentId.Bytes32()
+		currentIdBytesLittleEndian := bytesutil.ReverseByteOrder(currentIdBytesBigEndian[:])
+		hashedCurrentId := hash.Hash(currentIdBytesLittleEndian)
+		group := binary.LittleEndian.Uint64(hashedCurrentId[:8]) % numberOfCustodyGroups
+		custodyGroups[group] = true
+
+		if currentId.Cmp(maxUint256) == 0 {
+			currentId = uint256.NewInt(0)
+		} else {
+			currentId.Add(currentId, one)
+		}
+	}
+	return custodyGroups, nil
+}
+
+// ComputeColumnsForCustodyGroup returns the columns belonging to the given custody group.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/fulu/das-core.md#compute_columns_for_custody_group
+func ComputeColumnsForCustodyGroup(custodyGroup uint64) ([]uint64, error) {
+	beaconConfig := params.BeaconConfig()
+	numberOfCustodyGroups := beaconConfig.NumberOfCustodyGroups
+	if custodyGroup >= numberOfCustodyGroups {
+		return nil, ErrCustodyGroupTooLarge
+	}
+
+	columnsPerGroup := beaconConfig.NumberOfColumns / numberOfCustodyGroups
+	columns := make([]uint64, 0, columnsPerGroup)
+	for i := range columnsPerGroup {
+		columns = append(columns, numberOfCustodyGroups*i+custodyGroup)
+	}
+	return columns, nil
+}
+
+// CustodyColumns returns the columns belonging to all the given custody groups.
+func CustodyColumns(custodyGroups map[uint64]bool) (map[uint64]bool, error) {
+	columnsPerGroup := params.BeaconConfig().NumberOfColumns / params.BeaconConfig().NumberOfCustodyGroups
+	columns := make(map[uint64]bool, uint64(len(custodyGroups))*columnsPerGroup)
+	for group := range custodyGroups {
+		groupColumns, err := ComputeColumnsForCustodyGroup(group)
+		if err != nil {
+			return nil, errors.Wrapf(err, "compute columns for custody group %d", group)
+		}
+		for _, column := range groupColumns {
+			columns[column] = true
+		}
+	}
+	return columns, nil
+}
+
+// ComputeSubnetForDataColumnSidecar returns the subnet a data column sidecar with the given column index is
+// gossiped on.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/fulu/p2p-interface.md#compute_subnet_for_data_column_sidecar
+func ComputeSubnetForDataColumnSidecar(columnIndex uint64) uint64 {
+	return columnIndex % params.BeaconConfig().DataColumnSidecarSubnetCount
+}
+
+// DataColumnSubnets returns the sorted subnets the given columns are gossiped on.
+func DataColumnSubnets(columns map[uint64]bool) []uint64 {
+	subnets := make(map[uint64]bool, len(columns))
+	for column := range columns {
+		subnets[ComputeSubnetForDataColumnSidecar(column)] = true
+	}
+	sorted := make([]uint64, 0, len(subnets))
+	for subnet := range subnets {
+		sorted = append(sorted, subnet)
+	}
+	slices.Sort(sorted)
+	return sorted
+}
+
+// CustodyGroupCount returns the number of custody groups the node custodies. Nodes subscribed to all subnets
+// custody every group, other nodes custody the minimum required by the spec.
+func CustodyGroupCount(subscribeAllDataSubnets bool) uint64 {
+	if subscribeAllDataSubnets {
+		return params.BeaconConfig().NumberOfCustodyGroups
+	}
+	return params.BeaconConfig().CustodyRequirement
+}
@@ -0,0 +1,81 @@
+package peerdas_test
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/p2p/enode"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/peerdas"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func TestCustodyGroups(t *testing.T) {
+	numberOfCustodyGroups := params.BeaconConfig().NumberOfCustodyGroups
+
+	t.Run("too many groups", func(t *testing.T) {
+		_, err := peerdas.CustodyGroups(enode.ID{}, numberOfCustodyGroups+1)
+		require.ErrorIs(t, err, peerdas.ErrCustodyGroupCountTooLarge)
+	})
+	t.Run("all groups", func(t *testing.T) {
+		groups, err := peerdas.CustodyGroups(enode.ID{}, numberOfCustodyGroups)
+		require.NoError(t, err)
+		require.Equal(t, int(numberOfCustodyGroups), len(groups))
+	})
+	t.Run("deterministic", func(t *testing.T) {
+		nodeId := enode.ID{1, 2, 3}
+		groups, err := peerdas.CustodyGroups(nodeId, 4)
+		require.NoError(t, err)
+		require.Equal(t, 4, len(groups))
+		for group := range groups {
+			require.Equal(t, true, group < numberOfCustodyGroups)
+		}
+		again, err := peerdas.CustodyGroups(nodeId, 4)
+		require.NoError(t, err)
+		require.DeepEqual(t, groups, again)
+
+		// Custody groups of a larger count are a superset of the ones of a smaller count.
+		more, err := peerdas.CustodyGroups(nodeId, 8)
+		require.NoError(t, err)
+		for group := range groups {
+			require.Equal(t, true, more[group])
+		}
+	})
+	t.Run("node id wraps around", func(t *testing.T) {
+		var nodeId enode.ID
+		for i := range nodeId {
+			nodeId[i] = 0xff
+		}
+		groups, err := peerdas.CustodyGroups(nodeId, 16)
+		require.NoError(t, err)
+		require.Equal(t, 16, len(groups))
+	})
+}
+
+func TestComputeColumnsForCustodyGroup(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	cfg := params.BeaconConfig().Copy()
+	cfg.NumberOfColumns = 128
+	cfg.NumberOfCustodyGroups = 32
+	params.OverrideBeaconConfig(cfg)
+
+	columns, err := peerdas.ComputeColumnsForCustodyGroup(3)
+	require.NoError(t, err)
+	require.DeepEqual(t, []uint64{3, 35, 67, 99}, columns)
+
+	_, err = peerdas.ComputeColumnsForCustodyGroup(32)
+	require.ErrorIs(t, err, peerdas.ErrCustodyGroupTooLarge)
+
+	custodyColumns, err := peerdas.CustodyColumns(map[uint64]bool{0: true, 3: true})
+	require.NoError(t, err)
+	require.DeepEqual(t, map[uint64]bool{0: true, 32: true, 64: true, 96: true, 3: true, 35: true, 67: true, 99: true}, custodyColumns)
+}
+
+func TestDataColumnSubnets(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	cfg := params.BeaconConfig().Copy()
+	cfg.DataColumnSidecarSubnetCount = 64
+	params.OverrideBeaconConfig(cfg)
+
+	require.Equal(t, uint64(1), peerdas.ComputeSubnetForDataColumnSidecar(65))
+	require.DeepEqual(t, []uint64{1, 2, 10}, peerdas.DataColumnSubnets(map[uint64]bool{66: true, 1: true, 65: true, 10: true}))
+}
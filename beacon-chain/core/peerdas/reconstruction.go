@@ -0,0 +1,119 @@
+package peerdas
+
+import (
+	"cmp"
+	"slices"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain/kzg"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+)
+
+var (
+	// ErrNotEnoughDataColumnSidecars is returned when fewer sidecars than needed for a reconstruction are provided.
+	ErrNotEnoughDataColumnSidecars = errors.New("not enough data column sidecars to reconstruct the missing columns")
+	// ErrMixedBlockRoots is returned when the sidecars to reconstruct from do not all belong to the same block.
+	ErrMixedBlockRoots = errors.New("data column sidecars do not all belong to the same block")
+)
+
+// MinimumColumnsCountToReconstruct returns the minimum number of distinct columns of a block needed to
+// reconstruct all of its columns, i.e. half of NUMBER_OF_COLUMNS.
+func MinimumColumnsCountToReconstruct() uint64 {
+	return (params.BeaconConfig().NumberOfColumns + 1) / 2
+}
+
+// ReconstructDataColumnSidecars returns every data column sidecar of a block, given at least
+// MinimumColumnsCountToReconstruct distinct sidecars of it. The result is sorted by column index.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/fulu/das-core.md#recover_matrix
+func ReconstructDataColumnSidecars(sidecars []blocks.VerifiedRODataColumn) ([]blocks.VerifiedRODataColumn, error) {
+	if len(sidecars) == 0 {
+		return nil, ErrNotEnoughDataColumnSidecars
+	}
+	numberOfColumns := params.BeaconConfig().NumberOfColumns
+	root := sidecars[0].BlockRoot()
+	byIndex := make(map[uint64]blocks.VerifiedRODataColumn, len(sidecars))
+	for _, sidecar := range sidecars {
+		if sidecar.BlockRoot() != root {
+			return nil, errors.Wrapf(ErrMixedBlockRoots, "root=%#x, other root=%#x", root, sidecar.BlockRoot())
+		}
+		if sidecar.ColumnIndex >= numberOfColumns {
+			return nil, errors.Wrapf(ErrIndexTooLarge, "index=%d", sidecar.ColumnIndex)
+		}
+		byIndex[sidecar.ColumnIndex] = sidecar
+	}
+	if uint64(len(byIndex)) < MinimumColumnsCountToReconstruct() {
+		return nil, errors.Wrapf(ErrNotEnoughDataColumnSidecars, "got %d, need %d", len(byIndex), MinimumColumnsCountToReconstruct())
+	}
+
+	result := make([]blocks.VerifiedRODataColumn, 0, numberOfColumns)
+	for _, sidecar := range byIndex {
+		result = append(result, sidecar)
+	}
+	slices.SortFunc(result, func(a, b blocks.VerifiedRODataColumn) int {
+		return cmp.Compare(a.ColumnIndex, b.ColumnIndex)
+	})
+	if uint64(len(result)) == numberOfColumns {
+		return result, nil
+	}
+
+	matrix, err := recoverMatrix(result)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not recover the columns of block %#x", root)
+	}
+	ref := result[0]
+	for i := range numberOfColumns {
+		if _, ok := byIndex[i]; ok {
+			continue
+		}
+		sidecar := &ethpb.DataColumnSidecar{
+			ColumnIndex:                  i,
+			DataColumn:                   make([][]byte, len(matrix)),
+			KzgCommitments:               ref.KzgCommitments,
+			KzgProof:                     make([][]byte, len(matrix)),
+			SignedBlockHeader:            ref.SignedBlockHeader,
+			KzgCommitmentsInclusionProof: ref.KzgCommitmentsInclusionProof,
+		}
+		for row := range matrix {
+			sidecar.DataColumn[row] = matrix[row].Cells[i]
+			sidecar.KzgProof[row] = matrix[row].Proofs[i]
+		}
+		column, err := blocks.NewRODataColumnWithRoot(sidecar, root)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not build column %d", i)
+		}
+		result = append(result, blocks.NewVerifiedRODataColumn(column))
+	}
+	slices.SortFunc(result, func(a, b blocks.VerifiedRODataColumn) int {
+		return cmp.Compare(a.ColumnIndex, b.ColumnIndex)
+	})
+	return result, nil
+}
+
+// recoverMatrix recovers, for each blob of the block, every cell of the extended blob along with its proof
+// from the cells held by the given distinct sidecars.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/fulu/das-core.md#recover_matrix
+func recoverMatrix(sidecars []blocks.VerifiedRODataColumn) ([]kzg.CellsAndProofs, error) {
+	blobCount := len(sidecars[0].KzgCommitments)
+	indices := make([]uint64, len(sidecars))
+	for i, sidecar := range sidecars {
+		if len(sidecar.DataColumn) != blobCount {
+			return nil, errors.Wrapf(ErrMismatchLength, "column %d has %d cells for %d commitments", sidecar.ColumnIndex, len(sidecar.DataColumn), blobCount)
+		}
+		indices[i] = sidecar.ColumnIndex
+	}
+	matrix := make([]kzg.CellsAndProofs, blobCount)
+	for row := range matrix {
+		cells := make([][]byte, len(sidecars))
+		for i, sidecar := range sidecars {
+			cells[i] = sidecar.DataColumn[row]
+		}
+		recovered, err := kzg.RecoverCellsAndKZGProofs(indices, cells)
+		if err != nil {
+			return nil, errors.Wrapf(err, "blob %d", row)
+		}
+		matrix[row] = recovered
+	}
+	return matrix, nil
+}
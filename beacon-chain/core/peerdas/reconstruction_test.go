@@ -0,0 +1,88 @@
+package peerdas_test
+
+import (
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/peerdas"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+)
+
+func TestReconstructDataColumnSidecars(t *testing.T) {
+	numberOfColumns := params.BeaconConfig().NumberOfColumns
+	header := &ethpb.SignedBeaconBlockHeader{Header: &ethpb.BeaconBlockHeader{}, Signature: []byte{1}}
+	newColumns := func(root [32]byte, indices ...uint64) []blocks.VerifiedRODataColumn {
+		columns := make([]blocks.VerifiedRODataColumn, 0, len(indices))
+		for _, idx := range indices {
+			column, err := blocks.NewRODataColumnWithRoot(&ethpb.DataColumnSidecar{ColumnIndex: idx, SignedBlockHeader: header}, root)
+			require.NoError(t, err)
+			columns = append(columns, blocks.NewVerifiedRODataColumn(column))
+		}
+		return columns
+	}
+	rangeOf := func(n uint64) []uint64 {
+		indices := make([]uint64, 0, n)
+		for i := n; i > 0; i-- {
+			indices = append(indices, i-1)
+		}
+		return indices
+	}
+	minimum := peerdas.MinimumColumnsCountToReconstruct()
+	require.Equal(t, numberOfColumns/2, minimum)
+
+	t.Run("empty", func(t *testing.T) {
+		_, err := peerdas.ReconstructDataColumnSidecars(nil)
+		require.ErrorIs(t, err, peerdas.ErrNotEnoughDataColumnSidecars)
+	})
+	t.Run("not enough columns", func(t *testing.T) {
+		// Duplicates do not count towards the minimum.
+		columns := newColumns([32]byte{1}, rangeOf(minimum-1)...)
+		columns = append(columns, columns[0])
+		_, err := peerdas.ReconstructDataColumnSidecars(columns)
+		require.ErrorIs(t, err, peerdas.ErrNotEnoughDataColumnSidecars)
+	})
+	t.Run("mixed roots", func(t *testing.T) {
+		columns := append(newColumns([32]byte{1}, 0), newColumns([32]byte{2}, 1)...)
+		_, err := peerdas.ReconstructDataColumnSidecars(columns)
+		require.ErrorIs(t, err, peerdas.ErrMixedBlockRoots)
+	})
+	t.Run("index too large", func(t *testing.T) {
+		_, err := peerdas.ReconstructDataColumnSidecars(newColumns([32]byte{1}, numberOfColumns))
+		require.ErrorIs(t, err, peerdas.ErrIndexTooLarge)
+	})
+	t.Run("missing columns", func(t *testing.T) {
+		_, all := util.GenerateTestFuluBlockWithColumns(t, [32]byte{}, 1, 2)
+		// Keep the odd columns only, in reverse order.
+		var columns []blocks.VerifiedRODataColumn
+		for i := len(all) - 1; i >= 0; i -= 2 {
+			columns = append(columns, blocks.NewVerifiedRODataColumn(all[i]))
+		}
+		reconstructed, err := peerdas.ReconstructDataColumnSidecars(columns)
+		require.NoError(t, err)
+		require.Equal(t, len(all), len(reconstructed))
+		for i, column := range reconstructed {
+			require.Equal(t, all[i].BlockRoot(), column.BlockRoot())
+			require.DeepSSZEqual(t, all[i].DataColumnSidecar, column.DataColumnSidecar)
+		}
+	})
+	t.Run("invalid cells", func(t *testing.T) {
+		columns := newColumns([32]byte{1}, rangeOf(minimum)...)
+		for _, column := range columns {
+			column.KzgCommitments = [][]byte{{1}}
+			column.DataColumn = [][]byte{{2}}
+		}
+		_, err := peerdas.ReconstructDataColumnSidecars(columns)
+		require.ErrorContains(t, "could not recover the columns", err)
+	})
+	t.Run("all columns", func(t *testing.T) {
+		columns, err := peerdas.ReconstructDataColumnSidecars(newColumns([32]byte{1}, rangeOf(numberOfColumns)...))
+		require.NoError(t, err)
+		require.Equal(t, int(numberOfColumns), len(columns))
+		for i, column := range columns {
+			require.Equal(t, uint64(i), column.ColumnIndex)
+		}
+	})
+}
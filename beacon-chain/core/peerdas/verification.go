@@ -0,0 +1,77 @@
+package peerdas
+
+import (
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain/kzg"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+)
+
+var (
+	// ErrIndexTooLarge is returned when the column index of a sidecar is not lower than NUMBER_OF_COLUMNS.
+	ErrIndexTooLarge = errors.New("column index is larger than the specified columns count")
+	// ErrNoKzgCommitments is returned when a sidecar carries no KZG commitment.
+	ErrNoKzgCommitments = errors.New("no KZG commitments found")
+	// ErrMismatchLength is returned when the cells, commitments and proofs of a sidecar have different lengths.
+	ErrMismatchLength = errors.New("mismatch in the length of the column, commitments or proofs")
+	// ErrInvalidKZGProof is returned when the cell KZG proofs of sidecars do not verify.
+	ErrInvalidKZGProof = errors.New("invalid KZG proof")
+)
+
+// VerifyDataColumnSidecar checks the structure of a data column sidecar.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/fulu/p2p-interface.md#verify_data_column_sidecar
+func VerifyDataColumnSidecar(sidecar blocks.RODataColumn) error {
+	if sidecar.ColumnIndex >= params.BeaconConfig().NumberOfColumns {
+		return ErrIndexTooLarge
+	}
+	if len(sidecar.KzgCommitments) == 0 {
+		return ErrNoKzgCommitments
+	}
+	if len(sidecar.DataColumn) != len(sidecar.KzgCommitments) || len(sidecar.KzgCommitments) != len(sidecar.KzgProof) {
+		return ErrMismatchLength
+	}
+	return nil
+}
+
+// VerifyDataColumnSidecarInclusionProof checks the inclusion proof of the sidecar KZG commitments
+// against the body root of the sidecar block header.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/fulu/p2p-interface.md#verify_data_column_sidecar_inclusion_proof
+func VerifyDataColumnSidecarInclusionProof(sidecar blocks.RODataColumn) error {
+	return blocks.VerifyKZGInclusionProofColumn(sidecar)
+}
+
+// VerifyDataColumnsSidecarKZGProofs checks, in a single batch, the cell KZG proofs of the given sidecars.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/fulu/p2p-interface.md#verify_data_column_sidecar_kzg_proofs
+func VerifyDataColumnsSidecarKZGProofs(sidecars []blocks.RODataColumn) error {
+	count := 0
+	for _, sidecar := range sidecars {
+		if err := VerifyDataColumnSidecar(sidecar); err != nil {
+			return err
+		}
+		count += len(sidecar.DataColumn)
+	}
+	if count == 0 {
+		return nil
+	}
+
+	commitments := make([][]byte, 0, count)
+	indices := make([]uint64, 0, count)
+	cells := make([][]byte, 0, count)
+	proofs := make([][]byte, 0, count)
+	for _, sidecar := range sidecars {
+		for i := range sidecar.DataColumn {
+			commitments = append(commitments, sidecar.KzgCommitments[i])
+			indices = append(indices, sidecar.ColumnIndex)
+			cells = append(cells, sidecar.DataColumn[i])
+			proofs = append(proofs, sidecar.KzgProof[i])
+		}
+	}
+	ok, err := kzg.VerifyCellKZGProofBatch(commitments, indices, cells, proofs)
+	if err != nil {
+		return errors.Wrap(err, "could not verify cell KZG proofs")
+	}
+	if !ok {
+		return ErrInvalidKZGProof
+	}
+	return nil
+}
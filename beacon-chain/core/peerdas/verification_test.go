@@ -0,0 +1,67 @@
+package peerdas_test
+
+import (
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/peerdas"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestVerifyDataColumnSidecar(t *testing.T) {
+	newColumn := func(index uint64, cells, commitments, proofs int) blocks.RODataColumn {
+		sidecar := &ethpb.DataColumnSidecar{
+			ColumnIndex:    index,
+			DataColumn:     make([][]byte, cells),
+			KzgCommitments: make([][]byte, commitments),
+			KzgProof:       make([][]byte, proofs),
+			SignedBlockHeader: &ethpb.SignedBeaconBlockHeader{
+				Header: &ethpb.BeaconBlockHeader{
+					ParentRoot: make([]byte, fieldparams.RootLength),
+					StateRoot:  make([]byte, fieldparams.RootLength),
+					BodyRoot:   make([]byte, fieldparams.RootLength),
+				},
+				Signature: make([]byte, fieldparams.BLSSignatureLength),
+			},
+		}
+		column, err := blocks.NewRODataColumn(sidecar)
+		require.NoError(t, err)
+		return column
+	}
+
+	require.NoError(t, peerdas.VerifyDataColumnSidecar(newColumn(0, 2, 2, 2)))
+	require.ErrorIs(t, peerdas.VerifyDataColumnSidecar(newColumn(params.BeaconConfig().NumberOfColumns, 2, 2, 2)), peerdas.ErrIndexTooLarge)
+	require.ErrorIs(t, peerdas.VerifyDataColumnSidecar(newColumn(0, 0, 0, 0)), peerdas.ErrNoKzgCommitments)
+	require.ErrorIs(t, peerdas.VerifyDataColumnSidecar(newColumn(0, 1, 2, 2)), peerdas.ErrMismatchLength)
+	require.ErrorIs(t, peerdas.VerifyDataColumnSidecar(newColumn(0, 2, 2, 1)), peerdas.ErrMismatchLength)
+
+	err := peerdas.VerifyDataColumnsSidecarKZGProofs([]blocks.RODataColumn{newColumn(0, 1, 2, 2)})
+	require.ErrorIs(t, err, peerdas.ErrMismatchLength)
+}
+
+func TestVerifyDataColumnsSidecarKZGProofs(t *testing.T) {
+	_, columns := util.GenerateTestFuluBlockWithColumns(t, [32]byte{}, 1, 2)
+	require.NoError(t, peerdas.VerifyDataColumnsSidecarKZGProofs(nil))
+	require.NoError(t, peerdas.VerifyDataColumnsSidecarKZGProofs(columns))
+
+	// The cell of a column is swapped with the one of another column.
+	tampered := proto.Clone(columns[3].DataColumnSidecar).(*ethpb.DataColumnSidecar)
+	tampered.DataColumn[1] = columns[4].DataColumn[1]
+	column, err := blocks.NewRODataColumn(tampered)
+	require.NoError(t, err)
+	err = peerdas.VerifyDataColumnsSidecarKZGProofs([]blocks.RODataColumn{columns[0], column})
+	require.ErrorIs(t, err, peerdas.ErrInvalidKZGProof)
+
+	// Cells must have the expected size.
+	tampered = proto.Clone(columns[3].DataColumnSidecar).(*ethpb.DataColumnSidecar)
+	tampered.DataColumn[1] = []byte{1}
+	column, err = blocks.NewRODataColumn(tampered)
+	require.NoError(t, err)
+	err = peerdas.VerifyDataColumnsSidecarKZGProofs([]blocks.RODataColumn{column})
+	require.ErrorContains(t, "could not verify cell KZG proofs", err)
+}
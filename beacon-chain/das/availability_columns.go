@@ -0,0 +1,220 @@
+package das
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	errors "github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/peerdas"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/filesystem"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/verification"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/runtime/logging"
+	"github.com/prysmaticlabs/prysm/v5/runtime/version"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+	log "github.com/sirupsen/logrus"
+)
+
+// LazilyPersistentStoreColumn is an implementation of AvailabilityStore to be used for blocks from the Fulu fork on.
+// Like LazilyPersistentStore, it holds any data columns passed to Persist until IsDataAvailable is called for their
+// block. A block is available once all the columns custodied by the node are verified and saved to disk. When some
+// of them are missing but at least half of all the columns are available, the missing ones are reconstructed.
+type LazilyPersistentStoreColumn struct {
+	store          *filesystem.DataColumnStorage
+	cache          *dataColumnCache
+	verifier       DataColumnBatchVerifier
+	custodyColumns map[uint64]bool
+}
+
+var _ AvailabilityStore = &LazilyPersistentStoreColumn{}
+
+// DataColumnBatchVerifier enables LazilyPersistentStoreColumn to manage the verification process
+// going from RODataColumn->VerifiedRODataColumn, see BlobBatchVerifier.
+type DataColumnBatchVerifier interface {
+	VerifiedRODataColumns(ctx context.Context, blk blocks.ROBlock, sc []blocks.RODataColumn) ([]blocks.VerifiedRODataColumn, error)
+}
+
+// NewLazilyPersistentStoreColumn creates a new LazilyPersistentStoreColumn, checking the availability of
+// the given custody columns. This constructor should always be used when creating a LazilyPersistentStoreColumn
+// because it needs to initialize the cache under the hood.
+func NewLazilyPersistentStoreColumn(store *filesystem.DataColumnStorage, verifier DataColumnBatchVerifier, custodyColumns map[uint64]bool) *LazilyPersistentStoreColumn {
+	return &LazilyPersistentStoreColumn{
+		store:          store,
+		cache:          newDataColumnCache(),
+		verifier:       verifier,
+		custodyColumns: custodyColumns,
+	}
+}
+
+// Persist adds data columns to the working data column cache. Data columns stored in this cache will be persisted
+// for at least as long as the node is running. Once IsDataAvailable succeeds, all the custody columns of the
+// given block are guaranteed to be persisted for the remainder of the retention period.
+func (s *LazilyPersistentStoreColumn) Persist(current primitives.Slot, sidecars ...blocks.ROSidecar) error {
+	if len(sidecars) == 0 {
+		return nil
+	}
+	sc, err := blocks.DataColumnSidecarsFromSidecars(sidecars)
+	if err != nil {
+		return errors.Wrap(err, "data column sidecars from sidecars")
+	}
+	first := sc[0].BlockRoot()
+	for i := 1; i < len(sc); i++ {
+		if first != sc[i].BlockRoot() {
+			return errMixedRoots
+		}
+	}
+	if !params.WithinDataColumnDAPeriod(slots.ToEpoch(sc[0].Slot()), slots.ToEpoch(current)) {
+		return nil
+	}
+	key := keyFromDataColumn(sc[0])
+	entry := s.cache.ensure(key)
+	for i := range sc {
+		if err := entry.stash(&sc[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// IsDataAvailable returns nil if all the custody columns of the given block are persisted to the db and have been
+// verified. DataColumnSidecars already in the db are assumed to have been previously verified against the block.
+func (s *LazilyPersistentStoreColumn) IsDataAvailable(ctx context.Context, current primitives.Slot, b blocks.ROBlock) error {
+	blockCommitments, err := columnCommitmentsToCheck(b, current)
+	if err != nil {
+		return errors.Wrapf(err, "could not check data availability for block %#x", b.Root())
+	}
+	// Return early for blocks that are pre-fulu or which do not have any commitments.
+	if len(blockCommitments) == 0 {
+		return nil
+	}
+
+	key := keyFromBlock(b)
+	entry := s.cache.ensure(key)
+	defer s.cache.delete(key)
+	root := b.Root()
+	entry.setDiskSummary(s.store.Summary(root))
+	if entry.diskSummary.AllAvailable(s.custodyColumns) {
+		return nil
+	}
+
+	sidecars, err := entry.filter(root, blockCommitments, s.custodyColumns)
+	if errors.Is(err, errMissingSidecar) {
+		// Some custody columns are missing, they can still be recovered from the other columns.
+		return s.reconstructAndSave(ctx, b, entry, blockCommitments, err)
+	}
+	if err != nil {
+		return errors.Wrap(err, "incomplete DataColumnSidecar batch")
+	}
+	vscs, err := s.verify(ctx, b, sidecars)
+	if err != nil {
+		return err
+	}
+	return s.save(root, vscs)
+}
+
+// reconstructAndSave reconstructs the missing custody columns of the block from the columns on disk and in the cache,
+// provided there are enough of them. missingErr is returned as is when there are not.
+func (s *LazilyPersistentStoreColumn) reconstructAndSave(ctx context.Context, b blocks.ROBlock, entry *dataColumnCacheEntry, blockCommitments [][]byte, missingErr error) error {
+	root := b.Root()
+	stashed := entry.stashed()
+	available := entry.diskSummary.Count() + uint64(len(stashed))
+	if available < peerdas.MinimumColumnsCountToReconstruct() {
+		return errors.Wrap(missingErr, "incomplete DataColumnSidecar batch")
+	}
+
+	sidecars, err := entry.filter(root, blockCommitments, stashed)
+	if err != nil {
+		return errors.Wrap(err, "incomplete DataColumnSidecar batch")
+	}
+	vscs, err := s.verify(ctx, b, sidecars)
+	if err != nil {
+		return err
+	}
+	for idx := range entry.diskSummary.Stored() {
+		vsc, err := s.store.Get(root, idx)
+		if err != nil {
+			return errors.Wrapf(err, "failed to read DataColumnSidecar index %d for block %#x", idx, root)
+		}
+		vscs = append(vscs, vsc)
+	}
+
+	start := time.Now()
+	reconstructed, err := peerdas.ReconstructDataColumnSidecars(vscs)
+	if err != nil {
+		dataColumnReconstructionFailureCount.Inc()
+		return errors.Wrapf(err, "could not reconstruct data columns for block %#x", root)
+	}
+	dataColumnReconstructionTime.Observe(float64(time.Since(start).Milliseconds()))
+	dataColumnReconstructionCount.Inc()
+
+	toSave := make([]blocks.VerifiedRODataColumn, 0, len(s.custodyColumns))
+	for _, vsc := range reconstructed {
+		if s.custodyColumns[vsc.ColumnIndex] && !entry.diskSummary.HasIndex(vsc.ColumnIndex) {
+			toSave = append(toSave, vsc)
+		}
+	}
+	log.WithFields(logging.DataColumnFields(reconstructed[0].RODataColumn)).
+		WithField("availableColumns", available).
+		Debug("Reconstructed missing data columns")
+	return s.save(root, toSave)
+}
+
+// verify runs the thorough verifications of each DataColumnSidecar for the block. We don't save any DataColumnSidecar
+// if there are problems with the batch.
+func (s *LazilyPersistentStoreColumn) verify(ctx context.Context, b blocks.ROBlock, sidecars []blocks.RODataColumn) ([]blocks.VerifiedRODataColumn, error) {
+	vscs, err := s.verifier.VerifiedRODataColumns(ctx, b, sidecars)
+	if err != nil {
+		var me verification.VerificationMultiError
+		ok := errors.As(err, &me)
+		if ok && len(sidecars) > 0 {
+			fails := me.Failures()
+			lf := make(log.Fields, len(fails))
+			for i := range fails {
+				lf[fmt.Sprintf("fail_%d", i)] = fails[i].Error()
+			}
+			log.WithFields(lf).WithFields(logging.DataColumnFields(sidecars[0])).
+				Debug("invalid DataColumnSidecars received")
+		}
+		return nil, errors.Wrapf(err, "invalid DataColumnSidecars received for block %#x", b.Root())
+	}
+	return vscs, nil
+}
+
+// save ensures that each DataColumnSidecar is written to disk.
+func (s *LazilyPersistentStoreColumn) save(root [32]byte, vscs []blocks.VerifiedRODataColumn) error {
+	for i := range vscs {
+		if err := s.store.Save(vscs[i]); err != nil {
+			return errors.Wrapf(err, "failed to save DataColumnSidecar index %d for block %#x", vscs[i].ColumnIndex, root)
+		}
+	}
+	return nil
+}
+
+func columnCommitmentsToCheck(b blocks.ROBlock, current primitives.Slot) ([][]byte, error) {
+	if b.Version() < version.Fulu {
+		return nil, nil
+	}
+
+	// We are only required to check within MIN_EPOCHS_FOR_DATA_COLUMN_SIDECARS_REQUESTS
+	if !params.WithinDataColumnDAPeriod(slots.ToEpoch(b.Block().Slot()), slots.ToEpoch(current)) {
+		return nil, nil
+	}
+
+	kzgCommitments, err := b.Block().Body().BlobKzgCommitments()
+	if err != nil {
+		return nil, err
+	}
+
+	maxBlobCount := params.BeaconConfig().MaxBlobsPerBlock(b.Block().Slot())
+	if len(kzgCommitments) > maxBlobCount {
+		return nil, errIndexOutOfBounds
+	}
+
+	result := make([][]byte, len(kzgCommitments))
+	copy(result, kzgCommitments)
+
+	return result, nil
+}
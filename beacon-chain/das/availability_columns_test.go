@@ -0,0 +1,145 @@
+package das
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/peerdas"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/filesystem"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/verification"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+)
+
+func TestLazilyPersistentColumn_NoCommitments(t *testing.T) {
+	ctx := context.Background()
+	mcv := &mockDataColumnBatchVerifier{t: t, err: errors.New("verification should not run")}
+	as := NewLazilyPersistentStoreColumn(filesystem.NewEphemeralDataColumnStorage(t), mcv, map[uint64]bool{0: true})
+
+	denebBlk, _ := util.GenerateTestDenebBlockWithSidecar(t, [32]byte{}, 1, 3)
+	require.NoError(t, as.IsDataAvailable(ctx, 1, denebBlk))
+	fuluBlk, _ := util.GenerateTestFuluBlockWithColumns(t, [32]byte{}, 1, 0)
+	require.NoError(t, as.IsDataAvailable(ctx, 1, fuluBlk))
+}
+
+func TestLazilyPersistentColumn_Missing(t *testing.T) {
+	ctx := context.Background()
+	store := filesystem.NewEphemeralDataColumnStorage(t)
+	blk, columns := util.GenerateTestFuluBlockWithColumns(t, [32]byte{}, 1, 2)
+
+	mcv := &mockDataColumnBatchVerifier{t: t, scs: []blocks.RODataColumn{columns[1], columns[3]}}
+	as := NewLazilyPersistentStoreColumn(store, mcv, map[uint64]bool{1: true, 3: true})
+
+	// Only one custody column persisted.
+	require.NoError(t, as.Persist(1, blocks.NewSidecarFromDataColumnSidecar(columns[3])))
+	require.ErrorIs(t, as.IsDataAvailable(ctx, 1, blk), errMissingSidecar)
+
+	// Non custody columns don't matter.
+	require.NoError(t, as.Persist(1, blocks.NewSidecarsFromDataColumnSidecars(columns[4:8])...))
+	require.ErrorIs(t, as.IsDataAvailable(ctx, 1, blk), errMissingSidecar)
+
+	// All custody columns persisted.
+	require.NoError(t, as.Persist(1, blocks.NewSidecarsFromDataColumnSidecars([]blocks.RODataColumn{columns[1], columns[3]})...))
+	require.NoError(t, as.IsDataAvailable(ctx, 1, blk))
+	summary := store.Summary(blk.Root())
+	require.Equal(t, true, summary.HasIndex(1))
+	require.Equal(t, true, summary.HasIndex(3))
+	require.Equal(t, uint64(2), summary.Count())
+
+	// Columns already on disk are not verified again.
+	mcv.err = errors.New("verification should not run")
+	require.NoError(t, as.IsDataAvailable(ctx, 1, blk))
+}
+
+func TestLazilyPersistentColumn_Mismatch(t *testing.T) {
+	ctx := context.Background()
+	blk, columns := util.GenerateTestFuluBlockWithColumns(t, [32]byte{}, 1, 2)
+
+	mcv := &mockDataColumnBatchVerifier{t: t, err: errors.New("kzg check should not run")}
+	columns[0].KzgCommitments = [][]byte{columns[0].KzgCommitments[0], bytesutil.PadTo([]byte("nope"), 48)}
+	as := NewLazilyPersistentStoreColumn(filesystem.NewEphemeralDataColumnStorage(t), mcv, map[uint64]bool{0: true})
+
+	require.NoError(t, as.Persist(1, blocks.NewSidecarFromDataColumnSidecar(columns[0])))
+	require.ErrorIs(t, as.IsDataAvailable(ctx, 1, blk), errCommitmentMismatch)
+}
+
+func TestLazilyPersistentColumn_Reconstruction(t *testing.T) {
+	ctx := context.Background()
+	minimum := peerdas.MinimumColumnsCountToReconstruct()
+	blk, columns := util.GenerateTestFuluBlockWithColumns(t, [32]byte{}, 1, 1)
+	store := filesystem.NewEphemeralDataColumnStorage(t)
+
+	// Part of the columns are already on disk, custody column 0 is not available anywhere.
+	onDisk := columns[1:3]
+	for _, vsc := range verification.FakeVerifyDataColumnSliceForTest(t, onDisk) {
+		require.NoError(t, store.Save(vsc))
+	}
+	stashed := columns[3 : minimum+1]
+
+	mcv := &mockDataColumnBatchVerifier{t: t, scs: stashed}
+	as := NewLazilyPersistentStoreColumn(store, mcv, map[uint64]bool{0: true})
+
+	// One column short of the minimum, the reconstruction is not attempted.
+	require.NoError(t, as.Persist(1, blocks.NewSidecarsFromDataColumnSidecars(stashed[:len(stashed)-1])...))
+	err := as.IsDataAvailable(ctx, 1, blk)
+	require.ErrorIs(t, err, errMissingSidecar)
+
+	// Enough columns, the missing custody column is reconstructed and saved, the other missing columns are not.
+	require.NoError(t, as.Persist(1, blocks.NewSidecarsFromDataColumnSidecars(stashed)...))
+	require.NoError(t, as.IsDataAvailable(ctx, 1, blk))
+	summary := store.Summary(blk.Root())
+	require.Equal(t, true, summary.HasIndex(0))
+	require.Equal(t, false, summary.HasIndex(minimum+1))
+	saved, err := store.Get(blk.Root(), 0)
+	require.NoError(t, err)
+	require.DeepSSZEqual(t, columns[0].DataColumnSidecar, saved.DataColumnSidecar)
+}
+
+func TestLazilyPersistColumnOnceCommitted(t *testing.T) {
+	_, columns := util.GenerateTestFuluBlockWithColumns(t, [32]byte{}, 1, 2)
+	as := NewLazilyPersistentStoreColumn(filesystem.NewEphemeralDataColumnStorage(t), &mockDataColumnBatchVerifier{}, map[uint64]bool{})
+	// stashes as expected
+	require.NoError(t, as.Persist(1, blocks.NewSidecarsFromDataColumnSidecars(columns[:4])...))
+	// ignores duplicates
+	require.ErrorIs(t, as.Persist(1, blocks.NewSidecarFromDataColumnSidecar(columns[0])), ErrDuplicateSidecar)
+
+	// rejects blobs
+	_, blobs := util.GenerateTestDenebBlockWithSidecar(t, [32]byte{}, 1, 1)
+	require.ErrorIs(t, as.Persist(1, blocks.NewSidecarFromBlobSidecar(blobs[0])), blocks.ErrNotDataColumnSidecar)
+
+	_, more := util.GenerateTestFuluBlockWithColumns(t, [32]byte{}, 2, 2)
+	// rejects sidecars of different blocks in the same call
+	require.ErrorIs(t, as.Persist(1, blocks.NewSidecarsFromDataColumnSidecars([]blocks.RODataColumn{columns[5], more[5]})...), errMixedRoots)
+
+	// ignores sidecars before the retention period
+	slotOOB, err := slots.EpochStart(params.BeaconConfig().MinEpochsForDataColumnSidecarsRequest)
+	require.NoError(t, err)
+	require.NoError(t, as.Persist(32+slotOOB, blocks.NewSidecarFromDataColumnSidecar(columns[0])))
+
+	// doesn't ignore new sidecars with a different block root
+	require.NoError(t, as.Persist(1, blocks.NewSidecarsFromDataColumnSidecars(more[:4])...))
+}
+
+type mockDataColumnBatchVerifier struct {
+	t   *testing.T
+	scs []blocks.RODataColumn
+	err error
+}
+
+var _ DataColumnBatchVerifier = &mockDataColumnBatchVerifier{}
+
+func (m *mockDataColumnBatchVerifier) VerifiedRODataColumns(_ context.Context, _ blocks.ROBlock, scs []blocks.RODataColumn) ([]blocks.VerifiedRODataColumn, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	require.Equal(m.t, len(m.scs), len(scs))
+	for i := range m.scs {
+		require.Equal(m.t, m.scs[i].ColumnIndex, scs[i].ColumnIndex)
+	}
+	return verification.FakeVerifyDataColumnSliceForTest(m.t, scs), nil
+}
@@ -0,0 +1,44 @@
+package das
+
+import (
+	"context"
+
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/runtime/version"
+)
+
+// ForkAwareStore is an implementation of AvailabilityStore for batches of blocks that may cross the Fulu fork.
+// Blob sidecars and the blocks before the Fulu fork are handled by its blob store, data column sidecars and the
+// blocks from the Fulu fork on by its column store.
+type ForkAwareStore struct {
+	blobs   AvailabilityStore
+	columns AvailabilityStore
+}
+
+var _ AvailabilityStore = &ForkAwareStore{}
+
+// NewForkAwareStore creates a ForkAwareStore from a blob store, typically a LazilyPersistentStore, and a column
+// store, typically a LazilyPersistentStoreColumn.
+func NewForkAwareStore(blobs, columns AvailabilityStore) *ForkAwareStore {
+	return &ForkAwareStore{blobs: blobs, columns: columns}
+}
+
+// Persist passes the sidecars to the store of their type. The sidecars of a single call must all be of the same type.
+func (s *ForkAwareStore) Persist(current primitives.Slot, sidecars ...blocks.ROSidecar) error {
+	if len(sidecars) == 0 {
+		return nil
+	}
+	if _, err := sidecars[0].DataColumn(); err == nil {
+		return s.columns.Persist(current, sidecars...)
+	}
+	return s.blobs.Persist(current, sidecars...)
+}
+
+// IsDataAvailable checks the availability of the block with the store of its fork.
+func (s *ForkAwareStore) IsDataAvailable(ctx context.Context, current primitives.Slot, b blocks.ROBlock) error {
+	if b.Version() >= version.Fulu {
+		return s.columns.IsDataAvailable(ctx, current, b)
+	}
+	return s.blobs.IsDataAvailable(ctx, current, b)
+}
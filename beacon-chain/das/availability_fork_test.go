@@ -0,0 +1,43 @@
+package das
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+)
+
+func TestForkAwareStore(t *testing.T) {
+	ctx := context.Background()
+	errBlobs, errColumns := errors.New("blobs"), errors.New("columns")
+	var persisted []string
+	blobStore := &MockAvailabilityStore{
+		VerifyAvailabilityCallback: func(context.Context, primitives.Slot, blocks.ROBlock) error { return errBlobs },
+		PersistBlobsCallback: func(primitives.Slot, ...blocks.ROSidecar) error {
+			persisted = append(persisted, "blobs")
+			return nil
+		},
+	}
+	columnStore := &MockAvailabilityStore{
+		VerifyAvailabilityCallback: func(context.Context, primitives.Slot, blocks.ROBlock) error { return errColumns },
+		PersistBlobsCallback: func(primitives.Slot, ...blocks.ROSidecar) error {
+			persisted = append(persisted, "columns")
+			return nil
+		},
+	}
+	s := NewForkAwareStore(blobStore, columnStore)
+
+	denebBlk, blobs := util.GenerateTestDenebBlockWithSidecar(t, [32]byte{}, 1, 1)
+	fuluBlk, columns := util.GenerateTestFuluBlockWithColumns(t, [32]byte{}, 1, 1)
+	require.NoError(t, s.Persist(1))
+	require.NoError(t, s.Persist(1, blocks.NewSidecarsFromBlobSidecars(blobs)...))
+	require.NoError(t, s.Persist(1, blocks.NewSidecarsFromDataColumnSidecars(columns[:2])...))
+	require.DeepEqual(t, []string{"blobs", "columns"}, persisted)
+
+	require.ErrorIs(t, s.IsDataAvailable(ctx, 1, denebBlk), errBlobs)
+	require.ErrorIs(t, s.IsDataAvailable(ctx, 1, fuluBlk), errColumns)
+}
@@ -122,17 +122,17 @@ func TestLazilyPersistent_Missing(t *testing.T) {
 	as := NewLazilyPersistentStore(store, mbv)
 
 	// Only one commitment persisted, should return error with other indices
-	require.NoError(t, as.Persist(1, scs[2]))
+	require.NoError(t, as.Persist(1, blocks.NewSidecarFromBlobSidecar(scs[2])))
 	err := as.IsDataAvailable(ctx, 1, blk)
 	require.ErrorIs(t, err, errMissingSidecar)
 
 	// All but one persisted, return missing idx
-	require.NoError(t, as.Persist(1, scs[0]))
+	require.NoError(t, as.Persist(1, blocks.NewSidecarFromBlobSidecar(scs[0])))
 	err = as.IsDataAvailable(ctx, 1, blk)
 	require.ErrorIs(t, err, errMissingSidecar)
 
 	// All persisted, return nil
-	require.NoError(t, as.Persist(1, scs...))
+	require.NoError(t, as.Persist(1, blocks.NewSidecarsFromBlobSidecars(scs)...))
 
 	require.NoError(t, as.IsDataAvailable(ctx, 1, blk))
 }
@@ -148,7 +148,7 @@ func TestLazilyPersistent_Mismatch(t *testing.T) {
 	as := NewLazilyPersistentStore(store, mbv)
 
 	// Only one commitment persisted, should return error with other indices
-	require.NoError(t, as.Persist(1, scs[0]))
+	require.NoError(t, as.Persist(1, blocks.NewSidecarFromBlobSidecar(scs[0])))
 	err := as.IsDataAvailable(ctx, 1, blk)
 	require.NotNil(t, err)
 	require.ErrorIs(t, err, errCommitmentMismatch)
@@ -158,22 +158,22 @@ func TestLazyPersistOnceCommitted(t *testing.T) {
 	_, scs := util.GenerateTestDenebBlockWithSidecar(t, [32]byte{}, 1, 6)
 	as := NewLazilyPersistentStore(filesystem.NewEphemeralBlobStorage(t), &mockBlobBatchVerifier{})
 	// stashes as expected
-	require.NoError(t, as.Persist(1, scs...))
+	require.NoError(t, as.Persist(1, blocks.NewSidecarsFromBlobSidecars(scs)...))
 	// ignores duplicates
-	require.ErrorIs(t, as.Persist(1, scs...), ErrDuplicateSidecar)
+	require.ErrorIs(t, as.Persist(1, blocks.NewSidecarsFromBlobSidecars(scs)...), ErrDuplicateSidecar)
 
 	// ignores index out of bound
 	scs[0].Index = 6
-	require.ErrorIs(t, as.Persist(1, scs[0]), errIndexOutOfBounds)
+	require.ErrorIs(t, as.Persist(1, blocks.NewSidecarFromBlobSidecar(scs[0])), errIndexOutOfBounds)
 
 	_, more := util.GenerateTestDenebBlockWithSidecar(t, [32]byte{}, 1, 4)
 	// ignores sidecars before the retention period
 	slotOOB, err := slots.EpochStart(params.BeaconConfig().MinEpochsForBlobsSidecarsRequest)
 	require.NoError(t, err)
-	require.NoError(t, as.Persist(32+slotOOB, more[0]))
+	require.NoError(t, as.Persist(32+slotOOB, blocks.NewSidecarFromBlobSidecar(more[0])))
 
 	// doesn't ignore new sidecars with a different block root
-	require.NoError(t, as.Persist(1, more...))
+	require.NoError(t, as.Persist(1, blocks.NewSidecarsFromBlobSidecars(more)...))
 }
 
 type mockBlobBatchVerifier struct {
@@ -0,0 +1,112 @@
+package das
+
+import (
+	"bytes"
+	"slices"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/filesystem"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+)
+
+var errColumnIndexOutOfBounds = errors.New("sidecar.index >= NUMBER_OF_COLUMNS")
+
+type dataColumnCache struct {
+	entries map[cacheKey]*dataColumnCacheEntry
+}
+
+func newDataColumnCache() *dataColumnCache {
+	return &dataColumnCache{entries: make(map[cacheKey]*dataColumnCacheEntry)}
+}
+
+// keyFromDataColumn is a convenience method for constructing a cacheKey from a DataColumnSidecar value.
+func keyFromDataColumn(sc blocks.RODataColumn) cacheKey {
+	return cacheKey{slot: sc.Slot(), root: sc.BlockRoot()}
+}
+
+// ensure returns the entry for the given key, creating it if it isn't already present.
+func (c *dataColumnCache) ensure(key cacheKey) *dataColumnCacheEntry {
+	e, ok := c.entries[key]
+	if !ok {
+		e = &dataColumnCacheEntry{scs: make([]*blocks.RODataColumn, params.BeaconConfig().NumberOfColumns)}
+		c.entries[key] = e
+	}
+	return e
+}
+
+// delete removes the cache entry from the cache.
+func (c *dataColumnCache) delete(key cacheKey) {
+	delete(c.entries, key)
+}
+
+// dataColumnCacheEntry holds a fixed-length cache of DataColumnSidecars, one slot per column index.
+type dataColumnCacheEntry struct {
+	scs         []*blocks.RODataColumn
+	diskSummary filesystem.DataColumnStorageSummary
+}
+
+func (e *dataColumnCacheEntry) setDiskSummary(sum filesystem.DataColumnStorageSummary) {
+	e.diskSummary = sum
+}
+
+// stash adds an item to the in-memory cache of DataColumnSidecars.
+// Only the first DataColumnSidecar of a given index will be kept in the cache.
+// stash will return an error if the given data column is already in the cache, or if the index is out of bounds.
+func (e *dataColumnCacheEntry) stash(sc *blocks.RODataColumn) error {
+	if sc.ColumnIndex >= uint64(len(e.scs)) {
+		return errors.Wrapf(errColumnIndexOutOfBounds, "index=%d", sc.ColumnIndex)
+	}
+	if e.scs[sc.ColumnIndex] != nil {
+		return errors.Wrapf(ErrDuplicateSidecar, "root=%#x, index=%d", sc.BlockRoot(), sc.ColumnIndex)
+	}
+	e.scs[sc.ColumnIndex] = sc
+	return nil
+}
+
+// stashed returns the indices of the data columns in the cache that are not already available on disk.
+func (e *dataColumnCacheEntry) stashed() map[uint64]bool {
+	indices := make(map[uint64]bool)
+	for i, sc := range e.scs {
+		if sc != nil && !e.diskSummary.HasIndex(uint64(i)) {
+			indices[uint64(i)] = true
+		}
+	}
+	return indices
+}
+
+// filter returns the cached sidecars for the given column indices, excluding the ones already available on disk.
+// It returns custom errors if the cache is missing any of these columns, or if the commitments of a cached column
+// do not match those found in the block. The result is sorted by column index.
+func (e *dataColumnCacheEntry) filter(root [32]byte, kc [][]byte, indices map[uint64]bool) ([]blocks.RODataColumn, error) {
+	sorted := make([]uint64, 0, len(indices))
+	for idx := range indices {
+		sorted = append(sorted, idx)
+	}
+	slices.Sort(sorted)
+
+	scs := make([]blocks.RODataColumn, 0, len(sorted))
+	for _, idx := range sorted {
+		// We already have this column, we don't need to write it or validate it.
+		if e.diskSummary.HasIndex(idx) {
+			continue
+		}
+		if idx >= uint64(len(e.scs)) {
+			return nil, errors.Wrapf(errColumnIndexOutOfBounds, "root=%#x, index=%d", root, idx)
+		}
+		sc := e.scs[idx]
+		if sc == nil {
+			return nil, errors.Wrapf(errMissingSidecar, "root=%#x, index=%d", root, idx)
+		}
+		if len(sc.KzgCommitments) != len(kc) {
+			return nil, errors.Wrapf(errCommitmentMismatch, "root=%#x, index=%d, commitments=%d, block commitments=%d", root, idx, len(sc.KzgCommitments), len(kc))
+		}
+		for i := range kc {
+			if !bytes.Equal(kc[i], sc.KzgCommitments[i]) {
+				return nil, errors.Wrapf(errCommitmentMismatch, "root=%#x, index=%d, commitment=%#x, block commitment=%#x", root, idx, sc.KzgCommitments[i], kc[i])
+			}
+		}
+		scs = append(scs, *sc)
+	}
+	return scs, nil
+}
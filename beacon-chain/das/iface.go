@@ -11,9 +11,11 @@ import (
 // verified and saved sidecars.
 // Persist guarantees that the sidecar will be available to perform a DA check
 // for the life of the beacon node process.
-// IsDataAvailable guarantees that all blobs committed to in the block have been
+// IsDataAvailable guarantees that all the sidecars the node needs for the block have been
 // durably persisted before returning a non-error value.
+// Implementations only accept the sidecar type of the forks they are used for: blobs for
+// LazilyPersistentStore and data columns for LazilyPersistentStoreColumn.
 type AvailabilityStore interface {
 	IsDataAvailable(ctx context.Context, current primitives.Slot, b blocks.ROBlock) error
-	Persist(current primitives.Slot, sc ...blocks.ROBlob) error
+	Persist(current primitives.Slot, sc ...blocks.ROSidecar) error
 }
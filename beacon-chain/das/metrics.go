@@ -0,0 +1,22 @@
+package das
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	dataColumnReconstructionTime = promauto.NewHistogram(prometheus.HistogramOpts{
+		Name:    "data_column_reconstruction_milliseconds",
+		Help:    "Time taken to reconstruct the missing data columns of a block in milliseconds",
+		Buckets: []float64{10, 25, 50, 100, 250, 500, 1000, 2000, 4000},
+	})
+	dataColumnReconstructionCount = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "data_column_reconstruction_total",
+		Help: "Number of times the missing data columns of a block were reconstructed",
+	})
+	dataColumnReconstructionFailureCount = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "data_column_reconstruction_failed_total",
+		Help: "Number of times the missing data columns of a block could not be reconstructed",
+	})
+)
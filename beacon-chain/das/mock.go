@@ -10,7 +10,7 @@ import (
 // MockAvailabilityStore is an implementation of AvailabilityStore that can be used by other packages in tests.
 type MockAvailabilityStore struct {
 	VerifyAvailabilityCallback func(ctx context.Context, current primitives.Slot, b blocks.ROBlock) error
-	PersistBlobsCallback       func(current primitives.Slot, sc ...blocks.ROBlob) error
+	PersistBlobsCallback       func(current primitives.Slot, sc ...blocks.ROSidecar) error
 }
 
 var _ AvailabilityStore = &MockAvailabilityStore{}
@@ -24,7 +24,7 @@ func (m *MockAvailabilityStore) IsDataAvailable(ctx context.Context, current pri
 }
 
 // Persist satisfies the corresponding method of the AvailabilityStore interface in a way that is useful for tests.
-func (m *MockAvailabilityStore) Persist(current primitives.Slot, sc ...blocks.ROBlob) error {
+func (m *MockAvailabilityStore) Persist(current primitives.Slot, sc ...blocks.ROSidecar) error {
 	if m.PersistBlobsCallback != nil {
 		return m.PersistBlobsCallback(current, sc...)
 	}
@@ -0,0 +1,98 @@
+// Package backend defines the embedded key-value storage engines the beacon node
+// database can be built on. The interfaces mirror the subset of the bolt API used by
+// the kv package: buckets of sorted keys, read-only and read-write transactions and
+// cursors. Bolt remains the default backend, and pebble, an LSM-based store, is
+// provided for large archive nodes where bolt's freelist and lack of compaction
+// become a bottleneck.
+package backend
+
+import (
+	"github.com/pkg/errors"
+	bolt "go.etcd.io/bbolt"
+)
+
+// Kind identifies a storage backend.
+type Kind string
+
+const (
+	// Bolt stores data in a single bbolt file. This is the default backend.
+	Bolt Kind = "bolt"
+	// Pebble stores data in a pebble LSM directory.
+	Pebble Kind = "pebble"
+)
+
+var (
+	// ErrBucketNotFound is returned when deleting a bucket that does not exist.
+	ErrBucketNotFound = bolt.ErrBucketNotFound
+	// ErrTxNotWritable is returned when writing within a read-only transaction.
+	ErrTxNotWritable = bolt.ErrTxNotWritable
+	// ErrKeyRequired is returned when writing an empty key.
+	ErrKeyRequired = bolt.ErrKeyRequired
+	// ErrBucketNameRequired is returned when creating a bucket with an empty name.
+	ErrBucketNameRequired = bolt.ErrBucketNameRequired
+)
+
+// Kinds lists the supported backends.
+var Kinds = []Kind{Bolt, Pebble}
+
+// ParseKind returns the backend named by s.
+func ParseKind(s string) (Kind, error) {
+	for _, k := range Kinds {
+		if string(k) == s {
+			return k, nil
+		}
+	}
+	return "", errors.Errorf("unknown database backend %q, expected one of %v", s, Kinds)
+}
+
+// DB is a transactional key-value store organized in buckets.
+type DB interface {
+	// View runs fn within a read-only transaction over a consistent view of the database.
+	View(fn func(Tx) error) error
+	// Update runs fn within a read-write transaction, which is committed if fn returns
+	// nil and discarded otherwise. Read-write transactions are serialized.
+	Update(fn func(Tx) error) error
+	// Close releases the resources held by the database.
+	Close() error
+}
+
+// Tx is a database transaction. It must not be used once the function it was passed to returns.
+// Read errors of the methods which do not return an error, such as Bucket, Get and the cursor
+// methods, fail the transaction: they read nothing, and View or Update return the error.
+type Tx interface {
+	// Bucket returns the bucket with the given name, or nil if it does not exist.
+	Bucket(name []byte) Bucket
+	// CreateBucketIfNotExists returns the bucket with the given name, creating it if needed.
+	CreateBucketIfNotExists(name []byte) (Bucket, error)
+	// DeleteBucket deletes the bucket with the given name along with all of its keys.
+	DeleteBucket(name []byte) error
+	// ForEach calls fn for every bucket, in name order.
+	ForEach(fn func(name []byte, b Bucket) error) error
+}
+
+// Bucket is a sorted collection of key-value pairs.
+type Bucket interface {
+	// Get returns the value of key, or nil if the key does not exist. The returned
+	// slice is only valid for the life of the transaction.
+	Get(key []byte) []byte
+	// Put sets the value of key.
+	Put(key []byte, value []byte) error
+	// Delete removes key. Deleting a missing key is not an error.
+	Delete(key []byte) error
+	// Cursor returns a cursor over the keys of the bucket.
+	Cursor() Cursor
+	// ForEach calls fn for every key-value pair of the bucket, in key order. The bucket
+	// must not be modified from fn.
+	ForEach(fn func(k, v []byte) error) error
+}
+
+// Cursor iterates over the keys of a bucket in order. Each method returns a nil
+// key once the cursor moves past either end of the bucket.
+type Cursor interface {
+	First() (key []byte, value []byte)
+	Last() (key []byte, value []byte)
+	Next() (key []byte, value []byte)
+	Prev() (key []byte, value []byte)
+	// Seek moves the cursor to the first key greater than or equal to seek.
+	Seek(seek []byte) (key []byte, value []byte)
+}
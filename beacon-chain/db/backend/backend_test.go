@@ -0,0 +1,284 @@
+package backend
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"path/filepath"
+	"testing"
+
+	"github.com/cockroachdb/pebble"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	bolt "go.etcd.io/bbolt"
+)
+
+func setupBolt(t *testing.T) DB {
+	db, err := bolt.Open(filepath.Join(t.TempDir(), "test.db"), 0600, nil)
+	require.NoError(t, err)
+	d := NewBolt(db)
+	t.Cleanup(func() {
+		require.NoError(t, d.Close())
+	})
+	return d
+}
+
+func setupPebble(t *testing.T) DB {
+	d, err := OpenPebble(t.TempDir())
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, d.Close())
+	})
+	return d
+}
+
+var backends = map[Kind]func(t *testing.T) DB{
+	Bolt:   setupBolt,
+	Pebble: setupPebble,
+}
+
+func TestParseKind(t *testing.T) {
+	k, err := ParseKind("pebble")
+	require.NoError(t, err)
+	require.Equal(t, Pebble, k)
+	_, err = ParseKind("leveldb")
+	require.ErrorContains(t, "unknown database backend", err)
+}
+
+func TestDB_Buckets(t *testing.T) {
+	for kind, setup := range backends {
+		t.Run(string(kind), func(t *testing.T) {
+			db := setup(t)
+			require.NoError(t, db.View(func(tx Tx) error {
+				require.Equal(t, true, tx.Bucket([]byte("a")) == nil)
+				_, err := tx.CreateBucketIfNotExists([]byte("a"))
+				require.ErrorIs(t, err, ErrTxNotWritable)
+				return nil
+			}))
+			require.NoError(t, db.Update(func(tx Tx) error {
+				for _, name := range []string{"b", "a", "ab"} {
+					b, err := tx.CreateBucketIfNotExists([]byte(name))
+					require.NoError(t, err)
+					require.NoError(t, b.Put([]byte("k"), []byte(name)))
+				}
+				return nil
+			}))
+			var names []string
+			require.NoError(t, db.View(func(tx Tx) error {
+				return tx.ForEach(func(name []byte, b Bucket) error {
+					names = append(names, string(name))
+					require.DeepEqual(t, name, b.Get([]byte("k")))
+					return nil
+				})
+			}))
+			require.DeepEqual(t, []string{"a", "ab", "b"}, names)
+
+			require.NoError(t, db.Update(func(tx Tx) error {
+				require.NoError(t, tx.DeleteBucket([]byte("a")))
+				require.ErrorIs(t, tx.DeleteBucket([]byte("c")), ErrBucketNotFound)
+				return nil
+			}))
+			require.NoError(t, db.Update(func(tx Tx) error {
+				require.Equal(t, true, tx.Bucket([]byte("a")) == nil)
+				b, err := tx.CreateBucketIfNotExists([]byte("a"))
+				require.NoError(t, err)
+				// Keys of a deleted bucket do not survive its recreation.
+				require.Equal(t, true, b.Get([]byte("k")) == nil)
+				require.DeepEqual(t, []byte("ab"), tx.Bucket([]byte("ab")).Get([]byte("k")))
+				return nil
+			}))
+		})
+	}
+}
+
+func TestDB_Keys(t *testing.T) {
+	for kind, setup := range backends {
+		t.Run(string(kind), func(t *testing.T) {
+			db := setup(t)
+			name := []byte("bucket")
+			require.NoError(t, db.Update(func(tx Tx) error {
+				b, err := tx.CreateBucketIfNotExists(name)
+				require.NoError(t, err)
+				require.NoError(t, b.Put([]byte{2}, []byte("two")))
+				require.NoError(t, b.Put([]byte{1}, []byte("one")))
+				require.NoError(t, b.Put([]byte{3}, []byte{}))
+				require.NoError(t, b.Put([]byte{4}, []byte("four")))
+				require.ErrorIs(t, b.Put(nil, []byte("none")), ErrKeyRequired)
+				// Writes are visible within the transaction.
+				require.DeepEqual(t, []byte("two"), b.Get([]byte{2}))
+				return b.Delete([]byte{4})
+			}))
+			require.NoError(t, db.View(func(tx Tx) error {
+				b := tx.Bucket(name)
+				require.DeepEqual(t, []byte("one"), b.Get([]byte{1}))
+				require.Equal(t, true, b.Get([]byte{3}) != nil, "empty value must be distinguishable from a missing key")
+				require.Equal(t, true, b.Get([]byte{4}) == nil)
+				require.ErrorIs(t, b.Put([]byte{5}, nil), ErrTxNotWritable)
+
+				var keys []byte
+				require.NoError(t, b.ForEach(func(k, _ []byte) error {
+					keys = append(keys, k...)
+					return nil
+				}))
+				require.DeepEqual(t, []byte{1, 2, 3}, keys)
+				return nil
+			}))
+			// A failed transaction is discarded.
+			err := db.Update(func(tx Tx) error {
+				require.NoError(t, tx.Bucket(name).Put([]byte{5}, []byte("five")))
+				return errors.New("failed")
+			})
+			require.ErrorContains(t, "failed", err)
+			require.NoError(t, db.View(func(tx Tx) error {
+				require.Equal(t, true, tx.Bucket(name).Get([]byte{5}) == nil)
+				return nil
+			}))
+		})
+	}
+}
+
+func TestDB_Cursor(t *testing.T) {
+	for kind, setup := range backends {
+		t.Run(string(kind), func(t *testing.T) {
+			db := setup(t)
+			require.NoError(t, db.Update(func(tx Tx) error {
+				// Neighbouring buckets must not leak into the cursor.
+				for _, name := range []string{"a", "b", "c"} {
+					b, err := tx.CreateBucketIfNotExists([]byte(name))
+					require.NoError(t, err)
+					for _, k := range []byte{10, 20, 30} {
+						require.NoError(t, b.Put([]byte{k}, []byte(name)))
+					}
+				}
+				return nil
+			}))
+			require.NoError(t, db.View(func(tx Tx) error {
+				c := tx.Bucket([]byte("b")).Cursor()
+				k, v := c.First()
+				require.DeepEqual(t, []byte{10}, k)
+				require.DeepEqual(t, []byte("b"), v)
+				k, _ = c.Next()
+				require.DeepEqual(t, []byte{20}, k)
+				k, _ = c.Last()
+				require.DeepEqual(t, []byte{30}, k)
+				k, _ = c.Prev()
+				require.DeepEqual(t, []byte{20}, k)
+				k, _ = c.Seek([]byte{15})
+				require.DeepEqual(t, []byte{20}, k)
+				k, _ = c.Seek([]byte{30})
+				require.DeepEqual(t, []byte{30}, k)
+				k, _ = c.Next()
+				require.Equal(t, true, k == nil)
+				k, _ = c.Seek([]byte{31})
+				require.Equal(t, true, k == nil)
+				return nil
+			}))
+		})
+	}
+}
+
+// failingReader fails every point read of the underlying reader.
+type failingReader struct {
+	pebble.Reader
+}
+
+var errRead = errors.New("read failure")
+
+func (failingReader) Get([]byte) ([]byte, io.Closer, error) {
+	return nil, nil, errRead
+}
+
+func TestPebble_ReadErrors(t *testing.T) {
+	db := setupPebble(t)
+	require.NoError(t, db.Update(func(tx Tx) error {
+		b, err := tx.CreateBucketIfNotExists([]byte("a"))
+		require.NoError(t, err)
+		return b.Put([]byte("k"), []byte("v"))
+	}))
+	p, ok := db.(*pebbleDB)
+	require.Equal(t, true, ok)
+
+	snap := p.db.NewSnapshot()
+	defer func() {
+		require.NoError(t, snap.Close())
+	}()
+	tx := &pebbleTx{r: failingReader{snap}}
+	require.Equal(t, true, tx.Bucket([]byte("a")) == nil)
+	require.ErrorIs(t, tx.err, errRead)
+	require.ErrorIs(t, tx.DeleteBucket([]byte("a")), ErrTxNotWritable)
+
+	tx = &pebbleTx{r: failingReader{snap}}
+	require.Equal(t, true, newPebbleBucket(tx, []byte("a")).Get([]byte("k")) == nil)
+	require.ErrorIs(t, tx.err, errRead)
+
+	// The error is returned by the transaction even if fn ignores it.
+	err := db.View(func(tx Tx) error {
+		tx.(*pebbleTx).r = failingReader{tx.(*pebbleTx).r}
+		tx.Bucket([]byte("a"))
+		return nil
+	})
+	require.ErrorIs(t, err, errRead)
+	err = db.Update(func(tx Tx) error {
+		b, err := tx.CreateBucketIfNotExists([]byte("b"))
+		require.NoError(t, err)
+		require.NoError(t, b.Put([]byte("k"), []byte("v")))
+		tx.(*pebbleTx).r = failingReader{tx.(*pebbleTx).r}
+		tx.Bucket([]byte("a"))
+		return nil
+	})
+	require.ErrorIs(t, err, errRead)
+	// The failed transaction is not committed.
+	require.NoError(t, db.View(func(tx Tx) error {
+		require.Equal(t, true, tx.Bucket([]byte("b")) == nil)
+		return nil
+	}))
+}
+
+func TestCopy(t *testing.T) {
+	for kind, setup := range backends {
+		t.Run(string(kind), func(t *testing.T) {
+			src := setup(t)
+			dst := setupPebble(t)
+			if kind == Pebble {
+				dst = setupBolt(t)
+			}
+			// Values larger than the copy batch size span several transactions.
+			large := make([]byte, copyBatchSize/2+1)
+			require.NoError(t, src.Update(func(tx Tx) error {
+				for i := 0; i < 3; i++ {
+					b, err := tx.CreateBucketIfNotExists([]byte(fmt.Sprintf("bucket%d", i)))
+					require.NoError(t, err)
+					for j := 0; j < 10; j++ {
+						require.NoError(t, b.Put([]byte{byte(j)}, []byte{byte(i), byte(j)}))
+					}
+				}
+				b, err := tx.CreateBucketIfNotExists([]byte("large"))
+				require.NoError(t, err)
+				for j := 0; j < 3; j++ {
+					require.NoError(t, b.Put([]byte{byte(j)}, large))
+				}
+				_, err = tx.CreateBucketIfNotExists([]byte("empty"))
+				return err
+			}))
+			require.NoError(t, Copy(context.Background(), dst, src))
+
+			require.NoError(t, dst.View(func(tx Tx) error {
+				for i := 0; i < 3; i++ {
+					b := tx.Bucket([]byte(fmt.Sprintf("bucket%d", i)))
+					for j := 0; j < 10; j++ {
+						require.DeepEqual(t, []byte{byte(i), byte(j)}, b.Get([]byte{byte(j)}))
+					}
+				}
+				var n int
+				require.NoError(t, tx.Bucket([]byte("large")).ForEach(func(_, v []byte) error {
+					require.Equal(t, len(large), len(v))
+					n++
+					return nil
+				}))
+				require.Equal(t, 3, n)
+				require.Equal(t, false, tx.Bucket([]byte("empty")) == nil)
+				return nil
+			}))
+		})
+	}
+}
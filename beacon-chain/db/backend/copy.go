@@ -0,0 +1,84 @@
+package backend
+
+import (
+	"context"
+)
+
+// copyBatchSize bounds the size of the values copied within a single pair of transactions,
+// which keeps memory usage low and avoids long running read transactions on the source.
+const copyBatchSize = 64 << 20
+
+// Copy copies every bucket of src, along with its keys, into dst. Existing keys of dst are
+// overwritten, and keys of dst missing from src are kept.
+func Copy(ctx context.Context, dst, src DB) error {
+	var names [][]byte
+	if err := src.View(func(tx Tx) error {
+		return tx.ForEach(func(name []byte, _ Bucket) error {
+			names = append(names, append([]byte{}, name...))
+			return nil
+		})
+	}); err != nil {
+		return err
+	}
+	for _, name := range names {
+		n, err := copyBucket(ctx, dst, src, name)
+		if err != nil {
+			return err
+		}
+		log.WithField("bucket", string(name)).WithField("keys", n).Info("Copied bucket")
+	}
+	return nil
+}
+
+func copyBucket(ctx context.Context, dst, src DB, name []byte) (int, error) {
+	if err := dst.Update(func(tx Tx) error {
+		_, err := tx.CreateBucketIfNotExists(name)
+		return err
+	}); err != nil {
+		return 0, err
+	}
+	var total int
+	var next []byte
+	for start := true; start || next != nil; start = false {
+		if err := ctx.Err(); err != nil {
+			return total, err
+		}
+		var keys, values [][]byte
+		if err := src.View(func(tx Tx) error {
+			b := tx.Bucket(name)
+			if b == nil {
+				return nil
+			}
+			c := b.Cursor()
+			var k, v []byte
+			if start {
+				k, v = c.First()
+			} else {
+				k, v = c.Seek(next)
+			}
+			size := 0
+			for ; k != nil && size < copyBatchSize; k, v = c.Next() {
+				keys = append(keys, append([]byte{}, k...))
+				values = append(values, append([]byte{}, v...))
+				size += len(k) + len(v)
+			}
+			next = append([]byte(nil), k...)
+			return nil
+		}); err != nil {
+			return total, err
+		}
+		if err := dst.Update(func(tx Tx) error {
+			b := tx.Bucket(name)
+			for i := range keys {
+				if err := b.Put(keys[i], values[i]); err != nil {
+					return err
+				}
+			}
+			return nil
+		}); err != nil {
+			return total, err
+		}
+		total += len(keys)
+	}
+	return total, nil
+}
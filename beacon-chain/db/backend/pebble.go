@@ -0,0 +1,340 @@
+package backend
+
+import (
+	"sync"
+
+	"github.com/cockroachdb/pebble"
+	"github.com/pkg/errors"
+)
+
+const (
+	pebbleCacheSize    = 256 << 20
+	pebbleMemTableSize = 64 << 20
+	// Bucket names are stored with a one byte length prefix.
+	maxBucketNameLength = 255
+)
+
+// Pebble has no notion of buckets, so every bucket is stored as a marker key along with
+// its data keys, which share a prefix made of the length and name of the bucket:
+//
+//	'b' | name                     -> nil
+//	'd' | len(name) | name | key   -> value
+var (
+	bucketMarkerPrefix = []byte{'b'}
+	bucketDataPrefix   = []byte{'d'}
+)
+
+// OpenPebble opens, or creates, a pebble database in the given directory.
+func OpenPebble(dir string) (DB, error) {
+	cache := pebble.NewCache(pebbleCacheSize)
+	defer cache.Unref()
+	db, err := pebble.Open(dir, &pebble.Options{
+		Cache:        cache,
+		MemTableSize: pebbleMemTableSize,
+		Logger:       pebbleLogger{},
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "could not open pebble database")
+	}
+	return &pebbleDB{db: db}, nil
+}
+
+type pebbleDB struct {
+	db *pebble.DB
+	// Pebble batches do not conflict with each other, so writable transactions are
+	// serialized to provide the same isolation as bolt.
+	lock sync.Mutex
+}
+
+func (p *pebbleDB) View(fn func(Tx) error) error {
+	snap := p.db.NewSnapshot()
+	tx := &pebbleTx{r: snap}
+	defer func() {
+		tx.closeIterators()
+		if err := snap.Close(); err != nil {
+			log.WithError(err).Error("Could not close pebble snapshot")
+		}
+	}()
+	if err := fn(tx); err != nil {
+		return err
+	}
+	return tx.err
+}
+
+func (p *pebbleDB) Update(fn func(Tx) error) error {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	batch := p.db.NewIndexedBatch()
+	tx := &pebbleTx{r: batch, batch: batch}
+	defer func() {
+		if err := batch.Close(); err != nil {
+			log.WithError(err).Error("Could not close pebble batch")
+		}
+	}()
+	err := fn(tx)
+	tx.closeIterators()
+	if err != nil {
+		return err
+	}
+	if tx.err != nil {
+		return tx.err
+	}
+	return batch.Commit(pebble.Sync)
+}
+
+func (p *pebbleDB) Close() error {
+	return p.db.Close()
+}
+
+type pebbleTx struct {
+	r pebble.Reader
+	// batch is nil for read-only transactions.
+	batch *pebble.Batch
+	// Iterators must be closed before the underlying snapshot or batch.
+	iterators []*pebble.Iterator
+	// err is the first read error of the transaction. The Bucket, Get and Cursor methods
+	// cannot return errors, so the transaction fails with it instead.
+	err error
+}
+
+// fail records a read error, which View and Update return once fn is done.
+func (t *pebbleTx) fail(err error, msg string) {
+	if t.err == nil {
+		t.err = errors.Wrap(err, msg)
+	}
+}
+
+func (t *pebbleTx) get(key []byte) ([]byte, error) {
+	v, closer, err := t.r.Get(key)
+	if errors.Is(err, pebble.ErrNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := closer.Close(); err != nil {
+			log.WithError(err).Error("Could not release pebble value")
+		}
+	}()
+	// The value is only valid until the closer is closed, and callers expect a non-nil
+	// slice for an existing key even if it is empty.
+	return append(make([]byte, 0, len(v)), v...), nil
+}
+
+func (t *pebbleTx) newIter(lower, upper []byte) (*pebble.Iterator, error) {
+	it, err := t.r.NewIter(&pebble.IterOptions{LowerBound: lower, UpperBound: upper})
+	if err != nil {
+		return nil, err
+	}
+	t.iterators = append(t.iterators, it)
+	return it, nil
+}
+
+func (t *pebbleTx) closeIterators() {
+	for _, it := range t.iterators {
+		if err := it.Close(); err != nil {
+			log.WithError(err).Error("Could not close pebble iterator")
+		}
+	}
+	t.iterators = nil
+}
+
+func (t *pebbleTx) Bucket(name []byte) Bucket {
+	v, err := t.get(bucketMarkerKey(name))
+	if err != nil {
+		t.fail(err, "could not read pebble bucket "+string(name))
+		return nil
+	}
+	if v == nil {
+		return nil
+	}
+	return newPebbleBucket(t, name)
+}
+
+func (t *pebbleTx) CreateBucketIfNotExists(name []byte) (Bucket, error) {
+	if t.batch == nil {
+		return nil, ErrTxNotWritable
+	}
+	if len(name) == 0 {
+		return nil, ErrBucketNameRequired
+	}
+	if len(name) > maxBucketNameLength {
+		return nil, errors.Errorf("bucket name is %d bytes long, longer than %d", len(name), maxBucketNameLength)
+	}
+	if err := t.batch.Set(bucketMarkerKey(name), nil, nil); err != nil {
+		return nil, err
+	}
+	return newPebbleBucket(t, name), nil
+}
+
+func (t *pebbleTx) DeleteBucket(name []byte) error {
+	if t.batch == nil {
+		return ErrTxNotWritable
+	}
+	if t.Bucket(name) == nil {
+		if t.err != nil {
+			return t.err
+		}
+		return ErrBucketNotFound
+	}
+	prefix := bucketDataKeyPrefix(name)
+	if err := t.batch.DeleteRange(prefix, prefixEnd(prefix), nil); err != nil {
+		return err
+	}
+	return t.batch.Delete(bucketMarkerKey(name), nil)
+}
+
+func (t *pebbleTx) ForEach(fn func(name []byte, b Bucket) error) error {
+	it, err := t.newIter(bucketMarkerPrefix, prefixEnd(bucketMarkerPrefix))
+	if err != nil {
+		return err
+	}
+	for valid := it.First(); valid; valid = it.Next() {
+		name := append([]byte{}, it.Key()[len(bucketMarkerPrefix):]...)
+		if err := fn(name, newPebbleBucket(t, name)); err != nil {
+			return err
+		}
+	}
+	return it.Error()
+}
+
+type pebbleBucket struct {
+	tx     *pebbleTx
+	prefix []byte
+}
+
+func newPebbleBucket(tx *pebbleTx, name []byte) *pebbleBucket {
+	return &pebbleBucket{tx: tx, prefix: bucketDataKeyPrefix(name)}
+}
+
+func (b *pebbleBucket) key(k []byte) []byte {
+	return append(append(make([]byte, 0, len(b.prefix)+len(k)), b.prefix...), k...)
+}
+
+func (b *pebbleBucket) Get(key []byte) []byte {
+	v, err := b.tx.get(b.key(key))
+	if err != nil {
+		b.tx.fail(err, "could not read pebble key")
+		return nil
+	}
+	return v
+}
+
+func (b *pebbleBucket) Put(key []byte, value []byte) error {
+	if b.tx.batch == nil {
+		return ErrTxNotWritable
+	}
+	if len(key) == 0 {
+		return ErrKeyRequired
+	}
+	return b.tx.batch.Set(b.key(key), value, nil)
+}
+
+func (b *pebbleBucket) Delete(key []byte) error {
+	if b.tx.batch == nil {
+		return ErrTxNotWritable
+	}
+	return b.tx.batch.Delete(b.key(key), nil)
+}
+
+func (b *pebbleBucket) Cursor() Cursor {
+	it, err := b.tx.newIter(b.prefix, prefixEnd(b.prefix))
+	if err != nil {
+		b.tx.fail(err, "could not create pebble iterator")
+		return emptyCursor{}
+	}
+	return &pebbleCursor{tx: b.tx, it: it, prefix: b.prefix}
+}
+
+func (b *pebbleBucket) ForEach(fn func(k, v []byte) error) error {
+	c := b.Cursor()
+	for k, v := c.First(); k != nil; k, v = c.Next() {
+		if err := fn(k, v); err != nil {
+			return err
+		}
+	}
+	return b.tx.err
+}
+
+type pebbleCursor struct {
+	tx     *pebbleTx
+	it     *pebble.Iterator
+	prefix []byte
+}
+
+func (c *pebbleCursor) First() ([]byte, []byte) {
+	return c.item(c.it.First())
+}
+
+func (c *pebbleCursor) Last() ([]byte, []byte) {
+	return c.item(c.it.Last())
+}
+
+func (c *pebbleCursor) Next() ([]byte, []byte) {
+	return c.item(c.it.Next())
+}
+
+func (c *pebbleCursor) Prev() ([]byte, []byte) {
+	return c.item(c.it.Prev())
+}
+
+func (c *pebbleCursor) Seek(seek []byte) ([]byte, []byte) {
+	key := append(append(make([]byte, 0, len(c.prefix)+len(seek)), c.prefix...), seek...)
+	return c.item(c.it.SeekGE(key))
+}
+
+func (c *pebbleCursor) item(valid bool) ([]byte, []byte) {
+	if !valid {
+		// An iterator also becomes invalid when it fails to read.
+		if err := c.it.Error(); err != nil {
+			c.tx.fail(err, "could not iterate over pebble keys")
+		}
+		return nil, nil
+	}
+	k := append([]byte{}, c.it.Key()[len(c.prefix):]...)
+	v := append(make([]byte, 0, len(c.it.Value())), c.it.Value()...)
+	return k, v
+}
+
+type emptyCursor struct{}
+
+func (emptyCursor) First() ([]byte, []byte)      { return nil, nil }
+func (emptyCursor) Last() ([]byte, []byte)       { return nil, nil }
+func (emptyCursor) Next() ([]byte, []byte)       { return nil, nil }
+func (emptyCursor) Prev() ([]byte, []byte)       { return nil, nil }
+func (emptyCursor) Seek([]byte) ([]byte, []byte) { return nil, nil }
+
+func bucketMarkerKey(name []byte) []byte {
+	return append(append(make([]byte, 0, len(bucketMarkerPrefix)+len(name)), bucketMarkerPrefix...), name...)
+}
+
+func bucketDataKeyPrefix(name []byte) []byte {
+	prefix := make([]byte, 0, len(bucketDataPrefix)+1+len(name))
+	prefix = append(prefix, bucketDataPrefix...)
+	prefix = append(prefix, byte(len(name)))
+	return append(prefix, name...)
+}
+
+// prefixEnd returns the smallest key greater than every key starting with prefix.
+func prefixEnd(prefix []byte) []byte {
+	end := append([]byte{}, prefix...)
+	for i := len(end) - 1; i >= 0; i-- {
+		end[i]++
+		if end[i] != 0 {
+			return end[:i+1]
+		}
+	}
+	return nil
+}
+
+// pebbleLogger forwards pebble's informational messages to the debug log.
+type pebbleLogger struct{}
+
+func (pebbleLogger) Infof(format string, args ...interface{}) {
+	log.Debugf(format, args...)
+}
+
+func (pebbleLogger) Fatalf(format string, args ...interface{}) {
+	log.Fatalf(format, args...)
+}
@@ -0,0 +1,82 @@
+package era
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+
+	"github.com/pkg/errors"
+)
+
+// headerSize is the size of an e2store record header: a 2 byte type, a 4 byte little-endian
+// data length and 2 reserved bytes that must be zero.
+const headerSize = 8
+
+// EntryType identifies the kind of data held by an e2store record.
+type EntryType [2]byte
+
+var (
+	// TypeEmpty is an entry without meaning, which readers skip.
+	TypeEmpty = EntryType{0x00, 0x00}
+	// TypeCompressedSignedBeaconBlock holds a snappy framed, SSZ encoded signed beacon block.
+	TypeCompressedSignedBeaconBlock = EntryType{0x01, 0x00}
+	// TypeCompressedBeaconState holds a snappy framed, SSZ encoded beacon state.
+	TypeCompressedBeaconState = EntryType{0x02, 0x00}
+	// TypeVersion marks the beginning of an e2store file. It has no data.
+	TypeVersion = EntryType{0x65, 0x32}
+	// TypeSlotIndex maps slots to the offsets of the records holding their data.
+	TypeSlotIndex = EntryType{0x69, 0x32}
+)
+
+var errReservedBytes = errors.New("e2store record has non-zero reserved bytes")
+
+// Entry is a single e2store record.
+type Entry struct {
+	Type EntryType
+	Data []byte
+}
+
+// e2Writer appends e2store records to an underlying writer, keeping track of the offset of each record.
+type e2Writer struct {
+	w      io.Writer
+	offset int64
+}
+
+// write appends a record of the given type and returns the offset at which the record starts.
+func (e *e2Writer) write(typ EntryType, data []byte) (int64, error) {
+	if uint64(len(data)) > uint64(^uint32(0)) {
+		return 0, fmt.Errorf("e2store record of %d bytes is too large", len(data))
+	}
+	var header [headerSize]byte
+	copy(header[:2], typ[:])
+	binary.LittleEndian.PutUint32(header[2:6], uint32(len(data)))
+	if _, err := e.w.Write(header[:]); err != nil {
+		return 0, errors.Wrap(err, "could not write record header")
+	}
+	if _, err := e.w.Write(data); err != nil {
+		return 0, errors.Wrap(err, "could not write record data")
+	}
+	start := e.offset
+	e.offset += int64(headerSize + len(data))
+	return start, nil
+}
+
+// readEntry reads the record starting at the given offset. It returns the record and the offset of the next one.
+func readEntry(r io.ReaderAt, offset int64) (*Entry, int64, error) {
+	var header [headerSize]byte
+	if _, err := r.ReadAt(header[:], offset); err != nil {
+		return nil, 0, errors.Wrapf(err, "could not read record header at offset %d", offset)
+	}
+	if header[6] != 0 || header[7] != 0 {
+		return nil, 0, errors.Wrapf(errReservedBytes, "offset %d", offset)
+	}
+	e := &Entry{Data: make([]byte, binary.LittleEndian.Uint32(header[2:6]))}
+	copy(e.Type[:], header[:2])
+	if len(e.Data) == 0 {
+		return e, offset + headerSize, nil
+	}
+	if _, err := r.ReadAt(e.Data, offset+headerSize); err != nil {
+		return nil, 0, errors.Wrapf(err, "could not read record data at offset %d", offset)
+	}
+	return e, offset + headerSize + int64(len(e.Data)), nil
+}
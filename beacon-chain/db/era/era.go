@@ -0,0 +1,337 @@
+package era
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+
+	"github.com/golang/snappy"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/interfaces"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/encoding/ssz/detect"
+)
+
+// Extension is the file extension of era files.
+const Extension = ".era"
+
+var (
+	errBlindedBlock      = errors.New("era files require full blocks, but the block is blinded")
+	errBlockOutOfRange   = errors.New("block slot is outside of the era")
+	errBlockOutOfOrder   = errors.New("blocks must be added in increasing slot order")
+	errWrongStateSlot    = errors.New("state slot does not match the end of the era")
+	errWriterFinalized   = errors.New("era writer has already been finalized")
+	errMalformedIndex    = errors.New("malformed slot index")
+	errUnexpectedRecord  = errors.New("unexpected record type")
+	errMissingVersion    = errors.New("era file does not start with a version record")
+	errSlotNotInEraRange = errors.New("slot is outside of the era's block range")
+)
+
+// SlotsPerEra is the number of slots covered by one era file, SLOTS_PER_HISTORICAL_ROOT.
+func SlotsPerEra() primitives.Slot {
+	return primitives.Slot(params.BeaconConfig().SlotsPerHistoricalRoot)
+}
+
+// StateSlot returns the slot of the state stored in the given era file. Era N holds the
+// blocks of slots [(N-1)*SLOTS_PER_HISTORICAL_ROOT, N*SLOTS_PER_HISTORICAL_ROOT) and the state at the end of that range.
+func StateSlot(era uint64) primitives.Slot {
+	return primitives.Slot(era) * SlotsPerEra()
+}
+
+// Writer produces a single era file, following the layout
+//
+//	Version | block* | state | slot-index(block)? | slot-index(state)
+//
+// described in https://github.com/status-im/nimbus-eth2/blob/stable/docs/e2store.md.
+// Era 0 only holds the genesis state and has no block index.
+type Writer struct {
+	e            *e2Writer
+	era          uint64
+	blockOffsets []int64
+	lastSlot     primitives.Slot
+	hasBlock     bool
+	finalized    bool
+}
+
+// NewWriter starts a new era file for the given era number by writing the version record.
+func NewWriter(w io.Writer, era uint64) (*Writer, error) {
+	e := &e2Writer{w: w}
+	if _, err := e.write(TypeVersion, nil); err != nil {
+		return nil, err
+	}
+	ew := &Writer{e: e, era: era}
+	if era > 0 {
+		ew.blockOffsets = make([]int64, SlotsPerEra())
+	}
+	return ew, nil
+}
+
+// AddBlock appends a compressed block to the era file. Blocks have to be added in increasing slot order.
+func (w *Writer) AddBlock(blk interfaces.ReadOnlySignedBeaconBlock) error {
+	if w.finalized {
+		return errWriterFinalized
+	}
+	if blk.IsBlinded() {
+		return errBlindedBlock
+	}
+	slot := blk.Block().Slot()
+	if w.era == 0 || slot < StateSlot(w.era-1) || slot >= StateSlot(w.era) {
+		return errors.Wrapf(errBlockOutOfRange, "slot=%d, era=%d", slot, w.era)
+	}
+	if w.hasBlock && slot <= w.lastSlot {
+		return errors.Wrapf(errBlockOutOfOrder, "slot=%d, previous=%d", slot, w.lastSlot)
+	}
+	sszBlock, err := blk.MarshalSSZ()
+	if err != nil {
+		return errors.Wrap(err, "could not marshal block")
+	}
+	data, err := compress(sszBlock)
+	if err != nil {
+		return err
+	}
+	offset, err := w.e.write(TypeCompressedSignedBeaconBlock, data)
+	if err != nil {
+		return err
+	}
+	w.blockOffsets[slot-StateSlot(w.era-1)] = offset
+	w.lastSlot, w.hasBlock = slot, true
+	return nil
+}
+
+// Finalize writes the era state followed by the slot indices. The writer can not be used afterwards.
+func (w *Writer) Finalize(st state.ReadOnlyBeaconState) error {
+	if w.finalized {
+		return errWriterFinalized
+	}
+	if st.Slot() != StateSlot(w.era) {
+		return errors.Wrapf(errWrongStateSlot, "state slot=%d, expected=%d", st.Slot(), StateSlot(w.era))
+	}
+	sszState, err := st.MarshalSSZ()
+	if err != nil {
+		return errors.Wrap(err, "could not marshal state")
+	}
+	data, err := compress(sszState)
+	if err != nil {
+		return err
+	}
+	stateOffset, err := w.e.write(TypeCompressedBeaconState, data)
+	if err != nil {
+		return err
+	}
+	if w.era > 0 {
+		if err := w.writeIndex(StateSlot(w.era-1), w.blockOffsets); err != nil {
+			return errors.Wrap(err, "could not write block index")
+		}
+	}
+	if err := w.writeIndex(StateSlot(w.era), []int64{stateOffset}); err != nil {
+		return errors.Wrap(err, "could not write state index")
+	}
+	w.finalized = true
+	return nil
+}
+
+// writeIndex writes a slot index record. Offsets are stored relative to the beginning of the index record,
+// a zero offset marks a slot without data.
+func (w *Writer) writeIndex(startSlot primitives.Slot, offsets []int64) error {
+	indexStart := w.e.offset
+	data := make([]byte, 0, 8*(len(offsets)+2))
+	data = binary.LittleEndian.AppendUint64(data, uint64(startSlot))
+	for _, o := range offsets {
+		rel := int64(0)
+		if o != 0 {
+			rel = o - indexStart
+		}
+		data = binary.LittleEndian.AppendUint64(data, uint64(rel))
+	}
+	data = binary.LittleEndian.AppendUint64(data, uint64(len(offsets)))
+	_, err := w.e.write(TypeSlotIndex, data)
+	return err
+}
+
+// slotIndex is a parsed slot index record, with offsets converted to absolute file offsets.
+type slotIndex struct {
+	startSlot primitives.Slot
+	offsets   []int64
+	start     int64
+}
+
+// readIndexEndingAt parses the slot index record whose last byte is right before the given offset.
+func readIndexEndingAt(r io.ReaderAt, end int64) (*slotIndex, error) {
+	if end < headerSize+16 {
+		return nil, errors.Wrap(errMalformedIndex, "file too small")
+	}
+	var countBytes [8]byte
+	if _, err := r.ReadAt(countBytes[:], end-8); err != nil {
+		return nil, errors.Wrap(err, "could not read index count")
+	}
+	count := binary.LittleEndian.Uint64(countBytes[:])
+	// No index of an era file covers more than the slots of the era.
+	if count > uint64(SlotsPerEra()) {
+		return nil, errors.Wrapf(errMalformedIndex, "count %d exceeds the slots of an era", count)
+	}
+	size := headerSize + 16 + 8*count
+	if size > uint64(end) {
+		return nil, errors.Wrapf(errMalformedIndex, "count %d does not fit in file", count)
+	}
+	start := end - int64(size)
+	e, _, err := readEntry(r, start)
+	if err != nil {
+		return nil, err
+	}
+	if e.Type != TypeSlotIndex {
+		return nil, errors.Wrapf(errUnexpectedRecord, "expected slot index, got %#x", e.Type)
+	}
+	if uint64(len(e.Data)) != 16+8*count {
+		return nil, errors.Wrapf(errMalformedIndex, "index of %d bytes does not hold %d offsets", len(e.Data), count)
+	}
+	idx := &slotIndex{
+		startSlot: primitives.Slot(binary.LittleEndian.Uint64(e.Data[:8])),
+		offsets:   make([]int64, count),
+		start:     start,
+	}
+	for i := range idx.offsets {
+		rel := int64(binary.LittleEndian.Uint64(e.Data[8+8*i:]))
+		if rel != 0 {
+			idx.offsets[i] = start + rel
+		}
+	}
+	return idx, nil
+}
+
+// Reader gives access to the blocks and the state stored in an era file.
+type Reader struct {
+	r           io.ReaderAt
+	era         uint64
+	blockIndex  *slotIndex
+	stateOffset int64
+}
+
+// NewReader parses the indices of the era file held by r, which is size bytes long.
+func NewReader(r io.ReaderAt, size int64) (*Reader, error) {
+	version, _, err := readEntry(r, 0)
+	if err != nil {
+		return nil, err
+	}
+	if version.Type != TypeVersion {
+		return nil, errMissingVersion
+	}
+	stateIndex, err := readIndexEndingAt(r, size)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read state index")
+	}
+	if len(stateIndex.offsets) != 1 || stateIndex.offsets[0] == 0 {
+		return nil, errors.Wrap(errMalformedIndex, "state index must reference exactly one state")
+	}
+	if stateIndex.startSlot%SlotsPerEra() != 0 {
+		return nil, errors.Wrapf(errMalformedIndex, "state slot %d is not at an era boundary", stateIndex.startSlot)
+	}
+	er := &Reader{
+		r:           r,
+		era:         uint64(stateIndex.startSlot / SlotsPerEra()),
+		stateOffset: stateIndex.offsets[0],
+	}
+	if er.era > 0 {
+		er.blockIndex, err = readIndexEndingAt(r, stateIndex.start)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not read block index")
+		}
+		if er.blockIndex.startSlot != StateSlot(er.era-1) || primitives.Slot(len(er.blockIndex.offsets)) != SlotsPerEra() {
+			return nil, errors.Wrap(errMalformedIndex, "block index does not cover the era")
+		}
+	}
+	return er, nil
+}
+
+// Era returns the era number of the file.
+func (r *Reader) Era() uint64 {
+	return r.era
+}
+
+// State decompresses and decodes the era state.
+func (r *Reader) State() (state.BeaconState, error) {
+	data, err := r.decompressedRecord(r.stateOffset, TypeCompressedBeaconState)
+	if err != nil {
+		return nil, err
+	}
+	cf, err := detect.FromState(data)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not detect fork of era state")
+	}
+	return cf.UnmarshalBeaconState(data)
+}
+
+// Block decompresses and decodes the block at the given slot. It returns nil if the slot is empty.
+func (r *Reader) Block(slot primitives.Slot) (interfaces.ReadOnlySignedBeaconBlock, error) {
+	if r.blockIndex == nil || slot < r.blockIndex.startSlot || slot >= r.blockIndex.startSlot+SlotsPerEra() {
+		return nil, errors.Wrapf(errSlotNotInEraRange, "slot=%d, era=%d", slot, r.era)
+	}
+	offset := r.blockIndex.offsets[slot-r.blockIndex.startSlot]
+	if offset == 0 {
+		return nil, nil
+	}
+	data, err := r.decompressedRecord(offset, TypeCompressedSignedBeaconBlock)
+	if err != nil {
+		return nil, err
+	}
+	cf, err := detect.FromBlock(data)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not detect fork of block at slot %d", slot)
+	}
+	blk, err := cf.UnmarshalBeaconBlock(data)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not unmarshal block at slot %d", slot)
+	}
+	return blk, nil
+}
+
+// Blocks returns all blocks of the era in increasing slot order.
+func (r *Reader) Blocks() ([]interfaces.ReadOnlySignedBeaconBlock, error) {
+	if r.blockIndex == nil {
+		return nil, nil
+	}
+	var blks []interfaces.ReadOnlySignedBeaconBlock
+	for i, offset := range r.blockIndex.offsets {
+		if offset == 0 {
+			continue
+		}
+		blk, err := r.Block(r.blockIndex.startSlot + primitives.Slot(i))
+		if err != nil {
+			return nil, err
+		}
+		blks = append(blks, blk)
+	}
+	return blks, nil
+}
+
+func (r *Reader) decompressedRecord(offset int64, typ EntryType) ([]byte, error) {
+	e, _, err := readEntry(r.r, offset)
+	if err != nil {
+		return nil, err
+	}
+	if e.Type != typ {
+		return nil, errors.Wrapf(errUnexpectedRecord, "expected %#x, got %#x at offset %d", typ, e.Type, offset)
+	}
+	return decompress(e.Data)
+}
+
+func compress(data []byte) ([]byte, error) {
+	var buf bytes.Buffer
+	w := snappy.NewBufferedWriter(&buf)
+	if _, err := w.Write(data); err != nil {
+		return nil, errors.Wrap(err, "could not compress record")
+	}
+	if err := w.Close(); err != nil {
+		return nil, errors.Wrap(err, "could not compress record")
+	}
+	return buf.Bytes(), nil
+}
+
+func decompress(data []byte) ([]byte, error) {
+	out, err := io.ReadAll(snappy.NewReader(bytes.NewReader(data)))
+	if err != nil {
+		return nil, errors.Wrap(err, "could not decompress record")
+	}
+	return out, nil
+}
@@ -0,0 +1,171 @@
+package era
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/interfaces"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+)
+
+func testBlock(t *testing.T, slot primitives.Slot) interfaces.ReadOnlySignedBeaconBlock {
+	t.Helper()
+	b := util.NewBeaconBlock()
+	b.Block.Slot = slot
+	wsb, err := blocks.NewSignedBeaconBlock(b)
+	require.NoError(t, err)
+	return wsb
+}
+
+func TestWriterReader_RoundTrip(t *testing.T) {
+	ctx := context.Background()
+	st, err := util.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, st.SetSlot(StateSlot(1)))
+
+	blks := []interfaces.ReadOnlySignedBeaconBlock{testBlock(t, 0), testBlock(t, 3), testBlock(t, SlotsPerEra()-1)}
+	var buf bytes.Buffer
+	w, err := NewWriter(&buf, 1)
+	require.NoError(t, err)
+	for _, b := range blks {
+		require.NoError(t, w.AddBlock(b))
+	}
+	require.NoError(t, w.Finalize(st))
+
+	r, err := NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), r.Era())
+
+	gotState, err := r.State()
+	require.NoError(t, err)
+	wantRoot, err := st.HashTreeRoot(ctx)
+	require.NoError(t, err)
+	gotRoot, err := gotState.HashTreeRoot(ctx)
+	require.NoError(t, err)
+	require.Equal(t, wantRoot, gotRoot)
+
+	gotBlks, err := r.Blocks()
+	require.NoError(t, err)
+	require.Equal(t, len(blks), len(gotBlks))
+	for i, b := range blks {
+		want, err := b.Block().HashTreeRoot()
+		require.NoError(t, err)
+		got, err := gotBlks[i].Block().HashTreeRoot()
+		require.NoError(t, err)
+		require.Equal(t, want, got)
+	}
+
+	empty, err := r.Block(1)
+	require.NoError(t, err)
+	require.Equal(t, true, empty == nil)
+	_, err = r.Block(SlotsPerEra())
+	require.ErrorIs(t, err, errSlotNotInEraRange)
+}
+
+func TestWriterReader_Genesis(t *testing.T) {
+	st, err := util.NewBeaconState()
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	w, err := NewWriter(&buf, 0)
+	require.NoError(t, err)
+	require.ErrorIs(t, w.AddBlock(testBlock(t, 0)), errBlockOutOfRange)
+	require.NoError(t, w.Finalize(st))
+
+	r, err := NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), r.Era())
+	blks, err := r.Blocks()
+	require.NoError(t, err)
+	require.Equal(t, 0, len(blks))
+	gotState, err := r.State()
+	require.NoError(t, err)
+	require.Equal(t, primitives.Slot(0), gotState.Slot())
+}
+
+func TestWriter_Errors(t *testing.T) {
+	t.Run("out of range", func(t *testing.T) {
+		w, err := NewWriter(&bytes.Buffer{}, 2)
+		require.NoError(t, err)
+		require.ErrorIs(t, w.AddBlock(testBlock(t, StateSlot(1)-1)), errBlockOutOfRange)
+		require.ErrorIs(t, w.AddBlock(testBlock(t, StateSlot(2))), errBlockOutOfRange)
+	})
+	t.Run("out of order", func(t *testing.T) {
+		w, err := NewWriter(&bytes.Buffer{}, 1)
+		require.NoError(t, err)
+		require.NoError(t, w.AddBlock(testBlock(t, 5)))
+		require.ErrorIs(t, w.AddBlock(testBlock(t, 5)), errBlockOutOfOrder)
+		require.ErrorIs(t, w.AddBlock(testBlock(t, 4)), errBlockOutOfOrder)
+	})
+	t.Run("blinded", func(t *testing.T) {
+		w, err := NewWriter(&bytes.Buffer{}, 1)
+		require.NoError(t, err)
+		b, err := blocks.NewSignedBeaconBlock(util.NewBlindedBeaconBlockBellatrix())
+		require.NoError(t, err)
+		require.ErrorIs(t, w.AddBlock(b), errBlindedBlock)
+	})
+	t.Run("wrong state slot", func(t *testing.T) {
+		st, err := util.NewBeaconState()
+		require.NoError(t, err)
+		require.NoError(t, st.SetSlot(StateSlot(1)+1))
+		w, err := NewWriter(&bytes.Buffer{}, 1)
+		require.NoError(t, err)
+		require.ErrorIs(t, w.Finalize(st), errWrongStateSlot)
+	})
+	t.Run("finalized", func(t *testing.T) {
+		st, err := util.NewBeaconState()
+		require.NoError(t, err)
+		w, err := NewWriter(&bytes.Buffer{}, 0)
+		require.NoError(t, err)
+		require.NoError(t, w.Finalize(st))
+		require.ErrorIs(t, w.Finalize(st), errWriterFinalized)
+	})
+}
+
+func TestNewReader_Malformed(t *testing.T) {
+	t.Run("missing version", func(t *testing.T) {
+		var buf bytes.Buffer
+		e := &e2Writer{w: &buf}
+		_, err := e.write(TypeCompressedBeaconState, []byte{1, 2, 3})
+		require.NoError(t, err)
+		_, err = NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+		require.ErrorIs(t, err, errMissingVersion)
+	})
+	t.Run("truncated", func(t *testing.T) {
+		var buf bytes.Buffer
+		_, err := NewWriter(&buf, 1)
+		require.NoError(t, err)
+		_, err = NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+		require.ErrorIs(t, err, errMalformedIndex)
+	})
+	t.Run("truncated index", func(t *testing.T) {
+		var buf bytes.Buffer
+		_, err := NewWriter(&buf, 1)
+		require.NoError(t, err)
+		// The index record declares 8 bytes of data, but its count of 1 needs 24.
+		buf.Write(append(TypeSlotIndex[:], 8, 0, 0, 0, 0, 0))
+		for _, v := range []uint64{uint64(StateSlot(1)), 8, 1} {
+			buf.Write(binary.LittleEndian.AppendUint64(nil, v))
+		}
+		_, err = NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+		require.ErrorIs(t, err, errMalformedIndex)
+	})
+	t.Run("index count too large", func(t *testing.T) {
+		var buf bytes.Buffer
+		_, err := NewWriter(&buf, 1)
+		require.NoError(t, err)
+		buf.Write(binary.LittleEndian.AppendUint64(nil, uint64(SlotsPerEra())+1))
+		_, err = NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+		require.ErrorIs(t, err, errMalformedIndex)
+	})
+	t.Run("reserved bytes", func(t *testing.T) {
+		data := []byte{0x65, 0x32, 0, 0, 0, 0, 0, 1}
+		_, err := NewReader(bytes.NewReader(data), int64(len(data)))
+		require.ErrorIs(t, err, errReservedBytes)
+	})
+}
@@ -0,0 +1,219 @@
+package era
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/filters"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/iface"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state/stategen"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/interfaces"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/io/file"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+	"github.com/sirupsen/logrus"
+)
+
+var errEraNotFinalized = errors.New("era is not finalized")
+
+// finalizedHistory answers the questions stategen needs to replay finalized history from the database:
+// only finalized blocks are canonical, and the finalized slot acts as the current slot.
+type finalizedHistory struct {
+	db            iface.ReadOnlyDatabase
+	finalizedSlot primitives.Slot
+}
+
+func (f *finalizedHistory) IsCanonical(ctx context.Context, blockRoot [32]byte) (bool, error) {
+	return f.db.IsFinalizedBlock(ctx, blockRoot), nil
+}
+
+func (f *finalizedHistory) CurrentSlot() primitives.Slot {
+	return f.finalizedSlot
+}
+
+// LastFinalizedEra returns the highest era whose state slot is finalized in the database.
+func LastFinalizedEra(ctx context.Context, db iface.ReadOnlyDatabase) (uint64, error) {
+	cp, err := db.FinalizedCheckpoint(ctx)
+	if err != nil {
+		return 0, errors.Wrap(err, "could not get finalized checkpoint")
+	}
+	finalizedSlot, err := slots.EpochStart(cp.Epoch)
+	if err != nil {
+		return 0, err
+	}
+	return uint64(finalizedSlot / SlotsPerEra()), nil
+}
+
+// FileName returns the conventional name of an era file: <config-name>-<era-number>-<short-era-root>.era,
+// where the era root is the genesis validators root for era 0 and the historical root of the era otherwise.
+func FileName(st state.ReadOnlyBeaconState, era uint64) (string, error) {
+	root, err := eraRoot(st, era)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s-%05d-%x%s", params.BeaconConfig().ConfigName, era, root[:4], Extension), nil
+}
+
+func eraRoot(st state.ReadOnlyBeaconState, era uint64) ([32]byte, error) {
+	if era == 0 {
+		var root [32]byte
+		copy(root[:], st.GenesisValidatorsRoot())
+		return root, nil
+	}
+	historicalRoots, err := st.HistoricalRoots()
+	if err != nil {
+		return [32]byte{}, errors.Wrap(err, "could not get historical roots")
+	}
+	idx := era - 1
+	if idx < uint64(len(historicalRoots)) {
+		var root [32]byte
+		copy(root[:], historicalRoots[idx])
+		return root, nil
+	}
+	summaries, err := st.HistoricalSummaries()
+	if err != nil {
+		return [32]byte{}, errors.Wrap(err, "could not get historical summaries")
+	}
+	idx -= uint64(len(historicalRoots))
+	if idx >= uint64(len(summaries)) {
+		return [32]byte{}, fmt.Errorf("state at slot %d has no historical accumulator for era %d", st.Slot(), era)
+	}
+	return summaries[idx].HashTreeRoot()
+}
+
+// Export writes one era file per era in [startEra, endEra] to dir and returns the paths of the written files.
+// All exported eras must be finalized. Blocks are taken from the database, and the era states
+// are regenerated by replaying finalized blocks from the closest stored state.
+func Export(ctx context.Context, db iface.ReadOnlyDatabase, dir string, startEra, endEra uint64) ([]string, error) {
+	if startEra > endEra {
+		return nil, fmt.Errorf("start era %d is after end era %d", startEra, endEra)
+	}
+	lastEra, err := LastFinalizedEra(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+	if endEra > lastEra {
+		return nil, errors.Wrapf(errEraNotFinalized, "requested era %d, last finalized era is %d", endEra, lastEra)
+	}
+	if err := file.MkdirAll(dir); err != nil {
+		return nil, errors.Wrapf(err, "could not create directory %s", dir)
+	}
+
+	history := &finalizedHistory{db: db, finalizedSlot: StateSlot(lastEra)}
+	replayer := stategen.NewCanonicalHistory(db, history, history)
+	paths := make([]string, 0, endEra-startEra+1)
+	for era := startEra; era <= endEra; era++ {
+		if ctx.Err() != nil {
+			return paths, ctx.Err()
+		}
+		path, err := exportEra(ctx, db, replayer, dir, era)
+		if err != nil {
+			return paths, errors.Wrapf(err, "could not export era %d", era)
+		}
+		log.WithFields(logrus.Fields{
+			"era":  era,
+			"path": path,
+		}).Info("Exported era file")
+		paths = append(paths, path)
+	}
+	return paths, nil
+}
+
+func exportEra(ctx context.Context, db iface.ReadOnlyDatabase, replayer stategen.ReplayerBuilder, dir string, era uint64) (string, error) {
+	st, err := eraState(ctx, db, replayer, era)
+	if err != nil {
+		return "", err
+	}
+	var blks []interfaces.ReadOnlySignedBeaconBlock
+	if era > 0 {
+		blks, err = eraBlocks(ctx, db, era)
+		if err != nil {
+			return "", err
+		}
+	}
+	name, err := FileName(st, era)
+	if err != nil {
+		return "", err
+	}
+	path := filepath.Join(dir, name)
+
+	tmp := path + ".tmp"
+	f, err := os.OpenFile(tmp, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, params.BeaconIoConfig().ReadWritePermissions)
+	if err != nil {
+		return "", errors.Wrapf(err, "could not create %s", tmp)
+	}
+	if err := writeEra(f, era, blks, st); err != nil {
+		if closeErr := f.Close(); closeErr != nil {
+			log.WithError(closeErr).Error("Could not close incomplete era file")
+		}
+		if rmErr := os.Remove(tmp); rmErr != nil {
+			log.WithError(rmErr).Error("Could not remove incomplete era file")
+		}
+		return "", err
+	}
+	if err := f.Close(); err != nil {
+		return "", errors.Wrapf(err, "could not close %s", tmp)
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		return "", errors.Wrapf(err, "could not rename %s", tmp)
+	}
+	return path, nil
+}
+
+func writeEra(f *os.File, era uint64, blks []interfaces.ReadOnlySignedBeaconBlock, st state.ReadOnlyBeaconState) error {
+	w, err := NewWriter(f, era)
+	if err != nil {
+		return err
+	}
+	for _, b := range blks {
+		if err := w.AddBlock(b); err != nil {
+			return errors.Wrapf(err, "could not add block at slot %d", b.Block().Slot())
+		}
+	}
+	return w.Finalize(st)
+}
+
+// eraState returns the state at the first slot after the era's blocks, without applying a block at that slot.
+func eraState(ctx context.Context, db iface.ReadOnlyDatabase, replayer stategen.ReplayerBuilder, era uint64) (state.BeaconState, error) {
+	if era == 0 {
+		st, err := db.GenesisState(ctx)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not get genesis state")
+		}
+		if st == nil || st.IsNil() {
+			return nil, errors.New("genesis state not found")
+		}
+		return st, nil
+	}
+	target := StateSlot(era)
+	st, err := replayer.ReplayerForSlot(target-1).ReplayToSlot(ctx, target)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not replay state for slot %d", target)
+	}
+	return st, nil
+}
+
+// eraBlocks returns the finalized blocks of the era in increasing slot order.
+func eraBlocks(ctx context.Context, db iface.ReadOnlyDatabase, era uint64) ([]interfaces.ReadOnlySignedBeaconBlock, error) {
+	f := filters.NewFilter().SetStartSlot(StateSlot(era - 1)).SetEndSlot(StateSlot(era) - 1)
+	blks, roots, err := db.Blocks(ctx, f)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get blocks")
+	}
+	finalized := make([]interfaces.ReadOnlySignedBeaconBlock, 0, len(blks))
+	for i, b := range blks {
+		if db.IsFinalizedBlock(ctx, roots[i]) {
+			finalized = append(finalized, b)
+		}
+	}
+	sort.Slice(finalized, func(i, j int) bool {
+		return finalized[i].Block().Slot() < finalized[j].Block().Slot()
+	})
+	return finalized, nil
+}
@@ -0,0 +1,282 @@
+package era
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/pkg/errors"
+	beacondb "github.com/prysmaticlabs/prysm/v5/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/iface"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/interfaces"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+	"github.com/sirupsen/logrus"
+)
+
+// importBatchSize is the number of blocks saved to the database in a single call.
+const importBatchSize = 256
+
+var (
+	errGenesisMismatch = errors.New("era file does not belong to the chain in the database")
+	errBlockNotInState = errors.New("block is not part of the era state's block roots")
+	errBrokenChain     = errors.New("block does not descend from the previous block")
+)
+
+// Import reads the given era files and saves their blocks and states in the database, rebuilding the block
+// indices as if the blocks had been synced. Files must be passed in increasing era order. Every block is
+// checked against the block roots of its era state before anything is written. When the imported history
+// goes beyond the database's finalized checkpoint, the checkpoint and head are advanced to the era states.
+func Import(ctx context.Context, db iface.HeadAccessDatabase, paths []string) error {
+	var last *importedEra
+	for _, p := range paths {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		imported, err := importFile(ctx, db, p, last)
+		if err != nil {
+			return errors.Wrapf(err, "could not import %s", p)
+		}
+		log.WithFields(logrus.Fields{
+			"era":    imported.era,
+			"blocks": imported.blocks,
+			"path":   p,
+		}).Info("Imported era file")
+		// Advancing the checkpoint after every file keeps the walk that rebuilds the finalized index short.
+		if err := advanceCheckpoints(ctx, db, imported); err != nil {
+			return err
+		}
+		last = imported
+	}
+	if last == nil {
+		return nil
+	}
+	return updateBackfillStatus(ctx, db)
+}
+
+// importedEra summarizes an era file that has been written to the database.
+type importedEra struct {
+	era            uint64
+	blocks         int
+	stateBlockRoot [32]byte
+	state          state.BeaconState
+}
+
+func importFile(ctx context.Context, db iface.HeadAccessDatabase, path string, previous *importedEra) (*importedEra, error) {
+	f, err := os.Open(path) // #nosec G304
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := f.Close(); err != nil {
+			log.WithError(err).WithField("path", path).Error("Could not close era file")
+		}
+	}()
+	info, err := f.Stat()
+	if err != nil {
+		return nil, err
+	}
+	r, err := NewReader(f, info.Size())
+	if err != nil {
+		return nil, err
+	}
+	if previous != nil && r.Era() != previous.era+1 {
+		return nil, fmt.Errorf("era files are not consecutive, got era %d after era %d", r.Era(), previous.era)
+	}
+	st, err := r.State()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read era state")
+	}
+	if st.Slot() != StateSlot(r.Era()) {
+		return nil, errors.Wrapf(errWrongStateSlot, "state slot=%d, expected=%d", st.Slot(), StateSlot(r.Era()))
+	}
+	if err := checkGenesis(ctx, db, st); err != nil {
+		return nil, err
+	}
+
+	if r.Era() == 0 {
+		if err := importGenesis(ctx, db, st); err != nil {
+			return nil, err
+		}
+		root, err := latestBlockRoot(ctx, st)
+		if err != nil {
+			return nil, err
+		}
+		return &importedEra{era: 0, stateBlockRoot: root, state: st}, nil
+	}
+
+	blks, err := r.Blocks()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read era blocks")
+	}
+	if err := verifyBlocks(st, blks, previous); err != nil {
+		return nil, err
+	}
+	for i := 0; i < len(blks); i += importBatchSize {
+		end := min(i+importBatchSize, len(blks))
+		if err := db.SaveBlocks(ctx, blks[i:end]); err != nil {
+			return nil, errors.Wrap(err, "could not save blocks")
+		}
+	}
+
+	root, err := latestBlockRoot(ctx, st)
+	if err != nil {
+		return nil, err
+	}
+	if !db.HasState(ctx, root) {
+		if err := db.SaveState(ctx, st, root); err != nil {
+			return nil, errors.Wrap(err, "could not save era state")
+		}
+	}
+	if !db.HasStateSummary(ctx, root) {
+		if err := db.SaveStateSummary(ctx, &ethpb.StateSummary{Slot: st.Slot(), Root: root[:]}); err != nil {
+			return nil, errors.Wrap(err, "could not save era state summary")
+		}
+	}
+	return &importedEra{era: r.Era(), blocks: len(blks), stateBlockRoot: root, state: st}, nil
+}
+
+// checkGenesis makes sure the state belongs to the same chain as the database, if the database has a genesis state.
+func checkGenesis(ctx context.Context, db iface.HeadAccessDatabase, st state.BeaconState) error {
+	genesis, err := db.GenesisState(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not get genesis state")
+	}
+	if genesis == nil || genesis.IsNil() {
+		return nil
+	}
+	if !bytes.Equal(genesis.GenesisValidatorsRoot(), st.GenesisValidatorsRoot()) {
+		return errors.Wrapf(errGenesisMismatch, "genesis validators root %#x, era state has %#x", genesis.GenesisValidatorsRoot(), st.GenesisValidatorsRoot())
+	}
+	return nil
+}
+
+func importGenesis(ctx context.Context, db iface.HeadAccessDatabase, st state.BeaconState) error {
+	_, err := db.GenesisBlockRoot(ctx)
+	if err == nil {
+		log.Info("Genesis block already present in the database, skipping era 0")
+		return nil
+	}
+	if !beacondb.IsNotFound(err) {
+		return errors.Wrap(err, "could not get genesis block root")
+	}
+	return db.SaveGenesisData(ctx, st)
+}
+
+// verifyBlocks checks that every block is referenced by the era state's block roots,
+// and that the blocks form a chain with the last block of the previous era.
+func verifyBlocks(st state.BeaconState, blks []interfaces.ReadOnlySignedBeaconBlock, previous *importedEra) error {
+	var parent [32]byte
+	hasParent := false
+	if previous != nil {
+		parent, hasParent = previous.stateBlockRoot, true
+	}
+	for _, b := range blks {
+		root, err := b.Block().HashTreeRoot()
+		if err != nil {
+			return err
+		}
+		slot := b.Block().Slot()
+		stateRoot, err := st.BlockRootAtIndex(uint64(slot % params.BeaconConfig().SlotsPerHistoricalRoot))
+		if err != nil {
+			return errors.Wrapf(err, "could not get block root for slot %d", slot)
+		}
+		if !bytes.Equal(stateRoot, root[:]) {
+			return errors.Wrapf(errBlockNotInState, "slot=%d, root=%#x", slot, root)
+		}
+		// The genesis block has no parent.
+		if hasParent && slot > 0 && b.Block().ParentRoot() != parent {
+			return errors.Wrapf(errBrokenChain, "slot=%d, parent=%#x, expected=%#x", slot, b.Block().ParentRoot(), parent)
+		}
+		parent, hasParent = root, true
+	}
+	return nil
+}
+
+// latestBlockRoot returns the root of the latest block applied to the state. The state root of the latest
+// block header is only filled in by the next slot transition, so it is computed here when it is still empty.
+func latestBlockRoot(ctx context.Context, st state.BeaconState) ([32]byte, error) {
+	header := st.LatestBlockHeader()
+	if bytes.Equal(header.StateRoot, params.BeaconConfig().ZeroHash[:]) {
+		root, err := st.HashTreeRoot(ctx)
+		if err != nil {
+			return [32]byte{}, errors.Wrap(err, "could not compute state root")
+		}
+		header.StateRoot = root[:]
+	}
+	return header.HashTreeRoot()
+}
+
+// advanceCheckpoints moves the finalized and justified checkpoints and the head to the last imported era state,
+// if it is more recent than what the database already knows about.
+func advanceCheckpoints(ctx context.Context, db iface.HeadAccessDatabase, last *importedEra) error {
+	if last.era == 0 {
+		return nil
+	}
+	finalized, err := db.FinalizedCheckpoint(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not get finalized checkpoint")
+	}
+	epoch := slots.ToEpoch(last.state.Slot())
+	if finalized != nil && finalized.Epoch >= epoch && !bytesutil.ZeroRoot(finalized.Root) {
+		return nil
+	}
+	cp := &ethpb.Checkpoint{Epoch: epoch, Root: last.stateBlockRoot[:]}
+	if err := db.SaveJustifiedCheckpoint(ctx, cp); err != nil {
+		return errors.Wrap(err, "could not save justified checkpoint")
+	}
+	if err := db.SaveFinalizedCheckpoint(ctx, cp); err != nil {
+		return errors.Wrap(err, "could not save finalized checkpoint")
+	}
+	head, err := db.HeadBlock(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not get head block")
+	}
+	if head == nil || head.IsNil() || head.Block().Slot() < last.state.Slot() {
+		if err := db.SaveHeadBlockRoot(ctx, last.stateBlockRoot); err != nil {
+			return errors.Wrap(err, "could not save head block root")
+		}
+	}
+	log.WithFields(logrus.Fields{
+		"epoch": cp.Epoch,
+		"root":  fmt.Sprintf("%#x", cp.Root),
+	}).Info("Advanced finalized checkpoint to the last imported era")
+	return nil
+}
+
+// updateBackfillStatus lowers the backfill status of a checkpoint synced database to the oldest block that is
+// now connected to the origin block, so that the backfill service does not download imported blocks again.
+func updateBackfillStatus(ctx context.Context, db iface.HeadAccessDatabase) error {
+	status, err := db.BackfillStatus(ctx)
+	if err != nil {
+		// Databases synced from genesis have no backfill status.
+		return nil
+	}
+	updated := false
+	for {
+		parent, err := db.Block(ctx, bytesutil.ToBytes32(status.LowParentRoot))
+		if err != nil {
+			return errors.Wrap(err, "could not get block")
+		}
+		if parent == nil || parent.IsNil() {
+			break
+		}
+		pr := parent.Block().ParentRoot()
+		status.LowRoot = status.LowParentRoot
+		status.LowParentRoot = pr[:]
+		status.LowSlot = uint64(parent.Block().Slot())
+		updated = true
+		if status.LowSlot == 0 {
+			break
+		}
+	}
+	if !updated {
+		return nil
+	}
+	log.WithField("lowSlot", status.LowSlot).Info("Updated backfill status with imported blocks")
+	return db.SaveBackfillStatus(ctx, status)
+}
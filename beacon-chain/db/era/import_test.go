@@ -0,0 +1,157 @@
+package era
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	coreBlocks "github.com/prysmaticlabs/prysm/v5/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/iface"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/kv"
+	dbTest "github.com/prysmaticlabs/prysm/v5/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/interfaces"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+)
+
+// testChain builds blocks at the given slots on top of the genesis block of the database, and the era 1 state
+// referencing them.
+func testChain(t *testing.T, db iface.HeadAccessDatabase, blockSlots ...primitives.Slot) ([]interfaces.ReadOnlySignedBeaconBlock, [][32]byte, state.BeaconState) {
+	ctx := context.Background()
+	parent, err := db.GenesisBlockRoot(ctx)
+	require.NoError(t, err)
+
+	genesis, err := db.GenesisState(ctx)
+	require.NoError(t, err)
+	st, err := util.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, st.SetSlot(StateSlot(1)))
+	require.NoError(t, st.SetGenesisValidatorsRoot(genesis.GenesisValidatorsRoot()))
+
+	blks := make([]interfaces.ReadOnlySignedBeaconBlock, 0, len(blockSlots))
+	roots := make([][32]byte, 0, len(blockSlots))
+	latest := parent
+	next := 0
+	for slot := primitives.Slot(0); slot < SlotsPerEra(); slot++ {
+		if next < len(blockSlots) && blockSlots[next] == slot {
+			b := util.NewBeaconBlock()
+			b.Block.Slot = slot
+			b.Block.ParentRoot = latest[:]
+			b.Block.StateRoot = bytesutil.PadTo([]byte{byte(slot)}, 32)
+			wsb, err := blocks.NewSignedBeaconBlock(b)
+			require.NoError(t, err)
+			latest, err = wsb.Block().HashTreeRoot()
+			require.NoError(t, err)
+			header, err := wsb.Header()
+			require.NoError(t, err)
+			require.NoError(t, st.SetLatestBlockHeader(header.Header))
+			blks = append(blks, wsb)
+			roots = append(roots, latest)
+			next++
+		}
+		require.NoError(t, st.UpdateBlockRootAtIndex(uint64(slot), latest))
+	}
+	return blks, roots, st
+}
+
+func writeTestEra(t *testing.T, era uint64, blks []interfaces.ReadOnlySignedBeaconBlock, st state.BeaconState) string {
+	path := filepath.Join(t.TempDir(), "test"+Extension)
+	f, err := os.Create(path)
+	require.NoError(t, err)
+	require.NoError(t, writeEra(f, era, blks, st))
+	require.NoError(t, f.Close())
+	return path
+}
+
+func genesisDB(t *testing.T) iface.Database {
+	db := dbTest.SetupDB(t)
+	genesis, err := util.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, db.SaveGenesisData(context.Background(), genesis))
+	return db
+}
+
+func TestImport(t *testing.T) {
+	ctx := context.Background()
+	db := genesisDB(t)
+	blks, roots, st := testChain(t, db, 1, 2, 5)
+	path := writeTestEra(t, 1, blks, st)
+
+	require.NoError(t, Import(ctx, db, []string{path}))
+
+	for _, r := range roots {
+		require.Equal(t, true, db.HasBlock(ctx, r))
+		require.Equal(t, true, db.IsFinalizedBlock(ctx, r))
+	}
+	last := roots[len(roots)-1]
+	require.Equal(t, true, db.HasState(ctx, last))
+	cp, err := db.FinalizedCheckpoint(ctx)
+	require.NoError(t, err)
+	require.Equal(t, slots.ToEpoch(StateSlot(1)), cp.Epoch)
+	require.DeepEqual(t, last[:], cp.Root)
+	head, err := db.HeadBlock(ctx)
+	require.NoError(t, err)
+	headRoot, err := head.Block().HashTreeRoot()
+	require.NoError(t, err)
+	require.Equal(t, last, headRoot)
+
+	// Importing the same file again is a no-op.
+	require.NoError(t, Import(ctx, db, []string{path}))
+}
+
+func TestImport_BlockNotInState(t *testing.T) {
+	ctx := context.Background()
+	db := genesisDB(t)
+	blks, roots, st := testChain(t, db, 1, 2)
+	require.NoError(t, st.UpdateBlockRootAtIndex(2, [32]byte{'a'}))
+	path := writeTestEra(t, 1, blks, st)
+
+	require.ErrorIs(t, Import(ctx, db, []string{path}), errBlockNotInState)
+	require.Equal(t, false, db.HasBlock(ctx, roots[0]))
+}
+
+func TestImport_GenesisMismatch(t *testing.T) {
+	ctx := context.Background()
+	db := genesisDB(t)
+	blks, _, st := testChain(t, db, 1)
+	require.NoError(t, st.SetGenesisValidatorsRoot(bytesutil.PadTo([]byte{'b'}, 32)))
+	path := writeTestEra(t, 1, blks, st)
+
+	require.ErrorIs(t, Import(ctx, db, []string{path}), errGenesisMismatch)
+}
+
+func TestExportImport_Genesis(t *testing.T) {
+	ctx := context.Background()
+	// Only one database can be open at a time because of the metrics it registers.
+	source, err := kv.NewKVStore(ctx, t.TempDir())
+	require.NoError(t, err)
+	genesis, err := util.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, source.SaveGenesisData(ctx, genesis))
+	// The genesis state of a known network is embedded in the binary and takes precedence over the saved one.
+	exported, err := source.GenesisState(ctx)
+	require.NoError(t, err)
+	genesisBlock, err := coreBlocks.NewGenesisBlockForState(ctx, exported)
+	require.NoError(t, err)
+	want, err := genesisBlock.Block().HashTreeRoot()
+	require.NoError(t, err)
+
+	_, err = Export(ctx, source, t.TempDir(), 0, 1)
+	require.ErrorIs(t, err, errEraNotFinalized)
+	paths, err := Export(ctx, source, t.TempDir(), 0, 0)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(paths))
+	require.NoError(t, source.Close())
+
+	target := dbTest.SetupDB(t)
+	require.NoError(t, Import(ctx, target, paths))
+	got, err := target.GenesisBlockRoot(ctx)
+	require.NoError(t, err)
+	require.Equal(t, want, got)
+}
@@ -0,0 +1,367 @@
+package filesystem
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"path"
+	"path/filepath"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/verification"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/io/file"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/afero"
+)
+
+var (
+	errDataColumnIndexOutOfBounds = errors.New("data column index in file name >= NUMBER_OF_COLUMNS")
+	errDataColumnMaskLength       = errors.New("data column mask length does not match NUMBER_OF_COLUMNS")
+	errNoDataColumnBasePath       = errors.New("DataColumnStorage base path not specified in init")
+)
+
+// DataColumnStorageOption is a functional option for configuring a DataColumnStorage.
+type DataColumnStorageOption func(*DataColumnStorage) error
+
+// WithDataColumnBasePath is a required option that sets the base path of data column storage.
+func WithDataColumnBasePath(base string) DataColumnStorageOption {
+	return func(s *DataColumnStorage) error {
+		s.base = base
+		return nil
+	}
+}
+
+// WithDataColumnRetentionEpochs is an option that changes the number of epochs data columns will be persisted.
+func WithDataColumnRetentionEpochs(e primitives.Epoch) DataColumnStorageOption {
+	return func(s *DataColumnStorage) error {
+		s.retentionEpochs = e
+		return nil
+	}
+}
+
+// WithDataColumnSaveFsync is an option that causes Save to call fsync before renaming part files.
+func WithDataColumnSaveFsync(fsync bool) DataColumnStorageOption {
+	return func(s *DataColumnStorage) error {
+		s.fsync = fsync
+		return nil
+	}
+}
+
+// WithDataColumnFs allows the afero.Fs implementation to be customized. Used by tests
+// to substitute an in-memory filesystem.
+func WithDataColumnFs(fs afero.Fs) DataColumnStorageOption {
+	return func(s *DataColumnStorage) error {
+		s.fs = fs
+		return nil
+	}
+}
+
+// NewDataColumnStorage creates a new instance of the DataColumnStorage object. Like BlobStorage, it should only be
+// initialized once per beacon node.
+func NewDataColumnStorage(opts ...DataColumnStorageOption) (*DataColumnStorage, error) {
+	s := &DataColumnStorage{}
+	for _, o := range opts {
+		if err := o(s); err != nil {
+			return nil, errors.Wrap(err, "failed to create data column storage")
+		}
+	}
+	// Allow tests to set up a different fs using WithDataColumnFs.
+	if s.fs == nil {
+		if s.base == "" {
+			return nil, errNoDataColumnBasePath
+		}
+		s.base = path.Clean(s.base)
+		if err := file.MkdirAll(s.base); err != nil {
+			return nil, errors.Wrapf(err, "failed to create data column storage at %s", s.base)
+		}
+		s.fs = afero.NewBasePathFs(afero.NewOsFs(), s.base)
+	}
+	s.cache = newDataColumnStorageSummaryCache()
+	s.layout = &dataColumnLayout{fs: s.fs, cache: s.cache, pruner: newDataColumnPruner(s.retentionEpochs)}
+	return s, nil
+}
+
+// DataColumnStorage is the concrete implementation of the filesystem backend for saving and retrieving
+// DataColumnSidecars. Sidecars are stored in one file per block root and column index.
+type DataColumnStorage struct {
+	base            string
+	retentionEpochs primitives.Epoch
+	fsync           bool
+	fs              afero.Fs
+	layout          *dataColumnLayout
+	cache           *dataColumnStorageSummaryCache
+}
+
+// WarmCache populates the summary cache from the data columns on disk, and prunes the data columns that are outside
+// of the retention period at node startup.
+func (s *DataColumnStorage) WarmCache() {
+	start := time.Now()
+	log.Info("Data column filesystem cache warm-up started.")
+	iter, err := s.layout.iterateIdents(0)
+	if err != nil {
+		log.WithError(err).Error("Error encountered while warming up data column filesystem cache.")
+		return
+	}
+	var highest primitives.Epoch
+	for ident, err := iter.next(); !errors.Is(err, io.EOF); ident, err = iter.next() {
+		if errors.Is(err, errIdentFailure) {
+			idf := &identificationError{}
+			if errors.As(err, &idf) {
+				log.WithFields(idf.LogFields()).WithError(err).Error("Failed to cache data column for path")
+			}
+			continue
+		}
+		if err != nil {
+			log.WithError(err).Error("Error encountered while warming up data column filesystem cache.")
+			break
+		}
+		if err := s.cache.ensure(ident); err != nil {
+			log.WithFields(ident.logFields()).WithError(err).Error("Failed to cache data column")
+			continue
+		}
+		highest = max(highest, ident.epoch)
+	}
+	s.layout.pruner.notify(highest, s.layout)
+	log.WithField("elapsed", time.Since(start)).Info("Data column filesystem cache warm-up complete.")
+}
+
+// Save saves a verified data column sidecar.
+func (s *DataColumnStorage) Save(sidecar blocks.VerifiedRODataColumn) error {
+	startTime := time.Now()
+
+	ident := identForDataColumnSidecar(sidecar)
+	sszPath := s.layout.sszPath(ident)
+	exists, err := afero.Exists(s.fs, sszPath)
+	if err != nil {
+		return err
+	}
+	if exists {
+		log.WithFields(ident.logFields()).Debug("Ignoring a duplicate data column sidecar save attempt")
+		return nil
+	}
+
+	partialMoved := false
+	partPath, err := s.writePart(ident, sidecar)
+	// Ensure the partial file is deleted.
+	defer func() {
+		if partialMoved || partPath == "" {
+			return
+		}
+		// It's expected to error if the save is successful.
+		if err := s.fs.Remove(partPath); err == nil {
+			log.WithFields(logrus.Fields{
+				"partPath": partPath,
+			}).Debugf("Removed partial file")
+		}
+	}()
+	if err != nil {
+		return err
+	}
+
+	// Atomically rename the partial file to its final name.
+	if err := s.fs.Rename(partPath, sszPath); err != nil {
+		return errors.Wrap(err, "failed to rename partial file to final name")
+	}
+	partialMoved = true
+
+	if err := s.layout.notify(ident); err != nil {
+		return errors.Wrapf(err, "problem maintaining pruning cache/metrics for data column with root=%#x", sidecar.BlockRoot())
+	}
+	dataColumnsWrittenCounter.Inc()
+	dataColumnSaveLatency.Observe(float64(time.Since(startTime).Milliseconds()))
+	return nil
+}
+
+func (s *DataColumnStorage) writePart(ident blobIdent, sidecar blocks.VerifiedRODataColumn) (ppath string, err error) {
+	sidecarData, err := sidecar.MarshalSSZ()
+	if err != nil {
+		return "", errors.Wrap(err, "failed to serialize sidecar data")
+	}
+	if len(sidecarData) == 0 {
+		return "", errSidecarEmptySSZData
+	}
+
+	if err := s.fs.MkdirAll(s.layout.dir(ident), directoryPermissions()); err != nil {
+		return "", err
+	}
+	ppath = s.layout.partPath(ident, fmt.Sprintf("%p", sidecarData))
+
+	// Create a partial file and write the serialized data to it.
+	partialFile, err := s.fs.Create(ppath)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to create partial file")
+	}
+	defer func() {
+		cerr := partialFile.Close()
+		// The close error is probably less important than any existing error, so only overwrite nil err.
+		if cerr != nil && err == nil {
+			err = cerr
+		}
+	}()
+
+	n, err := partialFile.Write(sidecarData)
+	if err != nil {
+		return ppath, errors.Wrap(err, "failed to write to partial file")
+	}
+	if s.fsync {
+		if err := partialFile.Sync(); err != nil {
+			return ppath, err
+		}
+	}
+	if n != len(sidecarData) {
+		return ppath, fmt.Errorf("failed to write the full bytes of sidecarData, wrote only %d of %d bytes", n, len(sidecarData))
+	}
+	return ppath, nil
+}
+
+// Get retrieves a single DataColumnSidecar by its root and column index.
+// Since DataColumnStorage only writes data columns that have undergone full verification, the return
+// value is always a VerifiedRODataColumn.
+func (s *DataColumnStorage) Get(root [32]byte, idx uint64) (blocks.VerifiedRODataColumn, error) {
+	startTime := time.Now()
+	ident, err := s.cache.identForIdx(root, idx)
+	if err != nil {
+		return verification.VerifiedRODataColumnError(err)
+	}
+	defer func() {
+		dataColumnFetchLatency.Observe(float64(time.Since(startTime).Milliseconds()))
+	}()
+	return verification.VerifiedRODataColumnFromDisk(s.fs, root, s.layout.sszPath(ident))
+}
+
+// Remove removes all data columns for a given root.
+func (s *DataColumnStorage) Remove(root [32]byte) error {
+	dirIdent, err := s.cache.identForRoot(root)
+	if err != nil {
+		return err
+	}
+	_, err = s.layout.remove(dirIdent)
+	return err
+}
+
+// Summary returns the DataColumnStorageSummary for the given root.
+// Internally, this is a cached representation of the directory listing for the given root.
+func (s *DataColumnStorage) Summary(root [32]byte) DataColumnStorageSummary {
+	return s.cache.Summary(root)
+}
+
+// Clear deletes all files on the filesystem.
+func (s *DataColumnStorage) Clear() error {
+	dirs, err := listDir(s.fs, ".")
+	if err != nil {
+		return err
+	}
+	for _, dir := range dirs {
+		if err := s.fs.RemoveAll(dir); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// WithinRetentionPeriod checks if the requested epoch is within the data column retention period.
+func (s *DataColumnStorage) WithinRetentionPeriod(requested, current primitives.Epoch) bool {
+	if requested > math.MaxUint64-s.retentionEpochs {
+		// If there is an overflow, then the retention period was set to an extremely large number.
+		return true
+	}
+	return requested+s.retentionEpochs >= current
+}
+
+func identForDataColumnSidecar(sc blocks.VerifiedRODataColumn) blobIdent {
+	return newBlobIdent(sc.BlockRoot(), slots.ToEpoch(sc.Slot()), sc.ColumnIndex)
+}
+
+// dataColumnLayout organizes data column files by period and epoch like the by-epoch blob layout:
+// <period>/<epoch>/<root>/<column index>.ssz
+type dataColumnLayout struct {
+	fs     afero.Fs
+	cache  *dataColumnStorageSummaryCache
+	pruner *blobPruner
+}
+
+var _ identLayout = &dataColumnLayout{}
+
+func (l *dataColumnLayout) notify(ident blobIdent) error {
+	if err := l.cache.ensure(ident); err != nil {
+		return err
+	}
+	l.pruner.notify(ident.epoch, l)
+	return nil
+}
+
+// If before == 0, it won't be used as a filter and all idents will be returned.
+func (l *dataColumnLayout) iterateIdents(before primitives.Epoch) (*identIterator, error) {
+	entries, err := listDir(l.fs, ".")
+	if err != nil {
+		if os.IsNotExist(errors.Cause(err)) {
+			return &identIterator{eof: true}, nil
+		}
+		return nil, errors.Wrap(err, "failed to list data column storage")
+	}
+	return &identIterator{
+		fs:   l.fs,
+		path: ".",
+		layers: []layoutLayer{
+			{populateIdent: populateNoop, filter: isBeforePeriod(before)},
+			{populateIdent: populateEpoch, filter: isBeforeEpoch(before)},
+			{populateIdent: populateRoot, filter: isRootDir},  // extract root from path
+			{populateIdent: populateIndex, filter: isSszFile}, // extract column index from filename
+		},
+		entries: entries,
+	}, nil
+}
+
+func (l *dataColumnLayout) dir(n blobIdent) string {
+	return filepath.Join(l.epochDir(n.epoch), rootToString(n.root))
+}
+
+func (*dataColumnLayout) epochDir(epoch primitives.Epoch) string {
+	return filepath.Join(fmt.Sprintf("%d", periodForEpoch(epoch)), fmt.Sprintf("%d", epoch))
+}
+
+func (l *dataColumnLayout) sszPath(n blobIdent) string {
+	return filepath.Join(l.dir(n), n.sszFname())
+}
+
+func (l *dataColumnLayout) partPath(n blobIdent, entropy string) string {
+	return path.Join(l.dir(n), n.partFname(entropy))
+}
+
+func (l *dataColumnLayout) pruneBefore(before primitives.Epoch) (*pruneSummary, error) {
+	sums, err := pruneBefore(before, l)
+	if err != nil {
+		return nil, err
+	}
+	rollup := &pruneSummary{}
+	for epoch, sum := range sums {
+		rollup.blobsPruned += sum.blobsPruned
+		rollup.failedRemovals = append(rollup.failedRemovals, sum.failedRemovals...)
+		rmdir := l.epochDir(epoch)
+		if len(sum.failedRemovals) > 0 {
+			log.WithField("dir", rmdir).WithField("numFailed", len(sum.failedRemovals)).Error("Unable to remove epoch directory due to pruning failures")
+			continue
+		}
+		if err := l.fs.Remove(rmdir); err != nil {
+			log.WithField("dir", rmdir).WithError(err).Error("Failed to remove epoch directory while pruning")
+		}
+	}
+	return rollup, nil
+}
+
+func (l *dataColumnLayout) remove(ident blobIdent) (int, error) {
+	removed := l.cache.evict(ident.root)
+	// Skip the syscall if there are no data columns to remove.
+	if removed == 0 {
+		return 0, nil
+	}
+	if err := l.fs.RemoveAll(l.dir(ident)); err != nil {
+		return removed, err
+	}
+	return removed, nil
+}
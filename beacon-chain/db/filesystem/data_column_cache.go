@@ -0,0 +1,156 @@
+package filesystem
+
+import (
+	"sync"
+
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+)
+
+// DataColumnStorageSummary represents cached information about the DataColumnSidecars on disk for each root the
+// cache knows about.
+type DataColumnStorageSummary struct {
+	epoch primitives.Epoch
+	mask  []bool
+}
+
+// NewDataColumnStorageSummary creates a new DataColumnStorageSummary for a given epoch and mask.
+func NewDataColumnStorageSummary(epoch primitives.Epoch, mask []bool) (DataColumnStorageSummary, error) {
+	if uint64(len(mask)) != params.BeaconConfig().NumberOfColumns {
+		return DataColumnStorageSummary{}, errDataColumnMaskLength
+	}
+	return DataColumnStorageSummary{epoch: epoch, mask: mask}, nil
+}
+
+// HasIndex returns true if the DataColumnSidecar at the given index is available in the filesystem.
+func (s DataColumnStorageSummary) HasIndex(idx uint64) bool {
+	if idx >= uint64(len(s.mask)) {
+		return false
+	}
+	return s.mask[idx]
+}
+
+// AllAvailable returns true if we have all data columns for the given indices.
+func (s DataColumnStorageSummary) AllAvailable(indices map[uint64]bool) bool {
+	for idx := range indices {
+		if !s.HasIndex(idx) {
+			return false
+		}
+	}
+	return true
+}
+
+// Count returns the number of data columns available in the filesystem.
+func (s DataColumnStorageSummary) Count() uint64 {
+	count := uint64(0)
+	for _, has := range s.mask {
+		if has {
+			count++
+		}
+	}
+	return count
+}
+
+// Stored returns the indices of the data columns available in the filesystem.
+func (s DataColumnStorageSummary) Stored() map[uint64]bool {
+	stored := make(map[uint64]bool, s.Count())
+	for idx, has := range s.mask {
+		if has {
+			stored[uint64(idx)] = true
+		}
+	}
+	return stored
+}
+
+// DataColumnStorageSummarizer can be used to receive a summary of metadata about data columns on disk for a given
+// root. The DataColumnStorageSummary can be used to check which indices (if any) are available for a given block by
+// root.
+type DataColumnStorageSummarizer interface {
+	Summary(root [32]byte) DataColumnStorageSummary
+}
+
+type dataColumnStorageSummaryCache struct {
+	mu       sync.RWMutex
+	nColumns float64
+	cache    map[[32]byte]DataColumnStorageSummary
+}
+
+var _ DataColumnStorageSummarizer = &dataColumnStorageSummaryCache{}
+
+func newDataColumnStorageSummaryCache() *dataColumnStorageSummaryCache {
+	return &dataColumnStorageSummaryCache{
+		cache: make(map[[32]byte]DataColumnStorageSummary),
+	}
+}
+
+// Summary returns the DataColumnStorageSummary for `root`. The DataColumnStorageSummary can be used to check for the
+// presence of DataColumnSidecars based on Index.
+func (s *dataColumnStorageSummaryCache) Summary(root [32]byte) DataColumnStorageSummary {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.cache[root]
+}
+
+func (s *dataColumnStorageSummaryCache) ensure(ident blobIdent) error {
+	numberOfColumns := params.BeaconConfig().NumberOfColumns
+	if ident.index >= numberOfColumns {
+		return errDataColumnIndexOutOfBounds
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	v := s.cache[ident.root]
+	v.epoch = ident.epoch
+	if v.mask == nil {
+		v.mask = make([]bool, numberOfColumns)
+	}
+	if !v.mask[ident.index] {
+		s.updateMetrics(1)
+	}
+	v.mask[ident.index] = true
+	s.cache[ident.root] = v
+	return nil
+}
+
+func (s *dataColumnStorageSummaryCache) get(key [32]byte) (DataColumnStorageSummary, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	v, ok := s.cache[key]
+	return v, ok
+}
+
+func (s *dataColumnStorageSummaryCache) identForIdx(key [32]byte, idx uint64) (blobIdent, error) {
+	v, ok := s.get(key)
+	if !ok || !v.HasIndex(idx) {
+		return blobIdent{}, db.ErrNotFound
+	}
+	return newBlobIdent(key, v.epoch, idx), nil
+}
+
+func (s *dataColumnStorageSummaryCache) identForRoot(key [32]byte) (blobIdent, error) {
+	v, ok := s.get(key)
+	if !ok {
+		return blobIdent{}, db.ErrNotFound
+	}
+	return blobIdent{root: key, epoch: v.epoch}, nil
+}
+
+func (s *dataColumnStorageSummaryCache) evict(key [32]byte) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	v, ok := s.cache[key]
+	if !ok {
+		return 0
+	}
+	deleted := int(v.Count())
+	delete(s.cache, key)
+	if deleted > 0 {
+		s.updateMetrics(-float64(deleted))
+	}
+	return deleted
+}
+
+func (s *dataColumnStorageSummaryCache) updateMetrics(delta float64) {
+	s.nColumns += delta
+	dataColumnDiskCount.Set(s.nColumns)
+}
@@ -0,0 +1,35 @@
+package filesystem
+
+import (
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func TestNewDataColumnStorageSummary(t *testing.T) {
+	_, err := NewDataColumnStorageSummary(0, make([]bool, 3))
+	require.ErrorIs(t, err, errDataColumnMaskLength)
+
+	mask := make([]bool, params.BeaconConfig().NumberOfColumns)
+	mask[5] = true
+	s, err := NewDataColumnStorageSummary(0, mask)
+	require.NoError(t, err)
+	require.Equal(t, true, s.HasIndex(5))
+	require.Equal(t, false, s.HasIndex(6))
+	require.Equal(t, false, s.HasIndex(params.BeaconConfig().NumberOfColumns))
+	require.Equal(t, uint64(1), s.Count())
+}
+
+func TestDataColumnStorageSummaryCache(t *testing.T) {
+	root := [32]byte{1}
+	c := NewMockDataColumnStorageSummarizer(t, map[[32]byte][]uint64{root: {0, 7}})
+	require.DeepEqual(t, map[uint64]bool{0: true, 7: true}, c.Summary(root).Stored())
+	require.Equal(t, uint64(0), c.Summary([32]byte{2}).Count())
+
+	cache, ok := c.(*dataColumnStorageSummaryCache)
+	require.Equal(t, true, ok)
+	require.Equal(t, 2, cache.evict(root))
+	require.Equal(t, 0, cache.evict(root))
+	require.Equal(t, uint64(0), c.Summary(root).Count())
+}
@@ -0,0 +1,118 @@
+package filesystem
+
+import (
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/verification"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+	"github.com/spf13/afero"
+)
+
+func testDataColumns(t *testing.T, slot primitives.Slot, indices ...uint64) []blocks.VerifiedRODataColumn {
+	header := &ethpb.SignedBeaconBlockHeader{
+		Header: &ethpb.BeaconBlockHeader{
+			Slot:       slot,
+			ParentRoot: make([]byte, fieldparams.RootLength),
+			StateRoot:  make([]byte, fieldparams.RootLength),
+			BodyRoot:   make([]byte, fieldparams.RootLength),
+		},
+		Signature: make([]byte, fieldparams.BLSSignatureLength),
+	}
+	dcs := make([]blocks.RODataColumn, 0, len(indices))
+	for _, idx := range indices {
+		dc, err := blocks.NewRODataColumn(&ethpb.DataColumnSidecar{
+			ColumnIndex:                  idx,
+			DataColumn:                   [][]byte{make([]byte, 2048)},
+			KzgCommitments:               [][]byte{make([]byte, 48)},
+			KzgProof:                     [][]byte{make([]byte, 48)},
+			SignedBlockHeader:            header,
+			KzgCommitmentsInclusionProof: [][]byte{make([]byte, 32), make([]byte, 32), make([]byte, 32), make([]byte, 32)},
+		})
+		require.NoError(t, err)
+		dcs = append(dcs, dc)
+	}
+	return verification.FakeVerifyDataColumnSliceForTest(t, dcs)
+}
+
+func TestDataColumnStorage_SaveGet(t *testing.T) {
+	fs, s := NewEphemeralDataColumnStorageAndFs(t)
+	dcs := testDataColumns(t, 1, 3, 64, 127)
+	root := dcs[0].BlockRoot()
+	for _, dc := range dcs {
+		require.NoError(t, s.Save(dc))
+	}
+	// No error when attempting to write twice.
+	require.NoError(t, s.Save(dcs[0]))
+
+	for _, dc := range dcs {
+		got, err := s.Get(root, dc.ColumnIndex)
+		require.NoError(t, err)
+		require.DeepSSZEqual(t, dc.DataColumnSidecar, got.DataColumnSidecar)
+		require.Equal(t, root, got.BlockRoot())
+	}
+	_, err := s.Get(root, 4)
+	require.ErrorIs(t, err, db.ErrNotFound)
+
+	summary := s.Summary(root)
+	require.Equal(t, uint64(3), summary.Count())
+	require.DeepEqual(t, map[uint64]bool{3: true, 64: true, 127: true}, summary.Stored())
+	require.Equal(t, true, summary.AllAvailable(map[uint64]bool{3: true, 127: true}))
+	require.Equal(t, false, summary.AllAvailable(map[uint64]bool{3: true, 4: true}))
+
+	t.Run("warm cache", func(t *testing.T) {
+		warmed := NewEphemeralDataColumnStorageUsingFs(t, fs)
+		require.DeepEqual(t, summary.Stored(), warmed.Summary(root).Stored())
+		_, err := warmed.Get(root, 64)
+		require.NoError(t, err)
+	})
+
+	require.NoError(t, s.Remove(root))
+	require.Equal(t, uint64(0), s.Summary(root).Count())
+	exists, err := afero.Exists(fs, s.layout.dir(identForDataColumnSidecar(dcs[0])))
+	require.NoError(t, err)
+	require.Equal(t, false, exists)
+}
+
+func TestDataColumnStorage_IndexOutOfBounds(t *testing.T) {
+	s := NewEphemeralDataColumnStorage(t)
+	dcs := testDataColumns(t, 1, params.BeaconConfig().NumberOfColumns)
+	require.ErrorIs(t, s.Save(dcs[0]), errDataColumnIndexOutOfBounds)
+}
+
+func TestDataColumnStorage_Prune(t *testing.T) {
+	fs, s := NewEphemeralDataColumnStorageAndFs(t)
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	old := testDataColumns(t, slotsPerEpoch, 1, 2)
+	recent := testDataColumns(t, 3*slotsPerEpoch, 1)
+	for _, dc := range append(old, recent...) {
+		// Saving directly through the layout cache avoids the pruner goroutine.
+		ident := identForDataColumnSidecar(dc)
+		part, err := s.writePart(ident, dc)
+		require.NoError(t, err)
+		require.NoError(t, fs.Rename(part, s.layout.sszPath(ident)))
+		require.NoError(t, s.cache.ensure(ident))
+	}
+
+	sum, err := s.layout.pruneBefore(slots.ToEpoch(3 * slotsPerEpoch))
+	require.NoError(t, err)
+	require.Equal(t, 2, sum.blobsPruned)
+	require.Equal(t, uint64(0), s.Summary(old[0].BlockRoot()).Count())
+	require.Equal(t, uint64(1), s.Summary(recent[0].BlockRoot()).Count())
+	exists, err := afero.Exists(fs, s.layout.epochDir(1))
+	require.NoError(t, err)
+	require.Equal(t, false, exists)
+}
+
+func TestDataColumnStorage_WithinRetentionPeriod(t *testing.T) {
+	s := NewEphemeralDataColumnStorage(t)
+	retention := params.BeaconConfig().MinEpochsForDataColumnSidecarsRequest
+	require.Equal(t, true, s.WithinRetentionPeriod(1, retention+1))
+	require.Equal(t, false, s.WithinRetentionPeriod(1, retention+2))
+}
@@ -237,7 +237,14 @@ func (s pruneSummary) LogFields() logrus.Fields {
 	}
 }
 
-func pruneBefore(before primitives.Epoch, l fsLayout) (map[primitives.Epoch]*pruneSummary, error) {
+// identLayout is the part of a layout needed to iterate and remove the files it stores.
+type identLayout interface {
+	dir(n blobIdent) string
+	iterateIdents(before primitives.Epoch) (*identIterator, error)
+	remove(ident blobIdent) (int, error)
+}
+
+func pruneBefore(before primitives.Epoch, l identLayout) (map[primitives.Epoch]*pruneSummary, error) {
 	sums := make(map[primitives.Epoch]*pruneSummary)
 	iter, err := l.iterateIdents(before)
 	if err != nil {
@@ -272,7 +279,7 @@ func pruneBefore(before primitives.Epoch, l fsLayout) (map[primitives.Epoch]*pru
 	return sums, nil
 }
 
-func pruneOne(ident blobIdent, l fsLayout, sums map[primitives.Epoch]*pruneSummary) {
+func pruneOne(ident blobIdent, l identLayout, sums map[primitives.Epoch]*pruneSummary) {
 	// Skip pruning the n-1 ident if we're on the first real ident (lastIdent will be zero value).
 	if ident.root == params.BeaconConfig().ZeroHash {
 		return
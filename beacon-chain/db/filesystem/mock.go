@@ -75,3 +75,43 @@ func NewMockBlobStorageSummarizer(t *testing.T, set map[[32]byte][]int) BlobStor
 	}
 	return c
 }
+
+// NewEphemeralDataColumnStorage should only be used for tests.
+// The instance of DataColumnStorage returned is backed by an in-memory virtual filesystem.
+func NewEphemeralDataColumnStorage(t testing.TB, opts ...DataColumnStorageOption) *DataColumnStorage {
+	return NewEphemeralDataColumnStorageUsingFs(t, afero.NewMemMapFs(), opts...)
+}
+
+// NewEphemeralDataColumnStorageAndFs can be used by tests that want access to the virtual filesystem
+// in order to interact with it outside the parameters of the DataColumnStorage api.
+func NewEphemeralDataColumnStorageAndFs(t testing.TB, opts ...DataColumnStorageOption) (afero.Fs, *DataColumnStorage) {
+	fs := afero.NewMemMapFs()
+	return fs, NewEphemeralDataColumnStorageUsingFs(t, fs, opts...)
+}
+
+// NewEphemeralDataColumnStorageUsingFs returns a warmed DataColumnStorage backed by the given filesystem.
+func NewEphemeralDataColumnStorageUsingFs(t testing.TB, fs afero.Fs, opts ...DataColumnStorageOption) *DataColumnStorage {
+	opts = append(opts,
+		WithDataColumnRetentionEpochs(params.BeaconConfig().MinEpochsForDataColumnSidecarsRequest),
+		WithDataColumnFs(fs))
+	s, err := NewDataColumnStorage(opts...)
+	if err != nil {
+		t.Fatalf("error initializing test DataColumnStorage, err=%s", err.Error())
+	}
+	s.WarmCache()
+	return s
+}
+
+// NewMockDataColumnStorageSummarizer returns a DataColumnStorageSummarizer with the given column indices stored
+// for each root.
+func NewMockDataColumnStorageSummarizer(t *testing.T, set map[[32]byte][]uint64) DataColumnStorageSummarizer {
+	c := newDataColumnStorageSummaryCache()
+	for root, indices := range set {
+		for _, idx := range indices {
+			if err := c.ensure(newBlobIdent(root, 0, idx)); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	return c
+}
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
 	"github.com/sirupsen/logrus"
 )
@@ -19,20 +20,33 @@ var errNotBlobSSZ = errors.New("not a blob ssz file")
 // the pruner will invoke the pruneBefore method of the given layout in a new goroutine.
 // The details of pruning are left entirely to the layout, with the pruner's only responsibility being to
 // schedule just one pruning operation at a time, for each forward movement of the minimum retention epoch.
+// The pruner is shared by the blob and data column storages, which only differ in the files they prune.
 type blobPruner struct {
 	mu              sync.Mutex
 	prunedBefore    atomic.Uint64
 	retentionPeriod primitives.Epoch
+	kind            string
+	prunedCounter   prometheus.Counter
+}
+
+// prunableLayout is implemented by the storage layouts the pruner operates on.
+type prunableLayout interface {
+	pruneBefore(before primitives.Epoch) (*pruneSummary, error)
 }
 
 func newBlobPruner(retain primitives.Epoch) *blobPruner {
-	p := &blobPruner{retentionPeriod: retain + retentionBuffer}
+	p := &blobPruner{retentionPeriod: retain + retentionBuffer, kind: "blob", prunedCounter: blobsPrunedCounter}
+	return p
+}
+
+func newDataColumnPruner(retain primitives.Epoch) *blobPruner {
+	p := &blobPruner{retentionPeriod: retain + retentionBuffer, kind: "data column", prunedCounter: dataColumnsPrunedCounter}
 	return p
 }
 
 // notify returns a channel that is closed when the pruning operation is complete.
 // This is useful for tests, but at runtime fsLayouts or BlobStorage should not wait for completion.
-func (p *blobPruner) notify(latest primitives.Epoch, layout fsLayout) chan struct{} {
+func (p *blobPruner) notify(latest primitives.Epoch, layout prunableLayout) chan struct{} {
 	done := make(chan struct{})
 	floor := periodFloor(latest, p.retentionPeriod)
 	if primitives.Epoch(p.prunedBefore.Swap(uint64(floor))) >= floor {
@@ -46,14 +60,14 @@ func (p *blobPruner) notify(latest primitives.Epoch, layout fsLayout) chan struc
 		defer p.mu.Unlock()
 		sum, err := layout.pruneBefore(floor)
 		if err != nil {
-			log.WithError(err).WithFields(sum.LogFields()).Warn("Encountered errors during blob pruning.")
+			log.WithError(err).WithFields(sum.LogFields()).Warnf("Encountered errors during %s pruning.", p.kind)
 		}
 		log.WithFields(logrus.Fields{
 			"upToEpoch":    floor,
 			"duration":     time.Since(start).String(),
 			"filesRemoved": sum.blobsPruned,
-		}).Debug("Pruned old blobs")
-		blobsPrunedCounter.Add(float64(sum.blobsPruned))
+		}).Debugf("Pruned old %ss", p.kind)
+		p.prunedCounter.Add(float64(sum.blobsPruned))
 		close(done)
 	}()
 	return done
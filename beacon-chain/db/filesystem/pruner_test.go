@@ -88,7 +88,7 @@ func TestPrunerNotify(t *testing.T) {
 		t.Run(c.name, func(t *testing.T) {
 			actual := &pruneExpectation{}
 			l := &mockLayout{pruneBeforeFunc: actual.record}
-			pruner := &blobPruner{retentionPeriod: c.retentionPeriod}
+			pruner := &blobPruner{retentionPeriod: c.retentionPeriod, kind: "blob", prunedCounter: blobsPrunedCounter}
 			pruner.prunedBefore.Store(uint64(c.prunedBefore))
 			done := pruner.notify(c.latest, l)
 			<-done
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"path"
 
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/backend"
 	"github.com/prysmaticlabs/prysm/v5/config/params"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
 	"github.com/prysmaticlabs/prysm/v5/io/file"
@@ -59,58 +60,12 @@ func (s *Store) Backup(ctx context.Context, outputDir string, permissionOverride
 			log.WithError(err).Error("Failed to close backup database")
 		}
 	}()
-	// Prefetch all keys of buckets, and inner keys in a
-	// bucket to use less memory usage when backing up.
-	var bucketKeys [][]byte
-	bucketMap := make(map[string][][]byte)
-	err = s.db.View(func(tx *bolt.Tx) error {
-		return tx.ForEach(func(name []byte, b *bolt.Bucket) error {
-			newName := make([]byte, len(name))
-			copy(newName, name)
-			bucketKeys = append(bucketKeys, newName)
-			var innerKeys [][]byte
-			err := b.ForEach(func(k, v []byte) error {
-				if k == nil {
-					return nil
-				}
-				nKey := make([]byte, len(k))
-				copy(nKey, k)
-				innerKeys = append(innerKeys, nKey)
-				return nil
-			})
-			if err != nil {
-				return err
-			}
-			bucketMap[string(newName)] = innerKeys
-			return nil
-		})
-	})
-	if err != nil {
+	// Backups are always written in the bolt format, whatever the backend of the database, so that
+	// they can be restored the same way. Buckets are copied in small batches, as long-running read
+	// transactions are not handled well by Bolt.
+	if err := backend.Copy(ctx, backend.NewBolt(copyDB), s.db); err != nil {
 		return err
 	}
-	// Utilize much smaller writes, compared to
-	// writing for a whole bucket in a single transaction. Also
-	// prevent long-running read transactions, as Bolt doesn't
-	// handle those well.
-	for _, k := range bucketKeys {
-		log.Debugf("Copying bucket %s\n", k)
-		innerKeys := bucketMap[string(k)]
-		for _, ik := range innerKeys {
-			err = s.db.View(func(tx *bolt.Tx) error {
-				bkt := tx.Bucket(k)
-				return copyDB.Update(func(tx2 *bolt.Tx) error {
-					b2, err := tx2.CreateBucketIfNotExists(k)
-					if err != nil {
-						return err
-					}
-					return b2.Put(ik, bkt.Get(ik))
-				})
-			})
-			if err != nil {
-				return err
-			}
-		}
-	}
 	// Re-enable sync to allow bolt to fsync
 	// again.
 	copyDB.NoSync = false
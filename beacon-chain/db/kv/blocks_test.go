@@ -8,6 +8,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/backend"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/filters"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
 	"github.com/prysmaticlabs/prysm/v5/config/params"
@@ -20,7 +21,6 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/testing/assert"
 	"github.com/prysmaticlabs/prysm/v5/testing/require"
 	"github.com/prysmaticlabs/prysm/v5/testing/util"
-	bolt "go.etcd.io/bbolt"
 	"google.golang.org/protobuf/proto"
 )
 
@@ -370,7 +370,7 @@ func TestStore_HistoricalDataBeforeSlot(t *testing.T) {
 	require.NoError(t, db.SaveBlocks(ctx, blks))
 
 	// Mark state validator migration as complete
-	err := db.db.Update(func(tx *bolt.Tx) error {
+	err := db.db.Update(func(tx backend.Tx) error {
 		return tx.Bucket(migrationsBucket).Put(migrationStateValidatorsKey, migrationCompleted)
 	})
 	require.NoError(t, err)
@@ -422,7 +422,7 @@ func TestStore_HistoricalDataBeforeSlot(t *testing.T) {
 	require.NoError(t, db.SaveStateSummaries(ctx, ss))
 
 	// Verify slot indices exist before deletion
-	err = db.db.View(func(tx *bolt.Tx) error {
+	err = db.db.View(func(tx backend.Tx) error {
 		blockSlotBkt := tx.Bucket(blockSlotIndicesBucket)
 		stateSlotBkt := tx.Bucket(stateSlotIndicesBucket)
 
@@ -452,7 +452,7 @@ func TestStore_HistoricalDataBeforeSlot(t *testing.T) {
 		assert.Equal(t, false, db.HasBlock(ctx, root))
 
 		// Verify block parent root does not exist
-		err = db.db.View(func(tx *bolt.Tx) error {
+		err = db.db.View(func(tx backend.Tx) error {
 			require.Equal(t, 0, len(tx.Bucket(blockParentRootIndicesBucket).Get(root[:])))
 			return nil
 		})
@@ -467,7 +467,7 @@ func TestStore_HistoricalDataBeforeSlot(t *testing.T) {
 		assert.Equal(t, false, hasSummary)
 
 		// Verify validator hashes for block roots are deleted
-		err = db.db.View(func(tx *bolt.Tx) error {
+		err = db.db.View(func(tx backend.Tx) error {
 			assert.Equal(t, 0, len(tx.Bucket(blockRootValidatorHashesBucket).Get(root[:])))
 			return nil
 		})
@@ -475,7 +475,7 @@ func TestStore_HistoricalDataBeforeSlot(t *testing.T) {
 	}
 
 	// Verify slot indices are deleted
-	err = db.db.View(func(tx *bolt.Tx) error {
+	err = db.db.View(func(tx backend.Tx) error {
 		blockSlotBkt := tx.Bucket(blockSlotIndicesBucket)
 		stateSlotBkt := tx.Bucket(stateSlotIndicesBucket)
 
@@ -498,7 +498,7 @@ func TestStore_HistoricalDataBeforeSlot(t *testing.T) {
 
 		// Verify remaining block parent root exists, except last slot since we store parent roots of each block.
 		if i < slotsPerEpoch*4-1 {
-			err = db.db.View(func(tx *bolt.Tx) error {
+			err = db.db.View(func(tx backend.Tx) error {
 				require.NotNil(t, tx.Bucket(blockParentRootIndicesBucket).Get(root[:]), fmt.Sprintf("Expected block parent index to be deleted, slot: %d", i))
 				return nil
 			})
@@ -514,7 +514,7 @@ func TestStore_HistoricalDataBeforeSlot(t *testing.T) {
 		assert.Equal(t, true, hasSummary)
 
 		// Verify slot indices still exist
-		err = db.db.View(func(tx *bolt.Tx) error {
+		err = db.db.View(func(tx backend.Tx) error {
 			blockSlotBkt := tx.Bucket(blockSlotIndicesBucket)
 			stateSlotBkt := tx.Bucket(stateSlotIndicesBucket)
 
@@ -531,7 +531,7 @@ func TestStore_HistoricalDataBeforeSlot(t *testing.T) {
 		assert.NotNil(t, valsActual)
 
 		// Verify remaining validator hashes for block roots exists
-		err = db.db.View(func(tx *bolt.Tx) error {
+		err = db.db.View(func(tx backend.Tx) error {
 			assert.NotNil(t, tx.Bucket(blockRootValidatorHashesBucket).Get(root[:]))
 			return nil
 		})
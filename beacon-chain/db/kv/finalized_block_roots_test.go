@@ -5,6 +5,7 @@ import (
 	"context"
 	"testing"
 
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/backend"
 	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
 	"github.com/prysmaticlabs/prysm/v5/config/params"
 	consensusblocks "github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
@@ -15,7 +16,6 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/testing/assert"
 	"github.com/prysmaticlabs/prysm/v5/testing/require"
 	"github.com/prysmaticlabs/prysm/v5/testing/util"
-	bolt "go.etcd.io/bbolt"
 )
 
 var genesisBlockRoot = bytesutil.ToBytes32([]byte{'G', 'E', 'N', 'E', 'S', 'I', 'S'})
@@ -260,7 +260,7 @@ func TestStore_BackfillFinalizedIndexSingle(t *testing.T) {
 	enc, err := encode(ctx, ebf)
 	require.NoError(t, err)
 	// writing this to the index outside of the validating function to seed the test.
-	err = db.db.Update(func(tx *bolt.Tx) error {
+	err = db.db.Update(func(tx backend.Tx) error {
 		bkt := tx.Bucket(finalizedBlockRootsIndexBucket)
 		return bkt.Put(ebr[:], enc)
 	})
@@ -298,7 +298,7 @@ func TestStore_BackfillFinalizedIndex(t *testing.T) {
 	}
 	enc, err := encode(ctx, ebf)
 	require.NoError(t, err)
-	err = db.db.Update(func(tx *bolt.Tx) error {
+	err = db.db.Update(func(tx backend.Tx) error {
 		bkt := tx.Bucket(finalizedBlockRootsIndexBucket)
 		return bkt.Put(ebr[:], enc)
 	})
@@ -318,7 +318,7 @@ func TestStore_BackfillFinalizedIndex(t *testing.T) {
 	// use the real root so that it succeeds
 	require.NoError(t, db.BackfillFinalizedIndex(ctx, blks, ebr))
 	for i := range blks {
-		require.NoError(t, db.db.View(func(tx *bolt.Tx) error {
+		require.NoError(t, db.db.View(func(tx backend.Tx) error {
 			bkt := tx.Bucket(finalizedBlockRootsIndexBucket)
 			encfr := bkt.Get(blks[i].RootSlice())
 			require.Equal(t, true, len(encfr) > 0)
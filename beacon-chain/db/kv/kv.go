@@ -1,5 +1,6 @@
-// Package kv defines a bolt-db, key-value store implementation
-// of the Database interface defined by a Prysm beacon node.
+// Package kv defines a key-value store implementation of the Database
+// interface defined by a Prysm beacon node, built on top of one of the
+// embedded storage engines of the backend package.
 package kv
 
 import (
@@ -14,6 +15,7 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
 	prombolt "github.com/prysmaticlabs/prombbolt"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/backend"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/iface"
 	"github.com/prysmaticlabs/prysm/v5/config/features"
 	"github.com/prysmaticlabs/prysm/v5/config/params"
@@ -34,6 +36,8 @@ const (
 	BeaconNodeDbDirName = "beaconchaindata"
 	// DatabaseFileName is the name of the beacon node database.
 	DatabaseFileName = "beaconchain.db"
+	// PebbleDirName is the name of the directory of the beacon node database when using the pebble backend.
+	PebbleDirName = "beaconchain.pebble"
 
 	boltAllocSize = 8 * 1024 * 1024
 	// The size of hash length in bytes
@@ -82,13 +86,17 @@ var blockedBuckets = [][]byte{
 }
 
 // Store defines an implementation of the Prysm Database interface
-// using BoltDB as the underlying persistent kv-store for Ethereum Beacon Nodes.
+// using BoltDB, or another backend, as the underlying persistent kv-store for Ethereum Beacon Nodes.
 type Store struct {
-	db                  *bolt.DB
+	db                  backend.DB
+	dbBackend           backend.Kind
+	boltCollector       prometheus.Collector
 	databasePath        string
 	blockCache          *ristretto.Cache
 	validatorEntryCache *ristretto.Cache
 	stateSummaryCache   *stateSummaryCache
+	stateDiffExponents  []uint8
+	stateDiffCache      *stateDiffCache
 	ctx                 context.Context
 }
 
@@ -99,6 +107,12 @@ func StoreDatafilePath(dirPath string) string {
 	return path.Join(dirPath, DatabaseFileName)
 }
 
+// StorePebblePath is the canonical construction of the pebble database
+// directory path from the database directory path.
+func StorePebblePath(dirPath string) string {
+	return path.Join(dirPath, PebbleDirName)
+}
+
 var Buckets = [][]byte{
 	blocksBucket,
 	stateBucket,
@@ -121,12 +135,20 @@ var Buckets = [][]byte{
 
 	feeRecipientBucket,
 	registrationBucket,
+	stateDiffBucket,
 }
 
 // KVStoreOption is a functional option that modifies a kv.Store.
 type KVStoreOption func(*Store)
 
-// NewKVStore initializes a new boltDB key-value store at the directory
+// WithBackend selects the storage backend of the database. Bolt is used by default.
+func WithBackend(kind backend.Kind) KVStoreOption {
+	return func(s *Store) {
+		s.dbBackend = kind
+	}
+}
+
+// NewKVStore initializes a new key-value store at the directory
 // path specified, creates the kv-buckets based on the schema, and stores
 // an open connection db object as a property of the Store struct.
 func NewKVStore(ctx context.Context, dirPath string, opts ...KVStoreOption) (*Store, error) {
@@ -139,23 +161,6 @@ func NewKVStore(ctx context.Context, dirPath string, opts ...KVStoreOption) (*St
 			return nil, err
 		}
 	}
-	datafile := StoreDatafilePath(dirPath)
-	log.WithField("path", datafile).Info("Opening Bolt DB")
-	boltDB, err := bolt.Open(
-		datafile,
-		params.BeaconIoConfig().ReadWritePermissions,
-		&bolt.Options{
-			Timeout:         1 * time.Second,
-			InitialMmapSize: mmapSize,
-		},
-	)
-	if err != nil {
-		if errors.Is(err, bolt.ErrTimeout) {
-			return nil, errors.New("cannot obtain database lock, database may be in use by another process")
-		}
-		return nil, err
-	}
-	boltDB.AllocSize = boltAllocSize
 	blockCache, err := ristretto.NewCache(&ristretto.Config{
 		NumCounters: 1000,           // number of keys to track frequency of (1000).
 		MaxCost:     BlockCacheSize, // maximum cost of cache (1000 Blocks).
@@ -175,23 +180,30 @@ func NewKVStore(ctx context.Context, dirPath string, opts ...KVStoreOption) (*St
 	}
 
 	kv := &Store{
-		db:                  boltDB,
+		dbBackend:           backend.Bolt,
 		databasePath:        dirPath,
 		blockCache:          blockCache,
 		validatorEntryCache: validatorCache,
 		stateSummaryCache:   newStateSummaryCache(),
+		stateDiffExponents:  defaultStateDiffExponents,
+		stateDiffCache:      &stateDiffCache{},
 		ctx:                 ctx,
 	}
 	for _, o := range opts {
 		o(kv)
 	}
-	if err := kv.db.Update(func(tx *bolt.Tx) error {
+	if err := kv.openBackend(); err != nil {
+		return nil, err
+	}
+	if err := kv.db.Update(func(tx backend.Tx) error {
 		return createBuckets(tx, Buckets...)
 	}); err != nil {
 		return nil, err
 	}
-	if err = prometheus.Register(createBoltCollector(kv.db)); err != nil {
-		return nil, err
+	if kv.boltCollector != nil {
+		if err = prometheus.Register(kv.boltCollector); err != nil {
+			return nil, err
+		}
 	}
 	// Setup the type of block storage used depending on whether or not this is a fresh database.
 	if err := kv.setupBlockStorageType(ctx); err != nil {
@@ -201,6 +213,75 @@ func NewKVStore(ctx context.Context, dirPath string, opts ...KVStoreOption) (*St
 	return kv, nil
 }
 
+// openBackend opens the database of the configured backend. As an empty database of the
+// other backend would be created otherwise, it refuses to open a directory which only
+// holds a database of the other backend.
+func (s *Store) openBackend() error {
+	hasBolt, hasPebble, err := existingBackends(s.databasePath)
+	if err != nil {
+		return err
+	}
+	switch {
+	case s.dbBackend == backend.Bolt && hasPebble && !hasBolt:
+		return fmt.Errorf("found a %s database at %s, run with --db-backend=%s or migrate it with `prysmctl db migrate-backend`",
+			backend.Pebble, StorePebblePath(s.databasePath), backend.Pebble)
+	case s.dbBackend == backend.Pebble && hasBolt && !hasPebble:
+		return fmt.Errorf("found a %s database at %s, run with --db-backend=%s or migrate it with `prysmctl db migrate-backend`",
+			backend.Bolt, StoreDatafilePath(s.databasePath), backend.Bolt)
+	}
+	s.db, s.boltCollector, err = openDB(s.dbBackend, s.databasePath)
+	return err
+}
+
+// openDB opens, or creates, the database of the given backend in the database directory. A metrics
+// collector is returned along with bolt databases.
+func openDB(kind backend.Kind, dirPath string) (backend.DB, prometheus.Collector, error) {
+	switch kind {
+	case backend.Bolt:
+		datafile := StoreDatafilePath(dirPath)
+		log.WithField("path", datafile).Info("Opening Bolt DB")
+		boltDB, err := bolt.Open(
+			datafile,
+			params.BeaconIoConfig().ReadWritePermissions,
+			&bolt.Options{
+				Timeout:         1 * time.Second,
+				InitialMmapSize: mmapSize,
+			},
+		)
+		if err != nil {
+			if errors.Is(err, bolt.ErrTimeout) {
+				return nil, nil, errors.New("cannot obtain database lock, database may be in use by another process")
+			}
+			return nil, nil, err
+		}
+		boltDB.AllocSize = boltAllocSize
+		return backend.NewBolt(boltDB), createBoltCollector(boltDB), nil
+	case backend.Pebble:
+		pebbleDir := StorePebblePath(dirPath)
+		log.WithField("path", pebbleDir).Info("Opening Pebble DB")
+		db, err := backend.OpenPebble(pebbleDir)
+		if err != nil {
+			return nil, nil, err
+		}
+		return db, nil, nil
+	default:
+		return nil, nil, fmt.Errorf("unsupported database backend %q", kind)
+	}
+}
+
+// existingBackends reports which backends have a database in the database directory.
+func existingBackends(dirPath string) (hasBolt bool, hasPebble bool, err error) {
+	hasBolt, err = file.Exists(StoreDatafilePath(dirPath), file.Regular)
+	if err != nil {
+		return false, false, err
+	}
+	hasPebble, err = file.Exists(StorePebblePath(dirPath), file.Directory)
+	if err != nil {
+		return false, false, err
+	}
+	return hasBolt, hasPebble, nil
+}
+
 // ClearDB removes the previously stored database in the data directory.
 func (s *Store) ClearDB() error {
 	if err := s.Close(); err != nil {
@@ -209,16 +290,23 @@ func (s *Store) ClearDB() error {
 	if _, err := os.Stat(s.databasePath); os.IsNotExist(err) {
 		return nil
 	}
-	prometheus.Unregister(createBoltCollector(s.db))
+	if s.dbBackend == backend.Pebble {
+		if err := os.RemoveAll(StorePebblePath(s.databasePath)); err != nil {
+			return errors.Wrap(err, "could not remove database directory")
+		}
+		return nil
+	}
 	if err := os.Remove(path.Join(s.databasePath, DatabaseFileName)); err != nil {
 		return errors.Wrap(err, "could not remove database file")
 	}
 	return nil
 }
 
-// Close closes the underlying BoltDB database.
+// Close closes the underlying database.
 func (s *Store) Close() error {
-	prometheus.Unregister(createBoltCollector(s.db))
+	if s.boltCollector != nil {
+		prometheus.Unregister(s.boltCollector)
+	}
 
 	// Before DB closes, we should dump the cached state summary objects to DB.
 	if err := s.saveCachedStateSummariesDB(s.ctx); err != nil {
@@ -245,7 +333,7 @@ func (s *Store) setupBlockStorageType(ctx context.Context) error {
 	saveFull := features.Get().SaveFullExecutionPayloads
 
 	var saveBlinded bool
-	if err := s.db.Update(func(tx *bolt.Tx) error {
+	if err := s.db.Update(func(tx backend.Tx) error {
 		// If we have a key stating we wish to save blinded beacon blocks, then we set saveBlinded to true.
 		metadataBkt := tx.Bucket(chainMetadataBucket)
 		keyExists := len(metadataBkt.Get(saveBlindedBeaconBlocksKey)) > 0
@@ -289,7 +377,7 @@ func (s *Store) setupBlockStorageType(ctx context.Context) error {
 	return nil
 }
 
-func createBuckets(tx *bolt.Tx, buckets ...[]byte) error {
+func createBuckets(tx backend.Tx, buckets ...[]byte) error {
 	for _, bucket := range buckets {
 		if _, err := tx.CreateBucketIfNotExists(bucket); err != nil {
 			return err
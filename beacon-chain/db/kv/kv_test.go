@@ -6,12 +6,12 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/backend"
 	"github.com/prysmaticlabs/prysm/v5/config/features"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
 	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v5/testing/require"
 	"github.com/prysmaticlabs/prysm/v5/testing/util"
-	bolt "go.etcd.io/bbolt"
 )
 
 // setupDB instantiates and returns a Store instance.
@@ -73,7 +73,7 @@ func Test_setupBlockStorageType(t *testing.T) {
 	})
 	t.Run("existing database with blinded blocks but no key in metadata bucket should continue storing blinded blocks", func(t *testing.T) {
 		store := setupDB(t)
-		require.NoError(t, store.db.Update(func(tx *bolt.Tx) error {
+		require.NoError(t, store.db.Update(func(tx backend.Tx) error {
 			return tx.Bucket(chainMetadataBucket).Put(saveBlindedBeaconBlocksKey, []byte{1})
 		}))
 
@@ -92,7 +92,7 @@ func Test_setupBlockStorageType(t *testing.T) {
 		require.DeepEqual(t, wrappedBlock, retrievedBlk)
 
 		// We then delete the key from the bucket.
-		require.NoError(t, store.db.Update(func(tx *bolt.Tx) error {
+		require.NoError(t, store.db.Update(func(tx backend.Tx) error {
 			return tx.Bucket(chainMetadataBucket).Delete(saveBlindedBeaconBlocksKey)
 		}))
 
@@ -101,7 +101,7 @@ func Test_setupBlockStorageType(t *testing.T) {
 		require.NoError(t, err)
 
 		var shouldSaveBlinded bool
-		require.NoError(t, store.db.Update(func(tx *bolt.Tx) error {
+		require.NoError(t, store.db.Update(func(tx backend.Tx) error {
 			bkt := tx.Bucket(chainMetadataBucket)
 			shouldSaveBlinded = len(bkt.Get(saveBlindedBeaconBlocksKey)) > 0
 			return nil
@@ -139,7 +139,7 @@ func Test_setupBlockStorageType(t *testing.T) {
 	})
 	t.Run("existing database with full blocks type should continue storing full blocks", func(t *testing.T) {
 		store := setupDB(t)
-		require.NoError(t, store.db.Update(func(tx *bolt.Tx) error {
+		require.NoError(t, store.db.Update(func(tx backend.Tx) error {
 			return tx.Bucket(chainMetadataBucket).Delete(saveBlindedBeaconBlocksKey)
 		}))
 
@@ -213,3 +213,32 @@ func Test_setupBlockStorageType(t *testing.T) {
 		require.ErrorContains(t, fmt.Sprintf(errMsg, features.SaveFullExecutionPayloads.Name), err)
 	})
 }
+
+func TestNewKVStore_Backend(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	root := [32]byte{'a'}
+
+	store, err := NewKVStore(ctx, dir, WithBackend(backend.Pebble))
+	require.NoError(t, err)
+	require.NoError(t, store.SaveGenesisBlockRoot(ctx, root))
+	require.NoError(t, store.Close())
+
+	// The existing pebble database must not be shadowed by a new bolt one.
+	_, err = NewKVStore(ctx, dir)
+	require.ErrorContains(t, "--db-backend=pebble", err)
+
+	store, err = NewKVStore(ctx, dir, WithBackend(backend.Pebble))
+	require.NoError(t, err)
+	got, err := store.GenesisBlockRoot(ctx)
+	require.NoError(t, err)
+	require.Equal(t, root, got)
+
+	require.NoError(t, store.ClearDB())
+	store, err = NewKVStore(ctx, dir)
+	require.NoError(t, err)
+	require.NoError(t, store.Close())
+
+	_, err = NewKVStore(ctx, dir, WithBackend(backend.Pebble))
+	require.ErrorContains(t, "migrate-backend", err)
+}
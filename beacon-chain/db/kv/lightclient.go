@@ -7,6 +7,7 @@ import (
 
 	"github.com/golang/snappy"
 	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/backend"
 	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
 	"github.com/prysmaticlabs/prysm/v5/config/params"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/interfaces"
@@ -15,7 +16,6 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing/trace"
 	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v5/runtime/version"
-	bolt "go.etcd.io/bbolt"
 	"google.golang.org/protobuf/proto"
 )
 
@@ -23,7 +23,7 @@ func (s *Store) SaveLightClientUpdate(ctx context.Context, period uint64, update
 	_, span := trace.StartSpan(ctx, "BeaconDB.SaveLightClientUpdate")
 	defer span.End()
 
-	return s.db.Update(func(tx *bolt.Tx) error {
+	return s.db.Update(func(tx backend.Tx) error {
 		bkt := tx.Bucket(lightClientUpdatesBucket)
 		enc, err := encodeLightClientUpdate(update)
 		if err != nil {
@@ -46,7 +46,7 @@ func (s *Store) SaveLightClientBootstrap(ctx context.Context, blockRoot []byte,
 		return errors.Wrap(err, "could not hash current sync committee")
 	}
 
-	return s.db.Update(func(tx *bolt.Tx) error {
+	return s.db.Update(func(tx backend.Tx) error {
 		syncCommitteeBucket := tx.Bucket(lightClientSyncCommitteeBucket)
 		syncCommitteeAlreadyExists := syncCommitteeBucket.Get(syncCommitteeHash[:]) != nil
 		if !syncCommitteeAlreadyExists {
@@ -79,7 +79,7 @@ func (s *Store) LightClientBootstrap(ctx context.Context, blockRoot []byte) (int
 
 	var bootstrap interfaces.LightClientBootstrap
 	var syncCommitteeHash []byte
-	err := s.db.View(func(tx *bolt.Tx) error {
+	err := s.db.View(func(tx backend.Tx) error {
 		bkt := tx.Bucket(lightClientBootstrapBucket)
 		syncCommitteeBucket := tx.Bucket(lightClientSyncCommitteeBucket)
 		enc := bkt.Get(blockRoot)
@@ -190,7 +190,7 @@ func (s *Store) LightClientUpdates(ctx context.Context, startPeriod, endPeriod u
 	}
 
 	updates := make(map[uint64]interfaces.LightClientUpdate)
-	err := s.db.View(func(tx *bolt.Tx) error {
+	err := s.db.View(func(tx backend.Tx) error {
 		bkt := tx.Bucket(lightClientUpdatesBucket)
 		c := bkt.Cursor()
 
@@ -223,7 +223,7 @@ func (s *Store) LightClientUpdate(ctx context.Context, period uint64) (interface
 	defer span.End()
 
 	var update interfaces.LightClientUpdate
-	err := s.db.View(func(tx *bolt.Tx) error {
+	err := s.db.View(func(tx backend.Tx) error {
 		bkt := tx.Bucket(lightClientUpdatesBucket)
 		updateBytes := bkt.Get(bytesutil.Uint64ToBytesBigEndian(period))
 		if updateBytes == nil {
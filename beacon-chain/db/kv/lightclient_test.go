@@ -6,6 +6,7 @@ import (
 	"math/rand"
 	"testing"
 
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/backend"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
 	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
 	"github.com/prysmaticlabs/prysm/v5/config/params"
@@ -18,7 +19,6 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/testing/require"
 	"github.com/prysmaticlabs/prysm/v5/testing/util"
 	"github.com/prysmaticlabs/prysm/v5/time/slots"
-	bolt "go.etcd.io/bbolt"
 	"google.golang.org/protobuf/proto"
 )
 
@@ -737,10 +737,14 @@ func TestStore_LightClientBootstrap_MultipleBootstrapsWithSameSyncCommittee(t *t
 	require.DeepEqual(t, savedBranch2, retrievedBranch2, "retrieved bootstrap1 sync committee branch does not match saved bootstrap1 sync committee branch")
 
 	// Ensure that the sync committee is only stored once
-	err = db.db.View(func(tx *bolt.Tx) error {
+	err = db.db.View(func(tx backend.Tx) error {
 		bucket := tx.Bucket(lightClientSyncCommitteeBucket)
 		require.NotNil(t, bucket)
-		count := bucket.Stats().KeyN
+		count := 0
+		require.NoError(t, bucket.ForEach(func(_, _ []byte) error {
+			count++
+			return nil
+		}))
 		require.Equal(t, 1, count)
 		return nil
 	})
@@ -797,10 +801,14 @@ func TestStore_LightClientBootstrap_MultipleBootstrapsWithDifferentSyncCommittee
 	require.DeepEqual(t, savedBranch2, retrievedBranch2, "retrieved bootstrap1 sync committee branch does not match saved bootstrap1 sync committee branch")
 
 	// Ensure that the sync committee is stored twice
-	err = db.db.View(func(tx *bolt.Tx) error {
+	err = db.db.View(func(tx backend.Tx) error {
 		bucket := tx.Bucket(lightClientSyncCommitteeBucket)
 		require.NotNil(t, bucket)
-		count := bucket.Stats().KeyN
+		count := 0
+		require.NoError(t, bucket.ForEach(func(_, _ []byte) error {
+			count++
+			return nil
+		}))
 		require.Equal(t, 2, count)
 		return nil
 	})
@@ -0,0 +1,86 @@
+package kv
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/backend"
+	"github.com/prysmaticlabs/prysm/v5/io/file"
+)
+
+const migrateBackendStagingDirName = "migrate-backend.tmp"
+
+// MigrateBackend copies the database of the from backend found in the database directory into a new
+// database of the to backend, in the same directory. The source database is left untouched, so that
+// it can be removed by the operator once the node runs with the new backend. The new database is
+// written to a staging directory first, so that an interrupted migration never leaves a partial
+// database behind.
+func MigrateBackend(ctx context.Context, dirPath string, from, to backend.Kind) error {
+	if from == to {
+		return fmt.Errorf("database is already using the %s backend", from)
+	}
+	hasBolt, hasPebble, err := existingBackends(dirPath)
+	if err != nil {
+		return err
+	}
+	has := map[backend.Kind]bool{backend.Bolt: hasBolt, backend.Pebble: hasPebble}
+	if !has[from] {
+		return fmt.Errorf("no %s database found in %s", from, dirPath)
+	}
+	if has[to] {
+		return fmt.Errorf("a %s database already exists in %s", to, dirPath)
+	}
+
+	stagingDir := path.Join(dirPath, migrateBackendStagingDirName)
+	if err := os.RemoveAll(stagingDir); err != nil {
+		return errors.Wrap(err, "could not remove previous staging directory")
+	}
+	if err := file.MkdirAll(stagingDir); err != nil {
+		return err
+	}
+	defer func() {
+		if err := os.RemoveAll(stagingDir); err != nil {
+			log.WithError(err).Error("Could not remove staging directory")
+		}
+	}()
+
+	src, _, err := openDB(from, dirPath)
+	if err != nil {
+		return errors.Wrapf(err, "could not open %s database", from)
+	}
+	defer func() {
+		if err := src.Close(); err != nil {
+			log.WithError(err).Error("Could not close source database")
+		}
+	}()
+	dst, _, err := openDB(to, stagingDir)
+	if err != nil {
+		return errors.Wrapf(err, "could not open %s database", to)
+	}
+	if err := backend.Copy(ctx, dst, src); err != nil {
+		if closeErr := dst.Close(); closeErr != nil {
+			log.WithError(closeErr).Error("Could not close target database")
+		}
+		return errors.Wrap(err, "could not copy database")
+	}
+	if err := dst.Close(); err != nil {
+		return errors.Wrapf(err, "could not close %s database", to)
+	}
+
+	name := path.Base(databasePath(to, dirPath))
+	if err := os.Rename(path.Join(stagingDir, name), path.Join(dirPath, name)); err != nil {
+		return errors.Wrap(err, "could not move migrated database")
+	}
+	return nil
+}
+
+// databasePath returns the path of the file or directory of the database of the given backend.
+func databasePath(kind backend.Kind, dirPath string) string {
+	if kind == backend.Pebble {
+		return StorePebblePath(dirPath)
+	}
+	return StoreDatafilePath(dirPath)
+}
@@ -0,0 +1,49 @@
+package kv
+
+import (
+	"context"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/backend"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+)
+
+func TestMigrateBackend(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+
+	store, err := NewKVStore(ctx, dir)
+	require.NoError(t, err)
+	blk, err := blocks.NewSignedBeaconBlock(util.NewBeaconBlock())
+	require.NoError(t, err)
+	root, err := blk.Block().HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, store.SaveBlock(ctx, blk))
+	require.NoError(t, store.SaveGenesisBlockRoot(ctx, root))
+	require.NoError(t, store.Close())
+
+	require.ErrorContains(t, "already using", MigrateBackend(ctx, dir, backend.Bolt, backend.Bolt))
+	require.ErrorContains(t, "no pebble database", MigrateBackend(ctx, dir, backend.Pebble, backend.Bolt))
+	require.NoError(t, MigrateBackend(ctx, dir, backend.Bolt, backend.Pebble))
+	require.ErrorContains(t, "already exists", MigrateBackend(ctx, dir, backend.Bolt, backend.Pebble))
+	_, err = os.Stat(path.Join(dir, migrateBackendStagingDirName))
+	require.Equal(t, true, os.IsNotExist(err))
+
+	store, err = NewKVStore(ctx, dir, WithBackend(backend.Pebble))
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, store.Close())
+	}()
+	gotRoot, err := store.GenesisBlockRoot(ctx)
+	require.NoError(t, err)
+	require.Equal(t, root, gotRoot)
+	got, err := store.Block(ctx, root)
+	require.NoError(t, err)
+	gotRootFromBlock, err := got.Block().HashTreeRoot()
+	require.NoError(t, err)
+	require.Equal(t, root, gotRootFromBlock)
+}
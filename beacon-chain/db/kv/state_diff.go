@@ -0,0 +1,345 @@
+package kv
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"sync"
+
+	"github.com/golang/snappy"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/backend"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
+	statenative "github.com/prysmaticlabs/prysm/v5/beacon-chain/state/state-native"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing"
+	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing/trace"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/runtime/version"
+	"google.golang.org/protobuf/proto"
+)
+
+// The state diff storage keeps finalized states in a hierarchy of levels. Every level is defined by a power of two:
+// a slot belongs to the first level whose period divides it. States of the first level are stored as full
+// snapshots, states of every other level as a diff against the state at the start of the enclosing period of the
+// level above. Reconstructing a state therefore applies at most one diff per level, without any block processing.
+//
+// With the default exponents, snapshots are taken every 2^21 slots (about 290 days) and the lowest level stores a
+// diff for every slot against the state at the start of its epoch, so that every finalized state is stored.
+var defaultStateDiffExponents = []uint8{21, 18, 16, 13, 11, 9, 5, 0}
+
+// Kinds of state diff records.
+const (
+	stateDiffSnapshot byte = iota
+	stateDiffPatch
+)
+
+// stateDiffHeaderSize is the size of the record header: kind, version and, for patches, the slot of the base state.
+const stateDiffHeaderSize = 2 + 8
+
+var (
+	errUnknownStateDiffRecord = errors.New("unknown state diff record")
+	errStateDiffChainTooLong  = errors.New("state diff chain is longer than the number of levels")
+)
+
+// stateDiffCache holds the most recently used base state of the diff hierarchy. Consecutive reads and writes
+// mostly share the same base, which would otherwise be reconstructed for every state.
+type stateDiffCache struct {
+	sync.Mutex
+	slot    primitives.Slot
+	version int
+	state   proto.Message
+}
+
+func (c *stateDiffCache) get(slot primitives.Slot) (proto.Message, int, bool) {
+	c.Lock()
+	defer c.Unlock()
+	if c.state == nil || c.slot != slot {
+		return nil, 0, false
+	}
+	return c.state, c.version, true
+}
+
+func (c *stateDiffCache) put(slot primitives.Slot, v int, st proto.Message) {
+	c.Lock()
+	defer c.Unlock()
+	c.slot, c.version, c.state = slot, v, st
+}
+
+// stateDiffLevel returns the level of the hierarchy the slot belongs to.
+// The boolean is false if the slot is not stored at all.
+func (s *Store) stateDiffLevel(slot primitives.Slot) (int, bool) {
+	for i, e := range s.stateDiffExponents {
+		if uint64(slot)%(uint64(1)<<e) == 0 {
+			return i, true
+		}
+	}
+	return 0, false
+}
+
+// SaveStateDiff stores a finalized state in the hierarchical state diff storage, keyed by its slot.
+// States of slots that do not belong to any level of the hierarchy are ignored. A snapshot is stored
+// instead of a diff when the base state is missing or belongs to an older fork.
+func (s *Store) SaveStateDiff(ctx context.Context, st state.ReadOnlyBeaconState) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveStateDiff")
+	defer span.End()
+
+	slot := st.Slot()
+	level, ok := s.stateDiffLevel(slot)
+	if !ok || s.HasStateDiff(ctx, slot) {
+		return nil
+	}
+	target, ok := st.ToProtoUnsafe().(proto.Message)
+	if !ok {
+		return errors.New("state is not backed by a protobuf message")
+	}
+
+	var base proto.Message
+	var baseSlot primitives.Slot
+	if level > 0 {
+		baseSlot = slot - slot%primitives.Slot(uint64(1)<<s.stateDiffExponents[level-1])
+		err := s.db.View(func(tx backend.Tx) error {
+			b, v, err := s.baseStateProto(ctx, tx, baseSlot)
+			if err != nil {
+				return err
+			}
+			if v == st.Version() {
+				base = b
+			}
+			return nil
+		})
+		if err != nil && !errors.Is(err, ErrNotFound) {
+			tracing.AnnotateError(span, err)
+			return errors.Wrapf(err, "could not get base state at slot %d", baseSlot)
+		}
+	}
+
+	enc, err := encodeStateDiff(st.Version(), target, base, baseSlot)
+	if err != nil {
+		tracing.AnnotateError(span, err)
+		return err
+	}
+	if err := s.db.Update(func(tx backend.Tx) error {
+		return tx.Bucket(stateDiffBucket).Put(bytesutil.SlotToBytesBigEndian(slot), enc)
+	}); err != nil {
+		tracing.AnnotateError(span, err)
+		return err
+	}
+	if level < len(s.stateDiffExponents)-1 {
+		// The state is the base of the following states of lower levels.
+		s.stateDiffCache.put(slot, st.Version(), proto.Clone(target))
+	}
+	return nil
+}
+
+// HasStateDiff checks whether the state at the given slot is stored in the state diff storage.
+// A failed read is logged and reported as a missing state.
+func (s *Store) HasStateDiff(ctx context.Context, slot primitives.Slot) bool {
+	_, span := trace.StartSpan(ctx, "BeaconDB.HasStateDiff")
+	defer span.End()
+	exists := false
+	err := s.db.View(func(tx backend.Tx) error {
+		exists = tx.Bucket(stateDiffBucket).Get(bytesutil.SlotToBytesBigEndian(slot)) != nil
+		return nil
+	})
+	if err != nil {
+		tracing.AnnotateError(span, err)
+		log.WithError(err).WithField("slot", slot).Error("Could not check state diff")
+		return false
+	}
+	return exists
+}
+
+// HighestStateDiffSlot returns the highest slot at or below the given slot which is stored in the state diff
+// storage. The boolean is false if there is none.
+func (s *Store) HighestStateDiffSlot(ctx context.Context, slot primitives.Slot) (primitives.Slot, bool, error) {
+	_, span := trace.StartSpan(ctx, "BeaconDB.HighestStateDiffSlot")
+	defer span.End()
+	var key []byte
+	err := s.db.View(func(tx backend.Tx) error {
+		c := tx.Bucket(stateDiffBucket).Cursor()
+		seek := bytesutil.SlotToBytesBigEndian(slot)
+		k, _ := c.Seek(seek)
+		switch {
+		case k == nil:
+			k, _ = c.Last()
+		case !bytes.Equal(k, seek):
+			k, _ = c.Prev()
+		}
+		key = bytes.Clone(k)
+		return nil
+	})
+	if err != nil {
+		tracing.AnnotateError(span, err)
+		return 0, false, err
+	}
+	if key == nil {
+		return 0, false, nil
+	}
+	return bytesutil.BytesToSlotBigEndian(key), true, nil
+}
+
+// StateDiff reconstructs the state at the given slot from the state diff storage.
+// It returns ErrNotFoundState if the slot is not stored.
+func (s *Store) StateDiff(ctx context.Context, slot primitives.Slot) (state.BeaconState, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.StateDiff")
+	defer span.End()
+
+	var st state.BeaconState
+	err := s.db.View(func(tx backend.Tx) error {
+		m, v, err := s.stateProtoAtSlot(ctx, tx, slot, 0)
+		if err != nil {
+			return err
+		}
+		st, err = initializeStateFromProto(v, m)
+		return err
+	})
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return nil, errors.Wrapf(ErrNotFoundState, "no state diff for slot %d", slot)
+		}
+		tracing.AnnotateError(span, err)
+		return nil, err
+	}
+	return st, nil
+}
+
+// baseStateProto returns the state at the given slot for use as the base of a diff. The returned message is shared
+// with the cache and must not be modified.
+func (s *Store) baseStateProto(ctx context.Context, tx backend.Tx, slot primitives.Slot) (proto.Message, int, error) {
+	if m, v, ok := s.stateDiffCache.get(slot); ok {
+		return m, v, nil
+	}
+	m, v, err := s.stateProtoAtSlot(ctx, tx, slot, 0)
+	if err != nil {
+		return nil, 0, err
+	}
+	s.stateDiffCache.put(slot, v, m)
+	return m, v, nil
+}
+
+// stateProtoAtSlot decodes the record stored for the slot, recursively applying it to its base state.
+// The returned message is owned by the caller.
+func (s *Store) stateProtoAtSlot(ctx context.Context, tx backend.Tx, slot primitives.Slot, depth int) (proto.Message, int, error) {
+	if depth > len(s.stateDiffExponents) {
+		return nil, 0, errors.Wrapf(errStateDiffChainTooLong, "slot %d", slot)
+	}
+	enc := tx.Bucket(stateDiffBucket).Get(bytesutil.SlotToBytesBigEndian(slot))
+	if enc == nil {
+		return nil, 0, errors.Wrapf(ErrNotFound, "no state diff for slot %d", slot)
+	}
+	if len(enc) < stateDiffHeaderSize {
+		return nil, 0, errors.Wrapf(errUnknownStateDiffRecord, "record of slot %d is too short", slot)
+	}
+	kind, v := enc[0], int(enc[1])
+	baseSlot := primitives.Slot(binary.LittleEndian.Uint64(enc[2:stateDiffHeaderSize]))
+	payload, err := snappy.Decode(nil, enc[stateDiffHeaderSize:])
+	if err != nil {
+		return nil, 0, errors.Wrapf(err, "could not decompress state diff of slot %d", slot)
+	}
+
+	switch kind {
+	case stateDiffSnapshot:
+		m, err := newStateProto(v)
+		if err != nil {
+			return nil, 0, err
+		}
+		u, ok := m.(interface{ UnmarshalSSZ([]byte) error })
+		if !ok {
+			return nil, 0, fmt.Errorf("state of version %s can not be unmarshaled", version.String(v))
+		}
+		if err := u.UnmarshalSSZ(payload); err != nil {
+			return nil, 0, errors.Wrapf(err, "could not unmarshal snapshot of slot %d", slot)
+		}
+		return m, v, nil
+	case stateDiffPatch:
+		base, baseVersion, ok := s.stateDiffCache.get(baseSlot)
+		if ok {
+			base = proto.Clone(base)
+		} else {
+			base, baseVersion, err = s.stateProtoAtSlot(ctx, tx, baseSlot, depth+1)
+			if err != nil {
+				return nil, 0, err
+			}
+			s.stateDiffCache.put(baseSlot, baseVersion, proto.Clone(base))
+		}
+		if baseVersion != v {
+			return nil, 0, fmt.Errorf("diff of slot %d has version %s, base has %s", slot, version.String(v), version.String(baseVersion))
+		}
+		if err := applyStatePatch(base, payload); err != nil {
+			return nil, 0, errors.Wrapf(err, "could not apply state diff of slot %d", slot)
+		}
+		return base, v, nil
+	default:
+		return nil, 0, errors.Wrapf(errUnknownStateDiffRecord, "kind %d", kind)
+	}
+}
+
+// encodeStateDiff encodes target as a diff against base, or as a snapshot if base is nil.
+func encodeStateDiff(v int, target, base proto.Message, baseSlot primitives.Slot) ([]byte, error) {
+	header := make([]byte, stateDiffHeaderSize)
+	header[1] = byte(v)
+	var payload []byte
+	if base == nil {
+		header[0] = stateDiffSnapshot
+		m, ok := target.(interface{ MarshalSSZ() ([]byte, error) })
+		if !ok {
+			return nil, fmt.Errorf("state of version %s can not be marshaled", version.String(v))
+		}
+		var err error
+		if payload, err = m.MarshalSSZ(); err != nil {
+			return nil, errors.Wrap(err, "could not marshal state snapshot")
+		}
+	} else {
+		header[0] = stateDiffPatch
+		binary.LittleEndian.PutUint64(header[2:], uint64(baseSlot))
+		var err error
+		if payload, err = diffStateProtos(base, target); err != nil {
+			return nil, errors.Wrap(err, "could not compute state diff")
+		}
+	}
+	return append(header, snappy.Encode(nil, payload)...), nil
+}
+
+func newStateProto(v int) (proto.Message, error) {
+	switch v {
+	case version.Phase0:
+		return &ethpb.BeaconState{}, nil
+	case version.Altair:
+		return &ethpb.BeaconStateAltair{}, nil
+	case version.Bellatrix:
+		return &ethpb.BeaconStateBellatrix{}, nil
+	case version.Capella:
+		return &ethpb.BeaconStateCapella{}, nil
+	case version.Deneb:
+		return &ethpb.BeaconStateDeneb{}, nil
+	case version.Electra, version.Fulu:
+		return &ethpb.BeaconStateElectra{}, nil
+	default:
+		return nil, fmt.Errorf("unsupported state version %d", v)
+	}
+}
+
+func initializeStateFromProto(v int, m proto.Message) (state.BeaconState, error) {
+	switch pb := m.(type) {
+	case *ethpb.BeaconState:
+		return statenative.InitializeFromProtoUnsafePhase0(pb)
+	case *ethpb.BeaconStateAltair:
+		return statenative.InitializeFromProtoUnsafeAltair(pb)
+	case *ethpb.BeaconStateBellatrix:
+		return statenative.InitializeFromProtoUnsafeBellatrix(pb)
+	case *ethpb.BeaconStateCapella:
+		return statenative.InitializeFromProtoUnsafeCapella(pb)
+	case *ethpb.BeaconStateDeneb:
+		return statenative.InitializeFromProtoUnsafeDeneb(pb)
+	case *ethpb.BeaconStateElectra:
+		if v == version.Fulu {
+			return statenative.InitializeFromProtoUnsafeFulu(pb)
+		}
+		return statenative.InitializeFromProtoUnsafeElectra(pb)
+	default:
+		return nil, fmt.Errorf("unsupported state type %T", m)
+	}
+}
@@ -0,0 +1,414 @@
+package kv
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Field operations of a state patch. Every field of the state container is described by exactly one operation,
+// in the order of the container's field descriptors.
+const (
+	opUnchanged byte = iota
+	opClear
+	opReplace
+	opBytesPatch
+	opListPatch
+)
+
+// Runs of changed bytes closer than bytesPatchGap are merged, since describing a run costs a few bytes too.
+const bytesPatchGap = 8
+
+var errMalformedPatch = errors.New("malformed state patch")
+
+// sszAppender is implemented by the generated SSZ code of all consensus containers. It is used to compare list
+// elements without going through protobuf reflection, which matters for the validator registry.
+type sszAppender interface {
+	MarshalSSZTo(dst []byte) ([]byte, error)
+}
+
+// patchWriter accumulates the encoding of a state patch.
+type patchWriter struct {
+	buf []byte
+}
+
+func (w *patchWriter) byte(b byte) {
+	w.buf = append(w.buf, b)
+}
+
+func (w *patchWriter) uvarint(v uint64) {
+	w.buf = binary.AppendUvarint(w.buf, v)
+}
+
+func (w *patchWriter) bytes(b []byte) {
+	w.uvarint(uint64(len(b)))
+	w.buf = append(w.buf, b...)
+}
+
+// patchReader decodes a state patch written by patchWriter. The first decoding error is sticky.
+type patchReader struct {
+	buf []byte
+	err error
+}
+
+func (r *patchReader) byte() byte {
+	if r.err != nil {
+		return 0
+	}
+	if len(r.buf) == 0 {
+		r.err = errors.Wrap(errMalformedPatch, "unexpected end of patch")
+		return 0
+	}
+	b := r.buf[0]
+	r.buf = r.buf[1:]
+	return b
+}
+
+func (r *patchReader) uvarint() uint64 {
+	if r.err != nil {
+		return 0
+	}
+	v, n := binary.Uvarint(r.buf)
+	if n <= 0 {
+		r.err = errors.Wrap(errMalformedPatch, "invalid varint")
+		return 0
+	}
+	r.buf = r.buf[n:]
+	return v
+}
+
+func (r *patchReader) bytes() []byte {
+	n := r.uvarint()
+	if r.err != nil {
+		return nil
+	}
+	if n > uint64(len(r.buf)) {
+		r.err = errors.Wrapf(errMalformedPatch, "byte string of length %d exceeds patch", n)
+		return nil
+	}
+	b := r.buf[:n]
+	r.buf = r.buf[n:]
+	return b
+}
+
+// diffStateProtos encodes the changes needed to turn base into target. Both messages must be of the same type.
+func diffStateProtos(base, target proto.Message) ([]byte, error) {
+	b, t := base.ProtoReflect(), target.ProtoReflect()
+	if b.Descriptor().FullName() != t.Descriptor().FullName() {
+		return nil, fmt.Errorf("cannot diff %s against %s", t.Descriptor().FullName(), b.Descriptor().FullName())
+	}
+	w := &patchWriter{}
+	fields := t.Descriptor().Fields()
+	w.uvarint(uint64(fields.Len()))
+	for i := 0; i < fields.Len(); i++ {
+		if err := diffField(w, fields.Get(i), b, t); err != nil {
+			return nil, errors.Wrapf(err, "could not diff field %s", fields.Get(i).Name())
+		}
+	}
+	return w.buf, nil
+}
+
+func diffField(w *patchWriter, fd protoreflect.FieldDescriptor, base, target protoreflect.Message) error {
+	if fd.IsList() {
+		return diffList(w, fd, base.Get(fd).List(), target.Get(fd).List())
+	}
+	if fd.Kind() == protoreflect.MessageKind && !target.Has(fd) {
+		if base.Has(fd) {
+			w.byte(opClear)
+		} else {
+			w.byte(opUnchanged)
+		}
+		return nil
+	}
+	bv, tv := base.Get(fd), target.Get(fd)
+	switch fd.Kind() {
+	case protoreflect.Uint64Kind:
+		if bv.Uint() == tv.Uint() {
+			w.byte(opUnchanged)
+			return nil
+		}
+		w.byte(opReplace)
+		w.uvarint(tv.Uint())
+	case protoreflect.BytesKind:
+		diffBytes(w, bv.Bytes(), tv.Bytes())
+	case protoreflect.MessageKind:
+		if base.Has(fd) && proto.Equal(bv.Message().Interface(), tv.Message().Interface()) {
+			w.byte(opUnchanged)
+			return nil
+		}
+		enc, err := marshalElement(tv.Message().Interface())
+		if err != nil {
+			return err
+		}
+		w.byte(opReplace)
+		w.bytes(enc)
+	default:
+		return fmt.Errorf("unsupported field kind %s", fd.Kind())
+	}
+	return nil
+}
+
+// diffBytes encodes a byte string either as unchanged, as a list of changed runs or as a full replacement,
+// whichever is smaller.
+func diffBytes(w *patchWriter, base, target []byte) {
+	if bytes.Equal(base, target) {
+		w.byte(opUnchanged)
+		return
+	}
+	patch := &patchWriter{}
+	patch.uvarint(uint64(len(target)))
+	var runs [][2]int
+	for i := 0; i < len(target); i++ {
+		if i < len(base) && base[i] == target[i] {
+			continue
+		}
+		if n := len(runs); n > 0 && i-runs[n-1][1] <= bytesPatchGap {
+			runs[n-1][1] = i + 1
+			continue
+		}
+		runs = append(runs, [2]int{i, i + 1})
+	}
+	patch.uvarint(uint64(len(runs)))
+	prev := 0
+	for _, run := range runs {
+		patch.uvarint(uint64(run[0] - prev))
+		patch.bytes(target[run[0]:run[1]])
+		prev = run[1]
+	}
+	if len(patch.buf) >= len(target) {
+		w.byte(opReplace)
+		w.bytes(target)
+		return
+	}
+	w.byte(opBytesPatch)
+	w.buf = append(w.buf, patch.buf...)
+}
+
+// diffList encodes the elements of target that differ from base. When most of the list changed,
+// the full list is written instead.
+func diffList(w *patchWriter, fd protoreflect.FieldDescriptor, base, target protoreflect.List) error {
+	var bbuf, tbuf []byte
+	var changed []int
+	for i := 0; i < target.Len(); i++ {
+		if i >= base.Len() {
+			changed = append(changed, i)
+			continue
+		}
+		equal, err := elementsEqual(fd, base.Get(i), target.Get(i), &bbuf, &tbuf)
+		if err != nil {
+			return err
+		}
+		if !equal {
+			changed = append(changed, i)
+		}
+	}
+	if len(changed) == 0 && base.Len() == target.Len() {
+		w.byte(opUnchanged)
+		return nil
+	}
+	if 2*len(changed) > target.Len() {
+		w.byte(opReplace)
+		w.uvarint(uint64(target.Len()))
+		for i := 0; i < target.Len(); i++ {
+			if err := writeElement(w, fd, target.Get(i)); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	w.byte(opListPatch)
+	w.uvarint(uint64(target.Len()))
+	w.uvarint(uint64(len(changed)))
+	prev := 0
+	for _, i := range changed {
+		w.uvarint(uint64(i - prev))
+		prev = i
+		if err := writeElement(w, fd, target.Get(i)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func elementsEqual(fd protoreflect.FieldDescriptor, a, b protoreflect.Value, abuf, bbuf *[]byte) (bool, error) {
+	switch fd.Kind() {
+	case protoreflect.Uint64Kind:
+		return a.Uint() == b.Uint(), nil
+	case protoreflect.BytesKind:
+		return bytes.Equal(a.Bytes(), b.Bytes()), nil
+	case protoreflect.MessageKind:
+		am, bm := a.Message().Interface(), b.Message().Interface()
+		as, aok := am.(sszAppender)
+		bs, bok := bm.(sszAppender)
+		if !aok || !bok {
+			return proto.Equal(am, bm), nil
+		}
+		var err error
+		if *abuf, err = as.MarshalSSZTo((*abuf)[:0]); err != nil {
+			return false, err
+		}
+		if *bbuf, err = bs.MarshalSSZTo((*bbuf)[:0]); err != nil {
+			return false, err
+		}
+		return bytes.Equal(*abuf, *bbuf), nil
+	default:
+		return false, fmt.Errorf("unsupported list element kind %s", fd.Kind())
+	}
+}
+
+func writeElement(w *patchWriter, fd protoreflect.FieldDescriptor, v protoreflect.Value) error {
+	switch fd.Kind() {
+	case protoreflect.Uint64Kind:
+		w.uvarint(v.Uint())
+	case protoreflect.BytesKind:
+		w.bytes(v.Bytes())
+	case protoreflect.MessageKind:
+		enc, err := marshalElement(v.Message().Interface())
+		if err != nil {
+			return err
+		}
+		w.bytes(enc)
+	default:
+		return fmt.Errorf("unsupported list element kind %s", fd.Kind())
+	}
+	return nil
+}
+
+func marshalElement(m proto.Message) ([]byte, error) {
+	return proto.MarshalOptions{Deterministic: true}.Marshal(m)
+}
+
+// applyStatePatch applies a patch produced by diffStateProtos to base, which is modified in place.
+func applyStatePatch(base proto.Message, patch []byte) error {
+	m := base.ProtoReflect()
+	r := &patchReader{buf: patch}
+	fields := m.Descriptor().Fields()
+	if n := r.uvarint(); r.err == nil && n != uint64(fields.Len()) {
+		return errors.Wrapf(errMalformedPatch, "patch has %d fields, %s has %d", n, m.Descriptor().FullName(), fields.Len())
+	}
+	for i := 0; i < fields.Len() && r.err == nil; i++ {
+		if err := applyField(r, m, fields.Get(i)); err != nil {
+			return errors.Wrapf(err, "could not apply patch to field %s", fields.Get(i).Name())
+		}
+	}
+	if r.err != nil {
+		return r.err
+	}
+	if len(r.buf) != 0 {
+		return errors.Wrapf(errMalformedPatch, "%d trailing bytes", len(r.buf))
+	}
+	return nil
+}
+
+func applyField(r *patchReader, m protoreflect.Message, fd protoreflect.FieldDescriptor) error {
+	switch op := r.byte(); op {
+	case opUnchanged:
+		return nil
+	case opClear:
+		m.Clear(fd)
+		return nil
+	case opReplace:
+		if fd.IsList() {
+			list := m.Mutable(fd).List()
+			list.Truncate(0)
+			n := r.uvarint()
+			for i := uint64(0); i < n && r.err == nil; i++ {
+				v, err := readElement(r, fd, list)
+				if err != nil {
+					return err
+				}
+				list.Append(v)
+			}
+			return r.err
+		}
+		v, err := readElement(r, fd, nil)
+		if err != nil {
+			return err
+		}
+		if fd.Kind() == protoreflect.MessageKind {
+			nm := m.NewField(fd).Message()
+			if err := proto.Unmarshal(v.Bytes(), nm.Interface()); err != nil {
+				return err
+			}
+			v = protoreflect.ValueOfMessage(nm)
+		}
+		m.Set(fd, v)
+		return nil
+	case opBytesPatch:
+		base := m.Get(fd).Bytes()
+		out := make([]byte, r.uvarint())
+		copy(out, base)
+		runs := r.uvarint()
+		pos := uint64(0)
+		for i := uint64(0); i < runs && r.err == nil; i++ {
+			pos += r.uvarint()
+			run := r.bytes()
+			if pos+uint64(len(run)) > uint64(len(out)) {
+				return errors.Wrap(errMalformedPatch, "byte run exceeds field length")
+			}
+			copy(out[pos:], run)
+			pos += uint64(len(run))
+		}
+		m.Set(fd, protoreflect.ValueOfBytes(out))
+		return r.err
+	case opListPatch:
+		list := m.Mutable(fd).List()
+		n := int(r.uvarint())
+		if list.Len() > n {
+			list.Truncate(n)
+		}
+		changed := r.uvarint()
+		idx := 0
+		for i := uint64(0); i < changed && r.err == nil; i++ {
+			idx += int(r.uvarint())
+			v, err := readElement(r, fd, list)
+			if err != nil {
+				return err
+			}
+			switch {
+			case idx < list.Len():
+				list.Set(idx, v)
+			case idx == list.Len():
+				list.Append(v)
+			default:
+				return errors.Wrapf(errMalformedPatch, "list index %d skips past length %d", idx, list.Len())
+			}
+		}
+		if r.err == nil && list.Len() != n {
+			return errors.Wrapf(errMalformedPatch, "list has length %d after patch, expected %d", list.Len(), n)
+		}
+		return r.err
+	default:
+		return errors.Wrapf(errMalformedPatch, "unknown operation %d", op)
+	}
+}
+
+// readElement decodes a single value. Message elements of lists are unmarshaled into a new list element,
+// singular messages are returned as raw bytes for the caller to unmarshal.
+func readElement(r *patchReader, fd protoreflect.FieldDescriptor, list protoreflect.List) (protoreflect.Value, error) {
+	switch fd.Kind() {
+	case protoreflect.Uint64Kind:
+		return protoreflect.ValueOfUint64(r.uvarint()), r.err
+	case protoreflect.BytesKind:
+		return protoreflect.ValueOfBytes(bytes.Clone(r.bytes())), r.err
+	case protoreflect.MessageKind:
+		enc := r.bytes()
+		if r.err != nil {
+			return protoreflect.Value{}, r.err
+		}
+		if list == nil {
+			return protoreflect.ValueOfBytes(enc), nil
+		}
+		elem := list.NewElement()
+		if err := proto.Unmarshal(enc, elem.Message().Interface()); err != nil {
+			return protoreflect.Value{}, err
+		}
+		return elem, nil
+	default:
+		return protoreflect.Value{}, fmt.Errorf("unsupported field kind %s", fd.Kind())
+	}
+}
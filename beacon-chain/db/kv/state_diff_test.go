@@ -0,0 +1,175 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/backend"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+	"google.golang.org/protobuf/proto"
+)
+
+// advanceTestState moves the state to the given slot and modifies it the way a few blocks would.
+func advanceTestState(t *testing.T, st state.BeaconState, slot primitives.Slot) {
+	require.NoError(t, st.SetSlot(slot))
+	require.NoError(t, st.UpdateBlockRootAtIndex(uint64(slot)%uint64(params.BeaconConfig().SlotsPerHistoricalRoot), [32]byte{byte(slot)}))
+	require.NoError(t, st.UpdateBalancesAtIndex(primitives.ValidatorIndex(uint64(slot)%uint64(st.NumValidators())), uint64(slot)))
+	if slot%3 == 0 {
+		require.NoError(t, st.AppendValidator(&ethpb.Validator{
+			PublicKey:             bytesutil.PadTo([]byte{byte(slot)}, 48),
+			WithdrawalCredentials: make([]byte, 32),
+			EffectiveBalance:      params.BeaconConfig().MaxEffectiveBalance,
+		}))
+		require.NoError(t, st.AppendBalance(params.BeaconConfig().MaxEffectiveBalance))
+	}
+}
+
+func stateDiffRecordKind(t *testing.T, db *Store, slot primitives.Slot) byte {
+	var kind byte
+	require.NoError(t, db.db.View(func(tx backend.Tx) error {
+		enc := tx.Bucket(stateDiffBucket).Get(bytesutil.SlotToBytesBigEndian(slot))
+		require.NotNil(t, enc)
+		kind = enc[0]
+		return nil
+	}))
+	return kind
+}
+
+func TestStore_StateDiff_RoundTrip(t *testing.T) {
+	ctx := context.Background()
+	db := setupDB(t)
+	db.stateDiffExponents = []uint8{4, 2, 0}
+
+	st, _ := util.DeterministicGenesisState(t, 16)
+	roots := make(map[primitives.Slot][32]byte)
+	for slot := primitives.Slot(0); slot < 40; slot++ {
+		advanceTestState(t, st, slot)
+		require.NoError(t, db.SaveStateDiff(ctx, st))
+		r, err := st.HashTreeRoot(ctx)
+		require.NoError(t, err)
+		roots[slot] = r
+	}
+	assert.Equal(t, stateDiffSnapshot, stateDiffRecordKind(t, db, 0))
+	assert.Equal(t, stateDiffSnapshot, stateDiffRecordKind(t, db, 32))
+	assert.Equal(t, stateDiffPatch, stateDiffRecordKind(t, db, 20))
+	assert.Equal(t, stateDiffPatch, stateDiffRecordKind(t, db, 23))
+
+	// Read without the help of the cache.
+	db.stateDiffCache = &stateDiffCache{}
+	for slot := primitives.Slot(0); slot < 40; slot++ {
+		require.Equal(t, true, db.HasStateDiff(ctx, slot))
+		got, err := db.StateDiff(ctx, slot)
+		require.NoError(t, err)
+		r, err := got.HashTreeRoot(ctx)
+		require.NoError(t, err)
+		require.Equal(t, roots[slot], r, "slot %d", slot)
+	}
+
+	_, err := db.StateDiff(ctx, 40)
+	require.ErrorIs(t, err, ErrNotFoundState)
+}
+
+func TestStore_SaveStateDiff_SlotNotInHierarchy(t *testing.T) {
+	ctx := context.Background()
+	db := setupDB(t)
+	db.stateDiffExponents = []uint8{4, 2}
+
+	st, err := util.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, st.SetSlot(3))
+	require.NoError(t, db.SaveStateDiff(ctx, st))
+	require.Equal(t, false, db.HasStateDiff(ctx, 3))
+
+	// The base state at slot 0 is missing, the state is stored as a snapshot.
+	require.NoError(t, st.SetSlot(4))
+	require.NoError(t, db.SaveStateDiff(ctx, st))
+	require.Equal(t, true, db.HasStateDiff(ctx, 4))
+	assert.Equal(t, stateDiffSnapshot, stateDiffRecordKind(t, db, 4))
+}
+
+func TestStore_HighestStateDiffSlot(t *testing.T) {
+	ctx := context.Background()
+	db := setupDB(t)
+	db.stateDiffExponents = []uint8{4, 2}
+
+	_, ok, err := db.HighestStateDiffSlot(ctx, 10)
+	require.NoError(t, err)
+	require.Equal(t, false, ok)
+
+	st, err := util.NewBeaconState()
+	require.NoError(t, err)
+	for _, slot := range []primitives.Slot{4, 8, 16} {
+		require.NoError(t, st.SetSlot(slot))
+		require.NoError(t, db.SaveStateDiff(ctx, st))
+	}
+	for slot, want := range map[primitives.Slot]primitives.Slot{4: 4, 7: 4, 8: 8, 15: 8, 16: 16, 100: 16} {
+		got, ok, err := db.HighestStateDiffSlot(ctx, slot)
+		require.NoError(t, err)
+		require.Equal(t, true, ok)
+		assert.Equal(t, want, got, "slot %d", slot)
+	}
+	_, ok, err = db.HighestStateDiffSlot(ctx, 3)
+	require.NoError(t, err)
+	require.Equal(t, false, ok)
+}
+
+func TestStore_SaveStateDiff_ForkChange(t *testing.T) {
+	ctx := context.Background()
+	db := setupDB(t)
+	db.stateDiffExponents = []uint8{4, 0}
+
+	phase0, err := util.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, db.SaveStateDiff(ctx, phase0))
+
+	altair, err := util.NewBeaconStateAltair()
+	require.NoError(t, err)
+	require.NoError(t, altair.SetSlot(1))
+	require.NoError(t, db.SaveStateDiff(ctx, altair))
+	assert.Equal(t, stateDiffSnapshot, stateDiffRecordKind(t, db, 1))
+
+	got, err := db.StateDiff(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, altair.Version(), got.Version())
+	want, err := altair.HashTreeRoot(ctx)
+	require.NoError(t, err)
+	r, err := got.HashTreeRoot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, want, r)
+}
+
+func TestStatePatch(t *testing.T) {
+	st, _ := util.DeterministicGenesisState(t, 8)
+	base := proto.Clone(st.ToProtoUnsafe().(proto.Message))
+	advanceTestState(t, st, 3)
+	require.NoError(t, st.SetFork(&ethpb.Fork{
+		PreviousVersion: []byte{1, 2, 3, 4},
+		CurrentVersion:  []byte{5, 6, 7, 8},
+		Epoch:           9,
+	}))
+	target := st.ToProtoUnsafe().(proto.Message)
+
+	patch, err := diffStateProtos(base, target)
+	require.NoError(t, err)
+	got := proto.Clone(base)
+	require.NoError(t, applyStatePatch(got, patch))
+	require.Equal(t, true, proto.Equal(target, got))
+
+	t.Run("unchanged", func(t *testing.T) {
+		patch, err := diffStateProtos(target, target)
+		require.NoError(t, err)
+		got := proto.Clone(target)
+		require.NoError(t, applyStatePatch(got, patch))
+		require.Equal(t, true, proto.Equal(target, got))
+	})
+	t.Run("malformed", func(t *testing.T) {
+		require.ErrorIs(t, applyStatePatch(proto.Clone(base), patch[:len(patch)/2]), errMalformedPatch)
+	})
+}
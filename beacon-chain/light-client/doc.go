@@ -0,0 +1,4 @@
+// Package lightclient implements a light client following the chain from a trusted checkpoint. It verifies the
+// sync committee signatures of light client updates served by a full node, exposes the verified headers over a
+// small REST API and can drive an execution client through the engine API.
+package lightclient
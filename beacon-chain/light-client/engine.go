@@ -0,0 +1,58 @@
+package lightclient
+
+import (
+	"context"
+	"time"
+
+	gethRPC "github.com/ethereum/go-ethereum/rpc"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/execution"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/network"
+	pb "github.com/prysmaticlabs/prysm/v5/proto/engine/v1"
+	"github.com/prysmaticlabs/prysm/v5/runtime/version"
+)
+
+// engineClient drives an execution client through the engine API, following the headers verified by the light
+// client. Unlike the execution service of a full node, it only ever sends fork choice updates without payload
+// attributes, as a light client neither validates payloads nor proposes blocks.
+type engineClient struct {
+	rpc *gethRPC.Client
+}
+
+func newEngineClient(ctx context.Context, endpoint network.Endpoint) (*engineClient, error) {
+	c, err := network.NewExecutionRPCClient(ctx, endpoint, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not dial execution endpoint %s", endpoint.Url)
+	}
+	return &engineClient{rpc: c}, nil
+}
+
+// forkchoiceUpdated sends the given fork choice state to the execution client, using the engine API method of
+// the fork of the head header.
+func (e *engineClient) forkchoiceUpdated(ctx context.Context, v int, state *pb.ForkchoiceState) (*pb.PayloadStatus, error) {
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(params.BeaconConfig().ExecutionEngineTimeoutValue)*time.Second)
+	defer cancel()
+
+	var method string
+	switch {
+	case v >= version.Deneb:
+		method = execution.ForkchoiceUpdatedMethodV3
+	case v >= version.Capella:
+		method = execution.ForkchoiceUpdatedMethodV2
+	default:
+		return nil, errors.Errorf("light client headers of version %s do not carry execution data", version.String(v))
+	}
+	result := &execution.ForkchoiceUpdatedResponse{}
+	if err := e.rpc.CallContext(ctx, result, method, state, nil); err != nil {
+		return nil, errors.Wrapf(err, "could not call %s", method)
+	}
+	if result.Status == nil {
+		return nil, execution.ErrNilResponse
+	}
+	return result.Status, nil
+}
+
+func (e *engineClient) close() {
+	e.rpc.Close()
+}
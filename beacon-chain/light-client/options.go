@@ -0,0 +1,31 @@
+package lightclient
+
+import (
+	"github.com/prysmaticlabs/prysm/v5/network"
+)
+
+type Option func(s *Service) error
+
+// WithTrustedBlockRoot sets the root of the trusted checkpoint block the light client starts from.
+func WithTrustedBlockRoot(root [32]byte) Option {
+	return func(s *Service) error {
+		s.cfg.trustedBlockRoot = root
+		return nil
+	}
+}
+
+// WithSource sets the source of the light client updates.
+func WithSource(source Source) Option {
+	return func(s *Service) error {
+		s.cfg.source = source
+		return nil
+	}
+}
+
+// WithExecutionEndpoint sets the engine API endpoint of the execution client following the light client.
+func WithExecutionEndpoint(endpoint network.Endpoint) Option {
+	return func(s *Service) error {
+		s.cfg.executionEndpoint = endpoint
+		return nil
+	}
+}
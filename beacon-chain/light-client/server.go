@@ -0,0 +1,60 @@
+package lightclient
+
+import (
+	"net/http"
+
+	"github.com/prysmaticlabs/prysm/v5/api"
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/interfaces"
+	"github.com/prysmaticlabs/prysm/v5/network/httputil"
+	"github.com/prysmaticlabs/prysm/v5/runtime/version"
+)
+
+const (
+	finalizedHeaderPath  = "/prysm/v1/light_client/finalized_header"
+	optimisticHeaderPath = "/prysm/v1/light_client/optimistic_header"
+)
+
+// Server serves the headers verified by the light client service.
+type Server struct {
+	Service *Service
+}
+
+// RegisterRoutes adds the light client endpoints to the router.
+func (s *Server) RegisterRoutes(router *http.ServeMux) {
+	router.HandleFunc(http.MethodGet+" "+finalizedHeaderPath, s.GetFinalizedHeader)
+	router.HandleFunc(http.MethodGet+" "+optimisticHeaderPath, s.GetOptimisticHeader)
+}
+
+// GetFinalizedHeader returns the latest finalized header verified by the light client.
+func (s *Server) GetFinalizedHeader(w http.ResponseWriter, _ *http.Request) {
+	store := s.Service.Store()
+	if store == nil {
+		httputil.HandleError(w, "Light client is not bootstrapped", http.StatusServiceUnavailable)
+		return
+	}
+	writeHeader(w, store.FinalizedHeader())
+}
+
+// GetOptimisticHeader returns the latest header attested by the sync committee, as verified by the light client.
+func (s *Server) GetOptimisticHeader(w http.ResponseWriter, _ *http.Request) {
+	store := s.Service.Store()
+	if store == nil {
+		httputil.HandleError(w, "Light client is not bootstrapped", http.StatusServiceUnavailable)
+		return
+	}
+	writeHeader(w, store.OptimisticHeader())
+}
+
+func writeHeader(w http.ResponseWriter, header interfaces.LightClientHeader) {
+	data, err := structs.LightClientHeaderToJSON(header)
+	if err != nil {
+		httputil.HandleError(w, "Could not convert light client header: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set(api.VersionHeader, version.String(header.Version()))
+	httputil.WriteJson(w, &structs.LightClientHeaderResponse{
+		Version: version.String(header.Version()),
+		Data:    data,
+	})
+}
@@ -0,0 +1,278 @@
+package lightclient
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/interfaces"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/network"
+	pb "github.com/prysmaticlabs/prysm/v5/proto/engine/v1"
+	"github.com/prysmaticlabs/prysm/v5/runtime/version"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+	"github.com/sirupsen/logrus"
+)
+
+// retryInterval is the delay before retrying to bootstrap the light client after a failure.
+var retryInterval = 12 * time.Second
+
+type config struct {
+	trustedBlockRoot  [32]byte
+	source            Source
+	executionEndpoint network.Endpoint
+}
+
+// Service follows the chain from a trusted checkpoint using light client updates. Once bootstrapped, it processes
+// the updates of every slot and forwards the verified head to the execution client, if one is configured.
+type Service struct {
+	ctx         context.Context
+	cancel      context.CancelFunc
+	cfg         *config
+	store       *Store
+	storeSet    chan struct{}
+	genesisTime time.Time
+	engine      *engineClient
+	// lastHeadHash is the execution block hash last sent to the execution client.
+	lastHeadHash []byte
+}
+
+// NewService creates a light client service.
+func NewService(ctx context.Context, opts ...Option) (*Service, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	s := &Service{
+		ctx:      ctx,
+		cancel:   cancel,
+		cfg:      &config{},
+		storeSet: make(chan struct{}),
+	}
+	for _, opt := range opts {
+		if err := opt(s); err != nil {
+			cancel()
+			return nil, err
+		}
+	}
+	if s.cfg.source == nil {
+		cancel()
+		return nil, errors.New("no light client source configured")
+	}
+	if s.cfg.trustedBlockRoot == [32]byte{} {
+		cancel()
+		return nil, errors.New("no trusted block root configured")
+	}
+	if s.cfg.executionEndpoint.Url != "" {
+		engine, err := newEngineClient(ctx, s.cfg.executionEndpoint)
+		if err != nil {
+			cancel()
+			return nil, err
+		}
+		s.engine = engine
+	}
+	return s, nil
+}
+
+// Start the light client service.
+func (s *Service) Start() {
+	go s.run()
+}
+
+// Stop the light client service.
+func (s *Service) Stop() error {
+	s.cancel()
+	if s.engine != nil {
+		s.engine.close()
+	}
+	if closer, ok := s.cfg.source.(io.Closer); ok {
+		return closer.Close()
+	}
+	return nil
+}
+
+// Status returns an error until the light client store is initialized from the trusted checkpoint.
+func (s *Service) Status() error {
+	if s.Store() == nil {
+		return errors.New("light client is not bootstrapped")
+	}
+	return nil
+}
+
+// Store returns the light client store, or nil until the service is bootstrapped.
+func (s *Service) Store() *Store {
+	select {
+	case <-s.storeSet:
+		return s.store
+	default:
+		return nil
+	}
+}
+
+func (s *Service) run() {
+	if err := s.bootstrap(); err != nil {
+		if !errors.Is(err, context.Canceled) {
+			log.WithError(err).Error("Could not bootstrap light client")
+		}
+		return
+	}
+	s.sync()
+
+	ticker := slots.NewSlotTicker(s.genesisTime, params.BeaconConfig().SecondsPerSlot)
+	defer ticker.Done()
+	for {
+		select {
+		case <-s.ctx.Done():
+			return
+		case <-ticker.C():
+			s.sync()
+		}
+	}
+}
+
+// bootstrap initializes the store from the trusted block root, retrying until the source serves a valid bootstrap.
+func (s *Service) bootstrap() error {
+	for {
+		err := s.initializeStore()
+		if err == nil {
+			return nil
+		}
+		log.WithError(err).WithField("trustedBlockRoot", fmt.Sprintf("%#x", s.cfg.trustedBlockRoot)).Warn("Could not initialize light client store, retrying")
+		select {
+		case <-s.ctx.Done():
+			return s.ctx.Err()
+		case <-time.After(retryInterval):
+		}
+	}
+}
+
+func (s *Service) initializeStore() error {
+	genesisTime, gvr, err := s.cfg.source.Genesis(s.ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not get genesis")
+	}
+	// The genesis validators root is part of the signing domain, make sure the source follows the expected network.
+	expected := params.BeaconConfig().GenesisValidatorsRoot
+	if expected != ([32]byte{}) && expected != gvr {
+		return errors.Errorf("source genesis validators root %#x does not match the network's %#x", gvr, expected)
+	}
+	bootstrap, err := s.cfg.source.Bootstrap(s.ctx, s.cfg.trustedBlockRoot)
+	if err != nil {
+		return errors.Wrap(err, "could not get bootstrap")
+	}
+	store, err := NewStore(s.cfg.trustedBlockRoot, bootstrap, gvr)
+	if err != nil {
+		return errors.Wrap(err, "invalid bootstrap")
+	}
+	s.genesisTime = genesisTime
+	s.store = store
+	close(s.storeSet)
+	log.WithFields(logrus.Fields{
+		"slot": bootstrap.Header().Beacon().Slot,
+		"root": fmt.Sprintf("%#x", s.cfg.trustedBlockRoot),
+	}).Info("Initialized light client store from trusted block root")
+	return nil
+}
+
+// sync brings the store up to date with the current slot: it catches up on past sync committee periods, processes
+// the latest finality and optimistic updates and forces an update when finality did not advance for too long.
+func (s *Service) sync() {
+	currentSlot := slots.CurrentSlot(uint64(s.genesisTime.Unix()))
+	s.syncPeriods(currentSlot)
+
+	finalityUpdate, err := s.cfg.source.FinalityUpdate(s.ctx)
+	if err != nil {
+		log.WithError(err).Debug("Could not get light client finality update")
+	} else if err := s.store.ProcessFinalityUpdate(finalityUpdate, currentSlot); err != nil {
+		log.WithError(err).Debug("Could not process light client finality update")
+	}
+
+	optimisticUpdate, err := s.cfg.source.OptimisticUpdate(s.ctx)
+	if err != nil {
+		log.WithError(err).Debug("Could not get light client optimistic update")
+	} else if err := s.store.ProcessOptimisticUpdate(optimisticUpdate, currentSlot); err != nil {
+		log.WithError(err).Debug("Could not process light client optimistic update")
+	}
+
+	if err := s.store.ProcessForceUpdate(currentSlot); err != nil {
+		log.WithError(err).Error("Could not force light client update")
+	}
+
+	log.WithFields(logrus.Fields{
+		"finalizedSlot":  s.store.FinalizedHeader().Beacon().Slot,
+		"optimisticSlot": s.store.OptimisticHeader().Beacon().Slot,
+	}).Debug("Synced light client store")
+	s.notifyEngine()
+}
+
+// syncPeriods processes the best update of each sync committee period between the store and the current slot.
+func (s *Service) syncPeriods(currentSlot primitives.Slot) {
+	storePeriod := syncCommitteePeriod(s.store.FinalizedHeader().Beacon().Slot)
+	currentPeriod := syncCommitteePeriod(currentSlot)
+	if storePeriod == currentPeriod && s.store.IsNextSyncCommitteeKnown() {
+		return
+	}
+	count := min(currentPeriod-storePeriod+1, params.BeaconConfig().MaxRequestLightClientUpdates)
+	updates, err := s.cfg.source.UpdatesByRange(s.ctx, storePeriod, count)
+	if err != nil {
+		log.WithError(err).Debug("Could not get light client updates")
+		return
+	}
+	for _, u := range updates {
+		if err := s.store.ProcessUpdate(u, currentSlot); err != nil {
+			log.WithError(err).WithField("signatureSlot", u.SignatureSlot()).Debug("Could not process light client update")
+		}
+	}
+}
+
+// notifyEngine sends the latest verified headers to the execution client when the optimistic head changed.
+func (s *Service) notifyEngine() {
+	if s.engine == nil {
+		return
+	}
+	optimistic := s.store.OptimisticHeader()
+	if optimistic.Version() < version.Capella {
+		return
+	}
+	headHash, err := executionBlockHash(optimistic)
+	if err != nil {
+		log.WithError(err).Error("Could not get execution block hash of optimistic header")
+		return
+	}
+	if bytes.Equal(headHash, s.lastHeadHash) {
+		return
+	}
+	finalizedHash, err := executionBlockHash(s.store.FinalizedHeader())
+	if err != nil {
+		log.WithError(err).Error("Could not get execution block hash of finalized header")
+		return
+	}
+	status, err := s.engine.forkchoiceUpdated(s.ctx, optimistic.Version(), &pb.ForkchoiceState{
+		HeadBlockHash:      headHash,
+		SafeBlockHash:      finalizedHash,
+		FinalizedBlockHash: finalizedHash,
+	})
+	if err != nil {
+		log.WithError(err).Error("Could not notify execution client of the light client head")
+		return
+	}
+	s.lastHeadHash = headHash
+	log.WithFields(logrus.Fields{
+		"headBlockHash":      fmt.Sprintf("%#x", headHash),
+		"finalizedBlockHash": fmt.Sprintf("%#x", finalizedHash),
+		"status":             status.Status,
+	}).Debug("Notified execution client of the light client head")
+}
+
+// executionBlockHash returns the execution block hash of the header, or the zero hash for headers before Capella.
+func executionBlockHash(header interfaces.LightClientHeader) ([]byte, error) {
+	if header.Version() < version.Capella {
+		return make([]byte, 32), nil
+	}
+	execution, err := header.Execution()
+	if err != nil {
+		return nil, err
+	}
+	return execution.BlockHash(), nil
+}
@@ -0,0 +1,121 @@
+package lightclient
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/api"
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/interfaces"
+	"github.com/prysmaticlabs/prysm/v5/runtime/version"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+type mockSource struct {
+	genesisTime      time.Time
+	gvr              [32]byte
+	bootstrap        interfaces.LightClientBootstrap
+	updates          []interfaces.LightClientUpdate
+	finalityUpdate   interfaces.LightClientFinalityUpdate
+	optimisticUpdate interfaces.LightClientOptimisticUpdate
+}
+
+func (m *mockSource) Genesis(context.Context) (time.Time, [32]byte, error) {
+	return m.genesisTime, m.gvr, nil
+}
+
+func (m *mockSource) Bootstrap(context.Context, [32]byte) (interfaces.LightClientBootstrap, error) {
+	return m.bootstrap, nil
+}
+
+func (m *mockSource) UpdatesByRange(context.Context, uint64, uint64) ([]interfaces.LightClientUpdate, error) {
+	return m.updates, nil
+}
+
+func (m *mockSource) FinalityUpdate(context.Context) (interfaces.LightClientFinalityUpdate, error) {
+	if m.finalityUpdate == nil {
+		return nil, errors.New("not found")
+	}
+	return m.finalityUpdate, nil
+}
+
+func (m *mockSource) OptimisticUpdate(context.Context) (interfaces.LightClientOptimisticUpdate, error) {
+	if m.optimisticUpdate == nil {
+		return nil, errors.New("not found")
+	}
+	return m.optimisticUpdate, nil
+}
+
+func TestService_Sync(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	c := newChain(t)
+	bootstrap, bootstrapHeader := c.bootstrap()
+	update, attested := c.update(c.start+16, bootstrapHeader, 400)
+	finalityUpdate, _ := c.finalityUpdate(c.start+32, attested, 400)
+	currentSlot := c.start + 40
+	source := &mockSource{
+		genesisTime:      time.Now().Add(-time.Duration(uint64(currentSlot)*params.BeaconConfig().SecondsPerSlot) * time.Second),
+		gvr:              c.gvr,
+		bootstrap:        bootstrap,
+		updates:          []interfaces.LightClientUpdate{update},
+		finalityUpdate:   finalityUpdate,
+		optimisticUpdate: c.optimisticUpdate(c.start+36, 400),
+	}
+
+	t.Run("wrong network", func(t *testing.T) {
+		s, err := NewService(context.Background(), WithSource(source), WithTrustedBlockRoot(c.root(bootstrapHeader)))
+		require.NoError(t, err)
+		require.ErrorContains(t, "does not match the network", s.initializeStore())
+		require.ErrorContains(t, "not bootstrapped", s.Status())
+	})
+
+	cfg := params.BeaconConfig().Copy()
+	cfg.GenesisValidatorsRoot = c.gvr
+	params.OverrideBeaconConfig(cfg)
+
+	s, err := NewService(context.Background(), WithSource(source), WithTrustedBlockRoot(c.root(bootstrapHeader)))
+	require.NoError(t, err)
+	require.NoError(t, s.initializeStore())
+	require.NoError(t, s.Status())
+
+	s.sync()
+	require.Equal(t, c.start+16, s.Store().FinalizedHeader().Beacon().Slot)
+	require.Equal(t, c.start+36, s.Store().OptimisticHeader().Beacon().Slot)
+}
+
+func TestServer_GetHeaders(t *testing.T) {
+	c := newChain(t)
+	bootstrap, header := c.bootstrap()
+	s, err := NewService(context.Background(), WithSource(&mockSource{}), WithTrustedBlockRoot(c.root(header)))
+	require.NoError(t, err)
+	router := http.NewServeMux()
+	(&Server{Service: s}).RegisterRoutes(router)
+
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, finalizedHeaderPath, nil))
+	require.Equal(t, http.StatusServiceUnavailable, rec.Code)
+
+	s.store, err = NewStore(c.root(header), bootstrap, c.gvr)
+	require.NoError(t, err)
+	close(s.storeSet)
+
+	for _, path := range []string{finalizedHeaderPath, optimisticHeaderPath} {
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+		require.Equal(t, http.StatusOK, rec.Code)
+		require.Equal(t, version.String(version.Altair), rec.Header().Get(api.VersionHeader))
+		resp := &structs.LightClientHeaderResponse{}
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), resp))
+		require.Equal(t, version.String(version.Altair), resp.Version)
+		h := &structs.LightClientHeader{}
+		require.NoError(t, json.Unmarshal(resp.Data, h))
+		require.Equal(t, strconv.FormatUint(uint64(c.start), 10), h.Beacon.Slot)
+	}
+}
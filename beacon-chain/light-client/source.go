@@ -0,0 +1,79 @@
+package lightclient
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/api/client/beacon"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/interfaces"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+)
+
+// Source provides the light client data verified by the store. Sources are not trusted, everything they return
+// is checked against the sync committee signatures before being used.
+type Source interface {
+	Genesis(ctx context.Context) (time.Time, [32]byte, error)
+	Bootstrap(ctx context.Context, blockRoot [32]byte) (interfaces.LightClientBootstrap, error)
+	UpdatesByRange(ctx context.Context, startPeriod, count uint64) ([]interfaces.LightClientUpdate, error)
+	FinalityUpdate(ctx context.Context) (interfaces.LightClientFinalityUpdate, error)
+	OptimisticUpdate(ctx context.Context) (interfaces.LightClientOptimisticUpdate, error)
+}
+
+// APISource is a Source backed by the light client endpoints of a beacon API.
+type APISource struct {
+	client                *beacon.Client
+	genesisValidatorsRoot [32]byte
+}
+
+var _ Source = (*APISource)(nil)
+
+// NewAPISource creates a Source fetching light client data from the beacon API at the given URL.
+func NewAPISource(host string) (*APISource, error) {
+	c, err := beacon.NewClient(host)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not create beacon API client for %s", host)
+	}
+	return &APISource{client: c}, nil
+}
+
+// Genesis returns the genesis time and genesis validators root of the chain followed by the beacon node.
+// It must be called before UpdatesByRange, which needs the genesis validators root to decode updates.
+func (s *APISource) Genesis(ctx context.Context) (time.Time, [32]byte, error) {
+	g, err := s.client.GetGenesis(ctx)
+	if err != nil {
+		return time.Time{}, [32]byte{}, err
+	}
+	genesisTime, err := strconv.ParseInt(g.GenesisTime, 10, 64)
+	if err != nil {
+		return time.Time{}, [32]byte{}, errors.Wrapf(err, "invalid genesis time %s", g.GenesisTime)
+	}
+	gvr, err := hexutil.Decode(g.GenesisValidatorsRoot)
+	if err != nil {
+		return time.Time{}, [32]byte{}, errors.Wrapf(err, "invalid genesis validators root %s", g.GenesisValidatorsRoot)
+	}
+	s.genesisValidatorsRoot = bytesutil.ToBytes32(gvr)
+	return time.Unix(genesisTime, 0), s.genesisValidatorsRoot, nil
+}
+
+// Bootstrap returns the light client bootstrap of the given block root.
+func (s *APISource) Bootstrap(ctx context.Context, blockRoot [32]byte) (interfaces.LightClientBootstrap, error) {
+	return s.client.GetLightClientBootstrap(ctx, blockRoot)
+}
+
+// UpdatesByRange returns the best light client updates of count sync committee periods, starting at startPeriod.
+func (s *APISource) UpdatesByRange(ctx context.Context, startPeriod, count uint64) ([]interfaces.LightClientUpdate, error) {
+	return s.client.GetLightClientUpdatesByRange(ctx, startPeriod, count, s.genesisValidatorsRoot)
+}
+
+// FinalityUpdate returns the latest light client finality update.
+func (s *APISource) FinalityUpdate(ctx context.Context) (interfaces.LightClientFinalityUpdate, error) {
+	return s.client.GetLightClientFinalityUpdate(ctx)
+}
+
+// OptimisticUpdate returns the latest light client optimistic update.
+func (s *APISource) OptimisticUpdate(ctx context.Context) (interfaces.LightClientOptimisticUpdate, error) {
+	return s.client.GetLightClientOptimisticUpdate(ctx)
+}
@@ -0,0 +1,636 @@
+package lightclient
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/libp2p/go-libp2p"
+	mplex "github.com/libp2p/go-libp2p-mplex"
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	pubsubpb "github.com/libp2p/go-libp2p-pubsub/pb"
+	"github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/libp2p/go-libp2p/core/host"
+	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/libp2p/go-libp2p/core/protocol"
+	"github.com/libp2p/go-libp2p/p2p/security/noise"
+	libp2ptcp "github.com/libp2p/go-libp2p/p2p/transport/tcp"
+	ma "github.com/multiformats/go-multiaddr"
+	"github.com/pkg/errors"
+	fastssz "github.com/prysmaticlabs/fastssz"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/encoder"
+	p2ptypes "github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/types"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/interfaces"
+	lightclient "github.com/prysmaticlabs/prysm/v5/consensus-types/light-client"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v5/network/forks"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/runtime/version"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/proto"
+)
+
+// responseCodeSuccess is the result of a successful req/resp response chunk.
+const responseCodeSuccess = byte(0x00)
+
+// dialTimeout bounds connecting to a peer and opening a stream to it.
+var dialTimeout = 10 * time.Second
+
+// P2PSource is a Source requesting light client data from beacon nodes over the light client req/resp protocols.
+// It also subscribes to the light client gossip topics, the latest gossiped finality and optimistic updates being
+// used instead of requesting them as long as they are recent.
+type P2PSource struct {
+	host                  host.Host
+	pubsub                *pubsub.PubSub
+	encoding              encoder.NetworkEncoding
+	peers                 []peer.AddrInfo
+	genesisTime           time.Time
+	genesisValidatorsRoot [32]byte
+
+	subLock       sync.Mutex
+	subDigest     [4]byte
+	subCancel     context.CancelFunc
+	lock          sync.RWMutex
+	finality      interfaces.LightClientFinalityUpdate
+	optimistic    interfaces.LightClientOptimisticUpdate
+	handshakeLock sync.Mutex
+}
+
+var _ Source = (*P2PSource)(nil)
+
+// NewP2PSource creates a Source fetching light client data from the beacon nodes at the given multiaddrs, which
+// must include the peer ID. The genesis of the chain cannot be requested over p2p, so it must be known beforehand.
+func NewP2PSource(ctx context.Context, genesisTime time.Time, genesisValidatorsRoot [32]byte, peerAddrs []string) (*P2PSource, error) {
+	if len(peerAddrs) == 0 {
+		return nil, errors.New("no light client peers configured")
+	}
+	addrs := make([]ma.Multiaddr, 0, len(peerAddrs))
+	for _, a := range peerAddrs {
+		addr, err := ma.NewMultiaddr(a)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid peer multiaddr %s", a)
+		}
+		addrs = append(addrs, addr)
+	}
+	peers, err := peer.AddrInfosFromP2pAddrs(addrs...)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get peer infos from multiaddrs")
+	}
+
+	// Beacon nodes expect secp256k1 identities, the same as in their ENRs.
+	key, _, err := crypto.GenerateSecp256k1Key(rand.Reader)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not generate p2p key")
+	}
+	h, err := libp2p.New(
+		libp2p.Identity(key),
+		libp2p.NoListenAddrs,
+		libp2p.UserAgent(version.BuildData()),
+		libp2p.Transport(libp2ptcp.NewTCPTransport),
+		libp2p.DefaultMuxers,
+		libp2p.Muxer("/mplex/6.7.0", mplex.DefaultTransport),
+		libp2p.Security(noise.ID, noise.New),
+		libp2p.Ping(false),
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not create p2p host")
+	}
+	ps, err := pubsub.NewGossipSub(ctx, h,
+		pubsub.WithMessageSignaturePolicy(pubsub.StrictNoSign),
+		pubsub.WithNoAuthor(),
+		pubsub.WithMessageIdFn(func(pmsg *pubsubpb.Message) string {
+			return p2p.MsgID(genesisValidatorsRoot[:], pmsg)
+		}),
+		pubsub.WithMaxMessageSize(int(params.BeaconConfig().GossipMaxSize)),
+		pubsub.WithDirectPeers(peers),
+	)
+	if err != nil {
+		if closeErr := h.Close(); closeErr != nil {
+			log.WithError(closeErr).Debug("Could not close p2p host")
+		}
+		return nil, errors.Wrap(err, "could not create gossipsub router")
+	}
+
+	s := &P2PSource{
+		host:                  h,
+		pubsub:                ps,
+		encoding:              encoder.SszNetworkEncoder{},
+		peers:                 peers,
+		genesisTime:           genesisTime,
+		genesisValidatorsRoot: genesisValidatorsRoot,
+	}
+	s.registerHandlers()
+	return s, nil
+}
+
+// Close disconnects from the peers and stops the gossip subscriptions.
+func (s *P2PSource) Close() error {
+	s.subLock.Lock()
+	if s.subCancel != nil {
+		s.subCancel()
+	}
+	s.subLock.Unlock()
+	return s.host.Close()
+}
+
+// Genesis returns the configured genesis time and genesis validators root.
+func (s *P2PSource) Genesis(_ context.Context) (time.Time, [32]byte, error) {
+	return s.genesisTime, s.genesisValidatorsRoot, nil
+}
+
+// Bootstrap requests the light client bootstrap of the given block root.
+func (s *P2PSource) Bootstrap(ctx context.Context, blockRoot [32]byte) (interfaces.LightClientBootstrap, error) {
+	req := p2ptypes.LightClientBootstrapReq(blockRoot)
+	stream, err := s.request(ctx, p2p.RPCLightClientBootstrapTopicV1, &req)
+	if err != nil {
+		return nil, err
+	}
+	defer s.closeStream(stream)
+	v, err := s.readChunkHeader(stream)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not read light client bootstrap for block root %#x", blockRoot)
+	}
+	var m interface {
+		proto.Message
+		fastssz.Unmarshaler
+	}
+	switch {
+	case v >= version.Electra:
+		m = &ethpb.LightClientBootstrapElectra{}
+	case v >= version.Deneb:
+		m = &ethpb.LightClientBootstrapDeneb{}
+	case v >= version.Capella:
+		m = &ethpb.LightClientBootstrapCapella{}
+	case v >= version.Altair:
+		m = &ethpb.LightClientBootstrapAltair{}
+	default:
+		return nil, errors.Errorf("light client bootstrap not supported for version %s", version.String(v))
+	}
+	if err := s.encoding.DecodeWithMaxLength(stream, m); err != nil {
+		return nil, errors.Wrap(err, "could not decode light client bootstrap")
+	}
+	return lightclient.NewWrappedBootstrap(m)
+}
+
+// UpdatesByRange requests the best light client updates of count sync committee periods, starting at startPeriod.
+func (s *P2PSource) UpdatesByRange(ctx context.Context, startPeriod, count uint64) ([]interfaces.LightClientUpdate, error) {
+	count = min(count, params.BeaconConfig().MaxRequestLightClientUpdates)
+	stream, err := s.request(ctx, p2p.RPCLightClientUpdatesByRangeTopicV1, &p2ptypes.LightClientUpdatesByRangeReq{
+		StartPeriod: startPeriod,
+		Count:       count,
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer s.closeStream(stream)
+
+	updates := make([]interfaces.LightClientUpdate, 0, count)
+	for uint64(len(updates)) < count {
+		v, err := s.readChunkHeader(stream)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not read light client update, start_period=%d, count=%d", startPeriod, count)
+		}
+		var m interface {
+			proto.Message
+			fastssz.Unmarshaler
+		}
+		switch {
+		case v >= version.Electra:
+			m = &ethpb.LightClientUpdateElectra{}
+		case v >= version.Deneb:
+			m = &ethpb.LightClientUpdateDeneb{}
+		case v >= version.Capella:
+			m = &ethpb.LightClientUpdateCapella{}
+		case v >= version.Altair:
+			m = &ethpb.LightClientUpdateAltair{}
+		default:
+			return nil, errors.Errorf("light client update not supported for version %s", version.String(v))
+		}
+		if err := s.encoding.DecodeWithMaxLength(stream, m); err != nil {
+			return nil, errors.Wrap(err, "could not decode light client update")
+		}
+		update, err := lightclient.NewWrappedUpdate(m)
+		if err != nil {
+			return nil, err
+		}
+		updates = append(updates, update)
+	}
+	return updates, nil
+}
+
+// FinalityUpdate returns the latest gossiped light client finality update if it is recent, otherwise it requests
+// the latest one.
+func (s *P2PSource) FinalityUpdate(ctx context.Context) (interfaces.LightClientFinalityUpdate, error) {
+	s.subscribe()
+	s.lock.RLock()
+	update := s.finality
+	s.lock.RUnlock()
+	if update != nil && s.isRecent(update.SignatureSlot()) {
+		return update, nil
+	}
+
+	stream, err := s.request(ctx, p2p.RPCLightClientFinalityUpdateTopicV1, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer s.closeStream(stream)
+	forkVersion, err := s.readChunkForkVersion(stream)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read light client finality update")
+	}
+	newUpdate, ok := p2ptypes.LightClientFinalityUpdateMap[forkVersion]
+	if !ok {
+		return nil, errors.Errorf("light client finality update not supported for fork version %#x", forkVersion)
+	}
+	update, err = newUpdate()
+	if err != nil {
+		return nil, err
+	}
+	if err := s.encoding.DecodeWithMaxLength(stream, update); err != nil {
+		return nil, errors.Wrap(err, "could not decode light client finality update")
+	}
+	return update, nil
+}
+
+// OptimisticUpdate returns the latest gossiped light client optimistic update if it is recent, otherwise it requests
+// the latest one.
+func (s *P2PSource) OptimisticUpdate(ctx context.Context) (interfaces.LightClientOptimisticUpdate, error) {
+	s.subscribe()
+	s.lock.RLock()
+	update := s.optimistic
+	s.lock.RUnlock()
+	if update != nil && s.isRecent(update.SignatureSlot()) {
+		return update, nil
+	}
+
+	stream, err := s.request(ctx, p2p.RPCLightClientOptimisticUpdateTopicV1, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer s.closeStream(stream)
+	forkVersion, err := s.readChunkForkVersion(stream)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read light client optimistic update")
+	}
+	newUpdate, ok := p2ptypes.LightClientOptimisticUpdateMap[forkVersion]
+	if !ok {
+		return nil, errors.Errorf("light client optimistic update not supported for fork version %#x", forkVersion)
+	}
+	update, err = newUpdate()
+	if err != nil {
+		return nil, err
+	}
+	if err := s.encoding.DecodeWithMaxLength(stream, update); err != nil {
+		return nil, errors.Wrap(err, "could not decode light client optimistic update")
+	}
+	return update, nil
+}
+
+// isRecent returns whether an update signed at the given slot was gossiped during the current or previous slot.
+func (s *P2PSource) isRecent(signatureSlot primitives.Slot) bool {
+	return signatureSlot+1 >= slots.SinceGenesis(s.genesisTime)
+}
+
+// request sends a request to a connected peer on the given topic and returns the stream to read the response from.
+// A nil request is sent for the topics without payload.
+func (s *P2PSource) request(ctx context.Context, topic string, req fastssz.Marshaler) (network.Stream, error) {
+	pid, err := s.connectedPeer(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.requestPeer(ctx, pid, topic, req)
+}
+
+func (s *P2PSource) requestPeer(ctx context.Context, pid peer.ID, topic string, req fastssz.Marshaler) (network.Stream, error) {
+	dialCtx, cancel := context.WithTimeout(ctx, dialTimeout)
+	defer cancel()
+	stream, err := s.host.NewStream(dialCtx, pid, protocol.ID(topic+s.encoding.ProtocolSuffix()))
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not open stream to peer %s", pid)
+	}
+	if req != nil {
+		if _, err := s.encoding.EncodeWithMaxLength(stream, req); err != nil {
+			_err := stream.Reset()
+			_ = _err
+			return nil, errors.Wrap(err, "could not write request")
+		}
+	}
+	if err := stream.CloseWrite(); err != nil {
+		_err := stream.Reset()
+		_ = _err
+		return nil, errors.Wrap(err, "could not close stream for writing")
+	}
+	return stream, nil
+}
+
+// connectedPeer returns the first configured peer we are connected to, connecting to the configured peers in order
+// if there is none.
+func (s *P2PSource) connectedPeer(ctx context.Context) (peer.ID, error) {
+	for _, p := range s.peers {
+		if s.host.Network().Connectedness(p.ID) == network.Connected {
+			return p.ID, nil
+		}
+	}
+	s.handshakeLock.Lock()
+	defer s.handshakeLock.Unlock()
+	for _, p := range s.peers {
+		if err := s.connect(ctx, p); err != nil {
+			log.WithError(err).WithField("peer", p.ID).Debug("Could not connect to light client peer")
+			if err := s.host.Network().ClosePeer(p.ID); err != nil {
+				log.WithError(err).WithField("peer", p.ID).Debug("Could not disconnect from light client peer")
+			}
+			continue
+		}
+		return p.ID, nil
+	}
+	return "", errors.New("could not connect to any light client peer")
+}
+
+// connect connects to a peer and exchanges status messages with it, as beacon nodes disconnect from the peers which
+// do not send one.
+func (s *P2PSource) connect(ctx context.Context, p peer.AddrInfo) error {
+	ctx, cancel := context.WithTimeout(ctx, dialTimeout)
+	defer cancel()
+	if err := s.host.Connect(ctx, p); err != nil {
+		return err
+	}
+	status, err := s.status()
+	if err != nil {
+		return err
+	}
+	stream, err := s.requestPeer(ctx, p.ID, p2p.RPCStatusTopicV1, status)
+	if err != nil {
+		return err
+	}
+	defer s.closeStream(stream)
+	if err := s.readResponseCode(stream); err != nil {
+		return errors.Wrap(err, "could not read status response")
+	}
+	msg := &ethpb.Status{}
+	if err := s.encoding.DecodeWithMaxLength(stream, msg); err != nil {
+		return errors.Wrap(err, "could not decode status response")
+	}
+	if !bytes.Equal(msg.ForkDigest, status.ForkDigest) {
+		return errors.Errorf("peer is on fork digest %#x, expected %#x", msg.ForkDigest, status.ForkDigest)
+	}
+	log.WithFields(logrus.Fields{
+		"peer":     p.ID,
+		"headSlot": msg.HeadSlot,
+	}).Info("Connected to light client peer")
+	return nil
+}
+
+// status returns the status message of the light client. It has no chain of its own, so it advertises the genesis
+// as its finalized checkpoint and head.
+func (s *P2PSource) status() (*ethpb.Status, error) {
+	digest, err := s.currentForkDigest()
+	if err != nil {
+		return nil, err
+	}
+	return &ethpb.Status{
+		ForkDigest:     digest[:],
+		FinalizedRoot:  params.BeaconConfig().ZeroHash[:],
+		FinalizedEpoch: 0,
+		HeadRoot:       params.BeaconConfig().ZeroHash[:],
+		HeadSlot:       0,
+	}, nil
+}
+
+func (s *P2PSource) currentForkDigest() ([4]byte, error) {
+	return forks.ForkDigestFromEpoch(slots.EpochsSinceGenesis(s.genesisTime), s.genesisValidatorsRoot[:])
+}
+
+// readResponseCode reads the result of a response chunk, returning the error message sent by the peer, or io.EOF
+// when the peer has no more chunks to send.
+func (s *P2PSource) readResponseCode(stream network.Stream) error {
+	if err := stream.SetReadDeadline(time.Now().Add(params.BeaconConfig().TtfbTimeoutDuration())); err != nil {
+		return err
+	}
+	code := make([]byte, 1)
+	if _, err := io.ReadFull(stream, code); err != nil {
+		return err
+	}
+	if err := stream.SetReadDeadline(time.Now().Add(params.BeaconConfig().RespTimeoutDuration())); err != nil {
+		return err
+	}
+	if code[0] == responseCodeSuccess {
+		return nil
+	}
+	msg := &p2ptypes.ErrorMessage{}
+	if err := s.encoding.DecodeWithMaxLength(stream, msg); err != nil {
+		return errors.Wrapf(err, "could not decode error message of response code %d", code[0])
+	}
+	return errors.Errorf("peer responded with code %d: %s", code[0], string(*msg))
+}
+
+// readChunkForkVersion reads the result and context bytes of a response chunk, returning the fork version of the
+// fork digest in the context bytes.
+func (s *P2PSource) readChunkForkVersion(stream network.Stream) ([4]byte, error) {
+	if err := s.readResponseCode(stream); err != nil {
+		return [4]byte{}, err
+	}
+	digest := make([]byte, 4)
+	if _, err := io.ReadFull(stream, digest); err != nil {
+		return [4]byte{}, errors.Wrap(err, "could not read context bytes")
+	}
+	forkVersion, _, err := forks.RetrieveForkDataFromDigest(bytesutil.ToBytes4(digest), s.genesisValidatorsRoot[:])
+	if err != nil {
+		return [4]byte{}, errors.Wrap(err, "unknown fork digest")
+	}
+	return forkVersion, nil
+}
+
+// readChunkHeader reads the result and context bytes of a response chunk, returning the version of the fork of the
+// fork digest in the context bytes.
+func (s *P2PSource) readChunkHeader(stream network.Stream) (int, error) {
+	forkVersion, err := s.readChunkForkVersion(stream)
+	if err != nil {
+		return 0, err
+	}
+	epoch, ok := params.BeaconConfig().ForkVersionSchedule[forkVersion]
+	if !ok {
+		return 0, errors.Errorf("unknown fork version %#x", forkVersion)
+	}
+	return slots.ToForkVersion(slots.UnsafeEpochStart(epoch)), nil
+}
+
+func (s *P2PSource) closeStream(stream network.Stream) {
+	if err := stream.Close(); err != nil {
+		log.WithError(err).Debug("Could not close stream")
+	}
+}
+
+// registerHandlers registers the handlers of the req/resp protocols beacon nodes use to check on their peers.
+func (s *P2PSource) registerHandlers() {
+	suffix := s.encoding.ProtocolSuffix()
+	s.host.SetStreamHandler(protocol.ID(p2p.RPCStatusTopicV1+suffix), s.handle(new(ethpb.Status), func() (fastssz.Marshaler, error) {
+		return s.status()
+	}))
+	s.host.SetStreamHandler(protocol.ID(p2p.RPCPingTopicV1+suffix), s.handle(new(primitives.SSZUint64), func() (fastssz.Marshaler, error) {
+		seq := primitives.SSZUint64(0)
+		return &seq, nil
+	}))
+	s.host.SetStreamHandler(protocol.ID(p2p.RPCMetaDataTopicV1+suffix), s.handle(nil, func() (fastssz.Marshaler, error) {
+		return &ethpb.MetaDataV0{Attnets: bitfield.NewBitvector64()}, nil
+	}))
+	s.host.SetStreamHandler(protocol.ID(p2p.RPCMetaDataTopicV2+suffix), s.handle(nil, func() (fastssz.Marshaler, error) {
+		return &ethpb.MetaDataV1{Attnets: bitfield.NewBitvector64(), Syncnets: bitfield.NewBitvector4()}, nil
+	}))
+	s.host.SetStreamHandler(protocol.ID(p2p.RPCGoodByeTopicV1+suffix), func(stream network.Stream) {
+		msg := new(primitives.SSZUint64)
+		if err := s.encoding.DecodeWithMaxLength(stream, msg); err == nil {
+			log.WithFields(logrus.Fields{
+				"peer":   stream.Conn().RemotePeer(),
+				"reason": p2ptypes.GoodbyeCodeMessages[p2ptypes.RPCGoodbyeCode(*msg)],
+			}).Debug("Peer said goodbye")
+		}
+		s.closeStream(stream)
+	})
+}
+
+// handle returns a stream handler reading a request into req, unless it is nil, and responding with a single chunk.
+func (s *P2PSource) handle(req fastssz.Unmarshaler, resp func() (fastssz.Marshaler, error)) network.StreamHandler {
+	return func(stream network.Stream) {
+		defer s.closeStream(stream)
+		if err := stream.SetDeadline(time.Now().Add(params.BeaconConfig().RespTimeoutDuration())); err != nil {
+			return
+		}
+		if req != nil {
+			if err := s.encoding.DecodeWithMaxLength(stream, req); err != nil {
+				log.WithError(err).Debug("Could not decode request")
+				return
+			}
+		}
+		msg, err := resp()
+		if err != nil {
+			log.WithError(err).Debug("Could not build response")
+			return
+		}
+		if _, err := stream.Write([]byte{responseCodeSuccess}); err != nil {
+			return
+		}
+		if _, err := s.encoding.EncodeWithMaxLength(stream, msg); err != nil {
+			log.WithError(err).Debug("Could not write response")
+		}
+	}
+}
+
+// subscribe subscribes to the light client gossip topics of the current fork, leaving the topics of the previous
+// fork once it is over.
+func (s *P2PSource) subscribe() {
+	digest, err := s.currentForkDigest()
+	if err != nil {
+		log.WithError(err).Debug("Could not compute fork digest")
+		return
+	}
+	s.subLock.Lock()
+	defer s.subLock.Unlock()
+	if s.subCancel != nil && s.subDigest == digest {
+		return
+	}
+	if s.subCancel != nil {
+		s.subCancel()
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	s.subDigest = digest
+	s.subCancel = cancel
+
+	s.subscribeTopic(ctx, fmt.Sprintf(p2p.LightClientFinalityUpdateTopicFormat, digest), func(forkVersion [4]byte, data []byte) error {
+		newUpdate, ok := p2ptypes.LightClientFinalityUpdateMap[forkVersion]
+		if !ok {
+			return errors.Errorf("light client finality update not supported for fork version %#x", forkVersion)
+		}
+		update, err := newUpdate()
+		if err != nil {
+			return err
+		}
+		if err := s.encoding.DecodeGossip(data, update); err != nil {
+			return err
+		}
+		s.lock.Lock()
+		if s.finality == nil || update.SignatureSlot() > s.finality.SignatureSlot() {
+			s.finality = update
+		}
+		s.lock.Unlock()
+		return nil
+	})
+	s.subscribeTopic(ctx, fmt.Sprintf(p2p.LightClientOptimisticUpdateTopicFormat, digest), func(forkVersion [4]byte, data []byte) error {
+		newUpdate, ok := p2ptypes.LightClientOptimisticUpdateMap[forkVersion]
+		if !ok {
+			return errors.Errorf("light client optimistic update not supported for fork version %#x", forkVersion)
+		}
+		update, err := newUpdate()
+		if err != nil {
+			return err
+		}
+		if err := s.encoding.DecodeGossip(data, update); err != nil {
+			return err
+		}
+		s.lock.Lock()
+		if s.optimistic == nil || update.SignatureSlot() > s.optimistic.SignatureSlot() {
+			s.optimistic = update
+		}
+		s.lock.Unlock()
+		return nil
+	})
+}
+
+// subscribeTopic joins a gossip topic and handles its messages until the context is canceled. Messages are not
+// validated, the store verifies everything the source returns.
+func (s *P2PSource) subscribeTopic(ctx context.Context, topic string, handle func(forkVersion [4]byte, data []byte) error) {
+	topic += s.encoding.ProtocolSuffix()
+	digest, err := p2p.ExtractGossipDigest(topic)
+	if err != nil {
+		log.WithError(err).WithField("topic", topic).Error("Could not extract gossip digest")
+		return
+	}
+	forkVersion, _, err := forks.RetrieveForkDataFromDigest(digest, s.genesisValidatorsRoot[:])
+	if err != nil {
+		log.WithError(err).WithField("topic", topic).Error("Unknown gossip fork digest")
+		return
+	}
+	t, err := s.pubsub.Join(topic)
+	if err != nil {
+		log.WithError(err).WithField("topic", topic).Error("Could not join topic")
+		return
+	}
+	sub, err := t.Subscribe()
+	if err != nil {
+		log.WithError(err).WithField("topic", topic).Error("Could not subscribe to topic")
+		if err := t.Close(); err != nil {
+			log.WithError(err).Debug("Could not close topic")
+		}
+		return
+	}
+	go func() {
+		defer func() {
+			sub.Cancel()
+			if err := t.Close(); err != nil {
+				log.WithError(err).WithField("topic", topic).Debug("Could not close topic")
+			}
+		}()
+		for {
+			msg, err := sub.Next(ctx)
+			if err != nil {
+				// The subscription is only canceled when leaving the topic.
+				return
+			}
+			if err := handle(forkVersion, msg.Data); err != nil {
+				log.WithError(err).WithField("topic", topic).Debug("Could not handle gossip message")
+			}
+		}
+	}()
+}
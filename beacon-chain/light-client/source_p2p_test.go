@@ -0,0 +1,169 @@
+package lightclient
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p"
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	pubsubpb "github.com/libp2p/go-libp2p-pubsub/pb"
+	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/protocol"
+	fastssz "github.com/prysmaticlabs/fastssz"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/signing"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/encoder"
+	p2ptypes "github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/types"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func TestP2PSource(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	c := newChain(t)
+	bootstrap, header := c.bootstrap()
+	update, attested := c.update(c.start+16, header, 400)
+	finalityUpdate, _ := c.finalityUpdate(c.start+32, attested, 400)
+	// The finality update is signed during the current slot, in altair.
+	genesisTime := time.Now().Add(-time.Duration(uint64(c.start+33)*params.BeaconConfig().SecondsPerSlot) * time.Second)
+	digest, err := signing.ComputeForkDigest(params.BeaconConfig().AltairForkVersion, c.gvr[:])
+	require.NoError(t, err)
+
+	// The server plays the part of a beacon node serving light client data.
+	server, err := libp2p.New(libp2p.ListenAddrStrings("/ip4/127.0.0.1/tcp/0"))
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, server.Close())
+	}()
+	enc := encoder.SszNetworkEncoder{}
+	writeChunk := func(stream network.Stream, obj fastssz.Marshaler) {
+		_, err := stream.Write([]byte{responseCodeSuccess})
+		require.NoError(t, err)
+		_, err = stream.Write(digest[:])
+		require.NoError(t, err)
+		_, err = enc.EncodeWithMaxLength(stream, obj)
+		require.NoError(t, err)
+	}
+	statusReceived := make(chan *ethpb.Status, 1)
+	server.SetStreamHandler(protocol.ID(p2p.RPCStatusTopicV1+enc.ProtocolSuffix()), func(stream network.Stream) {
+		defer func() { require.NoError(t, stream.Close()) }()
+		status := &ethpb.Status{}
+		require.NoError(t, enc.DecodeWithMaxLength(stream, status))
+		statusReceived <- status
+		_, err := stream.Write([]byte{responseCodeSuccess})
+		require.NoError(t, err)
+		_, err = enc.EncodeWithMaxLength(stream, status)
+		require.NoError(t, err)
+	})
+	server.SetStreamHandler(protocol.ID(p2p.RPCLightClientBootstrapTopicV1+enc.ProtocolSuffix()), func(stream network.Stream) {
+		defer func() { require.NoError(t, stream.Close()) }()
+		req := new(p2ptypes.LightClientBootstrapReq)
+		require.NoError(t, enc.DecodeWithMaxLength(stream, req))
+		require.Equal(t, c.root(header), [32]byte(*req))
+		writeChunk(stream, bootstrap)
+	})
+	server.SetStreamHandler(protocol.ID(p2p.RPCLightClientUpdatesByRangeTopicV1+enc.ProtocolSuffix()), func(stream network.Stream) {
+		defer func() { require.NoError(t, stream.Close()) }()
+		req := new(p2ptypes.LightClientUpdatesByRangeReq)
+		require.NoError(t, enc.DecodeWithMaxLength(stream, req))
+		require.Equal(t, uint64(2), req.StartPeriod)
+		require.Equal(t, uint64(3), req.Count)
+		// Only one of the requested periods is available.
+		writeChunk(stream, update)
+	})
+	server.SetStreamHandler(protocol.ID(p2p.RPCLightClientOptimisticUpdateTopicV1+enc.ProtocolSuffix()), func(stream network.Stream) {
+		defer func() { require.NoError(t, stream.Close()) }()
+		_, err := stream.Write([]byte{3})
+		require.NoError(t, err)
+		msg := p2ptypes.ErrorMessage(p2ptypes.ErrResourceUnavailable.Error())
+		_, err = enc.EncodeWithMaxLength(stream, &msg)
+		require.NoError(t, err)
+	})
+
+	s, err := NewP2PSource(ctx, genesisTime, c.gvr, []string{fmt.Sprintf("%s/p2p/%s", server.Addrs()[0], server.ID())})
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, s.Close())
+	}()
+
+	t.Run("genesis", func(t *testing.T) {
+		gotTime, gotGvr, err := s.Genesis(ctx)
+		require.NoError(t, err)
+		require.Equal(t, genesisTime, gotTime)
+		require.Equal(t, c.gvr, gotGvr)
+	})
+	t.Run("bootstrap", func(t *testing.T) {
+		got, err := s.Bootstrap(ctx, c.root(header))
+		require.NoError(t, err)
+		require.DeepSSZEqual(t, bootstrap.Proto(), got.Proto())
+		select {
+		case status := <-statusReceived:
+			require.DeepEqual(t, digest[:], status.ForkDigest)
+		default:
+			t.Fatal("Status was not sent when connecting")
+		}
+	})
+	t.Run("updates by range", func(t *testing.T) {
+		got, err := s.UpdatesByRange(ctx, 2, 3)
+		require.NoError(t, err)
+		require.Equal(t, 1, len(got))
+		require.DeepSSZEqual(t, update.Proto(), got[0].Proto())
+	})
+	t.Run("error response", func(t *testing.T) {
+		_, err := s.OptimisticUpdate(ctx)
+		require.ErrorContains(t, p2ptypes.ErrResourceUnavailable.Error(), err)
+	})
+	t.Run("ping", func(t *testing.T) {
+		stream, err := server.NewStream(ctx, s.host.ID(), protocol.ID(p2p.RPCPingTopicV1+enc.ProtocolSuffix()))
+		require.NoError(t, err)
+		seq := primitives.SSZUint64(3)
+		_, err = enc.EncodeWithMaxLength(stream, &seq)
+		require.NoError(t, err)
+		require.NoError(t, stream.CloseWrite())
+		require.NoError(t, s.readResponseCode(stream))
+		require.NoError(t, enc.DecodeWithMaxLength(stream, &seq))
+		require.Equal(t, primitives.SSZUint64(0), seq)
+	})
+	t.Run("gossiped finality update", func(t *testing.T) {
+		ps, err := pubsub.NewGossipSub(ctx, server,
+			pubsub.WithMessageSignaturePolicy(pubsub.StrictNoSign),
+			pubsub.WithNoAuthor(),
+			pubsub.WithMessageIdFn(func(pmsg *pubsubpb.Message) string {
+				return p2p.MsgID(c.gvr[:], pmsg)
+			}),
+		)
+		require.NoError(t, err)
+		topic, err := ps.Join(fmt.Sprintf(p2p.LightClientFinalityUpdateTopicFormat, digest) + enc.ProtocolSuffix())
+		require.NoError(t, err)
+		defer func() {
+			require.NoError(t, topic.Close())
+		}()
+		s.subscribe()
+
+		buf := new(bytes.Buffer)
+		_, err = enc.EncodeGossip(buf, finalityUpdate)
+		require.NoError(t, err)
+		for i := 0; ; i++ {
+			s.lock.RLock()
+			received := s.finality != nil
+			s.lock.RUnlock()
+			if received {
+				break
+			}
+			require.Equal(t, true, i < 50, "Finality update was not gossiped")
+			if len(topic.ListPeers()) > 0 {
+				require.NoError(t, topic.Publish(ctx, buf.Bytes()))
+			}
+			time.Sleep(100 * time.Millisecond)
+		}
+		got, err := s.FinalityUpdate(ctx)
+		require.NoError(t, err)
+		require.DeepSSZEqual(t, finalityUpdate.Proto(), got.Proto())
+	})
+}
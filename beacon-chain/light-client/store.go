@@ -0,0 +1,512 @@
+package lightclient
+
+import (
+	"bytes"
+	"sync"
+
+	"github.com/pkg/errors"
+	lightclient "github.com/prysmaticlabs/prysm/v5/beacon-chain/core/light-client"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/signing"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/interfaces"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/container/trie"
+	"github.com/prysmaticlabs/prysm/v5/crypto/bls"
+	"github.com/prysmaticlabs/prysm/v5/network/forks"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/runtime/version"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+)
+
+// Subtree indices of the generalized indices used by light client Merkle proofs. The depth of the proofs, and thus
+// the generalized index, changes with Electra but the subtree indices are the same for all forks.
+const (
+	finalizedRootSubtreeIndex        = 41 // FINALIZED_ROOT_GINDEX = 105, 169 since Electra
+	currentSyncCommitteeSubtreeIndex = 22 // CURRENT_SYNC_COMMITTEE_GINDEX = 54, 86 since Electra
+	nextSyncCommitteeSubtreeIndex    = 23 // NEXT_SYNC_COMMITTEE_GINDEX = 55, 87 since Electra
+	executionPayloadSubtreeIndex     = 9  // EXECUTION_PAYLOAD_GINDEX = 25
+)
+
+var (
+	errInvalidHeader        = errors.New("invalid light client header")
+	errInvalidMerkleBranch  = errors.New("invalid merkle branch")
+	errInvalidSignature     = errors.New("invalid sync committee signature")
+	errNotEnoughSyncBits    = errors.New("not enough sync committee participants")
+	errIrrelevantUpdate     = errors.New("update is not relevant to the store")
+	errUnexpectedCommittee  = errors.New("update next sync committee does not match the store")
+	errUntrustedBootstrap   = errors.New("bootstrap header does not match the trusted block root")
+	errInvalidUpdateSlots   = errors.New("invalid update slots")
+	errUnknownSyncCommittee = errors.New("sync committee of the signature period is not known")
+)
+
+// Store is the light client store of the consensus specs. It tracks the latest finalized and optimistic headers
+// verified against the signatures of the sync committees, starting from a trusted bootstrap.
+// spec: https://github.com/ethereum/consensus-specs/blob/dev/specs/altair/light-client/sync-protocol.md#lightclientstore
+type Store struct {
+	lock                          sync.RWMutex
+	genesisValidatorsRoot         [32]byte
+	finalizedHeader               interfaces.LightClientHeader
+	currentSyncCommittee          *ethpb.SyncCommittee
+	nextSyncCommittee             *ethpb.SyncCommittee
+	bestValidUpdate               interfaces.LightClientUpdate
+	optimisticHeader              interfaces.LightClientHeader
+	previousMaxActiveParticipants uint64
+	currentMaxActiveParticipants  uint64
+}
+
+// update is the common form of light client updates, finality updates and optimistic updates processed by the
+// store. Missing sync committee or finality data is represented by nil fields.
+type update struct {
+	attestedHeader          interfaces.LightClientHeader
+	nextSyncCommittee       *ethpb.SyncCommittee
+	nextSyncCommitteeBranch [][]byte
+	finalizedHeader         interfaces.LightClientHeader
+	finalityBranch          [][]byte
+	syncAggregate           *ethpb.SyncAggregate
+	signatureSlot           primitives.Slot
+	// full is the original update when the update carries all fields, it is the only kind tracked as best valid update.
+	full interfaces.LightClientUpdate
+}
+
+// NewStore initializes a light client store from a bootstrap matching the trusted block root.
+// spec: https://github.com/ethereum/consensus-specs/blob/dev/specs/altair/light-client/sync-protocol.md#initialize_light_client_store
+func NewStore(trustedBlockRoot [32]byte, bootstrap interfaces.LightClientBootstrap, genesisValidatorsRoot [32]byte) (*Store, error) {
+	header := bootstrap.Header()
+	if err := validateHeader(header); err != nil {
+		return nil, err
+	}
+	root, err := header.Beacon().HashTreeRoot()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not compute bootstrap header root")
+	}
+	if root != trustedBlockRoot {
+		return nil, errors.Wrapf(errUntrustedBootstrap, "got %#x, want %#x", root, trustedBlockRoot)
+	}
+
+	var branch [][]byte
+	if bootstrap.Version() >= version.Electra {
+		b, err := bootstrap.CurrentSyncCommitteeBranchElectra()
+		if err != nil {
+			return nil, err
+		}
+		branch = branchToBytes(b[:])
+	} else {
+		b, err := bootstrap.CurrentSyncCommitteeBranch()
+		if err != nil {
+			return nil, err
+		}
+		branch = branchToBytes(b[:])
+	}
+	committeeRoot, err := bootstrap.CurrentSyncCommittee().HashTreeRoot()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not compute current sync committee root")
+	}
+	if !trie.VerifyMerkleProof(header.Beacon().StateRoot, committeeRoot[:], currentSyncCommitteeSubtreeIndex, branch) {
+		return nil, errors.Wrap(errInvalidMerkleBranch, "current sync committee")
+	}
+
+	return &Store{
+		genesisValidatorsRoot: genesisValidatorsRoot,
+		finalizedHeader:       header,
+		currentSyncCommittee:  bootstrap.CurrentSyncCommittee(),
+		optimisticHeader:      header,
+	}, nil
+}
+
+// FinalizedHeader returns the latest finalized header verified by the store.
+func (s *Store) FinalizedHeader() interfaces.LightClientHeader {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.finalizedHeader
+}
+
+// OptimisticHeader returns the latest header attested by a sufficient share of the sync committee.
+func (s *Store) OptimisticHeader() interfaces.LightClientHeader {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.optimisticHeader
+}
+
+// IsNextSyncCommitteeKnown returns true when the sync committee of the period following the finalized header is known.
+func (s *Store) IsNextSyncCommitteeKnown() bool {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.isNextSyncCommitteeKnown()
+}
+
+// ProcessUpdate validates a light client update and applies it to the store when it is final enough.
+// spec: https://github.com/ethereum/consensus-specs/blob/dev/specs/altair/light-client/sync-protocol.md#process_light_client_update
+func (s *Store) ProcessUpdate(u interfaces.LightClientUpdate, currentSlot primitives.Slot) error {
+	hasSyncCommittee, err := lightclient.HasRelevantSyncCommittee(u)
+	if err != nil {
+		return err
+	}
+	hasFinality, err := lightclient.HasFinality(u)
+	if err != nil {
+		return err
+	}
+	up := &update{
+		attestedHeader: u.AttestedHeader(),
+		syncAggregate:  u.SyncAggregate(),
+		signatureSlot:  u.SignatureSlot(),
+		full:           u,
+	}
+	if hasSyncCommittee {
+		up.nextSyncCommittee = u.NextSyncCommittee()
+		if u.Version() >= version.Electra {
+			b, err := u.NextSyncCommitteeBranchElectra()
+			if err != nil {
+				return err
+			}
+			up.nextSyncCommitteeBranch = branchToBytes(b[:])
+		} else {
+			b, err := u.NextSyncCommitteeBranch()
+			if err != nil {
+				return err
+			}
+			up.nextSyncCommitteeBranch = branchToBytes(b[:])
+		}
+	}
+	if hasFinality {
+		up.finalizedHeader = u.FinalizedHeader()
+		if u.Version() >= version.Electra {
+			b, err := u.FinalityBranchElectra()
+			if err != nil {
+				return err
+			}
+			up.finalityBranch = branchToBytes(b[:])
+		} else {
+			b, err := u.FinalityBranch()
+			if err != nil {
+				return err
+			}
+			up.finalityBranch = branchToBytes(b[:])
+		}
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.processUpdate(up, currentSlot)
+}
+
+// ProcessFinalityUpdate validates a light client finality update and applies it to the store.
+// spec: https://github.com/ethereum/consensus-specs/blob/dev/specs/altair/light-client/sync-protocol.md#process_light_client_finality_update
+func (s *Store) ProcessFinalityUpdate(u interfaces.LightClientFinalityUpdate, currentSlot primitives.Slot) error {
+	up := &update{
+		attestedHeader:  u.AttestedHeader(),
+		finalizedHeader: u.FinalizedHeader(),
+		syncAggregate:   u.SyncAggregate(),
+		signatureSlot:   u.SignatureSlot(),
+	}
+	if u.Version() >= version.Electra {
+		b, err := u.FinalityBranchElectra()
+		if err != nil {
+			return err
+		}
+		up.finalityBranch = branchToBytes(b[:])
+	} else {
+		b, err := u.FinalityBranch()
+		if err != nil {
+			return err
+		}
+		up.finalityBranch = branchToBytes(b[:])
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.processUpdate(up, currentSlot)
+}
+
+// ProcessOptimisticUpdate validates a light client optimistic update and applies it to the store.
+// spec: https://github.com/ethereum/consensus-specs/blob/dev/specs/altair/light-client/sync-protocol.md#process_light_client_optimistic_update
+func (s *Store) ProcessOptimisticUpdate(u interfaces.LightClientOptimisticUpdate, currentSlot primitives.Slot) error {
+	up := &update{
+		attestedHeader: u.AttestedHeader(),
+		syncAggregate:  u.SyncAggregate(),
+		signatureSlot:  u.SignatureSlot(),
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.processUpdate(up, currentSlot)
+}
+
+// ProcessForceUpdate applies the best valid update when the finalized header has not advanced for a whole sync
+// committee period, so that the store does not get stuck when finality is lost.
+// spec: https://github.com/ethereum/consensus-specs/blob/dev/specs/altair/light-client/sync-protocol.md#process_light_client_store_force_update
+func (s *Store) ProcessForceUpdate(currentSlot primitives.Slot) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if currentSlot <= s.finalizedHeader.Beacon().Slot+updateTimeout() || s.bestValidUpdate == nil {
+		return nil
+	}
+	best := s.bestValidUpdate
+	up := &update{
+		attestedHeader:  best.AttestedHeader(),
+		finalizedHeader: best.FinalizedHeader(),
+		syncAggregate:   best.SyncAggregate(),
+		signatureSlot:   best.SignatureSlot(),
+		full:            best,
+	}
+	if hasSyncCommittee, err := lightclient.HasRelevantSyncCommittee(best); err != nil {
+		return err
+	} else if hasSyncCommittee {
+		up.nextSyncCommittee = best.NextSyncCommittee()
+	}
+	// Use the attested header as finalized header, as the update does not finalize anything newer.
+	if up.finalizedSlot() <= s.finalizedHeader.Beacon().Slot {
+		up.finalizedHeader = up.attestedHeader
+	}
+	if err := s.applyUpdate(up); err != nil {
+		return err
+	}
+	s.bestValidUpdate = nil
+	return nil
+}
+
+func (s *Store) processUpdate(u *update, currentSlot primitives.Slot) error {
+	if err := s.validateUpdate(u, currentSlot); err != nil {
+		return err
+	}
+
+	if u.full != nil {
+		if s.bestValidUpdate == nil {
+			s.bestValidUpdate = u.full
+		} else {
+			better, err := lightclient.IsBetterUpdate(u.full, s.bestValidUpdate)
+			if err != nil {
+				return err
+			}
+			if better {
+				s.bestValidUpdate = u.full
+			}
+		}
+	}
+
+	participants := u.syncAggregate.SyncCommitteeBits.Count()
+	s.currentMaxActiveParticipants = max(s.currentMaxActiveParticipants, participants)
+
+	if participants > s.safetyThreshold() && u.attestedHeader.Beacon().Slot > s.optimisticHeader.Beacon().Slot {
+		s.optimisticHeader = u.attestedHeader
+	}
+
+	hasFinalizedNextSyncCommittee := !s.isNextSyncCommitteeKnown() &&
+		u.nextSyncCommittee != nil &&
+		u.finalizedHeader != nil &&
+		syncCommitteePeriod(u.finalizedSlot()) == syncCommitteePeriod(u.attestedHeader.Beacon().Slot)
+	if participants*3 >= u.syncAggregate.SyncCommitteeBits.Len()*2 &&
+		(u.finalizedSlot() > s.finalizedHeader.Beacon().Slot || hasFinalizedNextSyncCommittee) {
+		if err := s.applyUpdate(u); err != nil {
+			return err
+		}
+		s.bestValidUpdate = nil
+	}
+	return nil
+}
+
+// validateUpdate checks the update against the store.
+// spec: https://github.com/ethereum/consensus-specs/blob/dev/specs/altair/light-client/sync-protocol.md#validate_light_client_update
+func (s *Store) validateUpdate(u *update, currentSlot primitives.Slot) error {
+	if u.syncAggregate.SyncCommitteeBits.Count() < params.BeaconConfig().MinSyncCommitteeParticipants {
+		return errNotEnoughSyncBits
+	}
+	if err := validateHeader(u.attestedHeader); err != nil {
+		return err
+	}
+
+	attestedSlot := u.attestedHeader.Beacon().Slot
+	finalizedSlot := u.finalizedSlot()
+	if currentSlot < u.signatureSlot || u.signatureSlot <= attestedSlot || attestedSlot < finalizedSlot {
+		return errors.Wrapf(errInvalidUpdateSlots, "current=%d signature=%d attested=%d finalized=%d", currentSlot, u.signatureSlot, attestedSlot, finalizedSlot)
+	}
+
+	storePeriod := syncCommitteePeriod(s.finalizedHeader.Beacon().Slot)
+	signaturePeriod := syncCommitteePeriod(u.signatureSlot)
+	if s.isNextSyncCommitteeKnown() {
+		if signaturePeriod != storePeriod && signaturePeriod != storePeriod+1 {
+			return errors.Wrapf(errUnknownSyncCommittee, "signature period %d, store period %d", signaturePeriod, storePeriod)
+		}
+	} else if signaturePeriod != storePeriod {
+		return errors.Wrapf(errUnknownSyncCommittee, "signature period %d, store period %d", signaturePeriod, storePeriod)
+	}
+
+	attestedPeriod := syncCommitteePeriod(attestedSlot)
+	hasNextSyncCommittee := !s.isNextSyncCommitteeKnown() && u.nextSyncCommittee != nil && attestedPeriod == storePeriod
+	if attestedSlot <= s.finalizedHeader.Beacon().Slot && !hasNextSyncCommittee {
+		return errIrrelevantUpdate
+	}
+
+	if u.finalizedHeader != nil {
+		var finalizedRoot [32]byte
+		if finalizedSlot != params.BeaconConfig().GenesisSlot {
+			if err := validateHeader(u.finalizedHeader); err != nil {
+				return err
+			}
+			r, err := u.finalizedHeader.Beacon().HashTreeRoot()
+			if err != nil {
+				return errors.Wrap(err, "could not compute finalized header root")
+			}
+			finalizedRoot = r
+		}
+		if !trie.VerifyMerkleProof(u.attestedHeader.Beacon().StateRoot, finalizedRoot[:], finalizedRootSubtreeIndex, u.finalityBranch) {
+			return errors.Wrap(errInvalidMerkleBranch, "finalized root")
+		}
+	}
+
+	if u.nextSyncCommittee != nil {
+		if attestedPeriod == storePeriod && s.isNextSyncCommitteeKnown() && !equalSyncCommittees(u.nextSyncCommittee, s.nextSyncCommittee) {
+			return errUnexpectedCommittee
+		}
+		committeeRoot, err := u.nextSyncCommittee.HashTreeRoot()
+		if err != nil {
+			return errors.Wrap(err, "could not compute next sync committee root")
+		}
+		if !trie.VerifyMerkleProof(u.attestedHeader.Beacon().StateRoot, committeeRoot[:], nextSyncCommitteeSubtreeIndex, u.nextSyncCommitteeBranch) {
+			return errors.Wrap(errInvalidMerkleBranch, "next sync committee")
+		}
+	}
+
+	committee := s.currentSyncCommittee
+	if signaturePeriod != storePeriod {
+		committee = s.nextSyncCommittee
+	}
+	return s.verifySyncAggregate(u, committee)
+}
+
+// verifySyncAggregate checks the sync committee signature of the attested header.
+func (s *Store) verifySyncAggregate(u *update, committee *ethpb.SyncCommittee) error {
+	bits := u.syncAggregate.SyncCommitteeBits
+	pubkeys := make([]bls.PublicKey, 0, bits.Count())
+	for i, pk := range committee.Pubkeys {
+		if !bits.BitAt(uint64(i)) {
+			continue
+		}
+		p, err := bls.PublicKeyFromBytes(pk)
+		if err != nil {
+			return errors.Wrap(err, "could not parse sync committee public key")
+		}
+		pubkeys = append(pubkeys, p)
+	}
+	sig, err := bls.SignatureFromBytes(u.syncAggregate.SyncCommitteeSignature)
+	if err != nil {
+		return errors.Wrap(err, "could not parse sync committee signature")
+	}
+
+	forkVersionSlot := max(u.signatureSlot, 1) - 1
+	fork, err := forks.ForkForEpochFromConfig(params.BeaconConfig(), slots.ToEpoch(forkVersionSlot))
+	if err != nil {
+		return errors.Wrap(err, "could not determine fork version")
+	}
+	domain, err := signing.ComputeDomain(params.BeaconConfig().DomainSyncCommittee, fork.CurrentVersion, s.genesisValidatorsRoot[:])
+	if err != nil {
+		return errors.Wrap(err, "could not compute sync committee domain")
+	}
+	signingRoot, err := signing.ComputeSigningRoot(u.attestedHeader.Beacon(), domain)
+	if err != nil {
+		return errors.Wrap(err, "could not compute signing root")
+	}
+	if !sig.FastAggregateVerify(pubkeys, signingRoot) {
+		return errInvalidSignature
+	}
+	return nil
+}
+
+// applyUpdate moves the store to the finalized header and sync committees of the update.
+// spec: https://github.com/ethereum/consensus-specs/blob/dev/specs/altair/light-client/sync-protocol.md#apply_light_client_update
+func (s *Store) applyUpdate(u *update) error {
+	storePeriod := syncCommitteePeriod(s.finalizedHeader.Beacon().Slot)
+	finalizedPeriod := syncCommitteePeriod(u.finalizedSlot())
+	if !s.isNextSyncCommitteeKnown() {
+		if finalizedPeriod != storePeriod {
+			return errors.Wrapf(errIrrelevantUpdate, "finalized period %d, store period %d", finalizedPeriod, storePeriod)
+		}
+		s.nextSyncCommittee = u.nextSyncCommittee
+	} else if finalizedPeriod == storePeriod+1 {
+		s.currentSyncCommittee = s.nextSyncCommittee
+		s.nextSyncCommittee = u.nextSyncCommittee
+		s.previousMaxActiveParticipants = s.currentMaxActiveParticipants
+		s.currentMaxActiveParticipants = 0
+	}
+	if u.finalizedSlot() > s.finalizedHeader.Beacon().Slot {
+		s.finalizedHeader = u.finalizedHeader
+		if s.finalizedHeader.Beacon().Slot > s.optimisticHeader.Beacon().Slot {
+			s.optimisticHeader = s.finalizedHeader
+		}
+	}
+	return nil
+}
+
+func (s *Store) isNextSyncCommitteeKnown() bool {
+	return s.nextSyncCommittee != nil
+}
+
+func (s *Store) safetyThreshold() uint64 {
+	return (s.previousMaxActiveParticipants + s.currentMaxActiveParticipants) / 2
+}
+
+func (u *update) finalizedSlot() primitives.Slot {
+	if u.finalizedHeader == nil {
+		return params.BeaconConfig().GenesisSlot
+	}
+	return u.finalizedHeader.Beacon().Slot
+}
+
+// validateHeader checks the execution payload header of the light client header against the block body root.
+// spec: https://github.com/ethereum/consensus-specs/blob/dev/specs/capella/light-client/sync-protocol.md#modified-is_valid_light_client_header
+func validateHeader(header interfaces.LightClientHeader) error {
+	if header.Version() < version.Capella {
+		return nil
+	}
+	branch, err := header.ExecutionBranch()
+	if err != nil {
+		return err
+	}
+	if slots.ToEpoch(header.Beacon().Slot) < params.BeaconConfig().CapellaForkEpoch {
+		if branch != (interfaces.LightClientExecutionBranch{}) {
+			return errors.Wrap(errInvalidHeader, "unexpected execution branch before capella")
+		}
+		return nil
+	}
+	execution, err := header.Execution()
+	if err != nil {
+		return err
+	}
+	root, err := execution.HashTreeRoot()
+	if err != nil {
+		return errors.Wrap(err, "could not compute execution payload header root")
+	}
+	if !trie.VerifyMerkleProof(header.Beacon().BodyRoot, root[:], executionPayloadSubtreeIndex, branchToBytes(branch[:])) {
+		return errors.Wrap(errInvalidMerkleBranch, "execution payload")
+	}
+	return nil
+}
+
+func equalSyncCommittees(a, b *ethpb.SyncCommittee) bool {
+	if len(a.Pubkeys) != len(b.Pubkeys) || !bytes.Equal(a.AggregatePubkey, b.AggregatePubkey) {
+		return false
+	}
+	for i := range a.Pubkeys {
+		if !bytes.Equal(a.Pubkeys[i], b.Pubkeys[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func branchToBytes(branch [][32]byte) [][]byte {
+	b := make([][]byte, len(branch))
+	for i := range branch {
+		b[i] = branch[i][:]
+	}
+	return b
+}
+
+func syncCommitteePeriod(slot primitives.Slot) uint64 {
+	return slots.SyncCommitteePeriod(slots.ToEpoch(slot))
+}
+
+// updateTimeout is the number of slots after which the best valid update is forced into the store.
+func updateTimeout() primitives.Slot {
+	return params.BeaconConfig().SlotsPerEpoch.Mul(uint64(params.BeaconConfig().EpochsPerSyncCommitteePeriod))
+}
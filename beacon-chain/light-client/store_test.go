@@ -0,0 +1,267 @@
+package lightclient
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/signing"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/interfaces"
+	lightclient "github.com/prysmaticlabs/prysm/v5/consensus-types/light-client"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/crypto/bls"
+	"github.com/prysmaticlabs/prysm/v5/crypto/bls/common"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+)
+
+// chain builds altair light client data whose Merkle branches are taken from real states, and whose sync
+// committee is made of a single key so that aggregate signatures are cheap to produce.
+type chain struct {
+	t         *testing.T
+	sk        bls.SecretKey
+	committee *ethpb.SyncCommittee
+	gvr       [32]byte
+	start     primitives.Slot
+}
+
+func newChain(t *testing.T) *chain {
+	sk, err := bls.RandKey()
+	require.NoError(t, err)
+	pubkeys := make([][]byte, params.BeaconConfig().SyncCommitteeSize)
+	for i := range pubkeys {
+		pubkeys[i] = sk.PublicKey().Marshal()
+	}
+	return &chain{
+		t:         t,
+		sk:        sk,
+		committee: &ethpb.SyncCommittee{Pubkeys: pubkeys, AggregatePubkey: sk.PublicKey().Marshal()},
+		gvr:       [32]byte{'g', 'v', 'r'},
+		start:     slots.UnsafeEpochStart(params.BeaconConfig().AltairForkEpoch) + 8,
+	}
+}
+
+// state returns an altair state at the given slot, finalizing the given block root.
+func (c *chain) state(slot primitives.Slot, finalizedRoot [32]byte) state.BeaconState {
+	st, err := util.NewBeaconStateAltair()
+	require.NoError(c.t, err)
+	require.NoError(c.t, st.SetSlot(slot))
+	require.NoError(c.t, st.SetCurrentSyncCommittee(c.committee))
+	require.NoError(c.t, st.SetNextSyncCommittee(c.committee))
+	require.NoError(c.t, st.SetFinalizedCheckpoint(&ethpb.Checkpoint{Epoch: slots.ToEpoch(slot) - 1, Root: finalizedRoot[:]}))
+	return st
+}
+
+func (c *chain) header(st state.BeaconState) *ethpb.LightClientHeaderAltair {
+	root, err := st.HashTreeRoot(context.Background())
+	require.NoError(c.t, err)
+	return &ethpb.LightClientHeaderAltair{Beacon: util.HydrateBeaconHeader(&ethpb.BeaconBlockHeader{Slot: st.Slot(), StateRoot: root[:]})}
+}
+
+func (c *chain) root(header *ethpb.LightClientHeaderAltair) [32]byte {
+	root, err := header.Beacon.HashTreeRoot()
+	require.NoError(c.t, err)
+	return root
+}
+
+// syncAggregate signs the attested header with the given number of participants.
+func (c *chain) syncAggregate(attested *ethpb.LightClientHeaderAltair, participants uint64) *ethpb.SyncAggregate {
+	domain, err := signing.ComputeDomain(params.BeaconConfig().DomainSyncCommittee, params.BeaconConfig().AltairForkVersion, c.gvr[:])
+	require.NoError(c.t, err)
+	signingRoot, err := signing.ComputeSigningRoot(attested.Beacon, domain)
+	require.NoError(c.t, err)
+	sig := c.sk.Sign(signingRoot[:])
+	bits := bitfield.NewBitvector512()
+	sigs := make([]bls.Signature, 0, participants)
+	for i := uint64(0); i < participants; i++ {
+		bits.SetBitAt(i, true)
+		sigs = append(sigs, sig)
+	}
+	aggregate := common.InfiniteSignature[:]
+	if participants > 0 {
+		aggregate = bls.AggregateSignatures(sigs).Marshal()
+	}
+	return &ethpb.SyncAggregate{SyncCommitteeBits: bits, SyncCommitteeSignature: aggregate}
+}
+
+// bootstrap returns the bootstrap of a block at the start slot, along with its header.
+func (c *chain) bootstrap() (interfaces.LightClientBootstrap, *ethpb.LightClientHeaderAltair) {
+	st := c.state(c.start, [32]byte{})
+	branch, err := st.CurrentSyncCommitteeProof(context.Background())
+	require.NoError(c.t, err)
+	header := c.header(st)
+	bootstrap, err := lightclient.NewWrappedBootstrapAltair(&ethpb.LightClientBootstrapAltair{
+		Header:                     header,
+		CurrentSyncCommittee:       c.committee,
+		CurrentSyncCommitteeBranch: branch,
+	})
+	require.NoError(c.t, err)
+	return bootstrap, header
+}
+
+// update returns an update attested at the given slot, finalizing the given header and carrying the next sync committee.
+func (c *chain) update(slot primitives.Slot, finalized *ethpb.LightClientHeaderAltair, participants uint64) (interfaces.LightClientUpdate, *ethpb.LightClientHeaderAltair) {
+	st := c.state(slot, c.root(finalized))
+	committeeBranch, err := st.NextSyncCommitteeProof(context.Background())
+	require.NoError(c.t, err)
+	finalityBranch, err := st.FinalizedRootProof(context.Background())
+	require.NoError(c.t, err)
+	attested := c.header(st)
+	update, err := lightclient.NewWrappedUpdateAltair(&ethpb.LightClientUpdateAltair{
+		AttestedHeader:          attested,
+		NextSyncCommittee:       c.committee,
+		NextSyncCommitteeBranch: committeeBranch,
+		FinalizedHeader:         finalized,
+		FinalityBranch:          finalityBranch,
+		SyncAggregate:           c.syncAggregate(attested, participants),
+		SignatureSlot:           slot + 1,
+	})
+	require.NoError(c.t, err)
+	return update, attested
+}
+
+func (c *chain) finalityUpdate(slot primitives.Slot, finalized *ethpb.LightClientHeaderAltair, participants uint64) (interfaces.LightClientFinalityUpdate, *ethpb.LightClientHeaderAltair) {
+	st := c.state(slot, c.root(finalized))
+	branch, err := st.FinalizedRootProof(context.Background())
+	require.NoError(c.t, err)
+	attested := c.header(st)
+	update, err := lightclient.NewWrappedFinalityUpdateAltair(&ethpb.LightClientFinalityUpdateAltair{
+		AttestedHeader:  attested,
+		FinalizedHeader: finalized,
+		FinalityBranch:  branch,
+		SyncAggregate:   c.syncAggregate(attested, participants),
+		SignatureSlot:   slot + 1,
+	})
+	require.NoError(c.t, err)
+	return update, attested
+}
+
+func (c *chain) optimisticUpdate(slot primitives.Slot, participants uint64) interfaces.LightClientOptimisticUpdate {
+	attested := c.header(c.state(slot, [32]byte{}))
+	update, err := lightclient.NewWrappedOptimisticUpdateAltair(&ethpb.LightClientOptimisticUpdateAltair{
+		AttestedHeader: attested,
+		SyncAggregate:  c.syncAggregate(attested, participants),
+		SignatureSlot:  slot + 1,
+	})
+	require.NoError(c.t, err)
+	return update
+}
+
+// store returns a store bootstrapped at the start slot, along with the bootstrap header.
+func (c *chain) store() (*Store, *ethpb.LightClientHeaderAltair) {
+	bootstrap, header := c.bootstrap()
+	s, err := NewStore(c.root(header), bootstrap, c.gvr)
+	require.NoError(c.t, err)
+	return s, header
+}
+
+func TestNewStore(t *testing.T) {
+	c := newChain(t)
+	bootstrap, header := c.bootstrap()
+
+	t.Run("untrusted root", func(t *testing.T) {
+		_, err := NewStore([32]byte{'a'}, bootstrap, c.gvr)
+		require.ErrorIs(t, err, errUntrustedBootstrap)
+	})
+	t.Run("invalid sync committee branch", func(t *testing.T) {
+		p := bootstrap.Proto().(*ethpb.LightClientBootstrapAltair)
+		branch := make([][]byte, len(p.CurrentSyncCommitteeBranch))
+		for i := range branch {
+			branch[i] = make([]byte, fieldparams.RootLength)
+		}
+		invalid, err := lightclient.NewWrappedBootstrapAltair(&ethpb.LightClientBootstrapAltair{
+			Header:                     p.Header,
+			CurrentSyncCommittee:       p.CurrentSyncCommittee,
+			CurrentSyncCommitteeBranch: branch,
+		})
+		require.NoError(t, err)
+		_, err = NewStore(c.root(header), invalid, c.gvr)
+		require.ErrorIs(t, err, errInvalidMerkleBranch)
+	})
+	t.Run("valid", func(t *testing.T) {
+		s, err := NewStore(c.root(header), bootstrap, c.gvr)
+		require.NoError(t, err)
+		require.Equal(t, c.start, s.FinalizedHeader().Beacon().Slot)
+		require.Equal(t, c.start, s.OptimisticHeader().Beacon().Slot)
+		require.Equal(t, false, s.IsNextSyncCommitteeKnown())
+	})
+}
+
+func TestStore_ProcessUpdate(t *testing.T) {
+	c := newChain(t)
+
+	t.Run("learns next sync committee", func(t *testing.T) {
+		s, bootstrapHeader := c.store()
+		update, _ := c.update(c.start+16, bootstrapHeader, 400)
+		require.NoError(t, s.ProcessUpdate(update, c.start+17))
+		require.Equal(t, true, s.IsNextSyncCommitteeKnown())
+		require.Equal(t, c.start, s.FinalizedHeader().Beacon().Slot)
+		require.Equal(t, c.start+16, s.OptimisticHeader().Beacon().Slot)
+	})
+	t.Run("from the future", func(t *testing.T) {
+		s, bootstrapHeader := c.store()
+		update, _ := c.update(c.start+16, bootstrapHeader, 400)
+		require.ErrorIs(t, s.ProcessUpdate(update, c.start+16), errInvalidUpdateSlots)
+	})
+	t.Run("invalid signature", func(t *testing.T) {
+		s, bootstrapHeader := c.store()
+		update, _ := c.update(c.start+16, bootstrapHeader, 400)
+		other, _ := c.update(c.start+15, bootstrapHeader, 400)
+		update.SetSyncAggregate(other.SyncAggregate())
+		require.ErrorIs(t, s.ProcessUpdate(update, c.start+17), errInvalidSignature)
+	})
+	t.Run("not enough participants", func(t *testing.T) {
+		s, bootstrapHeader := c.store()
+		update, _ := c.update(c.start+16, bootstrapHeader, 0)
+		require.ErrorIs(t, s.ProcessUpdate(update, c.start+17), errNotEnoughSyncBits)
+	})
+	t.Run("without supermajority", func(t *testing.T) {
+		s, bootstrapHeader := c.store()
+		update, _ := c.update(c.start+16, bootstrapHeader, 100)
+		require.NoError(t, s.ProcessUpdate(update, c.start+17))
+		require.Equal(t, false, s.IsNextSyncCommitteeKnown())
+		require.Equal(t, c.start+16, s.OptimisticHeader().Beacon().Slot)
+		require.NotNil(t, s.bestValidUpdate)
+	})
+}
+
+func TestStore_ProcessFinalityAndOptimisticUpdates(t *testing.T) {
+	c := newChain(t)
+	s, bootstrapHeader := c.store()
+	update, attested := c.update(c.start+16, bootstrapHeader, 400)
+	require.NoError(t, s.ProcessUpdate(update, c.start+17))
+
+	finalityUpdate, _ := c.finalityUpdate(c.start+32, attested, 400)
+	require.NoError(t, s.ProcessFinalityUpdate(finalityUpdate, c.start+33))
+	require.Equal(t, c.start+16, s.FinalizedHeader().Beacon().Slot)
+	require.Equal(t, c.start+32, s.OptimisticHeader().Beacon().Slot)
+
+	// The safety threshold is now half of the best participation seen, 200.
+	require.NoError(t, s.ProcessOptimisticUpdate(c.optimisticUpdate(c.start+40, 150), c.start+41))
+	require.Equal(t, c.start+32, s.OptimisticHeader().Beacon().Slot)
+	require.NoError(t, s.ProcessOptimisticUpdate(c.optimisticUpdate(c.start+40, 300), c.start+41))
+	require.Equal(t, c.start+40, s.OptimisticHeader().Beacon().Slot)
+
+	// Updates attested before the finalized header are not relevant anymore.
+	require.ErrorIs(t, s.ProcessOptimisticUpdate(c.optimisticUpdate(c.start+8, 400), c.start+41), errIrrelevantUpdate)
+}
+
+func TestStore_ProcessForceUpdate(t *testing.T) {
+	c := newChain(t)
+	s, bootstrapHeader := c.store()
+	update, _ := c.update(c.start+16, bootstrapHeader, 100)
+	require.NoError(t, s.ProcessUpdate(update, c.start+17))
+
+	require.NoError(t, s.ProcessForceUpdate(c.start+updateTimeout()))
+	require.Equal(t, c.start, s.FinalizedHeader().Beacon().Slot)
+
+	require.NoError(t, s.ProcessForceUpdate(c.start+updateTimeout()+1))
+	require.Equal(t, c.start+16, s.FinalizedHeader().Beacon().Slot)
+	require.Equal(t, true, s.IsNextSyncCommitteeKnown())
+	require.IsNil(t, s.bestValidUpdate)
+}
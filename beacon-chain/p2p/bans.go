@@ -0,0 +1,280 @@
+package p2p
+
+import (
+	"net"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/multiformats/go-multiaddr"
+	manet "github.com/multiformats/go-multiaddr/net"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/peers/peerdb"
+	prysmTime "github.com/prysmaticlabs/prysm/v5/time"
+	"github.com/sirupsen/logrus"
+)
+
+// ErrInvalidBanTarget is returned when a ban target is neither a peer ID, an IP address nor a CIDR range.
+var ErrInvalidBanTarget = errors.New("ban target must be a peer ID, an IP address or a CIDR range")
+
+// BanKind is the kind of target of a ban.
+type BanKind string
+
+const (
+	// BanKindPeer bans a peer ID.
+	BanKindPeer BanKind = "peer"
+	// BanKindIP bans an IP address.
+	BanKindIP BanKind = "ip"
+	// BanKindCIDR bans an IP range.
+	BanKindCIDR BanKind = "cidr"
+)
+
+// Ban is a manual ban of a peer ID, IP address or CIDR range, which the connection gater and the dialer honor.
+type Ban struct {
+	// Target is the banned peer ID, IP address or CIDR range, in its canonical form.
+	Target  string
+	Kind    BanKind
+	Reason  string
+	Created time.Time
+	// Expiry is the time the ban is lifted at, the ban is permanent if zero.
+	Expiry time.Time
+
+	ipNet *net.IPNet
+}
+
+// NewBan returns a ban of the target, which is parsed as a peer ID, an IP address or a CIDR range.
+func NewBan(target, reason string, expiry time.Time) (*Ban, error) {
+	b := &Ban{Reason: reason, Created: prysmTime.Now(), Expiry: expiry}
+	if err := b.parseTarget(target); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+func (b *Ban) parseTarget(target string) error {
+	if pid, err := peer.Decode(target); err == nil {
+		b.Kind, b.Target = BanKindPeer, pid.String()
+		return nil
+	}
+	if ip := net.ParseIP(target); ip != nil {
+		bits := 8 * net.IPv6len
+		if ip4 := ip.To4(); ip4 != nil {
+			ip, bits = ip4, 8*net.IPv4len
+		}
+		b.Kind, b.Target = BanKindIP, ip.String()
+		b.ipNet = &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)}
+		return nil
+	}
+	if _, ipNet, err := net.ParseCIDR(target); err == nil {
+		b.Kind, b.Target, b.ipNet = BanKindCIDR, ipNet.String(), ipNet
+		return nil
+	}
+	return errors.Wrap(ErrInvalidBanTarget, target)
+}
+
+func (b *Ban) expired(now time.Time) bool {
+	return !b.Expiry.IsZero() && !now.Before(b.Expiry)
+}
+
+func (b *Ban) fields() logrus.Fields {
+	fields := logrus.Fields{
+		"target": b.Target,
+		"kind":   b.Kind,
+		"reason": b.Reason,
+	}
+	if !b.Expiry.IsZero() {
+		fields["expiry"] = b.Expiry
+	}
+	return fields
+}
+
+// banList holds the manual bans, by target. Its lookups are safe to call on a nil list, which bans nothing.
+type banList struct {
+	sync.RWMutex
+	bans map[string]*Ban
+}
+
+func newBanList() *banList {
+	return &banList{bans: make(map[string]*Ban)}
+}
+
+func (l *banList) add(b *Ban) {
+	l.Lock()
+	defer l.Unlock()
+	l.bans[b.Target] = b
+}
+
+func (l *banList) remove(target string) bool {
+	l.Lock()
+	defer l.Unlock()
+	_, ok := l.bans[target]
+	delete(l.bans, target)
+	return ok
+}
+
+// all returns the bans which have not expired, the oldest first, and drops the expired ones.
+func (l *banList) all() []*Ban {
+	if l == nil {
+		return nil
+	}
+	l.Lock()
+	defer l.Unlock()
+	now := prysmTime.Now()
+	bans := make([]*Ban, 0, len(l.bans))
+	for target, b := range l.bans {
+		if b.expired(now) {
+			delete(l.bans, target)
+			continue
+		}
+		bans = append(bans, b)
+	}
+	sort.Slice(bans, func(i, j int) bool { return bans[i].Created.Before(bans[j].Created) })
+	return bans
+}
+
+// peerBan returns the ban of the peer ID, if any.
+func (l *banList) peerBan(pid peer.ID) *Ban {
+	if l == nil {
+		return nil
+	}
+	l.RLock()
+	defer l.RUnlock()
+	if b, ok := l.bans[pid.String()]; ok && !b.expired(prysmTime.Now()) {
+		return b
+	}
+	return nil
+}
+
+// addrBan returns a ban of the IP address of the multiaddress, if any.
+func (l *banList) addrBan(addr multiaddr.Multiaddr) *Ban {
+	if l == nil || addr == nil {
+		return nil
+	}
+	ip, err := manet.ToIP(addr)
+	if err != nil {
+		return nil
+	}
+	l.RLock()
+	defer l.RUnlock()
+	now := prysmTime.Now()
+	for _, b := range l.bans {
+		if b.ipNet != nil && b.ipNet.Contains(ip) && !b.expired(now) {
+			return b
+		}
+	}
+	return nil
+}
+
+// Ban bans the peer ID, IP address or CIDR range until the expiry, or permanently if the expiry is zero.
+// The ban is persisted in the peer database, and the connected peers it matches are disconnected.
+func (s *Service) Ban(target, reason string, expiry time.Time) (*Ban, error) {
+	b, err := NewBan(target, reason, expiry)
+	if err != nil {
+		return nil, err
+	}
+	if b.expired(prysmTime.Now()) {
+		return nil, errors.New("ban expiry is in the past")
+	}
+	if s.peerDB != nil {
+		if err := s.peerDB.SaveBan(s.ctx, banRecord(b)); err != nil {
+			return nil, errors.Wrap(err, "could not save ban in peer database")
+		}
+	}
+	s.bans.add(b)
+	log.WithFields(b.fields()).Warn("Banned peer")
+	s.disconnectBannedPeers()
+	return b, nil
+}
+
+// Unban lifts the ban of the peer ID, IP address or CIDR range. It returns false if the target was not banned.
+func (s *Service) Unban(target string) (bool, error) {
+	b := &Ban{}
+	if err := b.parseTarget(target); err != nil {
+		return false, err
+	}
+	if s.peerDB != nil {
+		if err := s.peerDB.DeleteBan(s.ctx, b.Target); err != nil {
+			return false, errors.Wrap(err, "could not delete ban from peer database")
+		}
+	}
+	if !s.bans.remove(b.Target) {
+		return false, nil
+	}
+	log.WithField("target", b.Target).Info("Unbanned peer")
+	return true, nil
+}
+
+// Bans returns the manual bans which have not expired, the oldest first.
+func (s *Service) Bans() []*Ban {
+	return s.bans.all()
+}
+
+// isBanned returns the ban of the peer, either by peer ID or by the IP address of one of its connections, if any.
+func (s *Service) isBanned(pid peer.ID) *Ban {
+	if b := s.bans.peerBan(pid); b != nil {
+		return b
+	}
+	for _, conn := range s.host.Network().ConnsToPeer(pid) {
+		if b := s.bans.addrBan(conn.RemoteMultiaddr()); b != nil {
+			return b
+		}
+	}
+	return nil
+}
+
+// disconnectBannedPeers disconnects the connected peers which are banned.
+func (s *Service) disconnectBannedPeers() {
+	if s.host == nil {
+		return
+	}
+	for _, pid := range s.host.Network().Peers() {
+		b := s.isBanned(pid)
+		if b == nil || s.host.Network().Connectedness(pid) != network.Connected {
+			continue
+		}
+		s.peers.SetDisconnectReason(pid, "banned: "+b.Reason)
+		if err := s.Disconnect(pid); err != nil {
+			log.WithError(err).WithField("peer", pid).Error("Could not disconnect banned peer")
+		}
+	}
+}
+
+// restoreBans loads the bans of the peer database, and deletes the ones which expired in the meantime.
+func (s *Service) restoreBans() {
+	records, err := s.peerDB.Bans(s.ctx)
+	if err != nil {
+		log.WithError(err).Error("Could not read bans from peer database")
+		return
+	}
+	now := prysmTime.Now()
+	restored := 0
+	for _, record := range records {
+		if record.Expired(now) {
+			if err := s.peerDB.DeleteBan(s.ctx, record.Target); err != nil {
+				log.WithError(err).WithField("target", record.Target).Error("Could not delete expired ban from peer database")
+			}
+			continue
+		}
+		b := &Ban{Reason: record.Reason, Created: record.Created, Expiry: record.Expiry}
+		if err := b.parseTarget(record.Target); err != nil {
+			log.WithError(err).Error("Could not restore ban from peer database")
+			continue
+		}
+		s.bans.add(b)
+		restored++
+	}
+	if restored > 0 {
+		log.WithField("bans", restored).Info("Restored bans from peer database")
+	}
+}
+
+func banRecord(b *Ban) *peerdb.BanRecord {
+	return &peerdb.BanRecord{
+		Target:  b.Target,
+		Reason:  b.Reason,
+		Created: b.Created,
+		Expiry:  b.Expiry,
+	}
+}
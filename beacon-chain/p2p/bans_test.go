@@ -0,0 +1,107 @@
+package p2p
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/network"
+	libp2ptest "github.com/libp2p/go-libp2p/p2p/host/peerstore/test"
+	ma "github.com/multiformats/go-multiaddr"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/peers"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/peers/peerdb"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/peers/scorers"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func TestService_Bans(t *testing.T) {
+	ctx := context.Background()
+	dataDir := t.TempDir()
+	newService := func() *Service {
+		peerDB, err := openPeerDB(&Config{DataDir: dataDir})
+		require.NoError(t, err)
+		addrFilter, err := configureFilter(&Config{})
+		require.NoError(t, err)
+		s := &Service{
+			ctx:        ctx,
+			cfg:        &Config{},
+			started:    true,
+			addrFilter: addrFilter,
+			peers: peers.NewStatus(ctx, &peers.StatusConfig{
+				PeerLimit:    30,
+				ScorerParams: &scorers.Config{},
+			}),
+			peerDB: peerDB,
+			bans:   newBanList(),
+		}
+		s.restoreBans()
+		return s
+	}
+	addr := func(s string) ma.Multiaddr {
+		a, err := ma.NewMultiaddr(s)
+		require.NoError(t, err)
+		return a
+	}
+	ids := libp2ptest.GeneratePeerIDs(2)
+	pid, otherPid := ids[0], ids[1]
+
+	s := newService()
+	_, err := s.Ban("foo", "spam", time.Time{})
+	require.ErrorIs(t, err, ErrInvalidBanTarget)
+	_, err = s.Ban("1.2.3.4", "spam", time.Now().Add(-time.Minute))
+	require.ErrorContains(t, "ban expiry is in the past", err)
+
+	b, err := s.Ban(pid.String(), "spam", time.Time{})
+	require.NoError(t, err)
+	assert.Equal(t, BanKindPeer, b.Kind)
+	b, err = s.Ban("::ffff:1.2.3.4", "dos", time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, BanKindIP, b.Kind)
+	assert.Equal(t, "1.2.3.4", b.Target)
+	b, err = s.Ban("10.1.2.3/16", "sybil", time.Time{})
+	require.NoError(t, err)
+	assert.Equal(t, BanKindCIDR, b.Kind)
+	assert.Equal(t, "10.1.0.0/16", b.Target)
+
+	// The connection gater honors the bans.
+	assert.Equal(t, false, s.InterceptPeerDial(pid))
+	assert.Equal(t, true, s.InterceptPeerDial(otherPid))
+	assert.Equal(t, false, s.InterceptSecured(network.DirInbound, pid, &maEndpoints{raddr: addr("/ip4/5.6.7.8/tcp/13000")}))
+	assert.Equal(t, false, s.InterceptAddrDial(otherPid, addr("/ip4/1.2.3.4/tcp/13000")))
+	assert.Equal(t, false, s.InterceptAddrDial(otherPid, addr("/ip4/10.1.200.1/tcp/13000")))
+	assert.Equal(t, true, s.InterceptAddrDial(otherPid, addr("/ip4/10.2.0.1/tcp/13000")))
+	assert.Equal(t, false, s.InterceptAccept(&maEndpoints{raddr: addr("/ip4/10.1.0.1/tcp/13000")}))
+
+	// The bans survive a restart, unless they expired in the meantime.
+	expiry := time.Now().Add(50 * time.Millisecond)
+	require.NoError(t, s.peerDB.SaveBan(ctx, &peerdb.BanRecord{Target: "5.6.7.8", Reason: "short", Created: time.Now(), Expiry: expiry}))
+	time.Sleep(time.Until(expiry))
+	require.NoError(t, s.peerDB.Close())
+	s = newService()
+	defer func() {
+		require.NoError(t, s.peerDB.Close())
+	}()
+	bans := s.Bans()
+	require.Equal(t, 3, len(bans))
+	records, err := s.peerDB.Bans(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 3, len(records), "expired ban not deleted")
+	assert.Equal(t, pid.String(), bans[0].Target)
+	assert.Equal(t, "spam", bans[0].Reason)
+	assert.Equal(t, "1.2.3.4", bans[1].Target)
+	assert.Equal(t, false, bans[1].Expiry.IsZero())
+	assert.Equal(t, "10.1.0.0/16", bans[2].Target)
+	assert.Equal(t, false, s.InterceptAddrDial(otherPid, addr("/ip4/10.1.200.1/tcp/13000")))
+
+	unbanned, err := s.Unban("10.1.9.9/16")
+	require.NoError(t, err)
+	assert.Equal(t, true, unbanned)
+	unbanned, err = s.Unban("10.1.0.0/16")
+	require.NoError(t, err)
+	assert.Equal(t, false, unbanned)
+	assert.Equal(t, true, s.InterceptAddrDial(otherPid, addr("/ip4/10.1.200.1/tcp/13000")))
+	records, err = s.peerDB.Bans(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 2, len(records))
+}
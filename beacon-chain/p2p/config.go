@@ -32,7 +32,12 @@ type Config struct {
 	UDPPort              uint
 	PingInterval         time.Duration
 	MaxPeers             uint
+	PeerDBMaxPeers       int
+	PeerDBExpiry         time.Duration
 	QueueSize            uint
+	GossipTraceDir       string
+	GossipTraceMaxSize   int64
+	GossipTraceMaxFiles  int
 	AllowListCIDR        string
 	DenyListCIDR         []string
 	StateNotifier        statefeed.Notifier
@@ -25,8 +25,9 @@ const (
 )
 
 // InterceptPeerDial tests whether we're permitted to Dial the specified peer.
-func (*Service) InterceptPeerDial(_ peer.ID) (allow bool) {
-	return true
+func (s *Service) InterceptPeerDial(pid peer.ID) (allow bool) {
+	// Disallow banned peers.
+	return s.bans.peerBan(pid) == nil
 }
 
 // InterceptAddrDial tests whether we're permitted to dial the specified
@@ -36,6 +37,10 @@ func (s *Service) InterceptAddrDial(pid peer.ID, m multiaddr.Multiaddr) (allow b
 	if s.peers.IsBad(pid) != nil {
 		return false
 	}
+	// Disallow banned ip addresses.
+	if s.bans.addrBan(m) != nil {
+		return false
+	}
 	return filterConnections(s.addrFilter, m)
 }
 
@@ -45,6 +50,11 @@ func (s *Service) InterceptAccept(n network.ConnMultiaddrs) (allow bool) {
 	if !s.started {
 		return false
 	}
+	if b := s.bans.addrBan(n.RemoteMultiaddr()); b != nil {
+		log.WithFields(logrus.Fields{"peer": n.RemoteMultiaddr(),
+			"reason": b.Reason}).Trace("Not accepting inbound dial from banned ip address")
+		return false
+	}
 	if !s.validateDial(n.RemoteMultiaddr()) {
 		// Allow other go-routines to run in the event
 		// we receive a large amount of junk connections.
@@ -63,8 +73,9 @@ func (s *Service) InterceptAccept(n network.ConnMultiaddrs) (allow bool) {
 
 // InterceptSecured tests whether a given connection, now authenticated,
 // is allowed.
-func (*Service) InterceptSecured(_ network.Direction, _ peer.ID, _ network.ConnMultiaddrs) (allow bool) {
-	return true
+func (s *Service) InterceptSecured(_ network.Direction, pid peer.ID, _ network.ConnMultiaddrs) (allow bool) {
+	// Disallow banned peers, whose peer ID is only known once the connection is secured.
+	return s.bans.peerBan(pid) == nil
 }
 
 // InterceptUpgraded tests whether a fully capable connection is allowed.
@@ -16,6 +16,7 @@ import (
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/go-bitfield"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/cache"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/peerdas"
 	"github.com/prysmaticlabs/prysm/v5/cmd/beacon-chain/flags"
 	"github.com/prysmaticlabs/prysm/v5/config/features"
 	"github.com/prysmaticlabs/prysm/v5/config/params"
@@ -467,6 +468,9 @@ func (s *Service) createLocalNode(
 
 	localNode = initializeAttSubnets(localNode)
 	localNode = initializeSyncCommSubnets(localNode)
+	if params.PeerDASEnabled() {
+		localNode = initializeCustodyGroupCount(localNode, peerdas.CustodyGroupCount(flags.Get().SubscribeAllDataSubnets))
+	}
 
 	if s.cfg != nil && s.cfg.HostAddress != "" {
 		hostIP := net.ParseIP(s.cfg.HostAddress)
@@ -546,6 +550,11 @@ func (s *Service) filterPeer(node *enode.Node) bool {
 		return false
 	}
 
+	// Ignore banned nodes.
+	if s.bans.peerBan(peerData.ID) != nil || s.bans.addrBan(multiAddrs[0]) != nil {
+		return false
+	}
+
 	// Ignore nodes that are already active.
 	if s.peers.IsActive(peerData.ID) {
 		// Constantly update enr for known peers
@@ -13,6 +13,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/helpers"
 	coreTime "github.com/prysmaticlabs/prysm/v5/beacon-chain/core/time"
 	"github.com/prysmaticlabs/prysm/v5/config/params"
+	pbrpc "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
 	"github.com/sirupsen/logrus"
 )
 
@@ -44,6 +45,9 @@ const (
 	// blsToExecutionChangeWeight specifies the scoring weight that we apply to
 	// our bls to execution topic.
 	blsToExecutionChangeWeight = 0.05
+	// lightClientUpdateWeight specifies the scoring weight that we apply to
+	// each of our light client update topics.
+	lightClientUpdateWeight = 0.05
 
 	// maxInMeshScore describes the max score a peer can attain from being in the mesh.
 	maxInMeshScore = 10
@@ -121,14 +125,63 @@ func (s *Service) topicScoreParams(topic string) (*pubsub.TopicScoreParams, erro
 		return defaultAttesterSlashingTopicParams(), nil
 	case strings.Contains(topic, GossipBlsToExecutionChangeMessage):
 		return defaultBlsToExecutionChangeTopicParams(), nil
+	case strings.Contains(topic, GossipLightClientFinalityUpdateMessage), strings.Contains(topic, GossipLightClientOptimisticUpdateMessage):
+		return defaultLightClientUpdateTopicParams(), nil
 	case strings.Contains(topic, GossipBlobSidecarMessage):
 		// TODO(Deneb): Using the default block scoring. But this should be updated.
 		return defaultBlockTopicParams(), nil
+	case strings.Contains(topic, GossipDataColumnSidecarMessage):
+		// TODO(Fulu): Using the default block scoring. But this should be updated.
+		return defaultBlockTopicParams(), nil
 	default:
 		return nil, errors.Errorf("unrecognized topic provided for parameter registration: %s", topic)
 	}
 }
 
+// TopicScoreComponents are the components of the gossipsub score of a peer on a topic, weighted
+// by the topic score parameters. The mesh failure penalty is not included, as it is not part of
+// the peer score snapshots.
+type TopicScoreComponents struct {
+	TopicWeight              float64
+	TimeInMesh               float64
+	FirstMessageDeliveries   float64
+	MeshMessageDeliveries    float64
+	InvalidMessageDeliveries float64
+	// Score is the sum of the components, multiplied by the topic weight.
+	Score float64
+}
+
+// TopicScoreComponents computes the components of the gossipsub score of a peer on a topic, from
+// the topic score parameters and the snapshot of the peer score.
+func (s *Service) TopicScoreComponents(topic string, snapshot *pbrpc.TopicScoreSnapshot) (*TopicScoreComponents, error) {
+	topicParams, err := s.topicScoreParams(topic)
+	if err != nil {
+		return nil, err
+	}
+	return topicScoreComponents(topicParams, snapshot), nil
+}
+
+// topicScoreComponents mirrors the topic score computation of gossipsub.
+func topicScoreComponents(topicParams *pubsub.TopicScoreParams, snapshot *pbrpc.TopicScoreSnapshot) *TopicScoreComponents {
+	c := &TopicScoreComponents{TopicWeight: topicParams.TopicWeight}
+	timeInMesh := time.Duration(snapshot.TimeInMesh) * time.Millisecond
+	if topicParams.TimeInMeshQuantum > 0 {
+		p1 := float64(timeInMesh / topicParams.TimeInMeshQuantum)
+		c.TimeInMesh = math.Min(p1, topicParams.TimeInMeshCap) * topicParams.TimeInMeshWeight
+	}
+	c.FirstMessageDeliveries = float64(snapshot.FirstMessageDeliveries) * topicParams.FirstMessageDeliveriesWeight
+	// The mesh message deliveries penalty only applies once the peer has been in the mesh for the activation window.
+	meshDeliveries := float64(snapshot.MeshMessageDeliveries)
+	if timeInMesh > topicParams.MeshMessageDeliveriesActivation && meshDeliveries < topicParams.MeshMessageDeliveriesThreshold {
+		deficit := topicParams.MeshMessageDeliveriesThreshold - meshDeliveries
+		c.MeshMessageDeliveries = deficit * deficit * topicParams.MeshMessageDeliveriesWeight
+	}
+	invalid := float64(snapshot.InvalidMessageDeliveries)
+	c.InvalidMessageDeliveries = invalid * invalid * topicParams.InvalidMessageDeliveriesWeight
+	c.Score = c.TopicWeight * (c.TimeInMesh + c.FirstMessageDeliveries + c.MeshMessageDeliveries + c.InvalidMessageDeliveries)
+	return c
+}
+
 func (s *Service) retrieveActiveValidators() (uint64, error) {
 	if s.activeValidatorCount != 0 {
 		return s.activeValidatorCount, nil
@@ -503,6 +556,28 @@ func defaultBlsToExecutionChangeTopicParams() *pubsub.TopicScoreParams {
 	}
 }
 
+func defaultLightClientUpdateTopicParams() *pubsub.TopicScoreParams {
+	return &pubsub.TopicScoreParams{
+		TopicWeight:                     lightClientUpdateWeight,
+		TimeInMeshWeight:                maxInMeshScore / inMeshCap(),
+		TimeInMeshQuantum:               inMeshTime(),
+		TimeInMeshCap:                   inMeshCap(),
+		FirstMessageDeliveriesWeight:    2,
+		FirstMessageDeliveriesDecay:     scoreDecay(oneHundredEpochs),
+		FirstMessageDeliveriesCap:       5,
+		MeshMessageDeliveriesWeight:     0,
+		MeshMessageDeliveriesDecay:      0,
+		MeshMessageDeliveriesCap:        0,
+		MeshMessageDeliveriesThreshold:  0,
+		MeshMessageDeliveriesWindow:     0,
+		MeshMessageDeliveriesActivation: 0,
+		MeshFailurePenaltyWeight:        0,
+		MeshFailurePenaltyDecay:         0,
+		InvalidMessageDeliveriesWeight:  -2000,
+		InvalidMessageDeliveriesDecay:   scoreDecay(invalidDecayPeriod),
+	}
+}
+
 func oneSlotDuration() time.Duration {
 	return time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second
 }
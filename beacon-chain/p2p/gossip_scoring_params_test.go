@@ -3,6 +3,7 @@ package p2p
 import (
 	"context"
 	"testing"
+	"time"
 
 	pubsub "github.com/libp2p/go-libp2p-pubsub"
 	dbutil "github.com/prysmaticlabs/prysm/v5/beacon-chain/db/testing"
@@ -76,3 +77,42 @@ func TestLoggingParameters(_ *testing.T) {
 	logGossipParameters("testing", defaultProposerSlashingTopicParams())
 	logGossipParameters("testing", defaultVoluntaryExitTopicParams())
 }
+
+func TestTopicScoreComponents(t *testing.T) {
+	topicParams := &pubsub.TopicScoreParams{
+		TopicWeight:                     0.5,
+		TimeInMeshWeight:                0.1,
+		TimeInMeshQuantum:               time.Second,
+		TimeInMeshCap:                   100,
+		FirstMessageDeliveriesWeight:    2,
+		MeshMessageDeliveriesWeight:     -1,
+		MeshMessageDeliveriesThreshold:  10,
+		MeshMessageDeliveriesActivation: time.Minute,
+		InvalidMessageDeliveriesWeight:  -10,
+	}
+
+	// The time in mesh is capped, and the mesh deliveries penalty is not active yet.
+	c := topicScoreComponents(topicParams, &ethpb.TopicScoreSnapshot{
+		TimeInMesh:               uint64((50 * time.Second).Milliseconds()),
+		FirstMessageDeliveries:   3,
+		MeshMessageDeliveries:    4,
+		InvalidMessageDeliveries: 2,
+	})
+	assert.DeepEqual(t, &TopicScoreComponents{
+		TopicWeight:              0.5,
+		TimeInMesh:               5,
+		FirstMessageDeliveries:   6,
+		MeshMessageDeliveries:    0,
+		InvalidMessageDeliveries: -40,
+		Score:                    -14.5,
+	}, c)
+
+	// Once active, the deficit of mesh deliveries below the threshold is penalized.
+	c = topicScoreComponents(topicParams, &ethpb.TopicScoreSnapshot{
+		TimeInMesh:            uint64((10 * time.Minute).Milliseconds()),
+		MeshMessageDeliveries: 4,
+	})
+	assert.Equal(t, float64(10), c.TimeInMesh)
+	assert.Equal(t, float64(-36), c.MeshMessageDeliveries)
+	assert.Equal(t, float64(-13), c.Score)
+}
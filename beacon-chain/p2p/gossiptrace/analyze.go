@@ -0,0 +1,140 @@
+package gossiptrace
+
+import (
+	"sort"
+	"time"
+)
+
+// Analyzer aggregates trace events, possibly from the traces of several nodes, into per-topic statistics.
+type Analyzer struct {
+	topics   map[string]*TopicReport
+	messages map[string]*messageArrivals
+}
+
+type messageArrivals struct {
+	topic     string
+	published time.Time
+	delivered []time.Time
+}
+
+// TopicReport holds the statistics of a topic.
+type TopicReport struct {
+	Topic      string
+	Published  int
+	Delivered  int
+	Duplicates int
+	Rejected   map[string]int
+	Grafts     int
+	Prunes     int
+	// DuplicateRatio is the number of duplicates received per delivered message.
+	DuplicateRatio float64
+	// Latency is the distribution of the arrival latencies of the messages of the topic.
+	Latency LatencyDistribution
+}
+
+// LatencyDistribution summarizes a set of latencies.
+type LatencyDistribution struct {
+	Count int
+	Min   time.Duration
+	P50   time.Duration
+	P90   time.Duration
+	P99   time.Duration
+	Max   time.Duration
+}
+
+// NewAnalyzer returns an empty analyzer.
+func NewAnalyzer() *Analyzer {
+	return &Analyzer{
+		topics:   make(map[string]*TopicReport),
+		messages: make(map[string]*messageArrivals),
+	}
+}
+
+// Add aggregates the event.
+func (a *Analyzer) Add(e *Event) {
+	t, ok := a.topics[e.Topic]
+	if !ok {
+		t = &TopicReport{Topic: e.Topic, Rejected: make(map[string]int)}
+		a.topics[e.Topic] = t
+	}
+	switch e.Type {
+	case EventPublish:
+		t.Published++
+		m := a.message(e)
+		if m.published.IsZero() || e.Time.Before(m.published) {
+			m.published = e.Time
+		}
+	case EventDeliver:
+		t.Delivered++
+		m := a.message(e)
+		m.delivered = append(m.delivered, e.Time)
+	case EventDuplicate:
+		t.Duplicates++
+	case EventReject:
+		t.Rejected[e.Reason]++
+	case EventGraft:
+		t.Grafts++
+	case EventPrune:
+		t.Prunes++
+	}
+}
+
+func (a *Analyzer) message(e *Event) *messageArrivals {
+	m, ok := a.messages[e.MsgID]
+	if !ok {
+		m = &messageArrivals{topic: e.Topic}
+		a.messages[e.MsgID] = m
+	}
+	return m
+}
+
+// Report returns the statistics of all the topics, sorted by topic.
+//
+// The arrival latency of a delivered message is measured from its publication when one of the traces includes it,
+// and from its earliest delivery among the traces otherwise, in which case that earliest delivery is not counted.
+// The traces of several nodes are therefore needed for the latencies to be meaningful.
+func (a *Analyzer) Report() []*TopicReport {
+	latencies := make(map[string][]time.Duration)
+	for _, m := range a.messages {
+		if len(m.delivered) == 0 {
+			continue
+		}
+		sort.Slice(m.delivered, func(i, j int) bool { return m.delivered[i].Before(m.delivered[j]) })
+		reference, arrivals := m.published, m.delivered
+		if reference.IsZero() {
+			reference, arrivals = m.delivered[0], m.delivered[1:]
+		}
+		for _, arrival := range arrivals {
+			latencies[m.topic] = append(latencies[m.topic], arrival.Sub(reference))
+		}
+	}
+
+	reports := make([]*TopicReport, 0, len(a.topics))
+	for _, t := range a.topics {
+		if t.Delivered > 0 {
+			t.DuplicateRatio = float64(t.Duplicates) / float64(t.Delivered)
+		}
+		t.Latency = distribution(latencies[t.Topic])
+		reports = append(reports, t)
+	}
+	sort.Slice(reports, func(i, j int) bool { return reports[i].Topic < reports[j].Topic })
+	return reports
+}
+
+func distribution(latencies []time.Duration) LatencyDistribution {
+	if len(latencies) == 0 {
+		return LatencyDistribution{}
+	}
+	sort.Slice(latencies, func(i, j int) bool { return latencies[i] < latencies[j] })
+	percentile := func(p int) time.Duration {
+		return latencies[(len(latencies)-1)*p/100]
+	}
+	return LatencyDistribution{
+		Count: len(latencies),
+		Min:   latencies[0],
+		P50:   percentile(50),
+		P90:   percentile(90),
+		P99:   percentile(99),
+		Max:   latencies[len(latencies)-1],
+	}
+}
@@ -0,0 +1,66 @@
+package gossiptrace
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func TestAnalyzer_Report(t *testing.T) {
+	const blocks, attestations = "/eth2/00000000/beacon_block/ssz_snappy", "/eth2/00000000/beacon_attestation_1/ssz_snappy"
+	start := time.Unix(1700000000, 0)
+	at := func(ms int) time.Time {
+		return start.Add(time.Duration(ms) * time.Millisecond)
+	}
+
+	a := NewAnalyzer()
+	for _, e := range []*Event{
+		// A block published by one of the traced nodes, delivered to the others.
+		{Time: at(0), Type: EventPublish, Topic: blocks, MsgID: "aa"},
+		{Time: at(100), Type: EventDeliver, Topic: blocks, MsgID: "aa"},
+		{Time: at(300), Type: EventDeliver, Topic: blocks, MsgID: "aa"},
+		{Time: at(200), Type: EventDeliver, Topic: blocks, MsgID: "aa"},
+		{Time: at(350), Type: EventDuplicate, Topic: blocks, MsgID: "aa"},
+		// A block published by an untraced node, latencies are measured from its earliest delivery.
+		{Time: at(1000), Type: EventDeliver, Topic: blocks, MsgID: "bb"},
+		{Time: at(1400), Type: EventDeliver, Topic: blocks, MsgID: "bb"},
+		{Time: at(1500), Type: EventDuplicate, Topic: blocks, MsgID: "bb"},
+		{Time: at(1600), Type: EventDuplicate, Topic: blocks, MsgID: "bb"},
+		{Time: at(1700), Type: EventReject, Topic: blocks, MsgID: "cc", Reason: "validation failed"},
+		{Time: at(1800), Type: EventGraft, Topic: blocks, Peer: "p1"},
+		// An attestation only seen by a single node.
+		{Time: at(2000), Type: EventDeliver, Topic: attestations, MsgID: "dd"},
+		{Time: at(2100), Type: EventPrune, Topic: attestations, Peer: "p1"},
+	} {
+		a.Add(e)
+	}
+
+	reports := a.Report()
+	require.Equal(t, 2, len(reports))
+
+	att := reports[0]
+	assert.Equal(t, attestations, att.Topic)
+	assert.Equal(t, 1, att.Delivered)
+	assert.Equal(t, 1, att.Prunes)
+	assert.Equal(t, float64(0), att.DuplicateRatio)
+	assert.Equal(t, 0, att.Latency.Count)
+
+	blk := reports[1]
+	assert.Equal(t, blocks, blk.Topic)
+	assert.Equal(t, 1, blk.Published)
+	assert.Equal(t, 5, blk.Delivered)
+	assert.Equal(t, 3, blk.Duplicates)
+	assert.Equal(t, 1, blk.Rejected["validation failed"])
+	assert.Equal(t, 1, blk.Grafts)
+	assert.Equal(t, 0.6, blk.DuplicateRatio)
+	assert.DeepEqual(t, LatencyDistribution{
+		Count: 4,
+		Min:   100 * time.Millisecond,
+		P50:   200 * time.Millisecond,
+		P90:   300 * time.Millisecond,
+		P99:   300 * time.Millisecond,
+		Max:   400 * time.Millisecond,
+	}, blk.Latency)
+}
@@ -0,0 +1,32 @@
+package gossiptrace
+
+import "time"
+
+// EventType is the type of a traced gossipsub event.
+type EventType string
+
+const (
+	// EventPublish is a message published by the node.
+	EventPublish EventType = "publish"
+	// EventDeliver is the first, valid, arrival of a message from a peer.
+	EventDeliver EventType = "deliver"
+	// EventDuplicate is an arrival of a message which had already been seen.
+	EventDuplicate EventType = "duplicate"
+	// EventReject is a message rejected by pubsub or by the topic validator.
+	EventReject EventType = "reject"
+	// EventGraft is a peer added to the mesh of a topic.
+	EventGraft EventType = "graft"
+	// EventPrune is a peer removed from the mesh of a topic.
+	EventPrune EventType = "prune"
+)
+
+// Event is a traced gossipsub event. Message events carry the hex encoded message ID and the peer the message was
+// received from, mesh events carry the grafted or pruned peer.
+type Event struct {
+	Time   time.Time `json:"time"`
+	Type   EventType `json:"type"`
+	Topic  string    `json:"topic"`
+	MsgID  string    `json:"msg_id,omitempty"`
+	Peer   string    `json:"peer,omitempty"`
+	Reason string    `json:"reason,omitempty"`
+}
@@ -0,0 +1,67 @@
+package gossiptrace
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/io/file"
+)
+
+// Files returns the paths of the trace files of the directory, in order, starting with the rotated files.
+func Files(dir string) ([]string, error) {
+	names, err := rotatedFiles(dir)
+	if err != nil {
+		return nil, err
+	}
+	paths := make([]string, 0, len(names)+1)
+	for _, name := range names {
+		paths = append(paths, filepath.Join(dir, name))
+	}
+	current := filepath.Join(dir, CurrentFileName)
+	exists, err := file.Exists(current, file.Regular)
+	if err != nil {
+		return nil, err
+	}
+	if exists {
+		paths = append(paths, current)
+	}
+	return paths, nil
+}
+
+// ReadFile calls fn on the events of a trace file. An event which was only partially written at the end of the file,
+// because it is still being written to or because of a crash, is ignored.
+func ReadFile(path string, fn func(*Event)) error {
+	f, err := os.Open(path) // #nosec G304 -- path is provided by the operator.
+	if err != nil {
+		return errors.Wrap(err, "could not open gossip trace file")
+	}
+	defer func() {
+		if err := f.Close(); err != nil {
+			log.WithError(err).Error("Could not close gossip trace file")
+		}
+	}()
+	r := bufio.NewReader(f)
+	for line := 1; ; line++ {
+		b, err := r.ReadBytes('\n')
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return errors.Wrap(err, "could not read gossip trace file")
+		}
+		b = bytes.TrimSpace(b)
+		if len(b) == 0 {
+			continue
+		}
+		e := &Event{}
+		if err := json.Unmarshal(b, e); err != nil {
+			return errors.Wrapf(err, "could not decode event at line %d of %s", line, path)
+		}
+		fn(e)
+	}
+}
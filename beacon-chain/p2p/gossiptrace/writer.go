@@ -0,0 +1,231 @@
+// Package gossiptrace records gossipsub message and mesh events to rotating JSON lines files, and analyzes them to
+// debug the propagation of messages.
+package gossiptrace
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prysmaticlabs/prysm/v5/io/file"
+)
+
+const (
+	// CurrentFileName is the name of the file events are appended to.
+	CurrentFileName = "trace.jsonl"
+	// DefaultMaxFileSize is the size above which the current file is rotated.
+	DefaultMaxFileSize = 64 << 20
+	// DefaultMaxFiles is the number of rotated files kept.
+	DefaultMaxFiles = 16
+
+	rotatedFilePrefix = "trace-"
+	rotatedFileSuffix = ".jsonl"
+	// eventBufferSize bounds the number of events waiting to be written, events are dropped above it so that
+	// tracing never blocks the pubsub event loop.
+	eventBufferSize = 1 << 14
+)
+
+var droppedEvents = promauto.NewCounter(prometheus.CounterOpts{
+	Name: "p2p_gossip_trace_dropped_events_total",
+	Help: "The number of gossipsub trace events dropped because the trace writer could not keep up.",
+})
+
+// Config holds the trace writer parameters.
+type Config struct {
+	// Dir is the directory of the trace files.
+	Dir string
+	// MaxFileSize is the size above which the current file is rotated.
+	MaxFileSize int64
+	// MaxFiles is the number of rotated files kept, the oldest ones are deleted first.
+	MaxFiles int
+}
+
+// Writer writes trace events, as JSON lines, to the current file of a directory. Once the current file grows above
+// the maximum size, it is renamed after the time of the rotation, and a new current file is started. Events are
+// written asynchronously.
+type Writer struct {
+	cfg    *Config
+	events chan *Event
+	stop   chan struct{}
+	done   chan struct{}
+	closed atomic.Bool
+	once   sync.Once
+
+	f    *os.File
+	buf  *bufio.Writer
+	size int64
+}
+
+// NewWriter opens the trace writer in the configured directory, creating it if needed, and starts writing events.
+func NewWriter(cfg *Config) (*Writer, error) {
+	if cfg.MaxFileSize <= 0 {
+		cfg.MaxFileSize = DefaultMaxFileSize
+	}
+	if cfg.MaxFiles <= 0 {
+		cfg.MaxFiles = DefaultMaxFiles
+	}
+	if err := file.MkdirAll(cfg.Dir); err != nil {
+		return nil, errors.Wrap(err, "could not create gossip trace directory")
+	}
+	w := &Writer{
+		cfg:    cfg,
+		events: make(chan *Event, eventBufferSize),
+		stop:   make(chan struct{}),
+		done:   make(chan struct{}),
+	}
+	if err := w.openCurrent(); err != nil {
+		return nil, err
+	}
+	go w.run()
+	log.WithField("dir", cfg.Dir).Info("Tracing gossipsub events")
+	return w, nil
+}
+
+// Record queues the event to be written. The event is dropped if the writer cannot keep up or is closed. Record does
+// nothing on a nil writer.
+func (w *Writer) Record(e *Event) {
+	if w == nil || w.closed.Load() {
+		return
+	}
+	select {
+	case w.events <- e:
+	default:
+		droppedEvents.Inc()
+	}
+}
+
+// Close writes the queued events and closes the current file. Events recorded afterwards are dropped.
+func (w *Writer) Close() error {
+	if w == nil {
+		return nil
+	}
+	w.once.Do(func() {
+		w.closed.Store(true)
+		close(w.stop)
+	})
+	<-w.done
+	return nil
+}
+
+func (w *Writer) run() {
+	defer close(w.done)
+	for {
+		select {
+		case e := <-w.events:
+			w.write(e)
+			// Flush once the queue is drained, so that bursts of events are written together.
+			if len(w.events) == 0 {
+				w.flush()
+			}
+		case <-w.stop:
+			for len(w.events) > 0 {
+				w.write(<-w.events)
+			}
+			w.flush()
+			if err := w.f.Close(); err != nil {
+				log.WithError(err).Error("Could not close gossip trace file")
+			}
+			return
+		}
+	}
+}
+
+func (w *Writer) write(e *Event) {
+	b, err := json.Marshal(e)
+	if err != nil {
+		log.WithError(err).Error("Could not marshal gossip trace event")
+		return
+	}
+	b = append(b, '\n')
+	if w.size > 0 && w.size+int64(len(b)) > w.cfg.MaxFileSize {
+		if err := w.rotate(); err != nil {
+			log.WithError(err).Error("Could not rotate gossip trace file")
+		}
+	}
+	n, err := w.buf.Write(b)
+	w.size += int64(n)
+	if err != nil {
+		log.WithError(err).Error("Could not write gossip trace event")
+	}
+}
+
+func (w *Writer) flush() {
+	if err := w.buf.Flush(); err != nil {
+		log.WithError(err).Error("Could not write gossip trace events")
+	}
+}
+
+func (w *Writer) openCurrent() error {
+	p := filepath.Join(w.cfg.Dir, CurrentFileName)
+	f, err := os.OpenFile(p, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0600) // #nosec G304 -- path is built from the trace directory.
+	if err != nil {
+		return errors.Wrap(err, "could not open gossip trace file")
+	}
+	info, err := f.Stat()
+	if err != nil {
+		return errors.Wrap(err, "could not stat gossip trace file")
+	}
+	w.f, w.buf, w.size = f, bufio.NewWriter(f), info.Size()
+	return nil
+}
+
+// rotate renames the current file after the time of the rotation, starts a new current file, and deletes the oldest
+// rotated files above the maximum number of files.
+func (w *Writer) rotate() error {
+	if err := w.buf.Flush(); err != nil {
+		return err
+	}
+	if err := w.f.Close(); err != nil {
+		return err
+	}
+	rotated := filepath.Join(w.cfg.Dir, rotatedFileName(time.Now()))
+	if err := os.Rename(filepath.Join(w.cfg.Dir, CurrentFileName), rotated); err != nil {
+		return err
+	}
+	if err := w.openCurrent(); err != nil {
+		return err
+	}
+	rotatedFiles, err := rotatedFiles(w.cfg.Dir)
+	if err != nil {
+		return err
+	}
+	for len(rotatedFiles) > w.cfg.MaxFiles {
+		if err := os.Remove(filepath.Join(w.cfg.Dir, rotatedFiles[0])); err != nil {
+			return errors.Wrap(err, "could not delete old gossip trace file")
+		}
+		rotatedFiles = rotatedFiles[1:]
+	}
+	return nil
+}
+
+func rotatedFileName(t time.Time) string {
+	return fmt.Sprintf("%s%020d%s", rotatedFilePrefix, t.UnixNano(), rotatedFileSuffix)
+}
+
+// rotatedFiles returns the names of the rotated files of the trace directory, the oldest first.
+func rotatedFiles(dir string) ([]string, error) {
+	dirEntries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not list gossip trace directory")
+	}
+	var names []string
+	for _, de := range dirEntries {
+		name := de.Name()
+		if de.IsDir() || name == CurrentFileName || !strings.HasPrefix(name, rotatedFilePrefix) || !strings.HasSuffix(name, rotatedFileSuffix) {
+			continue
+		}
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names, nil
+}
@@ -0,0 +1,74 @@
+package gossiptrace
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func TestWriter_RotatesAndReads(t *testing.T) {
+	dir := t.TempDir()
+	w, err := NewWriter(&Config{Dir: dir, MaxFileSize: 512, MaxFiles: 2})
+	require.NoError(t, err)
+
+	const count = 50
+	start := time.Unix(1700000000, 0).UTC()
+	for i := 0; i < count; i++ {
+		w.Record(&Event{
+			Time:  start.Add(time.Duration(i) * time.Millisecond),
+			Type:  EventDeliver,
+			Topic: "/eth2/00000000/beacon_block/ssz_snappy",
+			MsgID: fmt.Sprintf("%040x", i),
+			Peer:  "16Uiu2HAm",
+		})
+		// Rotated files are named after the time of the rotation, which must be distinct.
+		time.Sleep(time.Microsecond)
+	}
+	require.NoError(t, w.Close())
+	// Events recorded after closing are dropped.
+	w.Record(&Event{Type: EventDeliver})
+
+	paths, err := Files(dir)
+	require.NoError(t, err)
+	// Only the 2 most recent rotated files are kept, along with the current file.
+	require.Equal(t, 3, len(paths))
+	assert.Equal(t, filepath.Join(dir, CurrentFileName), paths[2])
+
+	var events []*Event
+	for _, p := range paths {
+		require.NoError(t, ReadFile(p, func(e *Event) {
+			events = append(events, e)
+		}))
+	}
+	require.Equal(t, true, len(events) > 0 && len(events) < count)
+	// The most recent events are kept, in order.
+	last := events[len(events)-1]
+	assert.Equal(t, fmt.Sprintf("%040x", count-1), last.MsgID)
+	assert.Equal(t, EventDeliver, last.Type)
+	assert.Equal(t, true, last.Time.Equal(start.Add((count-1)*time.Millisecond)))
+	for i := 1; i < len(events); i++ {
+		assert.Equal(t, true, events[i].Time.After(events[i-1].Time))
+	}
+}
+
+func TestReadFile_IgnoresPartialEvent(t *testing.T) {
+	p := filepath.Join(t.TempDir(), CurrentFileName)
+	content := `{"time":"2024-01-01T00:00:00Z","type":"publish","topic":"t","msg_id":"01"}` + "\n" + `{"time":"2024-01-01T00:00:01Z","ty`
+	require.NoError(t, os.WriteFile(p, []byte(content), 0600))
+
+	var events []*Event
+	require.NoError(t, ReadFile(p, func(e *Event) {
+		events = append(events, e)
+	}))
+	require.Equal(t, 1, len(events))
+	assert.Equal(t, EventPublish, events[0].Type)
+	assert.Equal(t, "01", events[0].MsgID)
+
+	require.NoError(t, os.WriteFile(p, []byte("not json\n"), 0600))
+	require.ErrorContains(t, "could not decode event at line 1", ReadFile(p, func(*Event) {}))
+}
@@ -52,6 +52,8 @@ func (s *Service) disconnectFromPeerOnError(
 			log.WithError(err).Error("Unable to disconnect from peer")
 		}
 	}
+	// The goodbye only carries a generic code, record the actual error instead.
+	s.peers.SetDisconnectReason(remotePeerID, fmt.Sprintf("handshake failed: %v", badPeerErr))
 
 	log.
 		WithError(badPeerErr).
@@ -2,7 +2,9 @@ package p2p
 
 import (
 	"context"
+	"time"
 
+	"github.com/ethereum/go-ethereum/p2p/enode"
 	"github.com/ethereum/go-ethereum/p2p/enr"
 	pubsub "github.com/libp2p/go-libp2p-pubsub"
 	"github.com/libp2p/go-libp2p/core/connmgr"
@@ -81,6 +83,7 @@ type PeerManager interface {
 	PeerID() peer.ID
 	Host() host.Host
 	ENR() *enr.Record
+	NodeID() enode.ID
 	DiscoveryAddresses() ([]multiaddr.Multiaddr, error)
 	RefreshPersistentSubnets()
 	FindPeersWithSubnet(ctx context.Context, topic string, subIndex uint64, threshold int) (bool, error)
@@ -97,6 +100,18 @@ type PeersProvider interface {
 	Peers() *peers.Status
 }
 
+// GossipScoreInspector computes the components of the gossipsub score of peers.
+type GossipScoreInspector interface {
+	TopicScoreComponents(topic string, snapshot *ethpb.TopicScoreSnapshot) (*TopicScoreComponents, error)
+}
+
+// PeerBanner manages the manual bans of peer IDs, IP addresses and CIDR ranges.
+type PeerBanner interface {
+	Ban(target, reason string, expiry time.Time) (*Ban, error)
+	Unban(target string) (bool, error)
+	Bans() []*Ban
+}
+
 // MetadataProvider returns the metadata related information for the local peer.
 type MetadataProvider interface {
 	Metadata() metadata.Metadata
@@ -0,0 +1,147 @@
+package p2p
+
+import (
+	"time"
+
+	"github.com/ethereum/go-ethereum/p2p/enode"
+	"github.com/libp2p/go-libp2p/core/peer"
+	ma "github.com/multiformats/go-multiaddr"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/peers"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/peers/peerdb"
+	"github.com/sirupsen/logrus"
+)
+
+// Interval at which the known peers are saved in the peer database.
+const peerDBSaveInterval = 5 * time.Minute
+
+// openPeerDB opens the peer database in the data directory, if any. Peers are only saved in it
+// when the maximum number of peers is positive, manual bans always are.
+func openPeerDB(cfg *Config) (*peerdb.Store, error) {
+	if cfg.DataDir == "" {
+		return nil, nil
+	}
+	return peerdb.NewStore(cfg.DataDir, &peerdb.Config{
+		MaxRecords: cfg.PeerDBMaxPeers,
+		Expiry:     cfg.PeerDBExpiry,
+	})
+}
+
+// restorePeers loads the peers of the peer database into the peer status, then dials the good ones,
+// the most recently seen first, so that the node does not wait for discovery to find peers again.
+func (s *Service) restorePeers() {
+	records, err := s.peerDB.Records(s.ctx)
+	if err != nil {
+		log.WithError(err).Error("Could not read peer database")
+		return
+	}
+	persisted := make([]*peers.PersistedPeer, 0, len(records))
+	addrInfos := make([]peer.AddrInfo, 0, len(records))
+	for _, record := range records {
+		pp, addrs, err := persistedPeerFromRecord(record)
+		if err != nil {
+			log.WithError(err).WithField("peer", record.ID).Debug("Could not restore peer from peer database")
+			continue
+		}
+		persisted = append(persisted, pp)
+		if !pp.Bad && len(addrs) > 0 && len(addrInfos) < int(s.cfg.MaxPeers) {
+			addrInfos = append(addrInfos, peer.AddrInfo{ID: pp.ID, Addrs: addrs})
+		}
+	}
+	s.peers.RestorePersistedPeers(persisted)
+	for _, info := range addrInfos {
+		// make each dial non-blocking
+		go func(info peer.AddrInfo) {
+			if err := s.connectWithPeer(s.ctx, info); err != nil {
+				log.WithError(err).Tracef("Could not connect with peer %s", info.String())
+			}
+		}(info)
+	}
+	log.WithFields(logrus.Fields{
+		"restored": len(persisted),
+		"dialed":   len(addrInfos),
+	}).Info("Restored peers from peer database")
+}
+
+// savePeers saves the known peers in the peer database.
+func (s *Service) savePeers() {
+	persisted := s.peers.PersistedPeers()
+	records := make([]*peerdb.Record, 0, len(persisted))
+	for _, pp := range persisted {
+		records = append(records, recordFromPersistedPeer(pp))
+	}
+	if err := s.peerDB.Save(s.ctx, records); err != nil {
+		log.WithError(err).Error("Could not save peer database")
+	}
+}
+
+// recordFromPersistedPeer converts a peer to its peer database record. The addresses advertised
+// in the ENR of the peer come first, as the address of an inbound connection is usually not dialable.
+func recordFromPersistedPeer(pp *peers.PersistedPeer) *peerdb.Record {
+	record := &peerdb.Record{
+		ID:           pp.ID,
+		Direction:    pp.Direction,
+		Score:        pp.Score,
+		BadResponses: pp.BadResponses,
+		Bad:          pp.Bad,
+		LastSeen:     pp.LastSeen,
+	}
+	addrs := make([]ma.Multiaddr, 0, 3)
+	if pp.Enr != nil {
+		if node, err := enode.New(enode.ValidSchemes, pp.Enr); err == nil {
+			record.ENR = node.String()
+			if nodeAddrs, err := retrieveMultiAddrsFromNode(node); err == nil {
+				addrs = append(addrs, nodeAddrs...)
+			}
+		}
+	}
+	if pp.Address != nil {
+		addrs = append(addrs, pp.Address)
+	}
+	seen := make(map[string]bool, len(addrs))
+	for _, addr := range addrs {
+		// Addresses are stored without their peer ID component.
+		transport, _ := peer.SplitAddr(addr)
+		if transport == nil || seen[transport.String()] {
+			continue
+		}
+		seen[transport.String()] = true
+		record.Addrs = append(record.Addrs, transport.String())
+	}
+	return record
+}
+
+// persistedPeerFromRecord converts a peer database record to a peer, with its dialable addresses.
+func persistedPeerFromRecord(record *peerdb.Record) (*peers.PersistedPeer, []ma.Multiaddr, error) {
+	if err := record.ID.Validate(); err != nil {
+		return nil, nil, errors.Wrap(err, "invalid peer ID")
+	}
+	pp := &peers.PersistedPeer{
+		ID:           record.ID,
+		Direction:    record.Direction,
+		LastSeen:     record.LastSeen,
+		Score:        record.Score,
+		BadResponses: record.BadResponses,
+		Bad:          record.Bad,
+	}
+	if record.ENR != "" {
+		node, err := enode.Parse(enode.ValidSchemes, record.ENR)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "invalid ENR")
+		}
+		pp.Enr = node.Record()
+	}
+	addrs := make([]ma.Multiaddr, 0, len(record.Addrs))
+	for _, rawAddr := range record.Addrs {
+		addr, err := ma.NewMultiaddr(rawAddr)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "invalid address %s", rawAddr)
+		}
+		addrs = append(addrs, addr)
+	}
+	// The address of the last connection comes last.
+	if len(addrs) > 0 {
+		pp.Address = addrs[len(addrs)-1]
+	}
+	return pp, addrs, nil
+}
@@ -0,0 +1,80 @@
+package p2p
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/p2p/enode"
+	"github.com/ethereum/go-ethereum/p2p/enr"
+	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/peer"
+	ma "github.com/multiformats/go-multiaddr"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/peers"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/peers/scorers"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func TestService_SaveRestorePeers(t *testing.T) {
+	ctx := context.Background()
+	dataDir := t.TempDir()
+	newService := func() *Service {
+		peerDB, err := openPeerDB(&Config{DataDir: dataDir, PeerDBMaxPeers: 10, PeerDBExpiry: time.Hour})
+		require.NoError(t, err)
+		return &Service{
+			ctx: ctx,
+			cfg: &Config{},
+			peers: peers.NewStatus(ctx, &peers.StatusConfig{
+				PeerLimit: 30,
+				ScorerParams: &scorers.Config{
+					BadResponsesScorerConfig: &scorers.BadResponsesScorerConfig{Threshold: 2},
+				},
+			}),
+			peerDB: peerDB,
+		}
+	}
+
+	// A peer with an ENR advertising its TCP port, connected from an ephemeral port.
+	db, err := enode.OpenDB(t.TempDir())
+	require.NoError(t, err)
+	_, key := createAddrAndPrivKey(t)
+	localNode := enode.NewLocalNode(db, key)
+	localNode.Set(enr.IPv4{192, 168, 0, 1})
+	localNode.Set(enr.TCP(13000))
+	info, _, err := convertToAddrInfo(localNode.Node())
+	require.NoError(t, err)
+	inboundAddr, err := ma.NewMultiaddr("/ip4/192.168.0.1/tcp/40000")
+	require.NoError(t, err)
+
+	s := newService()
+	s.peers.Add(localNode.Node().Record(), info.ID, inboundAddr, network.DirInbound)
+	s.peers.SetConnectionState(info.ID, peers.Connected)
+	s.savePeers()
+	require.NoError(t, s.peerDB.Close())
+
+	// The peer is restored after a restart, without being dialed as the peer limit is 0.
+	restored := newService()
+	defer func() {
+		require.NoError(t, restored.peerDB.Close())
+	}()
+	restored.restorePeers()
+	require.DeepEqual(t, []peer.ID{info.ID}, restored.peers.All())
+	dir, err := restored.peers.Direction(info.ID)
+	require.NoError(t, err)
+	assert.Equal(t, network.DirInbound, dir)
+	restoredENR, err := restored.peers.ENR(info.ID)
+	require.NoError(t, err)
+	assert.Equal(t, localNode.Node().Seq(), restoredENR.Seq())
+
+	records, err := restored.peerDB.Records(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(records))
+	assert.DeepEqual(t, []string{"/ip4/192.168.0.1/tcp/13000", "/ip4/192.168.0.1/tcp/40000"}, records[0].Addrs)
+	assert.Equal(t, localNode.Node().String(), records[0].ENR)
+
+	pp, addrs, err := persistedPeerFromRecord(records[0])
+	require.NoError(t, err)
+	assert.Equal(t, 2, len(addrs))
+	assert.Equal(t, inboundAddr.String(), pp.Address.String())
+}
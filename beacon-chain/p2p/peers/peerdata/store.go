@@ -52,6 +52,10 @@ type PeerData struct {
 	ConnState     ConnectionState
 	Enr           *enr.Record
 	NextValidTime time.Time
+	// LastSeen is the last time the peer was connected.
+	LastSeen time.Time
+	// DisconnectReason is the reason of the last disconnection initiated by either side, if known.
+	DisconnectReason string
 	// Chain related data.
 	MetaData                  metadata.Metadata
 	ChainState                *ethpb.Status
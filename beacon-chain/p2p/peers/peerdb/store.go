@@ -0,0 +1,262 @@
+// Package peerdb persists a bounded set of known peers, with their addresses, scores and
+// good or bad verdicts, as well as the manual bans of peers, so that they outlive restarts of the beacon node.
+package peerdb
+
+import (
+	"context"
+	"encoding/json"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/io/file"
+	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing/trace"
+	prysmTime "github.com/prysmaticlabs/prysm/v5/time"
+	bolt "go.etcd.io/bbolt"
+)
+
+// DatabaseFileName is the name of the peer database file in the data directory.
+const DatabaseFileName = "peers.db"
+
+var (
+	peersBucket = []byte("peers")
+	bansBucket  = []byte("bans")
+)
+
+// Config holds the peer database parameters.
+type Config struct {
+	// MaxRecords is the maximum number of peers kept, the least recently seen ones are dropped first.
+	MaxRecords int
+	// Expiry is the duration after which a peer which has not been seen is dropped.
+	Expiry time.Duration
+}
+
+// Record holds the persisted data of a peer.
+type Record struct {
+	ID           peer.ID           `json:"-"`
+	ENR          string            `json:"enr,omitempty"`
+	Addrs        []string          `json:"addrs"`
+	Direction    network.Direction `json:"direction"`
+	Score        float64           `json:"score"`
+	BadResponses int               `json:"bad_responses"`
+	Bad          bool              `json:"bad"`
+	LastSeen     time.Time         `json:"last_seen"`
+}
+
+// BanRecord holds a persisted manual ban of a peer ID, IP address or CIDR range.
+type BanRecord struct {
+	Target  string    `json:"-"`
+	Reason  string    `json:"reason"`
+	Created time.Time `json:"created"`
+	// Expiry is the time the ban is lifted at, the ban is permanent if zero.
+	Expiry time.Time `json:"expiry"`
+}
+
+// Expired returns whether the ban is lifted at the given time.
+func (r *BanRecord) Expired(now time.Time) bool {
+	return !r.Expiry.IsZero() && !now.Before(r.Expiry)
+}
+
+// Store is a BoltDB backed peer database.
+type Store struct {
+	db     *bolt.DB
+	config *Config
+}
+
+// NewStore opens the peer database in the given directory, creating it if needed.
+func NewStore(dirPath string, config *Config) (*Store, error) {
+	if err := file.MkdirAll(dirPath); err != nil {
+		return nil, err
+	}
+	boltDB, err := bolt.Open(
+		filepath.Join(dirPath, DatabaseFileName),
+		params.BeaconIoConfig().ReadWritePermissions,
+		&bolt.Options{Timeout: 1 * time.Second},
+	)
+	if err != nil {
+		if errors.Is(err, bolt.ErrTimeout) {
+			return nil, errors.New("cannot obtain peer database lock, database may be in use by another process")
+		}
+		return nil, err
+	}
+	if err := boltDB.Update(func(tx *bolt.Tx) error {
+		for _, bkt := range [][]byte{peersBucket, bansBucket} {
+			if _, err := tx.CreateBucketIfNotExists(bkt); err != nil {
+				return err
+			}
+		}
+		return nil
+	}); err != nil {
+		return nil, errors.Wrap(err, "could not create peer database buckets")
+	}
+	return &Store{db: boltDB, config: config}, nil
+}
+
+// Close closes the underlying BoltDB database.
+func (s *Store) Close() error {
+	return s.db.Close()
+}
+
+// Save merges the records into the database, overriding the existing records of the same peers.
+// Expired records are then dropped, as well as the least recently seen ones above the maximum number of records.
+func (s *Store) Save(ctx context.Context, records []*Record) error {
+	_, span := trace.StartSpan(ctx, "peerdb.Save")
+	defer span.End()
+	return s.db.Update(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(peersBucket)
+		for _, record := range records {
+			enc, err := json.Marshal(record)
+			if err != nil {
+				return errors.Wrap(err, "could not marshal peer record")
+			}
+			if err := bkt.Put([]byte(record.ID), enc); err != nil {
+				return err
+			}
+		}
+		all, err := decodeRecords(bkt)
+		if err != nil {
+			return err
+		}
+		for i, record := range all {
+			if i < s.config.MaxRecords && !s.expired(record) {
+				continue
+			}
+			if err := bkt.Delete([]byte(record.ID)); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// Records returns the records which have not expired, the most recently seen first.
+func (s *Store) Records(ctx context.Context) ([]*Record, error) {
+	_, span := trace.StartSpan(ctx, "peerdb.Records")
+	defer span.End()
+	records := make([]*Record, 0)
+	err := s.db.View(func(tx *bolt.Tx) error {
+		all, err := decodeRecords(tx.Bucket(peersBucket))
+		if err != nil {
+			return err
+		}
+		for _, record := range all {
+			if !s.expired(record) {
+				records = append(records, record)
+			}
+		}
+		return nil
+	})
+	return records, err
+}
+
+// Clear deletes all the peer records. Bans are kept.
+func (s *Store) Clear(ctx context.Context) error {
+	_, span := trace.StartSpan(ctx, "peerdb.Clear")
+	defer span.End()
+	return s.db.Update(func(tx *bolt.Tx) error {
+		if err := tx.DeleteBucket(peersBucket); err != nil {
+			return err
+		}
+		_, err := tx.CreateBucket(peersBucket)
+		return err
+	})
+}
+
+// SaveBan saves the ban, overriding any existing ban of the same target. Expired bans are then dropped.
+func (s *Store) SaveBan(ctx context.Context, record *BanRecord) error {
+	_, span := trace.StartSpan(ctx, "peerdb.SaveBan")
+	defer span.End()
+	return s.db.Update(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(bansBucket)
+		enc, err := json.Marshal(record)
+		if err != nil {
+			return errors.Wrap(err, "could not marshal ban record")
+		}
+		if err := bkt.Put([]byte(record.Target), enc); err != nil {
+			return err
+		}
+		all, err := decodeBans(bkt)
+		if err != nil {
+			return err
+		}
+		now := prysmTime.Now()
+		for _, ban := range all {
+			if ban.Expired(now) {
+				if err := bkt.Delete([]byte(ban.Target)); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+}
+
+// DeleteBan deletes the ban of the target, if any.
+func (s *Store) DeleteBan(ctx context.Context, target string) error {
+	_, span := trace.StartSpan(ctx, "peerdb.DeleteBan")
+	defer span.End()
+	return s.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(bansBucket).Delete([]byte(target))
+	})
+}
+
+// Bans returns all the bans, expired or not, the oldest first.
+func (s *Store) Bans(ctx context.Context) ([]*BanRecord, error) {
+	_, span := trace.StartSpan(ctx, "peerdb.Bans")
+	defer span.End()
+	var bans []*BanRecord
+	err := s.db.View(func(tx *bolt.Tx) error {
+		var err error
+		bans, err = decodeBans(tx.Bucket(bansBucket))
+		return err
+	})
+	return bans, err
+}
+
+func (s *Store) expired(record *Record) bool {
+	return s.config.Expiry > 0 && prysmTime.Since(record.LastSeen) > s.config.Expiry
+}
+
+// decodeRecords returns all the records of the bucket, the most recently seen first.
+func decodeRecords(bkt *bolt.Bucket) ([]*Record, error) {
+	records := make([]*Record, 0)
+	if err := bkt.ForEach(func(id, enc []byte) error {
+		record := &Record{}
+		if err := json.Unmarshal(enc, record); err != nil {
+			return errors.Wrapf(err, "could not unmarshal record of peer %s", peer.ID(id))
+		}
+		record.ID = peer.ID(id)
+		records = append(records, record)
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	sort.SliceStable(records, func(i, j int) bool {
+		return records[i].LastSeen.After(records[j].LastSeen)
+	})
+	return records, nil
+}
+
+// decodeBans returns all the bans of the bucket, the oldest first.
+func decodeBans(bkt *bolt.Bucket) ([]*BanRecord, error) {
+	bans := make([]*BanRecord, 0)
+	if err := bkt.ForEach(func(target, enc []byte) error {
+		record := &BanRecord{}
+		if err := json.Unmarshal(enc, record); err != nil {
+			return errors.Wrapf(err, "could not unmarshal ban of %s", target)
+		}
+		record.Target = string(target)
+		bans = append(bans, record)
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	sort.SliceStable(bans, func(i, j int) bool {
+		return bans[i].Created.Before(bans[j].Created)
+	})
+	return bans, nil
+}
@@ -0,0 +1,89 @@
+package peerdb
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func TestStore_SaveRecords(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	store, err := NewStore(dir, &Config{MaxRecords: 3, Expiry: time.Hour})
+	require.NoError(t, err)
+
+	now := time.Now()
+	require.NoError(t, store.Save(ctx, []*Record{
+		{ID: peer.ID("a"), Addrs: []string{"/ip4/1.2.3.4/tcp/13000"}, Direction: network.DirOutbound, LastSeen: now.Add(-time.Minute)},
+		{ID: peer.ID("b"), Bad: true, BadResponses: 6, LastSeen: now.Add(-2 * time.Minute)},
+		{ID: peer.ID("expired"), LastSeen: now.Add(-2 * time.Hour)},
+	}))
+	records, err := store.Records(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(records))
+	assert.Equal(t, peer.ID("a"), records[0].ID)
+	assert.DeepEqual(t, []string{"/ip4/1.2.3.4/tcp/13000"}, records[0].Addrs)
+	assert.Equal(t, network.DirOutbound, records[0].Direction)
+	assert.Equal(t, peer.ID("b"), records[1].ID)
+	assert.Equal(t, true, records[1].Bad)
+	assert.Equal(t, 6, records[1].BadResponses)
+
+	// The records survive a restart, and the least recently seen ones are dropped above the limit.
+	require.NoError(t, store.Close())
+	store, err = NewStore(dir, &Config{MaxRecords: 3, Expiry: time.Hour})
+	require.NoError(t, err)
+	require.NoError(t, store.Save(ctx, []*Record{
+		{ID: peer.ID("c"), LastSeen: now},
+		{ID: peer.ID("d"), LastSeen: now.Add(-30 * time.Second)},
+	}))
+	records, err = store.Records(ctx)
+	require.NoError(t, err)
+	ids := make([]peer.ID, len(records))
+	for i, record := range records {
+		ids[i] = record.ID
+	}
+	assert.DeepEqual(t, []peer.ID{"c", "d", "a"}, ids)
+
+	require.NoError(t, store.Clear(ctx))
+	records, err = store.Records(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(records))
+	require.NoError(t, store.Close())
+}
+
+func TestStore_SaveBans(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	store, err := NewStore(dir, &Config{MaxRecords: 3, Expiry: time.Hour})
+	require.NoError(t, err)
+
+	now := time.Now()
+	require.NoError(t, store.SaveBan(ctx, &BanRecord{Target: "10.0.0.0/8", Reason: "spam", Created: now.Add(-time.Minute)}))
+	require.NoError(t, store.SaveBan(ctx, &BanRecord{Target: "1.2.3.4", Reason: "expired", Created: now.Add(-2 * time.Hour), Expiry: now.Add(-time.Hour)}))
+	require.NoError(t, store.SaveBan(ctx, &BanRecord{Target: "5.6.7.8", Reason: "dos", Created: now, Expiry: now.Add(time.Hour)}))
+
+	// The bans survive a restart, and are not deleted with the peers.
+	require.NoError(t, store.Close())
+	store, err = NewStore(dir, &Config{MaxRecords: 3, Expiry: time.Hour})
+	require.NoError(t, err)
+	require.NoError(t, store.Clear(ctx))
+	bans, err := store.Bans(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(bans))
+	assert.Equal(t, "10.0.0.0/8", bans[0].Target)
+	assert.Equal(t, "spam", bans[0].Reason)
+	assert.Equal(t, true, bans[0].Expiry.IsZero())
+	assert.Equal(t, "5.6.7.8", bans[1].Target)
+
+	require.NoError(t, store.DeleteBan(ctx, "10.0.0.0/8"))
+	bans, err = store.Bans(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(bans))
+	assert.Equal(t, "5.6.7.8", bans[0].Target)
+	require.NoError(t, store.Close())
+}
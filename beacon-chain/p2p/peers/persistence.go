@@ -0,0 +1,89 @@
+package peers
+
+import (
+	"time"
+
+	"github.com/ethereum/go-ethereum/p2p/enr"
+	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/peer"
+	ma "github.com/multiformats/go-multiaddr"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/peers/peerdata"
+	prysmTime "github.com/prysmaticlabs/prysm/v5/time"
+)
+
+// PersistedPeer holds the data of a peer which is kept across restarts of the node.
+type PersistedPeer struct {
+	ID           peer.ID
+	Address      ma.Multiaddr
+	Direction    network.Direction
+	Enr          *enr.Record
+	LastSeen     time.Time
+	Score        float64
+	BadResponses int
+	Bad          bool
+}
+
+// PersistedPeers returns the peers worth remembering across restarts: the ones which have been
+// connected, and the ones the scorers consider bad. Trusted peers are left out, as they are configured.
+func (p *Status) PersistedPeers() []*PersistedPeer {
+	p.store.RLock()
+	defer p.store.RUnlock()
+
+	now := prysmTime.Now()
+	persisted := make([]*PersistedPeer, 0)
+	for pid, peerData := range p.store.Peers() {
+		if p.store.IsTrustedPeer(pid) {
+			continue
+		}
+		bad := p.scorers.IsBadPeerNoLock(pid) != nil
+		if peerData.LastSeen.IsZero() && !bad {
+			continue
+		}
+		lastSeen := peerData.LastSeen
+		if peerData.ConnState == Connected || lastSeen.IsZero() {
+			lastSeen = now
+		}
+		persisted = append(persisted, &PersistedPeer{
+			ID:           pid,
+			Address:      peerData.Address,
+			Direction:    peerData.Direction,
+			Enr:          peerData.Enr,
+			LastSeen:     lastSeen,
+			Score:        p.scorers.ScoreNoLock(pid),
+			BadResponses: peerData.BadResponses,
+			Bad:          bad,
+		})
+	}
+	return persisted
+}
+
+// RestorePersistedPeers adds the persisted peers which are not known yet, as disconnected peers.
+// The bad verdict of a peer is restored through its bad responses count, so that it decays like
+// any other. Good peers are only restored up to the peer store limit, in the given order.
+func (p *Status) RestorePersistedPeers(persisted []*PersistedPeer) {
+	p.store.Lock()
+	defer p.store.Unlock()
+
+	threshold := p.scorers.BadResponsesScorer().Params().Threshold
+	for _, pp := range persisted {
+		if _, ok := p.store.PeerData(pp.ID); ok {
+			continue
+		}
+		if !pp.Bad && len(p.store.Peers()) >= p.store.Config().MaxPeers {
+			continue
+		}
+		badResponses := pp.BadResponses
+		if pp.Bad && badResponses < threshold {
+			badResponses = threshold
+		}
+		p.store.SetPeerData(pp.ID, &peerdata.PeerData{
+			Address:      pp.Address,
+			Direction:    pp.Direction,
+			ConnState:    Disconnected,
+			Enr:          pp.Enr,
+			LastSeen:     pp.LastSeen,
+			BadResponses: badResponses,
+		})
+		p.addIpToTracker(pp.ID)
+	}
+}
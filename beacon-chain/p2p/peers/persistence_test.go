@@ -0,0 +1,71 @@
+package peers_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/peer"
+	ma "github.com/multiformats/go-multiaddr"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/peers"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/peers/scorers"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func TestStatus_PersistedPeers(t *testing.T) {
+	maxBadResponses := 2
+	newStatus := func() *peers.Status {
+		return peers.NewStatus(context.Background(), &peers.StatusConfig{
+			PeerLimit: 30,
+			ScorerParams: &scorers.Config{
+				BadResponsesScorerConfig: &scorers.BadResponsesScorerConfig{
+					Threshold: maxBadResponses,
+				},
+			},
+		})
+	}
+	p := newStatus()
+	address, err := ma.NewMultiaddr("/ip4/213.202.254.180/tcp/13000")
+	require.NoError(t, err)
+
+	connected := createPeer(t, p, address, network.DirOutbound, peers.Connected)
+	disconnected := createPeer(t, p, nil, network.DirInbound, peers.Connected)
+	p.SetConnectionState(disconnected, peers.Disconnected)
+	neverConnected := createPeer(t, p, nil, network.DirUnknown, peers.Disconnected)
+	bad := createPeer(t, p, nil, network.DirUnknown, peers.Disconnected)
+	for i := 0; i < maxBadResponses; i++ {
+		p.Scorers().BadResponsesScorer().Increment(bad)
+	}
+	trusted := createPeer(t, p, nil, network.DirUnknown, peers.Connected)
+	p.SetTrustedPeers([]peer.ID{trusted})
+
+	persisted := p.PersistedPeers()
+	byID := make(map[peer.ID]*peers.PersistedPeer, len(persisted))
+	for _, pp := range persisted {
+		byID[pp.ID] = pp
+	}
+	require.Equal(t, 3, len(byID))
+	require.NotNil(t, byID[connected])
+	assert.Equal(t, address.String(), byID[connected].Address.String())
+	assert.Equal(t, network.DirOutbound, byID[connected].Direction)
+	assert.Equal(t, false, byID[connected].Bad)
+	require.NotNil(t, byID[disconnected])
+	assert.Equal(t, false, byID[disconnected].LastSeen.IsZero())
+	require.NotNil(t, byID[bad])
+	assert.Equal(t, true, byID[bad].Bad)
+	_, ok := byID[neverConnected]
+	assert.Equal(t, false, ok)
+
+	// Restore the peers in a new status, the bad verdict is kept even if the bad responses count was lost.
+	byID[bad].BadResponses = 0
+	restored := newStatus()
+	restored.RestorePersistedPeers(persisted)
+	assert.Equal(t, 3, len(restored.All()))
+	assert.Equal(t, 0, len(restored.Connected()))
+	assert.NotNil(t, restored.IsBad(bad))
+	assert.NoError(t, restored.IsBad(connected))
+	dir, err := restored.Direction(connected)
+	require.NoError(t, err)
+	assert.Equal(t, network.DirOutbound, dir)
+}
@@ -316,9 +316,49 @@ func (p *Status) SetConnectionState(pid peer.ID, state peerdata.ConnectionState)
 	defer p.store.Unlock()
 
 	peerData := p.store.PeerDataGetOrCreate(pid)
+	if state == Connected || peerData.ConnState == Connected {
+		peerData.LastSeen = prysmTime.Now()
+	}
+	if state == Connected {
+		peerData.DisconnectReason = ""
+	}
 	peerData.ConnState = state
 }
 
+// SetDisconnectReason records the reason the given remote peer is being disconnected for.
+// The reason is cleared once the peer connects again.
+func (p *Status) SetDisconnectReason(pid peer.ID, reason string) {
+	p.store.Lock()
+	defer p.store.Unlock()
+
+	p.store.PeerDataGetOrCreate(pid).DisconnectReason = reason
+}
+
+// DisconnectReason gets the reason of the last disconnection of the given remote peer, which is
+// empty when the peer is connected or when the remote peer closed the connection without a goodbye.
+// This will error if the peer does not exist.
+func (p *Status) DisconnectReason(pid peer.ID) (string, error) {
+	p.store.RLock()
+	defer p.store.RUnlock()
+
+	if peerData, ok := p.store.PeerData(pid); ok {
+		return peerData.DisconnectReason, nil
+	}
+	return "", peerdata.ErrPeerUnknown
+}
+
+// LastSeen gets the last time the given remote peer was connected.
+// This will error if the peer does not exist.
+func (p *Status) LastSeen(pid peer.ID) (time.Time, error) {
+	p.store.RLock()
+	defer p.store.RUnlock()
+
+	if peerData, ok := p.store.PeerData(pid); ok {
+		return peerData.LastSeen, nil
+	}
+	return time.Time{}, peerdata.ErrPeerUnknown
+}
+
 // ConnectionState gets the connection state of the given remote peer.
 // This will error if the peer does not exist.
 func (p *Status) ConnectionState(pid peer.ID) (peerdata.ConnectionState, error) {
@@ -450,6 +450,35 @@ func TestPeerConnectionStatuses(t *testing.T) {
 	assert.Equal(t, numPeersAll, len(p.All()), "Unexpected number of peers")
 }
 
+func TestPeerDisconnectReason(t *testing.T) {
+	p := peers.NewStatus(context.Background(), &peers.StatusConfig{
+		PeerLimit:    30,
+		ScorerParams: &scorers.Config{},
+	})
+
+	unknown := peer.ID("unknown")
+	_, err := p.DisconnectReason(unknown)
+	assert.ErrorContains(t, peerdata.ErrPeerUnknown.Error(), err)
+	_, err = p.LastSeen(unknown)
+	assert.ErrorContains(t, peerdata.ErrPeerUnknown.Error(), err)
+
+	pid := addPeer(t, p, peers.Connected)
+	p.SetDisconnectReason(pid, "sent goodbye: peer score too low")
+	p.SetConnectionState(pid, peers.Disconnected)
+	reason, err := p.DisconnectReason(pid)
+	require.NoError(t, err)
+	assert.Equal(t, "sent goodbye: peer score too low", reason)
+	lastSeen, err := p.LastSeen(pid)
+	require.NoError(t, err)
+	assert.Equal(t, false, lastSeen.IsZero())
+
+	// The reason is cleared once the peer connects again.
+	p.SetConnectionState(pid, peers.Connected)
+	reason, err = p.DisconnectReason(pid)
+	require.NoError(t, err)
+	assert.Equal(t, "", reason)
+}
+
 func TestPeerValidTime(t *testing.T) {
 	maxBadResponses := 2
 	p := peers.NewStatus(context.Background(), &peers.StatusConfig{
@@ -1,15 +1,32 @@
 package p2p
 
 import (
+	"encoding/hex"
+	"time"
+
 	pubsub "github.com/libp2p/go-libp2p-pubsub"
 	"github.com/libp2p/go-libp2p/core/host"
 	"github.com/libp2p/go-libp2p/core/peer"
 	"github.com/libp2p/go-libp2p/core/protocol"
 	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/gossiptrace"
 )
 
 var _ = pubsub.RawTracer(gossipTracer{})
 
+// openGossipTrace opens the trace sink of gossipsub events, which is disabled
+// when no trace directory is configured.
+func openGossipTrace(cfg *Config) (*gossiptrace.Writer, error) {
+	if cfg.GossipTraceDir == "" {
+		return nil, nil
+	}
+	return gossiptrace.NewWriter(&gossiptrace.Config{
+		Dir:         cfg.GossipTraceDir,
+		MaxFileSize: cfg.GossipTraceMaxSize,
+		MaxFiles:    cfg.GossipTraceMaxFiles,
+	})
+}
+
 // Initializes the values for the pubsub rpc action.
 type action int
 
@@ -20,9 +37,11 @@ const (
 )
 
 // This tracer is used to implement metrics collection for messages received
-// and broadcasted through gossipsub.
+// and broadcasted through gossipsub. Message and mesh events are also recorded
+// to the trace sink, when one is configured.
 type gossipTracer struct {
 	host host.Host
+	sink *gossiptrace.Writer
 }
 
 // AddPeer .
@@ -48,31 +67,42 @@ func (g gossipTracer) Leave(topic string) {
 // Graft .
 func (g gossipTracer) Graft(p peer.ID, topic string) {
 	pubsubTopicsGraft.WithLabelValues(topic).Inc()
+	g.recordMesh(gossiptrace.EventGraft, p, topic)
 }
 
 // Prune .
 func (g gossipTracer) Prune(p peer.ID, topic string) {
 	pubsubTopicsPrune.WithLabelValues(topic).Inc()
+	g.recordMesh(gossiptrace.EventPrune, p, topic)
 }
 
 // ValidateMessage .
 func (g gossipTracer) ValidateMessage(msg *pubsub.Message) {
 	pubsubMessageValidate.WithLabelValues(*msg.Topic).Inc()
+	// Messages published by this node are validated before being sent.
+	if msg.Local {
+		g.recordMessage(gossiptrace.EventPublish, msg, "")
+	}
 }
 
 // DeliverMessage .
 func (g gossipTracer) DeliverMessage(msg *pubsub.Message) {
 	pubsubMessageDeliver.WithLabelValues(*msg.Topic).Inc()
+	if !msg.Local {
+		g.recordMessage(gossiptrace.EventDeliver, msg, "")
+	}
 }
 
 // RejectMessage .
 func (g gossipTracer) RejectMessage(msg *pubsub.Message, reason string) {
 	pubsubMessageReject.WithLabelValues(*msg.Topic, reason).Inc()
+	g.recordMessage(gossiptrace.EventReject, msg, reason)
 }
 
 // DuplicateMessage .
 func (g gossipTracer) DuplicateMessage(msg *pubsub.Message) {
 	pubsubMessageDuplicate.WithLabelValues(*msg.Topic).Inc()
+	g.recordMessage(gossiptrace.EventDuplicate, msg, "")
 }
 
 // UndeliverableMessage .
@@ -101,6 +131,33 @@ func (g gossipTracer) DropRPC(rpc *pubsub.RPC, p peer.ID) {
 	g.setMetricFromRPC(drop, pubsubRPCSubDrop, pubsubRPCPubDrop, pubsubRPCDrop, rpc)
 }
 
+// recordMesh records a mesh event to the trace sink.
+func (g gossipTracer) recordMesh(typ gossiptrace.EventType, p peer.ID, topic string) {
+	if g.sink == nil {
+		return
+	}
+	g.sink.Record(&gossiptrace.Event{Time: time.Now(), Type: typ, Topic: topic, Peer: p.String()})
+}
+
+// recordMessage records a message event to the trace sink. The message ID is hex encoded,
+// as it is made of raw bytes.
+func (g gossipTracer) recordMessage(typ gossiptrace.EventType, msg *pubsub.Message, reason string) {
+	if g.sink == nil {
+		return
+	}
+	e := &gossiptrace.Event{
+		Time:   time.Now(),
+		Type:   typ,
+		Topic:  msg.GetTopic(),
+		MsgID:  hex.EncodeToString([]byte(msg.ID)),
+		Reason: reason,
+	}
+	if !msg.Local {
+		e.Peer = msg.ReceivedFrom.String()
+	}
+	g.sink.Record(e)
+}
+
 func (g gossipTracer) setMetricFromRPC(act action, subCtr prometheus.Counter, pubCtr, ctrlCtr *prometheus.CounterVec, rpc *pubsub.RPC) {
 	subCtr.Add(float64(len(rpc.Subscriptions)))
 	if rpc.Control != nil {
@@ -0,0 +1,55 @@
+package p2p
+
+import (
+	"encoding/hex"
+	"path/filepath"
+	"testing"
+
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	pubsubpb "github.com/libp2p/go-libp2p-pubsub/pb"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/gossiptrace"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func TestGossipTracer_RecordsEvents(t *testing.T) {
+	dir := t.TempDir()
+	sink, err := openGossipTrace(&Config{GossipTraceDir: dir})
+	require.NoError(t, err)
+	g := gossipTracer{sink: sink}
+
+	topic := "/eth2/00000000/beacon_block/ssz_snappy"
+	from := peer.ID("remote")
+	published := &pubsub.Message{Message: &pubsubpb.Message{Topic: &topic}, ID: "\x01\x02", Local: true}
+	received := &pubsub.Message{Message: &pubsubpb.Message{Topic: &topic}, ID: "\x03\x04", ReceivedFrom: from}
+
+	g.ValidateMessage(published)
+	g.DeliverMessage(published)
+	g.ValidateMessage(received)
+	g.DeliverMessage(received)
+	g.DuplicateMessage(received)
+	g.RejectMessage(received, pubsub.RejectValidationFailed)
+	g.Graft(from, topic)
+	g.Prune(from, topic)
+	require.NoError(t, sink.Close())
+
+	var events []*gossiptrace.Event
+	require.NoError(t, gossiptrace.ReadFile(filepath.Join(dir, gossiptrace.CurrentFileName), func(e *gossiptrace.Event) {
+		events = append(events, e)
+	}))
+	wanted := []gossiptrace.Event{
+		{Type: gossiptrace.EventPublish, Topic: topic, MsgID: hex.EncodeToString([]byte(published.ID))},
+		{Type: gossiptrace.EventDeliver, Topic: topic, MsgID: hex.EncodeToString([]byte(received.ID)), Peer: from.String()},
+		{Type: gossiptrace.EventDuplicate, Topic: topic, MsgID: hex.EncodeToString([]byte(received.ID)), Peer: from.String()},
+		{Type: gossiptrace.EventReject, Topic: topic, MsgID: hex.EncodeToString([]byte(received.ID)), Peer: from.String(), Reason: pubsub.RejectValidationFailed},
+		{Type: gossiptrace.EventGraft, Topic: topic, Peer: from.String()},
+		{Type: gossiptrace.EventPrune, Topic: topic, Peer: from.String()},
+	}
+	require.Equal(t, len(wanted), len(events))
+	for i, e := range events {
+		assert.Equal(t, false, e.Time.IsZero())
+		e.Time = wanted[i].Time
+		assert.DeepEqual(t, wanted[i], *e)
+	}
+}
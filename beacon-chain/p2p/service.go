@@ -21,7 +21,9 @@ import (
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/v5/async"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/encoder"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/gossiptrace"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/peers"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/peers/peerdb"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/peers/scorers"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/types"
 	"github.com/prysmaticlabs/prysm/v5/config/features"
@@ -65,6 +67,9 @@ type Service struct {
 	cancel                context.CancelFunc
 	cfg                   *Config
 	peers                 *peers.Status
+	peerDB                *peerdb.Store
+	bans                  *banList
+	gossipTrace           *gossiptrace.Writer
 	addrFilter            *multiaddr.Filters
 	ipLimiter             *leakybucket.Collector
 	privKey               *ecdsa.PrivateKey
@@ -121,6 +126,7 @@ func NewService(ctx context.Context, cfg *Config) (*Service, error) {
 		isPreGenesis: true,
 		joinedTopics: make(map[string]*pubsub.Topic, len(gossipTopicMappings)),
 		subnetsLock:  make(map[uint64]*sync.RWMutex),
+		bans:         newBanList(),
 	}
 
 	ipAddr := prysmnetwork.IPAddr()
@@ -139,6 +145,11 @@ func NewService(ctx context.Context, cfg *Config) (*Service, error) {
 
 	s.host = h
 
+	s.gossipTrace, err = openGossipTrace(cfg)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to open gossip trace")
+	}
+
 	// Gossipsub registration is done before we add in any new peers
 	// due to libp2p's gossipsub implementation not taking into
 	// account previously added peers when creating the gossipsub
@@ -154,6 +165,9 @@ func NewService(ctx context.Context, cfg *Config) (*Service, error) {
 
 	gs, err := pubsub.NewGossipSub(s.ctx, s.host, psOpts...)
 	if err != nil {
+		if closeErr := s.gossipTrace.Close(); closeErr != nil {
+			log.WithError(closeErr).Error("Could not close gossip trace")
+		}
 		return nil, errors.Wrapf(err, "failed to create p2p pubsub")
 	}
 
@@ -172,6 +186,17 @@ func NewService(ctx context.Context, cfg *Config) (*Service, error) {
 	// Initialize Data maps.
 	types.InitializeDataMaps()
 
+	s.peerDB, err = openPeerDB(cfg)
+	if err != nil {
+		if closeErr := s.gossipTrace.Close(); closeErr != nil {
+			log.WithError(closeErr).Error("Could not close gossip trace")
+		}
+		return nil, errors.Wrap(err, "failed to open peer database")
+	}
+	if s.peerDB != nil {
+		s.restoreBans()
+	}
+
 	return s, nil
 }
 
@@ -217,6 +242,11 @@ func (s *Service) Start() {
 		go s.listenForNewNodes()
 	}
 
+	if s.peerDB != nil && s.cfg.PeerDBMaxPeers > 0 {
+		s.restorePeers()
+		async.RunEvery(s.ctx, peerDBSaveInterval, s.savePeers)
+	}
+
 	s.started = true
 
 	if len(s.cfg.StaticPeers) > 0 {
@@ -287,6 +317,15 @@ func (s *Service) Stop() error {
 	if s.dv5Listener != nil {
 		s.dv5Listener.Close()
 	}
+	if err := s.gossipTrace.Close(); err != nil {
+		log.WithError(err).Error("Could not close gossip trace")
+	}
+	if s.peerDB != nil {
+		if s.cfg.PeerDBMaxPeers > 0 {
+			s.savePeers()
+		}
+		return s.peerDB.Close()
+	}
 	return nil
 }
 
@@ -363,6 +402,20 @@ func (s *Service) ENR() *enr.Record {
 	return s.dv5Listener.Self().Record()
 }
 
+// NodeID returns the local node's discovery node ID, from which its custody groups are derived.
+func (s *Service) NodeID() enode.ID {
+	if s.dv5Listener != nil {
+		return s.dv5Listener.Self().ID()
+	}
+	// The node ID only depends on the private key, so it can be derived from the peer ID as well.
+	id, err := ConvertPeerIDToNodeID(s.PeerID())
+	if err != nil {
+		log.WithError(err).Error("Could not compute node ID from peer ID")
+		return enode.ID{}
+	}
+	return id
+}
+
 // DiscoveryAddresses represents our enr addresses as multiaddresses.
 func (s *Service) DiscoveryAddresses() ([]multiaddr.Multiaddr, error) {
 	if s.dv5Listener == nil {
@@ -475,6 +528,9 @@ func (s *Service) connectWithPeer(ctx context.Context, info peer.AddrInfo) error
 	if err := s.Peers().IsBad(info.ID); err != nil {
 		return errors.Wrap(err, "refused to connect to bad peer")
 	}
+	if b := s.bans.peerBan(info.ID); b != nil {
+		return errors.Errorf("refused to connect to banned peer: %s", b.Reason)
+	}
 	ctx, cancel := context.WithTimeout(ctx, maxDialTimeout)
 	defer cancel()
 	if err := s.host.Connect(ctx, info); err != nil {
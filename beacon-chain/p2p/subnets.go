@@ -14,6 +14,7 @@ import (
 	"github.com/prysmaticlabs/go-bitfield"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/cache"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/peerdas"
 	"github.com/prysmaticlabs/prysm/v5/cmd/beacon-chain/flags"
 	"github.com/prysmaticlabs/prysm/v5/config/params"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
@@ -31,6 +32,8 @@ var (
 
 	attSubnetEnrKey       = params.BeaconNetworkConfig().AttSubnetKey
 	syncCommsSubnetEnrKey = params.BeaconNetworkConfig().SyncCommsSubnetKey
+
+	custodyGroupCountEnrKey = params.BeaconNetworkConfig().CustodyGroupCountKey
 )
 
 // The value used with the subnet, in order
@@ -56,6 +59,8 @@ func (s *Service) nodeFilter(topic string, index uint64) (func(node *enode.Node)
 		return s.filterPeerForSyncSubnet(index), nil
 	case strings.Contains(topic, GossipBlobSidecarMessage):
 		return s.filterPeerForBlobSubnet(), nil
+	case strings.Contains(topic, GossipDataColumnSidecarMessage):
+		return s.filterPeerForDataColumnsSubnet(index), nil
 	default:
 		return nil, errors.Errorf("no subnet exists for provided topic: %s", topic)
 	}
@@ -276,6 +281,21 @@ func (s *Service) filterPeerForBlobSubnet() func(_ *enode.Node) bool {
 	}
 }
 
+// returns a method with filters peers specifically for a particular data column subnet.
+// Peers are candidates if one of the columns they custody is gossiped on the subnet.
+func (s *Service) filterPeerForDataColumnsSubnet(index uint64) func(node *enode.Node) bool {
+	return func(node *enode.Node) bool {
+		if !s.filterPeer(node) {
+			return false
+		}
+		subnets, err := dataColumnSubnets(node.ID(), node.Record())
+		if err != nil {
+			return false
+		}
+		return subnets[index]
+	}
+}
+
 // lower threshold to broadcast object compared to searching
 // for a subnet. So that even in the event of poor peer
 // connectivity, we can still broadcast an attestation.
@@ -417,6 +437,51 @@ func initializeSyncCommSubnets(node *enode.LocalNode) *enode.LocalNode {
 	return node
 }
 
+// Creates a new ENR entry with the custody group count of the beacon node.
+func initializeCustodyGroupCount(node *enode.LocalNode, custodyGroupCount uint64) *enode.LocalNode {
+	entry := enr.WithEntry(custodyGroupCountEnrKey, custodyGroupCount)
+	node.Set(entry)
+	return node
+}
+
+// Reads the custody group count entry from a node's ENR. Nodes which do not
+// advertise it custody the minimum number of groups.
+func custodyGroupCount(record *enr.Record) (uint64, error) {
+	var count uint64
+	if err := record.Load(enr.WithEntry(custodyGroupCountEnrKey, &count)); err != nil {
+		if enr.IsNotFound(err) {
+			return params.BeaconConfig().CustodyRequirement, nil
+		}
+		return 0, err
+	}
+	if count > params.BeaconConfig().NumberOfCustodyGroups {
+		return 0, errors.Errorf("invalid custody group count %d", count)
+	}
+	return count, nil
+}
+
+// Determines the data column subnets a node is subscribed to from the
+// custody group count in its ENR and its node ID.
+func dataColumnSubnets(nodeID enode.ID, record *enr.Record) (map[uint64]bool, error) {
+	count, err := custodyGroupCount(record)
+	if err != nil {
+		return nil, err
+	}
+	groups, err := peerdas.CustodyGroups(nodeID, count)
+	if err != nil {
+		return nil, err
+	}
+	columns, err := peerdas.CustodyColumns(groups)
+	if err != nil {
+		return nil, err
+	}
+	subnets := make(map[uint64]bool)
+	for column := range columns {
+		subnets[peerdas.ComputeSubnetForDataColumnSidecar(column)] = true
+	}
+	return subnets, nil
+}
+
 // Reads the attestation subnets entry from a node's ENR and determines
 // the committee indices of the attestation subnets the node is subscribed to.
 func attSubnets(record *enr.Record) (map[uint64]bool, error) {
@@ -503,6 +503,34 @@ func Test_SyncSubnets(t *testing.T) {
 	}
 }
 
+func Test_DataColumnSubnets(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	db, err := enode.OpenDB("")
+	require.NoError(t, err)
+	defer db.Close()
+	priv, _, err := crypto.GenerateSecp256k1Key(rand.Reader)
+	require.NoError(t, err)
+	convertedKey, err := ecdsaprysm.ConvertFromInterfacePrivKey(priv)
+	require.NoError(t, err)
+	localNode := enode.NewLocalNode(db, convertedKey)
+	cfg := params.BeaconConfig()
+
+	// Without the entry, the node custodies the minimum number of groups.
+	subnets, err := dataColumnSubnets(localNode.ID(), localNode.Node().Record())
+	require.NoError(t, err)
+	columnsPerGroup := cfg.NumberOfColumns / cfg.NumberOfCustodyGroups
+	assert.Equal(t, min(cfg.CustodyRequirement*columnsPerGroup, cfg.DataColumnSidecarSubnetCount), uint64(len(subnets)))
+
+	localNode = initializeCustodyGroupCount(localNode, cfg.NumberOfCustodyGroups)
+	subnets, err = dataColumnSubnets(localNode.ID(), localNode.Node().Record())
+	require.NoError(t, err)
+	assert.Equal(t, cfg.DataColumnSidecarSubnetCount, uint64(len(subnets)))
+
+	localNode = initializeCustodyGroupCount(localNode, cfg.NumberOfCustodyGroups+1)
+	_, err = dataColumnSubnets(localNode.ID(), localNode.Node().Record())
+	assert.ErrorContains(t, "invalid custody group count", err)
+}
+
 func TestSubnetComputation(t *testing.T) {
 	db, err := enode.OpenDB("")
 	assert.NoError(t, err)
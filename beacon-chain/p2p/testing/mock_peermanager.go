@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 
+	"github.com/ethereum/go-ethereum/p2p/enode"
 	"github.com/ethereum/go-ethereum/p2p/enr"
 	"github.com/libp2p/go-libp2p/core/host"
 	"github.com/libp2p/go-libp2p/core/peer"
@@ -13,6 +14,7 @@ import (
 // MockPeerManager is mock of the PeerManager interface.
 type MockPeerManager struct {
 	Enr               *enr.Record
+	Nid               enode.ID
 	PID               peer.ID
 	BHost             host.Host
 	DiscoveryAddr     []multiaddr.Multiaddr
@@ -39,6 +41,11 @@ func (m *MockPeerManager) ENR() *enr.Record {
 	return m.Enr
 }
 
+// NodeID .
+func (m *MockPeerManager) NodeID() enode.ID {
+	return m.Nid
+}
+
 // DiscoveryAddresses .
 func (m *MockPeerManager) DiscoveryAddresses() ([]multiaddr.Multiaddr, error) {
 	if m.FailDiscoveryAddr {
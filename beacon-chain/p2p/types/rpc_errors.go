@@ -15,4 +15,7 @@ var (
 	ErrBlobLTMinRequest    = errors.New("blob slot < minimum_request_epoch")
 	ErrMaxBlobReqExceeded  = errors.New("requested more than MAX_REQUEST_BLOB_SIDECARS")
 	ErrResourceUnavailable = errors.New("resource requested unavailable")
+
+	ErrDataColumnLTMinRequest   = errors.New("data column slot < minimum_request_epoch")
+	ErrMaxDataColumnReqExceeded = errors.New("requested more than MAX_REQUEST_DATA_COLUMN_SIDECARS")
 )
@@ -2,6 +2,7 @@ package types
 
 import (
 	"encoding/hex"
+	"sort"
 	"testing"
 
 	ssz "github.com/prysmaticlabs/fastssz"
@@ -80,6 +81,71 @@ func TestBlobSidecarsByRootReq_MarshalSSZ(t *testing.T) {
 	}
 }
 
+func TestDataColumnSidecarsByRootReq_MarshalSSZ(t *testing.T) {
+	ids := make([]*eth.DataColumnIdentifier, 10)
+	for i := range ids {
+		ids[i] = &eth.DataColumnIdentifier{
+			BlockRoot:   bytesutil.PadTo([]byte{byte(i)}, 32),
+			ColumnIndex: uint64(i),
+		}
+	}
+	r := DataColumnSidecarsByRootReq(ids)
+	by, err := r.MarshalSSZ()
+	require.NoError(t, err)
+	got := &DataColumnSidecarsByRootReq{}
+	require.NoError(t, got.UnmarshalSSZ(by))
+	for i, gid := range *got {
+		require.DeepEqual(t, ids[i], gid)
+	}
+	require.ErrorIs(t, got.UnmarshalSSZ(append(by, byte(0))), ssz.ErrIncorrectByteSize)
+
+	tooMany := make(DataColumnSidecarsByRootReq, params.BeaconConfig().MaxRequestDataColumnSidecars+1)
+	for i := range tooMany {
+		tooMany[i] = ids[0]
+	}
+	_, err = tooMany.MarshalSSZ()
+	require.ErrorContains(t, "data column sidecars by root request exceeds max size", err)
+}
+
+func TestDataColumnSidecarsByRootReq_Sort(t *testing.T) {
+	zero := bytesutil.PadTo([]byte{0}, 32)
+	one := bytesutil.PadTo([]byte{1}, 32)
+	r := DataColumnSidecarsByRootReq{
+		{BlockRoot: one, ColumnIndex: 0},
+		{BlockRoot: zero, ColumnIndex: 5},
+		{BlockRoot: zero, ColumnIndex: 2},
+	}
+	sort.Sort(r)
+	require.DeepEqual(t, zero, r[0].BlockRoot)
+	require.Equal(t, uint64(2), r[0].ColumnIndex)
+	require.Equal(t, uint64(5), r[1].ColumnIndex)
+	require.DeepEqual(t, one, r[2].BlockRoot)
+}
+
+func TestLightClientBootstrapReq_MarshalSSZ(t *testing.T) {
+	req := LightClientBootstrapReq(bytesutil.ToBytes32([]byte("root")))
+	by, err := req.MarshalSSZ()
+	require.NoError(t, err)
+	require.Equal(t, req.SizeSSZ(), len(by))
+
+	var got LightClientBootstrapReq
+	require.NoError(t, got.UnmarshalSSZ(by))
+	require.Equal(t, req, got)
+	require.ErrorIs(t, got.UnmarshalSSZ(by[1:]), ssz.ErrIncorrectByteSize)
+}
+
+func TestLightClientUpdatesByRangeReq_MarshalSSZ(t *testing.T) {
+	req := &LightClientUpdatesByRangeReq{StartPeriod: 5, Count: 12}
+	by, err := req.MarshalSSZ()
+	require.NoError(t, err)
+	require.Equal(t, "05000000000000000c00000000000000", hex.EncodeToString(by))
+
+	got := &LightClientUpdatesByRangeReq{}
+	require.NoError(t, got.UnmarshalSSZ(by))
+	require.DeepEqual(t, req, got)
+	require.ErrorIs(t, got.UnmarshalSSZ(append(by, 0)), ssz.ErrIncorrectByteSize)
+}
+
 func TestBeaconBlockByRootsReq_Limit(t *testing.T) {
 	fixedRoots := make([][32]byte, 0)
 	for i := uint64(0); i < params.BeaconConfig().MaxRequestBlocks+100; i++ {
@@ -121,22 +121,29 @@ func privKeyFromFile(path string) (*ecdsa.PrivateKey, error) {
 }
 
 // Retrieves node p2p metadata from a set of configuration values
-// from the p2p service.
+// from the p2p service. Without a data directory, the default metadata
+// is neither read from nor written to the working directory.
 // TODO: Figure out how to do a v1/v2 check.
 func metaDataFromConfig(cfg *Config) (metadata.Metadata, error) {
 	defaultKeyPath := path.Join(cfg.DataDir, metaDataPath)
 	metaDataPath := cfg.MetaDataDir
 
-	_, err := os.Stat(defaultKeyPath)
-	defaultMetadataExist := !os.IsNotExist(err)
-	if err != nil && defaultMetadataExist {
-		return nil, err
+	defaultMetadataExist := false
+	if cfg.DataDir != "" {
+		_, err := os.Stat(defaultKeyPath)
+		defaultMetadataExist = !os.IsNotExist(err)
+		if err != nil && defaultMetadataExist {
+			return nil, err
+		}
 	}
 	if metaDataPath == "" && !defaultMetadataExist {
 		metaData := &pb.MetaDataV0{
 			SeqNumber: 0,
 			Attnets:   bitfield.NewBitvector64(),
 		}
+		if cfg.DataDir == "" {
+			return wrapper.WrappedMetadataV0(metaData), nil
+		}
 		dst, err := proto.Marshal(metaData)
 		if err != nil {
 			return nil, err
@@ -2,6 +2,8 @@ package p2p
 
 import (
 	"fmt"
+	"os"
+	"path"
 	"testing"
 
 	"github.com/ethereum/go-ethereum/crypto"
@@ -81,3 +83,21 @@ func TestConvertPeerIDToNodeID(t *testing.T) {
 	actualNodeIDStr := actualNodeID.String()
 	require.Equal(t, expectedNodeIDStr, actualNodeIDStr)
 }
+
+func TestMetaDataFromConfig(t *testing.T) {
+	t.Run("no data dir", func(t *testing.T) {
+		md, err := metaDataFromConfig(&Config{})
+		require.NoError(t, err)
+		assert.Equal(t, uint64(0), md.SequenceNumber())
+		// Nothing is written to the working directory.
+		_, err = os.Stat(metaDataPath)
+		require.Equal(t, true, os.IsNotExist(err))
+	})
+	t.Run("data dir", func(t *testing.T) {
+		dataDir := t.TempDir()
+		_, err := metaDataFromConfig(&Config{DataDir: dataDir})
+		require.NoError(t, err)
+		_, err = os.Stat(path.Join(dataDir, metaDataPath))
+		require.NoError(t, err)
+	})
+}
@@ -8,6 +8,7 @@ import (
 	"strconv"
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/prysmaticlabs/prysm/v5/api"
 	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/altair"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/eth/helpers"
@@ -17,9 +18,11 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/config/params"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v5/encoding/ssz"
 	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing/trace"
 	"github.com/prysmaticlabs/prysm/v5/network/httputil"
 	ethpbalpha "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/runtime/version"
 	"github.com/prysmaticlabs/prysm/v5/time/slots"
 )
 
@@ -333,3 +336,158 @@ func (s *Server) stateForSyncCommittee(ctx context.Context, w http.ResponseWrite
 	}
 	return st, true
 }
+
+// GetPendingDeposits returns the pending deposits queue of the state with the given 'stateId'.
+// The queue only exists from the Electra fork onward, so requests for earlier states result in a 400.
+func (s *Server) GetPendingDeposits(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "beacon.GetPendingDeposits")
+	defer span.End()
+
+	st, ok := s.electraStateFromRequest(ctx, w, r)
+	if !ok {
+		return
+	}
+	deposits, err := st.PendingDeposits()
+	if err != nil {
+		httputil.HandleError(w, "Could not get pending deposits: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set(api.VersionHeader, version.String(st.Version()))
+	if httputil.RespondWithSsz(r) {
+		sszData, err := ssz.MarshalFixedList(deposits)
+		if err != nil {
+			httputil.HandleError(w, "Could not marshal pending deposits into SSZ: "+err.Error(), http.StatusInternalServerError)
+			return
+		}
+		httputil.WriteSsz(w, sszData, "pending_deposits.ssz")
+		return
+	}
+
+	isOptimistic, isFinalized, ok := s.stateMetadata(ctx, w, []byte(r.PathValue("state_id")), st)
+	if !ok {
+		return
+	}
+	resp := &structs.GetPendingDepositsResponse{
+		Version:             version.String(st.Version()),
+		ExecutionOptimistic: isOptimistic,
+		Finalized:           isFinalized,
+		Data:                structs.PendingDepositsFromConsensus(deposits),
+	}
+	httputil.WriteJson(w, resp)
+}
+
+// GetPendingPartialWithdrawals returns the pending partial withdrawals queue of the state with the given 'stateId'.
+// The queue only exists from the Electra fork onward, so requests for earlier states result in a 400.
+func (s *Server) GetPendingPartialWithdrawals(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "beacon.GetPendingPartialWithdrawals")
+	defer span.End()
+
+	st, ok := s.electraStateFromRequest(ctx, w, r)
+	if !ok {
+		return
+	}
+	withdrawals, err := st.PendingPartialWithdrawals()
+	if err != nil {
+		httputil.HandleError(w, "Could not get pending partial withdrawals: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set(api.VersionHeader, version.String(st.Version()))
+	if httputil.RespondWithSsz(r) {
+		sszData, err := ssz.MarshalFixedList(withdrawals)
+		if err != nil {
+			httputil.HandleError(w, "Could not marshal pending partial withdrawals into SSZ: "+err.Error(), http.StatusInternalServerError)
+			return
+		}
+		httputil.WriteSsz(w, sszData, "pending_partial_withdrawals.ssz")
+		return
+	}
+
+	isOptimistic, isFinalized, ok := s.stateMetadata(ctx, w, []byte(r.PathValue("state_id")), st)
+	if !ok {
+		return
+	}
+	resp := &structs.GetPendingPartialWithdrawalsResponse{
+		Version:             version.String(st.Version()),
+		ExecutionOptimistic: isOptimistic,
+		Finalized:           isFinalized,
+		Data:                structs.PendingPartialWithdrawalsFromConsensus(withdrawals),
+	}
+	httputil.WriteJson(w, resp)
+}
+
+// GetPendingConsolidations returns the pending consolidations queue of the state with the given 'stateId'.
+// The queue only exists from the Electra fork onward, so requests for earlier states result in a 400.
+func (s *Server) GetPendingConsolidations(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "beacon.GetPendingConsolidations")
+	defer span.End()
+
+	st, ok := s.electraStateFromRequest(ctx, w, r)
+	if !ok {
+		return
+	}
+	consolidations, err := st.PendingConsolidations()
+	if err != nil {
+		httputil.HandleError(w, "Could not get pending consolidations: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set(api.VersionHeader, version.String(st.Version()))
+	if httputil.RespondWithSsz(r) {
+		sszData, err := ssz.MarshalFixedList(consolidations)
+		if err != nil {
+			httputil.HandleError(w, "Could not marshal pending consolidations into SSZ: "+err.Error(), http.StatusInternalServerError)
+			return
+		}
+		httputil.WriteSsz(w, sszData, "pending_consolidations.ssz")
+		return
+	}
+
+	isOptimistic, isFinalized, ok := s.stateMetadata(ctx, w, []byte(r.PathValue("state_id")), st)
+	if !ok {
+		return
+	}
+	resp := &structs.GetPendingConsolidationsResponse{
+		Version:             version.String(st.Version()),
+		ExecutionOptimistic: isOptimistic,
+		Finalized:           isFinalized,
+		Data:                structs.PendingConsolidationsFromConsensus(consolidations),
+	}
+	httputil.WriteJson(w, resp)
+}
+
+// electraStateFromRequest fetches the state referenced by the request's 'state_id' path value
+// and makes sure it is at least at the Electra fork.
+func (s *Server) electraStateFromRequest(ctx context.Context, w http.ResponseWriter, r *http.Request) (state.BeaconState, bool) {
+	stateId := r.PathValue("state_id")
+	if stateId == "" {
+		httputil.HandleError(w, "state_id is required in URL params", http.StatusBadRequest)
+		return nil, false
+	}
+	st, err := s.Stater.State(ctx, []byte(stateId))
+	if err != nil {
+		shared.WriteStateFetchError(w, err)
+		return nil, false
+	}
+	if st.Version() < version.Electra {
+		httputil.HandleError(w, "State is prior to the Electra fork: "+version.String(st.Version()), http.StatusBadRequest)
+		return nil, false
+	}
+	return st, true
+}
+
+// stateMetadata returns the execution_optimistic and finalized flags for the given state.
+func (s *Server) stateMetadata(ctx context.Context, w http.ResponseWriter, stateId []byte, st state.BeaconState) (bool, bool, bool) {
+	isOptimistic, err := helpers.IsOptimistic(ctx, stateId, s.OptimisticModeFetcher, s.Stater, s.ChainInfoFetcher, s.BeaconDB)
+	if err != nil {
+		httputil.HandleError(w, "Could not check optimistic status: "+err.Error(), http.StatusInternalServerError)
+		return false, false, false
+	}
+	blockRoot, err := st.LatestBlockHeader().HashTreeRoot()
+	if err != nil {
+		httputil.HandleError(w, "Could not calculate root of latest block header: "+err.Error(), http.StatusInternalServerError)
+		return false, false, false
+	}
+	return isOptimistic, s.FinalizationFetcher.IsFinalized(ctx, blockRoot), true
+}
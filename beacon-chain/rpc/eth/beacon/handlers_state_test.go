@@ -12,6 +12,7 @@ import (
 	"time"
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/prysmaticlabs/prysm/v5/api"
 	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
 	chainMock "github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain/testing"
 	dbTest "github.com/prysmaticlabs/prysm/v5/beacon-chain/db/testing"
@@ -647,3 +648,178 @@ func TestGetSyncCommittees_Future(t *testing.T) {
 		}
 	}
 }
+
+func TestGetPendingDeposits(t *testing.T) {
+	st, err := util.NewBeaconStateElectra()
+	require.NoError(t, err)
+	deposits := []*ethpbalpha.PendingDeposit{
+		{
+			PublicKey:             bytesutil.PadTo([]byte("pubkey1"), 48),
+			WithdrawalCredentials: bytesutil.PadTo([]byte("creds1"), 32),
+			Amount:                32,
+			Signature:             bytesutil.PadTo([]byte("sig1"), 96),
+			Slot:                  1,
+		},
+		{
+			PublicKey:             bytesutil.PadTo([]byte("pubkey2"), 48),
+			WithdrawalCredentials: bytesutil.PadTo([]byte("creds2"), 32),
+			Amount:                64,
+			Signature:             bytesutil.PadTo([]byte("sig2"), 96),
+			Slot:                  2,
+		},
+	}
+	require.NoError(t, st.SetPendingDeposits(deposits))
+
+	chainService := &chainMock.ChainService{}
+	s := &Server{
+		Stater:                &testutil.MockStater{BeaconState: st},
+		OptimisticModeFetcher: chainService,
+		FinalizationFetcher:   chainService,
+		BeaconDB:              dbTest.SetupDB(t),
+	}
+
+	t.Run("json", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/eth/v1/beacon/states/{state_id}/pending_deposits", nil)
+		request.SetPathValue("state_id", "head")
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetPendingDeposits(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		assert.Equal(t, "electra", writer.Header().Get(api.VersionHeader))
+		resp := &structs.GetPendingDepositsResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		assert.Equal(t, "electra", resp.Version)
+		require.Equal(t, 2, len(resp.Data))
+		assert.Equal(t, hexutil.Encode(deposits[0].PublicKey), resp.Data[0].Pubkey)
+		assert.Equal(t, "64", resp.Data[1].Amount)
+		assert.Equal(t, "2", resp.Data[1].Slot)
+	})
+	t.Run("ssz", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/eth/v1/beacon/states/{state_id}/pending_deposits", nil)
+		request.SetPathValue("state_id", "head")
+		request.Header.Set("Accept", api.OctetStreamMediaType)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetPendingDeposits(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		assert.Equal(t, "electra", writer.Header().Get(api.VersionHeader))
+		first, err := deposits[0].MarshalSSZ()
+		require.NoError(t, err)
+		second, err := deposits[1].MarshalSSZ()
+		require.NoError(t, err)
+		assert.DeepEqual(t, append(first, second...), writer.Body.Bytes())
+	})
+	t.Run("pre-electra state", func(t *testing.T) {
+		denebSt, err := util.NewBeaconStateDeneb()
+		require.NoError(t, err)
+		s := &Server{Stater: &testutil.MockStater{BeaconState: denebSt}}
+
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/eth/v1/beacon/states/{state_id}/pending_deposits", nil)
+		request.SetPathValue("state_id", "head")
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetPendingDeposits(writer, request)
+		require.Equal(t, http.StatusBadRequest, writer.Code)
+		e := &httputil.DefaultJsonError{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+		assert.StringContains(t, "prior to the Electra fork", e.Message)
+	})
+	t.Run("no state_id", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/eth/v1/beacon/states/{state_id}/pending_deposits", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetPendingDeposits(writer, request)
+		require.Equal(t, http.StatusBadRequest, writer.Code)
+	})
+}
+
+func TestGetPendingPartialWithdrawals(t *testing.T) {
+	st, err := util.NewBeaconStateElectra()
+	require.NoError(t, err)
+	withdrawal := &ethpbalpha.PendingPartialWithdrawal{Index: 3, Amount: 100, WithdrawableEpoch: 5}
+	require.NoError(t, st.AppendPendingPartialWithdrawal(withdrawal))
+
+	chainService := &chainMock.ChainService{}
+	s := &Server{
+		Stater:                &testutil.MockStater{BeaconState: st},
+		OptimisticModeFetcher: chainService,
+		FinalizationFetcher:   chainService,
+		BeaconDB:              dbTest.SetupDB(t),
+	}
+
+	t.Run("json", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/eth/v1/beacon/states/{state_id}/pending_partial_withdrawals", nil)
+		request.SetPathValue("state_id", "head")
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetPendingPartialWithdrawals(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &structs.GetPendingPartialWithdrawalsResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		require.Equal(t, 1, len(resp.Data))
+		assert.Equal(t, "3", resp.Data[0].Index)
+		assert.Equal(t, "100", resp.Data[0].Amount)
+		assert.Equal(t, "5", resp.Data[0].WithdrawableEpoch)
+	})
+	t.Run("ssz", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/eth/v1/beacon/states/{state_id}/pending_partial_withdrawals", nil)
+		request.SetPathValue("state_id", "head")
+		request.Header.Set("Accept", api.OctetStreamMediaType)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetPendingPartialWithdrawals(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		expected, err := withdrawal.MarshalSSZ()
+		require.NoError(t, err)
+		assert.DeepEqual(t, expected, writer.Body.Bytes())
+	})
+}
+
+func TestGetPendingConsolidations(t *testing.T) {
+	st, err := util.NewBeaconStateElectra()
+	require.NoError(t, err)
+	consolidation := &ethpbalpha.PendingConsolidation{SourceIndex: 1, TargetIndex: 2}
+	require.NoError(t, st.AppendPendingConsolidation(consolidation))
+
+	chainService := &chainMock.ChainService{}
+	s := &Server{
+		Stater:                &testutil.MockStater{BeaconState: st},
+		OptimisticModeFetcher: chainService,
+		FinalizationFetcher:   chainService,
+		BeaconDB:              dbTest.SetupDB(t),
+	}
+
+	t.Run("json", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/eth/v1/beacon/states/{state_id}/pending_consolidations", nil)
+		request.SetPathValue("state_id", "head")
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetPendingConsolidations(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &structs.GetPendingConsolidationsResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		require.Equal(t, 1, len(resp.Data))
+		assert.Equal(t, "1", resp.Data[0].SourceIndex)
+		assert.Equal(t, "2", resp.Data[0].TargetIndex)
+	})
+	t.Run("ssz", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/eth/v1/beacon/states/{state_id}/pending_consolidations", nil)
+		request.SetPathValue("state_id", "head")
+		request.Header.Set("Accept", api.OctetStreamMediaType)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetPendingConsolidations(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		expected, err := consolidation.MarshalSSZ()
+		require.NoError(t, err)
+		assert.DeepEqual(t, expected, writer.Body.Bytes())
+	})
+}
@@ -36,6 +36,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v5/crypto/bls"
 	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v5/encoding/ssz"
 	"github.com/prysmaticlabs/prysm/v5/network/httputil"
 	eth "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v5/runtime/version"
@@ -3915,6 +3916,26 @@ func TestGetCommittees(t *testing.T) {
 			assert.Equal(t, epoch, slots.ToEpoch(primitives.Slot(slot)))
 		}
 	})
+	t.Run("Head all committees ssz", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, url, nil)
+		request.SetPathValue("state_id", "head")
+		request.Header.Set("Accept", api.OctetStreamMediaType)
+		writer := httptest.NewRecorder()
+
+		writer.Body = &bytes.Buffer{}
+		s.GetCommittees(writer, request)
+		assert.Equal(t, http.StatusOK, writer.Code)
+		assert.Equal(t, api.OctetStreamMediaType, writer.Header().Get("Content-Type"))
+		assert.Equal(t, version.String(version.Phase0), writer.Header().Get(api.VersionHeader))
+		resp, err := ssz.UnmarshalVariableList[structs.CommitteeSsz](writer.Body.Bytes())
+		require.NoError(t, err)
+		assert.Equal(t, int(params.BeaconConfig().SlotsPerEpoch)*2, len(resp))
+		for _, committee := range resp {
+			assert.Equal(t, true, committee.Index == 0 || committee.Index == 1)
+			assert.Equal(t, epoch, slots.ToEpoch(primitives.Slot(committee.Slot)))
+			assert.NotEqual(t, 0, len(committee.Validators))
+		}
+	})
 	t.Run("Head all committees of epoch 10", func(t *testing.T) {
 		query := url + "?epoch=10"
 		request := httptest.NewRequest(http.MethodGet, query, nil)
@@ -10,6 +10,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/api"
 	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/eth/helpers"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/eth/shared"
@@ -19,8 +20,10 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/validator"
 	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v5/encoding/ssz"
 	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing/trace"
 	"github.com/prysmaticlabs/prysm/v5/network/httputil"
+	"github.com/prysmaticlabs/prysm/v5/runtime/version"
 	"github.com/prysmaticlabs/prysm/v5/time/slots"
 )
 
@@ -84,12 +87,7 @@ func (s *Server) GetValidators(w http.ResponseWriter, r *http.Request) {
 	}
 	// return no data if all IDs are ignored
 	if len(rawIds) > 0 && len(ids) == 0 {
-		resp := &structs.GetValidatorsResponse{
-			Data:                []*structs.ValidatorContainer{},
-			ExecutionOptimistic: isOptimistic,
-			Finalized:           isFinalized,
-		}
-		httputil.WriteJson(w, resp)
+		writeValidators(w, r, st, []*structs.ValidatorContainer{}, isOptimistic, isFinalized)
 		return
 	}
 
@@ -119,12 +117,7 @@ func (s *Server) GetValidators(w http.ResponseWriter, r *http.Request) {
 			}
 			containers[i] = valContainerFromReadOnlyVal(val, id, balance, valStatus)
 		}
-		resp := &structs.GetValidatorsResponse{
-			Data:                containers,
-			ExecutionOptimistic: isOptimistic,
-			Finalized:           isFinalized,
-		}
-		httputil.WriteJson(w, resp)
+		writeValidators(w, r, st, containers, isOptimistic, isFinalized)
 		return
 	}
 
@@ -165,8 +158,24 @@ func (s *Server) GetValidators(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
+	writeValidators(w, r, st, valContainers, isOptimistic, isFinalized)
+}
+
+// writeValidators writes the validators as an SSZ list or as JSON, depending on what the client accepts.
+func writeValidators(
+	w http.ResponseWriter,
+	r *http.Request,
+	st state.BeaconState,
+	containers []*structs.ValidatorContainer,
+	isOptimistic, isFinalized bool,
+) {
+	w.Header().Set(api.VersionHeader, version.String(st.Version()))
+	if httputil.RespondWithSsz(r) {
+		shared.WriteSszList(w, containers, ssz.MarshalFixedList[*structs.ValidatorContainerSsz], "validators.ssz")
+		return
+	}
 	resp := &structs.GetValidatorsResponse{
-		Data:                valContainers,
+		Data:                containers,
 		ExecutionOptimistic: isOptimistic,
 		Finalized:           isFinalized,
 	}
@@ -230,6 +239,21 @@ func (s *Server) GetValidator(w http.ResponseWriter, r *http.Request) {
 	}
 	isFinalized := s.FinalizationFetcher.IsFinalized(ctx, blockRoot)
 
+	w.Header().Set(api.VersionHeader, version.String(st.Version()))
+	if httputil.RespondWithSsz(r) {
+		sszContainer, err := container.ToSsz()
+		if err != nil {
+			httputil.HandleError(w, "Could not convert validator to SSZ: "+err.Error(), http.StatusInternalServerError)
+			return
+		}
+		sszData, err := sszContainer.MarshalSSZ()
+		if err != nil {
+			httputil.HandleError(w, "Could not marshal validator to SSZ: "+err.Error(), http.StatusInternalServerError)
+			return
+		}
+		httputil.WriteSsz(w, sszData, "validator.ssz")
+		return
+	}
 	resp := &structs.GetValidatorResponse{
 		Data:                container,
 		ExecutionOptimistic: isOptimistic,
@@ -287,12 +311,7 @@ func (s *Server) GetValidatorBalances(w http.ResponseWriter, r *http.Request) {
 	}
 	// return no data if all IDs are ignored
 	if len(rawIds) > 0 && len(ids) == 0 {
-		resp := &structs.GetValidatorBalancesResponse{
-			Data:                []*structs.ValidatorBalance{},
-			ExecutionOptimistic: isOptimistic,
-			Finalized:           isFinalized,
-		}
-		httputil.WriteJson(w, resp)
+		writeValidatorBalances(w, r, st, []*structs.ValidatorBalance{}, isOptimistic, isFinalized)
 		return
 	}
 
@@ -316,8 +335,24 @@ func (s *Server) GetValidatorBalances(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
+	writeValidatorBalances(w, r, st, valBalances, isOptimistic, isFinalized)
+}
+
+// writeValidatorBalances writes the balances as an SSZ list or as JSON, depending on what the client accepts.
+func writeValidatorBalances(
+	w http.ResponseWriter,
+	r *http.Request,
+	st state.BeaconState,
+	balances []*structs.ValidatorBalance,
+	isOptimistic, isFinalized bool,
+) {
+	w.Header().Set(api.VersionHeader, version.String(st.Version()))
+	if httputil.RespondWithSsz(r) {
+		shared.WriteSszList(w, balances, ssz.MarshalFixedList[*structs.ValidatorBalanceSsz], "validator_balances.ssz")
+		return
+	}
 	resp := &structs.GetValidatorBalancesResponse{
-		Data:                valBalances,
+		Data:                balances,
 		ExecutionOptimistic: isOptimistic,
 		Finalized:           isFinalized,
 	}
@@ -11,6 +11,7 @@ import (
 	"testing"
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/prysmaticlabs/prysm/v5/api"
 	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
 	chainMock "github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain/testing"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/lookup"
@@ -19,8 +20,12 @@ import (
 	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
 	"github.com/prysmaticlabs/prysm/v5/config/params"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/validator"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v5/encoding/ssz"
 	"github.com/prysmaticlabs/prysm/v5/network/httputil"
 	eth "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/runtime/version"
 	"github.com/prysmaticlabs/prysm/v5/testing/assert"
 	"github.com/prysmaticlabs/prysm/v5/testing/require"
 	"github.com/prysmaticlabs/prysm/v5/testing/util"
@@ -69,6 +74,39 @@ func TestGetValidators(t *testing.T) {
 		assert.Equal(t, "18446744073709551615", val.Validator.ExitEpoch)
 		assert.Equal(t, "18446744073709551615", val.Validator.WithdrawableEpoch)
 	})
+	t.Run("ssz", func(t *testing.T) {
+		chainService := &chainMock.ChainService{}
+		s := Server{
+			Stater: &testutil.MockStater{
+				BeaconState: st,
+			},
+			HeadFetcher:           chainService,
+			OptimisticModeFetcher: chainService,
+			FinalizationFetcher:   chainService,
+		}
+
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/eth/v1/beacon/states/{state_id}/validators", nil)
+		request.SetPathValue("state_id", "head")
+		request.Header.Set("Accept", api.OctetStreamMediaType)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetValidators(writer, request)
+		assert.Equal(t, http.StatusOK, writer.Code)
+		assert.Equal(t, api.OctetStreamMediaType, writer.Header().Get("Content-Type"))
+		assert.Equal(t, version.String(version.Phase0), writer.Header().Get(api.VersionHeader))
+		resp, err := ssz.UnmarshalFixedList[structs.ValidatorContainerSsz](writer.Body.Bytes())
+		require.NoError(t, err)
+		require.Equal(t, 4, len(resp))
+		assert.Equal(t, uint64(0), resp[0].Index)
+		assert.Equal(t, uint64(32000000000), resp[0].Balance)
+		assert.Equal(t, uint8(validator.ActiveOngoing), resp[0].Status)
+		assert.DeepEqual(t, vals[0].PublicKey, resp[0].Pubkey)
+		assert.DeepEqual(t, vals[0].WithdrawalCredentials, resp[0].WithdrawalCredentials)
+		assert.Equal(t, uint64(exitedValIndex), resp[exitedValIndex].Index)
+		assert.Equal(t, uint64(0), resp[exitedValIndex].ExitEpoch)
+		assert.Equal(t, uint64(params.BeaconConfig().FarFutureEpoch), resp[0].WithdrawableEpoch)
+	})
 	t.Run("get by index", func(t *testing.T) {
 		chainService := &chainMock.ChainService{}
 		s := Server{
@@ -675,6 +713,34 @@ func TestGetValidator(t *testing.T) {
 		assert.Equal(t, "18446744073709551615", resp.Data.Validator.ExitEpoch)
 		assert.Equal(t, "18446744073709551615", resp.Data.Validator.WithdrawableEpoch)
 	})
+	t.Run("ssz", func(t *testing.T) {
+		chainService := &chainMock.ChainService{}
+		s := Server{
+			Stater: &testutil.MockStater{
+				BeaconState: st,
+			},
+			HeadFetcher:           chainService,
+			OptimisticModeFetcher: chainService,
+			FinalizationFetcher:   chainService,
+		}
+
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/eth/v1/beacon/states/{state_id}/validators/{validator_id}", nil)
+		request.SetPathValue("state_id", "head")
+		request.SetPathValue("validator_id", "1")
+		request.Header.Set("Accept", api.OctetStreamMediaType)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetValidator(writer, request)
+		assert.Equal(t, http.StatusOK, writer.Code)
+		assert.Equal(t, api.OctetStreamMediaType, writer.Header().Get("Content-Type"))
+		resp := &structs.ValidatorContainerSsz{}
+		require.NoError(t, resp.UnmarshalSSZ(writer.Body.Bytes()))
+		assert.Equal(t, uint64(1), resp.Index)
+		assert.Equal(t, uint64(32000000000), resp.Balance)
+		assert.Equal(t, uint8(validator.ActiveOngoing), resp.Status)
+		assert.DeepEqual(t, st.PubkeyAtIndex(1), bytesutil.ToBytes48(resp.Pubkey))
+	})
 	t.Run("get by pubkey", func(t *testing.T) {
 		chainService := &chainMock.ChainService{}
 		s := Server{
@@ -871,6 +937,34 @@ func TestGetValidatorBalances(t *testing.T) {
 		assert.Equal(t, "3", val.Index)
 		assert.Equal(t, "3", val.Balance)
 	})
+	t.Run("ssz", func(t *testing.T) {
+		chainService := &chainMock.ChainService{}
+		s := Server{
+			Stater: &testutil.MockStater{
+				BeaconState: st,
+			},
+			HeadFetcher:           chainService,
+			OptimisticModeFetcher: chainService,
+			FinalizationFetcher:   chainService,
+		}
+
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/eth/v1/beacon/states/{state_id}/validator_balances?id=1&id=3", nil)
+		request.SetPathValue("state_id", "head")
+		request.Header.Set("Accept", api.OctetStreamMediaType)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetValidatorBalances(writer, request)
+		assert.Equal(t, http.StatusOK, writer.Code)
+		assert.Equal(t, api.OctetStreamMediaType, writer.Header().Get("Content-Type"))
+		resp, err := ssz.UnmarshalFixedList[structs.ValidatorBalanceSsz](writer.Body.Bytes())
+		require.NoError(t, err)
+		require.Equal(t, 2, len(resp))
+		assert.Equal(t, uint64(1), resp[0].Index)
+		assert.Equal(t, uint64(1), resp[0].Balance)
+		assert.Equal(t, uint64(3), resp[1].Index)
+		assert.Equal(t, uint64(3), resp[1].Balance)
+	})
 	t.Run("get by index", func(t *testing.T) {
 		chainService := &chainMock.ChainService{}
 		s := Server{
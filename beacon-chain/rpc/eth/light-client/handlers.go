@@ -2,6 +2,7 @@ package lightclient
 
 import (
 	"context"
+	"encoding/binary"
 	"fmt"
 	"math"
 	"net/http"
@@ -10,13 +11,16 @@ import (
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/v5/api"
 	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	lightclient "github.com/prysmaticlabs/prysm/v5/beacon-chain/core/light-client"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/eth/shared"
 	"github.com/prysmaticlabs/prysm/v5/config/features"
 	"github.com/prysmaticlabs/prysm/v5/config/params"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/interfaces"
 	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing/trace"
+	"github.com/prysmaticlabs/prysm/v5/network/forks"
 	"github.com/prysmaticlabs/prysm/v5/network/httputil"
 	"github.com/prysmaticlabs/prysm/v5/runtime/version"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
 	"github.com/wealdtech/go-bytesutil"
 )
 
@@ -110,15 +114,28 @@ func (s *Server) GetLightClientUpdatesByRange(w http.ResponseWriter, req *http.R
 		return
 	}
 
-	updates := make([]*structs.LightClientUpdateResponse, 0, len(updatesMap))
-
+	// Only return the first contiguous range of updates
+	contiguous := make([]interfaces.LightClientUpdate, 0, len(updatesMap))
 	for i := startPeriod; i <= endPeriod; i++ {
 		update, ok := updatesMap[i]
 		if !ok {
-			// Only return the first contiguous range of updates
 			break
 		}
+		contiguous = append(contiguous, update)
+	}
+
+	if httputil.RespondWithSsz(req) {
+		ssz, err := s.lightClientUpdatesSSZ(contiguous)
+		if err != nil {
+			httputil.HandleError(w, "Could not marshal light client updates to SSZ: "+err.Error(), http.StatusInternalServerError)
+			return
+		}
+		httputil.WriteSsz(w, ssz, "light_client_updates.ssz")
+		return
+	}
 
+	updates := make([]*structs.LightClientUpdateResponse, 0, len(contiguous))
+	for _, update := range contiguous {
 		updateJson, err := structs.LightClientUpdateFromConsensus(update)
 		if err != nil {
 			httputil.HandleError(w, "Could not convert light client update: "+err.Error(), http.StatusInternalServerError)
@@ -182,15 +199,32 @@ func (s *Server) GetLightClientFinalityUpdate(w http.ResponseWriter, req *http.R
 		return
 	}
 
-	update, err := newLightClientFinalityUpdateFromBeaconState(ctx, s.ChainInfoFetcher.CurrentSlot(), st, block, attestedState, attestedBlock, finalizedBlock)
+	update, err := lightclient.NewLightClientFinalityUpdateFromBeaconState(ctx, s.ChainInfoFetcher.CurrentSlot(), st, block, attestedState, attestedBlock, finalizedBlock)
 	if err != nil {
 		httputil.HandleError(w, "Could not get light client finality update: "+err.Error(), http.StatusInternalServerError)
 		return
 	}
 
+	w.Header().Set(api.VersionHeader, version.String(attestedState.Version()))
+
+	if httputil.RespondWithSsz(req) {
+		ssz, err := update.MarshalSSZ()
+		if err != nil {
+			httputil.HandleError(w, "Could not marshal finality update to SSZ: "+err.Error(), http.StatusInternalServerError)
+			return
+		}
+		httputil.WriteSsz(w, ssz, "light_client_finality_update.ssz")
+		return
+	}
+
+	data, err := structs.LightClientFinalityUpdateFromConsensus(update)
+	if err != nil {
+		httputil.HandleError(w, "Could not marshal finality update to JSON: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
 	response := &structs.LightClientFinalityUpdateResponse{
 		Version: version.String(attestedState.Version()),
-		Data:    update,
+		Data:    data,
 	}
 
 	httputil.WriteJson(w, response)
@@ -232,20 +266,59 @@ func (s *Server) GetLightClientOptimisticUpdate(w http.ResponseWriter, req *http
 		return
 	}
 
-	update, err := newLightClientOptimisticUpdateFromBeaconState(ctx, s.ChainInfoFetcher.CurrentSlot(), st, block, attestedState, attestedBlock)
+	update, err := lightclient.NewLightClientOptimisticUpdateFromBeaconState(ctx, s.ChainInfoFetcher.CurrentSlot(), st, block, attestedState, attestedBlock)
 	if err != nil {
 		httputil.HandleError(w, "Could not get light client optimistic update: "+err.Error(), http.StatusInternalServerError)
 		return
 	}
 
+	w.Header().Set(api.VersionHeader, version.String(attestedState.Version()))
+
+	if httputil.RespondWithSsz(req) {
+		ssz, err := update.MarshalSSZ()
+		if err != nil {
+			httputil.HandleError(w, "Could not marshal optimistic update to SSZ: "+err.Error(), http.StatusInternalServerError)
+			return
+		}
+		httputil.WriteSsz(w, ssz, "light_client_optimistic_update.ssz")
+		return
+	}
+
+	data, err := structs.LightClientOptimisticUpdateFromConsensus(update)
+	if err != nil {
+		httputil.HandleError(w, "Could not marshal optimistic update to JSON: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
 	response := &structs.LightClientOptimisticUpdateResponse{
 		Version: version.String(attestedState.Version()),
-		Data:    update,
+		Data:    data,
 	}
 
 	httputil.WriteJson(w, response)
 }
 
+// lightClientUpdatesSSZ encodes updates as a sequence of response chunks, as required by the spec for SSZ responses.
+// Each chunk is the little-endian uint64 length of the chunk's remaining bytes, followed by the 4-byte fork digest
+// of the update's attested header and the SSZ-encoded update.
+func (s *Server) lightClientUpdatesSSZ(updates []interfaces.LightClientUpdate) ([]byte, error) {
+	genesisValidatorsRoot := s.HeadFetcher.HeadGenesisValidatorsRoot()
+	var buf []byte
+	for _, update := range updates {
+		updateSSZ, err := update.MarshalSSZ()
+		if err != nil {
+			return nil, errors.Wrap(err, "could not marshal update")
+		}
+		digest, err := forks.ForkDigestFromEpoch(slots.ToEpoch(update.AttestedHeader().Beacon().Slot), genesisValidatorsRoot[:])
+		if err != nil {
+			return nil, errors.Wrap(err, "could not compute fork digest")
+		}
+		buf = binary.LittleEndian.AppendUint64(buf, uint64(len(digest)+len(updateSSZ)))
+		buf = append(buf, digest[:]...)
+		buf = append(buf, updateSSZ...)
+	}
+	return buf, nil
+}
+
 // suitableBlock returns the latest block that satisfies all criteria required for creating a new update
 func (s *Server) suitableBlock(ctx context.Context, minSignaturesRequired uint64) (interfaces.ReadOnlySignedBeaconBlock, error) {
 	st, err := s.HeadFetcher.HeadState(ctx)
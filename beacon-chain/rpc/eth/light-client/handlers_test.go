@@ -3,6 +3,7 @@ package lightclient
 import (
 	"bytes"
 	"context"
+	"encoding/binary"
 	"encoding/json"
 	"fmt"
 	"math/rand"
@@ -12,6 +13,7 @@ import (
 	"testing"
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/prysmaticlabs/prysm/v5/api"
 	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
 	mock "github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain/testing"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/helpers"
@@ -26,11 +28,13 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/interfaces"
 	light_client "github.com/prysmaticlabs/prysm/v5/consensus-types/light-client"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/network/forks"
 	enginev1 "github.com/prysmaticlabs/prysm/v5/proto/engine/v1"
 	pb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v5/runtime/version"
 	"github.com/prysmaticlabs/prysm/v5/testing/require"
 	"github.com/prysmaticlabs/prysm/v5/testing/util"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
 )
 
 func TestLightClientHandler_GetLightClientBootstrap(t *testing.T) {
@@ -869,6 +873,66 @@ func TestLightClientHandler_GetLightClientByRange(t *testing.T) {
 		}
 	})
 
+	t.Run("multiple forks - capella, deneb ssz", func(t *testing.T) {
+		slotDeneb := primitives.Slot(config.DenebForkEpoch * primitives.Epoch(config.SlotsPerEpoch)).Add(1)
+		slotCapella := primitives.Slot(config.CapellaForkEpoch * primitives.Epoch(config.SlotsPerEpoch)).Add(1)
+
+		st, err := util.NewBeaconStateAltair()
+		require.NoError(t, err)
+		err = st.SetSlot(slotDeneb.Add(1))
+		require.NoError(t, err)
+
+		db := dbtesting.SetupDB(t)
+
+		updates := make([]interfaces.LightClientUpdate, 2)
+		updates[0], err = createUpdate(t, version.Capella)
+		require.NoError(t, err)
+		updatePeriod := slotCapella.Div(uint64(config.EpochsPerSyncCommitteePeriod)).Div(uint64(config.SlotsPerEpoch))
+		require.NoError(t, db.SaveLightClientUpdate(ctx, uint64(updatePeriod), updates[0]))
+		updates[1], err = createUpdate(t, version.Deneb)
+		require.NoError(t, err)
+		updatePeriod = slotDeneb.Div(uint64(config.EpochsPerSyncCommitteePeriod)).Div(uint64(config.SlotsPerEpoch))
+		require.NoError(t, db.SaveLightClientUpdate(ctx, uint64(updatePeriod), updates[1]))
+
+		mockChainService := &mock.ChainService{State: st}
+		s := &Server{
+			HeadFetcher: mockChainService,
+			BeaconDB:    db,
+		}
+		request := httptest.NewRequest("GET", "http://foo.com/?count=100&start_period=1", nil)
+		request.Header.Set("Accept", api.OctetStreamMediaType)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetLightClientUpdatesByRange(writer, request)
+
+		require.Equal(t, http.StatusOK, writer.Code)
+		require.Equal(t, api.OctetStreamMediaType, writer.Header().Get("Content-Type"))
+		genesisValidatorsRoot := mockChainService.HeadGenesisValidatorsRoot()
+		body := writer.Body.Bytes()
+		for _, update := range updates {
+			require.Equal(t, true, len(body) >= 8)
+			chunkLen := binary.LittleEndian.Uint64(body)
+			body = body[8:]
+			require.Equal(t, true, uint64(len(body)) >= chunkLen)
+			expectedDigest, err := forks.ForkDigestFromEpoch(slots.ToEpoch(update.AttestedHeader().Beacon().Slot), genesisValidatorsRoot[:])
+			require.NoError(t, err)
+			require.DeepEqual(t, expectedDigest[:], body[:4])
+			expectedSSZ, err := update.MarshalSSZ()
+			require.NoError(t, err)
+			require.DeepEqual(t, expectedSSZ, body[4:chunkLen])
+			body = body[chunkLen:]
+		}
+		require.Equal(t, 0, len(body))
+	})
+
+	t.Run("no updates ssz", func(t *testing.T) {
+		s := &Server{HeadFetcher: &mock.ChainService{}}
+		enc, err := s.lightClientUpdatesSSZ(nil)
+		require.NoError(t, err)
+		require.Equal(t, 0, len(enc))
+	})
+
 	t.Run("count bigger than limit", func(t *testing.T) {
 		config.MaxRequestLightClientUpdates = 2
 		params.OverrideBeaconConfig(config)
@@ -7,6 +7,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/prysmaticlabs/prysm/v5/api"
 	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/altair"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/epoch/precompute"
@@ -16,6 +17,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/config/params"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/encoding/ssz"
 	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing/trace"
 	"github.com/prysmaticlabs/prysm/v5/network/httputil"
 	"github.com/prysmaticlabs/prysm/v5/runtime/version"
@@ -60,6 +62,21 @@ func (s *Server) BlockRewards(w http.ResponseWriter, r *http.Request) {
 		httputil.WriteError(w, httpError)
 		return
 	}
+	w.Header().Set(api.VersionHeader, version.String(blk.Version()))
+	if httputil.RespondWithSsz(r) {
+		sszRewards, err := blockRewards.ToSsz()
+		if err != nil {
+			httputil.HandleError(w, "Could not convert block rewards to SSZ: "+err.Error(), http.StatusInternalServerError)
+			return
+		}
+		sszData, err := sszRewards.MarshalSSZ()
+		if err != nil {
+			httputil.HandleError(w, "Could not marshal block rewards to SSZ: "+err.Error(), http.StatusInternalServerError)
+			return
+		}
+		httputil.WriteSsz(w, sszData, "block_rewards.ssz")
+		return
+	}
 	response := &structs.BlockRewardsResponse{
 		Data:                blockRewards,
 		ExecutionOptimistic: optimistic,
@@ -99,11 +116,27 @@ func (s *Server) AttestationRewards(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	rewards := structs.AttestationRewards{
+		IdealRewards: idealRewards,
+		TotalRewards: totalRewards,
+	}
+	w.Header().Set(api.VersionHeader, version.String(st.Version()))
+	if httputil.RespondWithSsz(r) {
+		sszRewards, err := rewards.ToSsz()
+		if err != nil {
+			httputil.HandleError(w, "Could not convert attestation rewards to SSZ: "+err.Error(), http.StatusInternalServerError)
+			return
+		}
+		sszData, err := sszRewards.MarshalSSZ()
+		if err != nil {
+			httputil.HandleError(w, "Could not marshal attestation rewards to SSZ: "+err.Error(), http.StatusInternalServerError)
+			return
+		}
+		httputil.WriteSsz(w, sszData, "attestation_rewards.ssz")
+		return
+	}
 	resp := &structs.AttestationRewardsResponse{
-		Data: structs.AttestationRewards{
-			IdealRewards: idealRewards,
-			TotalRewards: totalRewards,
-		},
+		Data:                rewards,
 		ExecutionOptimistic: optimistic,
 		Finalized:           s.FinalizationFetcher.IsFinalized(r.Context(), blkRoot),
 	}
@@ -182,6 +215,23 @@ func (s *Server) SyncCommitteeRewards(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	w.Header().Set(api.VersionHeader, version.String(blk.Version()))
+	if httputil.RespondWithSsz(r) {
+		sszRewards := make([]*structs.SyncCommitteeRewardSsz, len(valIndices))
+		for i, valIdx := range valIndices {
+			sszRewards[i] = &structs.SyncCommitteeRewardSsz{
+				ValidatorIndex: uint64(valIdx),
+				Reward:         uint64(rewards[i]), // lint:ignore uintcast -- Penalties are encoded as two's complement.
+			}
+		}
+		sszData, err := ssz.MarshalFixedList(sszRewards)
+		if err != nil {
+			httputil.HandleError(w, "Could not marshal sync committee rewards to SSZ: "+err.Error(), http.StatusInternalServerError)
+			return
+		}
+		httputil.WriteSsz(w, sszData, "sync_committee_rewards.ssz")
+		return
+	}
 	scRewards := make([]structs.SyncCommitteeReward, len(valIndices))
 	for i, valIdx := range valIndices {
 		scRewards[i] = structs.SyncCommitteeReward{
@@ -13,6 +13,7 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/prysmaticlabs/prysm/v5/api"
 	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
 	mock "github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain/testing"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/altair"
@@ -30,6 +31,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/crypto/bls"
 	"github.com/prysmaticlabs/prysm/v5/crypto/bls/blst"
 	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v5/encoding/ssz"
 	"github.com/prysmaticlabs/prysm/v5/network/httputil"
 	eth "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v5/runtime/version"
@@ -314,6 +316,43 @@ func TestBlockRewards(t *testing.T) {
 		assert.Equal(t, true, resp.ExecutionOptimistic)
 		assert.Equal(t, false, resp.Finalized)
 	})
+	t.Run("altair ssz", func(t *testing.T) {
+		st, sbb, err := BlockRewardTestSetup(t, version.Altair)
+		require.NoError(t, err)
+
+		mockChainService := &mock.ChainService{Optimistic: true}
+		s := &Server{
+			Blocker: &testutil.MockBlocker{SlotBlockMap: map[primitives.Slot]interfaces.ReadOnlySignedBeaconBlock{
+				0: phase0block,
+				2: sbb,
+			}},
+			OptimisticModeFetcher: mockChainService,
+			FinalizationFetcher:   mockChainService,
+			BlockRewardFetcher: &BlockRewardService{
+				Replayer: mockstategen.NewReplayerBuilder(mockstategen.WithMockState(st)),
+				DB:       db,
+			},
+		}
+
+		url := "http://only.the.slot.number.at.the.end.is.important/2"
+		request := httptest.NewRequest("GET", url, nil)
+		request.Header.Set("Accept", api.OctetStreamMediaType)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.BlockRewards(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		assert.Equal(t, api.OctetStreamMediaType, writer.Header().Get("Content-Type"))
+		assert.Equal(t, version.String(version.Altair), writer.Header().Get(api.VersionHeader))
+		resp := &structs.BlockRewardsSsz{}
+		require.NoError(t, resp.UnmarshalSSZ(writer.Body.Bytes()))
+		assert.Equal(t, uint64(12), resp.ProposerIndex)
+		assert.Equal(t, uint64(125089490), resp.Total)
+		assert.Equal(t, uint64(89442), resp.Attestations)
+		assert.Equal(t, uint64(48), resp.SyncAggregate)
+		assert.Equal(t, uint64(62500000), resp.AttesterSlashings)
+		assert.Equal(t, uint64(62500000), resp.ProposerSlashings)
+	})
 	t.Run("bellatrix", func(t *testing.T) {
 		st, sbb, err := BlockRewardTestSetup(t, version.Bellatrix)
 		require.NoError(t, err)
@@ -617,6 +656,45 @@ func TestAttestationRewards(t *testing.T) {
 		assert.Equal(t, "-815841", resp.Data.TotalRewards[0].Target)
 		assert.Equal(t, "0", resp.Data.TotalRewards[0].Inactivity)
 	})
+	t.Run("penalty ssz", func(t *testing.T) {
+		st := st.Copy()
+		validators := st.Validators()
+		validators[63].Slashed = true
+		require.NoError(t, st.SetValidators(validators))
+
+		s := &Server{
+			Stater: &testutil.MockStater{StatesBySlot: map[primitives.Slot]state.BeaconState{
+				params.BeaconConfig().SlotsPerEpoch*3 - 1: st,
+			}},
+			TimeFetcher:           mockChainService,
+			OptimisticModeFetcher: mockChainService,
+			FinalizationFetcher:   mockChainService,
+		}
+
+		url := "http://only.the.epoch.number.at.the.end.is.important/1"
+		var body bytes.Buffer
+		valIds, err := json.Marshal([]string{"63"})
+		require.NoError(t, err)
+		_, err = body.Write(valIds)
+		require.NoError(t, err)
+		request := httptest.NewRequest("POST", url, &body)
+		request.Header.Set("Accept", api.OctetStreamMediaType)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.AttestationRewards(writer, request)
+		assert.Equal(t, http.StatusOK, writer.Code)
+		assert.Equal(t, api.OctetStreamMediaType, writer.Header().Get("Content-Type"))
+		resp := &structs.AttestationRewardsSsz{}
+		require.NoError(t, resp.UnmarshalSSZ(writer.Body.Bytes()))
+		require.Equal(t, 1, len(resp.TotalRewards))
+		assert.Equal(t, uint64(63), resp.TotalRewards[0].ValidatorIndex)
+		assert.Equal(t, int64(0), int64(resp.TotalRewards[0].Head))
+		assert.Equal(t, int64(-439299), int64(resp.TotalRewards[0].Source))
+		assert.Equal(t, int64(-815841), int64(resp.TotalRewards[0].Target))
+		assert.Equal(t, int64(0), int64(resp.TotalRewards[0].Inactivity))
+		assert.NotEqual(t, 0, len(resp.IdealRewards))
+	})
 	t.Run("inactivity", func(t *testing.T) {
 		st := st.Copy()
 		validators := st.Validators()
@@ -864,6 +942,35 @@ func TestSyncCommiteeRewards(t *testing.T) {
 		assert.Equal(t, true, resp.ExecutionOptimistic)
 		assert.Equal(t, false, resp.Finalized)
 	})
+	t.Run("ok - filtered vals ssz", func(t *testing.T) {
+		balances := make([]uint64, 0, valCount)
+		for i := 0; i < valCount; i++ {
+			balances = append(balances, params.BeaconConfig().MaxEffectiveBalance)
+		}
+		require.NoError(t, st.SetBalances(balances))
+
+		url := "http://only.the.slot.number.at.the.end.is.important/32"
+		var body bytes.Buffer
+		pubkey := fmt.Sprintf("%#x", secretKeys[10].PublicKey().Marshal())
+		valIds, err := json.Marshal([]string{"20", pubkey})
+		require.NoError(t, err)
+		_, err = body.Write(valIds)
+		require.NoError(t, err)
+		request := httptest.NewRequest("POST", url, &body)
+		request.Header.Set("Accept", api.OctetStreamMediaType)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.SyncCommitteeRewards(writer, request)
+		assert.Equal(t, http.StatusOK, writer.Code)
+		assert.Equal(t, api.OctetStreamMediaType, writer.Header().Get("Content-Type"))
+		resp, err := ssz.UnmarshalFixedList[structs.SyncCommitteeRewardSsz](writer.Body.Bytes())
+		require.NoError(t, err)
+		require.Equal(t, 2, len(resp))
+		assert.Equal(t, uint64(20), resp[0].ValidatorIndex)
+		assert.Equal(t, uint64(10), resp[1].ValidatorIndex)
+		assert.Equal(t, uint64(1396), resp[0].Reward+resp[1].Reward)
+	})
 	t.Run("ok - all vals", func(t *testing.T) {
 		balances := make([]uint64, 0, valCount)
 		for i := 0; i < valCount; i++ {
@@ -0,0 +1,33 @@
+package shared
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/prysmaticlabs/prysm/v5/network/httputil"
+)
+
+// WriteSszList writes the data of a JSON response as an SSZ list. Every item is converted to its SSZ encoding
+// and the resulting list is serialized with marshal.
+func WriteSszList[T interface{ ToSsz() (S, error) }, S any](
+	w http.ResponseWriter,
+	items []T,
+	marshal func([]S) ([]byte, error),
+	fileName string,
+) {
+	sszItems := make([]S, len(items))
+	for i, item := range items {
+		var err error
+		sszItems[i], err = item.ToSsz()
+		if err != nil {
+			httputil.HandleError(w, fmt.Sprintf("Could not convert item at index %d to SSZ: %s", i, err.Error()), http.StatusInternalServerError)
+			return
+		}
+	}
+	sszData, err := marshal(sszItems)
+	if err != nil {
+		httputil.HandleError(w, "Could not marshal response to SSZ: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	httputil.WriteSsz(w, sszData, fileName)
+}
@@ -38,6 +38,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/crypto/bls"
 	"github.com/prysmaticlabs/prysm/v5/crypto/bls/common"
 	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v5/encoding/ssz"
 	"github.com/prysmaticlabs/prysm/v5/network/httputil"
 	ethpbalpha "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v5/runtime/version"
@@ -1229,6 +1230,60 @@ func TestGetAttestationData(t *testing.T) {
 		assert.DeepEqual(t, expectedResponse, resp)
 	})
 
+	t.Run("ssz", func(t *testing.T) {
+		block := util.NewBeaconBlock()
+		block.Block.Slot = 3*params.BeaconConfig().SlotsPerEpoch + 1
+		blockRoot, err := block.Block.HashTreeRoot()
+		require.NoError(t, err)
+		justifiedRoot := [32]byte{'j'}
+		slot := 3*params.BeaconConfig().SlotsPerEpoch + 1
+		beaconState, err := util.NewBeaconState()
+		require.NoError(t, err)
+		require.NoError(t, beaconState.SetSlot(slot))
+		justifiedCheckpoint := &ethpbalpha.Checkpoint{
+			Epoch: 2,
+			Root:  justifiedRoot[:],
+		}
+		require.NoError(t, beaconState.SetCurrentJustifiedCheckpoint(justifiedCheckpoint))
+		offset := int64(slot.Mul(params.BeaconConfig().SecondsPerSlot))
+		chain := &mockChain.ChainService{
+			Genesis:                    time.Now().Add(time.Duration(-1*offset) * time.Second),
+			Root:                       blockRoot[:],
+			CurrentJustifiedCheckPoint: justifiedCheckpoint,
+			TargetRoot:                 blockRoot,
+			State:                      beaconState,
+		}
+		s := &Server{
+			SyncChecker:           &mockSync.Sync{IsSyncing: false},
+			HeadFetcher:           chain,
+			TimeFetcher:           chain,
+			OptimisticModeFetcher: chain,
+			CoreService: &core.Service{
+				HeadFetcher:           chain,
+				GenesisTimeFetcher:    chain,
+				FinalizedFetcher:      chain,
+				AttestationCache:      cache.NewAttestationDataCache(),
+				OptimisticModeFetcher: chain,
+			},
+		}
+
+		url := fmt.Sprintf("http://example.com?slot=%d&committee_index=%d", slot, 0)
+		request := httptest.NewRequest(http.MethodGet, url, nil)
+		request.Header.Set("Accept", api.OctetStreamMediaType)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetAttestationData(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		assert.Equal(t, api.OctetStreamMediaType, writer.Header().Get("Content-Type"))
+		assert.Equal(t, version.String(slots.ToForkVersion(slot)), writer.Header().Get(api.VersionHeader))
+		data := &ethpbalpha.AttestationData{}
+		require.NoError(t, data.UnmarshalSSZ(writer.Body.Bytes()))
+		assert.Equal(t, slot, data.Slot)
+		assert.DeepEqual(t, blockRoot[:], data.BeaconBlockRoot)
+		assert.DeepEqual(t, justifiedRoot[:], data.Source.Root)
+	})
+
 	t.Run("syncing", func(t *testing.T) {
 		beaconState, err := util.NewBeaconState()
 		require.NoError(t, err)
@@ -1812,6 +1867,33 @@ func TestGetAttesterDuties(t *testing.T) {
 		assert.Equal(t, "3", duty.CommitteesAtSlot)
 		assert.Equal(t, "80", duty.ValidatorCommitteeIndex)
 	})
+	t.Run("ssz", func(t *testing.T) {
+		var body bytes.Buffer
+		_, err = body.WriteString("[\"0\",\"1\"]")
+		require.NoError(t, err)
+		request := httptest.NewRequest(http.MethodGet, "http://www.example.com/eth/v1/validator/duties/attester/{epoch}", &body)
+		request.SetPathValue("epoch", "0")
+		request.Header.Set("Accept", api.OctetStreamMediaType)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetAttesterDuties(writer, request)
+		assert.Equal(t, http.StatusOK, writer.Code)
+		assert.Equal(t, api.OctetStreamMediaType, writer.Header().Get("Content-Type"))
+		assert.Equal(t, version.String(version.Phase0), writer.Header().Get(api.VersionHeader))
+		resp, err := ssz.UnmarshalFixedList[structs.AttesterDutySsz](writer.Body.Bytes())
+		require.NoError(t, err)
+		require.Equal(t, 2, len(resp))
+		duty := resp[0]
+		assert.Equal(t, uint64(1), duty.CommitteeIndex)
+		assert.Equal(t, uint64(0), duty.Slot)
+		assert.Equal(t, uint64(0), duty.ValidatorIndex)
+		assert.DeepEqual(t, pubKeys[0], duty.Pubkey)
+		assert.Equal(t, uint64(171), duty.CommitteeLength)
+		assert.Equal(t, uint64(3), duty.CommitteesAtSlot)
+		assert.Equal(t, uint64(80), duty.ValidatorCommitteeIndex)
+		assert.Equal(t, uint64(1), resp[1].ValidatorIndex)
+	})
 	t.Run("multiple validators", func(t *testing.T) {
 		var body bytes.Buffer
 		_, err = body.WriteString("[\"0\",\"1\"]")
@@ -2071,6 +2153,48 @@ func TestGetProposerDuties(t *testing.T) {
 		assert.Equal(t, "12289", expectedDuty.ValidatorIndex)
 		assert.Equal(t, hexutil.Encode(pubKeys[12289]), expectedDuty.Pubkey)
 	})
+	t.Run("ssz", func(t *testing.T) {
+		bs, err := transition.GenesisBeaconState(context.Background(), deposits, 0, eth1Data)
+		require.NoError(t, err, "Could not set up genesis state")
+		require.NoError(t, bs.SetSlot(params.BeaconConfig().SlotsPerEpoch))
+		require.NoError(t, bs.SetBlockRoots(roots))
+		chainSlot := primitives.Slot(0)
+		chain := &mockChain.ChainService{
+			State: bs, Root: genesisRoot[:], Slot: &chainSlot,
+		}
+		s := &Server{
+			Stater:                 &testutil.MockStater{StatesBySlot: map[primitives.Slot]state.BeaconState{0: bs}},
+			HeadFetcher:            chain,
+			TimeFetcher:            chain,
+			OptimisticModeFetcher:  chain,
+			SyncChecker:            &mockSync.Sync{IsSyncing: false},
+			PayloadIDCache:         cache.NewPayloadIDCache(),
+			TrackedValidatorsCache: cache.NewTrackedValidatorsCache(),
+			BeaconDB:               db,
+		}
+
+		request := httptest.NewRequest(http.MethodGet, "http://www.example.com/eth/v1/validator/duties/proposer/{epoch}", nil)
+		request.SetPathValue("epoch", "0")
+		request.Header.Set("Accept", api.OctetStreamMediaType)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetProposerDuties(writer, request)
+		assert.Equal(t, http.StatusOK, writer.Code)
+		assert.Equal(t, api.OctetStreamMediaType, writer.Header().Get("Content-Type"))
+		resp, err := ssz.UnmarshalFixedList[structs.ProposerDutySsz](writer.Body.Bytes())
+		require.NoError(t, err)
+		assert.Equal(t, 31, len(resp))
+		var expectedDuty *structs.ProposerDutySsz
+		for _, duty := range resp {
+			if duty.Slot == 11 {
+				expectedDuty = duty
+			}
+		}
+		require.NotNil(t, expectedDuty, "Expected duty for slot 11 not found")
+		assert.Equal(t, uint64(12289), expectedDuty.ValidatorIndex)
+		assert.DeepEqual(t, pubKeys[12289], expectedDuty.Pubkey)
+	})
 	t.Run("next epoch", func(t *testing.T) {
 		bs, err := transition.GenesisBeaconState(context.Background(), deposits, 0, eth1Data)
 		require.NoError(t, err, "Could not set up genesis state")
@@ -2270,6 +2394,29 @@ func TestGetSyncCommitteeDuties(t *testing.T) {
 		require.Equal(t, true, ok)
 		assert.Equal(t, 1, len(subnetId))
 	})
+	t.Run("ssz", func(t *testing.T) {
+		var body bytes.Buffer
+		_, err := body.WriteString("[\"0\",\"1\"]")
+		require.NoError(t, err)
+		request := httptest.NewRequest(http.MethodGet, "http://www.example.com/eth/v1/validator/duties/sync/{epoch}", &body)
+		request.SetPathValue("epoch", "0")
+		request.Header.Set("Accept", api.OctetStreamMediaType)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetSyncCommitteeDuties(writer, request)
+		assert.Equal(t, http.StatusOK, writer.Code)
+		assert.Equal(t, api.OctetStreamMediaType, writer.Header().Get("Content-Type"))
+		assert.Equal(t, version.String(version.Altair), writer.Header().Get(api.VersionHeader))
+		resp, err := ssz.UnmarshalVariableList[structs.SyncCommitteeDutySsz](writer.Body.Bytes())
+		require.NoError(t, err)
+		require.Equal(t, 2, len(resp))
+		assert.DeepEqual(t, vals[0].PublicKey, resp[0].Pubkey)
+		assert.Equal(t, uint64(0), resp[0].ValidatorIndex)
+		assert.DeepEqual(t, []uint64{0, 5}, resp[0].ValidatorSyncCommitteeIndices)
+		assert.DeepEqual(t, vals[1].PublicKey, resp[1].Pubkey)
+		assert.DeepEqual(t, []uint64{1}, resp[1].ValidatorSyncCommitteeIndices)
+	})
 	t.Run("multiple validators", func(t *testing.T) {
 		var body bytes.Buffer
 		_, err := body.WriteString("[\"1\",\"2\"]")
@@ -0,0 +1,123 @@
+package node
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p"
+	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing/trace"
+	"github.com/prysmaticlabs/prysm/v5/network/httputil"
+	prysmTime "github.com/prysmaticlabs/prysm/v5/time"
+)
+
+// ListBans retrieves the manual bans of peer IDs, IP addresses and CIDR ranges which have not expired.
+func (s *Server) ListBans(w http.ResponseWriter, r *http.Request) {
+	_, span := trace.StartSpan(r.Context(), "node.ListBans")
+	defer span.End()
+
+	if s.PeerBanner == nil {
+		httputil.HandleError(w, "Peer bans are not supported", http.StatusNotImplemented)
+		return
+	}
+	bans := s.PeerBanner.Bans()
+	data := make([]*structs.Ban, len(bans))
+	for i, b := range bans {
+		data[i] = httpBan(b)
+	}
+	httputil.WriteJson(w, &structs.ListBansResponse{Data: data})
+}
+
+// AddBan bans a peer ID, IP address or CIDR range for the given duration in seconds, or permanently
+// if no duration is given. Connected peers matching the ban are disconnected.
+func (s *Server) AddBan(w http.ResponseWriter, r *http.Request) {
+	_, span := trace.StartSpan(r.Context(), "node.AddBan")
+	defer span.End()
+
+	if s.PeerBanner == nil {
+		httputil.HandleError(w, "Peer bans are not supported", http.StatusNotImplemented)
+		return
+	}
+	var req structs.AddBanRequest
+	err := json.NewDecoder(r.Body).Decode(&req)
+	switch {
+	case errors.Is(err, io.EOF):
+		httputil.HandleError(w, "No data submitted", http.StatusBadRequest)
+		return
+	case err != nil:
+		httputil.HandleError(w, "Could not decode request body: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	if req.Target == "" {
+		httputil.HandleError(w, "Target is required", http.StatusBadRequest)
+		return
+	}
+	if req.Reason == "" {
+		httputil.HandleError(w, "Reason is required", http.StatusBadRequest)
+		return
+	}
+	var expiry time.Time
+	if req.Duration != "" {
+		seconds, err := strconv.ParseUint(req.Duration, 10, 32)
+		if err != nil || seconds == 0 {
+			httputil.HandleError(w, "Duration must be a positive number of seconds", http.StatusBadRequest)
+			return
+		}
+		expiry = prysmTime.Now().Add(time.Duration(seconds) * time.Second)
+	}
+	b, err := s.PeerBanner.Ban(req.Target, req.Reason, expiry)
+	if err != nil {
+		if errors.Is(err, p2p.ErrInvalidBanTarget) {
+			httputil.HandleError(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		httputil.HandleError(w, "Could not ban peer: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	httputil.WriteJson(w, &structs.AddBanResponse{Data: httpBan(b)})
+}
+
+// RemoveBan lifts the ban of a peer ID, IP address or CIDR range. The slash of a CIDR range must be
+// escaped in the URL.
+func (s *Server) RemoveBan(w http.ResponseWriter, r *http.Request) {
+	_, span := trace.StartSpan(r.Context(), "node.RemoveBan")
+	defer span.End()
+
+	if s.PeerBanner == nil {
+		httputil.HandleError(w, "Peer bans are not supported", http.StatusNotImplemented)
+		return
+	}
+	target := r.PathValue("target")
+	if target == "" {
+		httputil.HandleError(w, "target is required in URL params", http.StatusBadRequest)
+		return
+	}
+	unbanned, err := s.PeerBanner.Unban(target)
+	if err != nil {
+		if errors.Is(err, p2p.ErrInvalidBanTarget) {
+			httputil.HandleError(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		httputil.HandleError(w, "Could not unban peer: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if !unbanned {
+		httputil.HandleError(w, "Target is not banned", http.StatusNotFound)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+func httpBan(b *p2p.Ban) *structs.Ban {
+	return &structs.Ban{
+		Target:  b.Target,
+		Kind:    string(b.Kind),
+		Reason:  b.Reason,
+		Created: formatTime(b.Created),
+		Expiry:  formatTime(b.Expiry),
+	}
+}
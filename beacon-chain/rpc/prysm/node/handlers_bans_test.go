@@ -0,0 +1,102 @@
+package node
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+type mockPeerBanner struct {
+	bans []*p2p.Ban
+}
+
+func (m *mockPeerBanner) Ban(target, reason string, expiry time.Time) (*p2p.Ban, error) {
+	b, err := p2p.NewBan(target, reason, expiry)
+	if err != nil {
+		return nil, err
+	}
+	m.bans = append(m.bans, b)
+	return b, nil
+}
+
+func (m *mockPeerBanner) Unban(target string) (bool, error) {
+	for i, b := range m.bans {
+		if b.Target == target {
+			m.bans = append(m.bans[:i], m.bans[i+1:]...)
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (m *mockPeerBanner) Bans() []*p2p.Ban {
+	return m.bans
+}
+
+func TestBans(t *testing.T) {
+	banner := &mockPeerBanner{}
+	s := &Server{PeerBanner: banner}
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /prysm/v1/node/bans", s.ListBans)
+	mux.HandleFunc("POST /prysm/v1/node/bans", s.AddBan)
+	mux.HandleFunc("DELETE /prysm/v1/node/bans/{target}", s.RemoveBan)
+	do := func(method, path, body string) *httptest.ResponseRecorder {
+		request := httptest.NewRequest(method, "http://anything.is.fine"+path, strings.NewReader(body))
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		mux.ServeHTTP(writer, request)
+		return writer
+	}
+
+	t.Run("add", func(t *testing.T) {
+		writer := do(http.MethodPost, "/prysm/v1/node/bans", `{"target":"10.1.2.3/16","reason":"sybil","duration":"3600"}`)
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &structs.AddBanResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		assert.Equal(t, "10.1.0.0/16", resp.Data.Target)
+		assert.Equal(t, "cidr", resp.Data.Kind)
+		assert.Equal(t, "sybil", resp.Data.Reason)
+		expiry, err := time.Parse(time.RFC3339, resp.Data.Expiry)
+		require.NoError(t, err)
+		assert.Equal(t, true, expiry.After(time.Now().Add(59*time.Minute)))
+
+		writer = do(http.MethodPost, "/prysm/v1/node/bans", `{"target":"1.2.3.4","reason":"dos"}`)
+		require.Equal(t, http.StatusOK, writer.Code)
+	})
+	t.Run("invalid requests", func(t *testing.T) {
+		for _, body := range []string{
+			``,
+			`{"target":"foo","reason":"spam"}`,
+			`{"target":"1.2.3.4"}`,
+			`{"target":"1.2.3.4","reason":"spam","duration":"-1"}`,
+		} {
+			writer := do(http.MethodPost, "/prysm/v1/node/bans", body)
+			assert.Equal(t, http.StatusBadRequest, writer.Code, body)
+		}
+	})
+	t.Run("list", func(t *testing.T) {
+		writer := do(http.MethodGet, "/prysm/v1/node/bans", "")
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &structs.ListBansResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		require.Equal(t, 2, len(resp.Data))
+		assert.Equal(t, "1.2.3.4", resp.Data[1].Target)
+		assert.Equal(t, "", resp.Data[1].Expiry)
+	})
+	t.Run("remove", func(t *testing.T) {
+		writer := do(http.MethodDelete, "/prysm/v1/node/bans/10.1.0.0%2F16", "")
+		assert.Equal(t, http.StatusOK, writer.Code)
+		writer = do(http.MethodDelete, "/prysm/v1/node/bans/10.1.0.0%2F16", "")
+		assert.Equal(t, http.StatusNotFound, writer.Code)
+		assert.Equal(t, 1, len(banner.bans))
+	})
+}
@@ -0,0 +1,306 @@
+package node
+
+import (
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/peers/peerdata"
+	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing/trace"
+	"github.com/prysmaticlabs/prysm/v5/network/httputil"
+	eth "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1/metadata"
+)
+
+// ListPeerDetails retrieves everything the node knows about its peers, including their scores,
+// gossip statistics and rate limits. Peers can be filtered by connection state.
+func (s *Server) ListPeerDetails(w http.ResponseWriter, r *http.Request) {
+	_, span := trace.StartSpan(r.Context(), "node.ListPeerDetails")
+	defer span.End()
+
+	states := r.URL.Query()["state"]
+	allIds := s.PeersFetcher.Peers().All()
+	allDetails := make([]*structs.PeerDetails, 0, len(allIds))
+	for _, id := range allIds {
+		details, err := s.peerDetails(id)
+		if err != nil {
+			// The peer may have been pruned in the meantime.
+			if errors.Is(err, peerdata.ErrPeerUnknown) {
+				continue
+			}
+			httputil.HandleError(w, "Could not get peer details: "+err.Error(), http.StatusInternalServerError)
+			return
+		}
+		if len(states) > 0 && !matchesState(states, details.State) {
+			continue
+		}
+		allDetails = append(allDetails, details)
+	}
+	httputil.WriteJson(w, &structs.ListPeerDetailsResponse{Data: allDetails})
+}
+
+// GetPeerDetails retrieves everything the node knows about a peer, including its scores, gossip
+// statistics and rate limits, and why it was last disconnected.
+func (s *Server) GetPeerDetails(w http.ResponseWriter, r *http.Request) {
+	_, span := trace.StartSpan(r.Context(), "node.GetPeerDetails")
+	defer span.End()
+
+	rawId := r.PathValue("peer_id")
+	if rawId == "" {
+		httputil.HandleError(w, "peer_id is required in URL params", http.StatusBadRequest)
+		return
+	}
+	id, err := peer.Decode(rawId)
+	if err != nil {
+		httputil.HandleError(w, "Invalid peer ID: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	details, err := s.peerDetails(id)
+	if err != nil {
+		if errors.Is(err, peerdata.ErrPeerUnknown) {
+			httputil.HandleError(w, "Peer not found", http.StatusNotFound)
+			return
+		}
+		httputil.HandleError(w, "Could not get peer details: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	httputil.WriteJson(w, &structs.GetPeerDetailsResponse{Data: details})
+}
+
+func (s *Server) peerDetails(id peer.ID) (*structs.PeerDetails, error) {
+	peerStatus := s.PeersFetcher.Peers()
+	connState, err := peerStatus.ConnectionState(id)
+	if err != nil {
+		return nil, err
+	}
+	direction, err := peerStatus.Direction(id)
+	if err != nil {
+		return nil, err
+	}
+	record, err := peerStatus.ENR(id)
+	if err != nil {
+		return nil, err
+	}
+	var serializedEnr string
+	if record != nil {
+		serializedEnr, err = p2p.SerializeENR(record)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not serialize ENR")
+		}
+		serializedEnr = "enr:" + serializedEnr
+	}
+	address, err := peerStatus.Address(id)
+	if err != nil {
+		return nil, err
+	}
+	lastSeen, err := peerStatus.LastSeen(id)
+	if err != nil {
+		return nil, err
+	}
+	nextValidTime, err := peerStatus.NextValidTime(id)
+	if err != nil {
+		return nil, err
+	}
+	disconnectReason, err := peerStatus.DisconnectReason(id)
+	if err != nil {
+		return nil, err
+	}
+	md, err := peerStatus.Metadata(id)
+	if err != nil {
+		return nil, err
+	}
+
+	details := &structs.PeerDetails{
+		PeerId:           id.String(),
+		Enr:              serializedEnr,
+		Addresses:        make([]string, 0),
+		State:            eth.ConnectionState(connState).String(),
+		Direction:        eth.PeerDirection(direction).String(),
+		Trusted:          peerStatus.IsTrustedPeers(id),
+		LastSeen:         formatTime(lastSeen),
+		NextValidTime:    formatTime(nextValidTime),
+		DisconnectReason: disconnectReason,
+		BadPeerReason:    errorString(peerStatus.IsBad(id)),
+		Metadata:         peerMetadata(md),
+		RateLimits:       make([]*structs.PeerRateLimit, 0),
+	}
+	if address != nil {
+		details.Addresses = append(details.Addresses, address.String())
+	}
+	if s.PeerManager != nil && s.PeerManager.Host() != nil {
+		peerStore := s.PeerManager.Host().Peerstore()
+		for _, a := range peerStore.Addrs(id) {
+			if address != nil && address.Equal(a) {
+				continue
+			}
+			details.Addresses = append(details.Addresses, a.String())
+		}
+		if agentVersion, err := peerStore.Get(id, "AgentVersion"); err == nil {
+			details.AgentVersion, _ = agentVersion.(string)
+		}
+	}
+
+	chainStatus, err := s.peerChainStatus(id)
+	if err != nil {
+		return nil, err
+	}
+	details.ChainStatus = chainStatus
+	scores, err := s.peerScores(id)
+	if err != nil {
+		return nil, err
+	}
+	details.Scores = scores
+	if s.RateLimitFetcher != nil {
+		for _, l := range s.RateLimitFetcher.PeerRateLimits(id) {
+			details.RateLimits = append(details.RateLimits, &structs.PeerRateLimit{
+				Topic:     l.Topic,
+				Capacity:  strconv.FormatInt(l.Capacity, 10),
+				Remaining: strconv.FormatInt(l.Remaining, 10),
+				TillEmpty: l.TillEmpty.String(),
+			})
+		}
+	}
+	return details, nil
+}
+
+// peerChainStatus returns the last status message of the peer, if any, along with the reason it was found invalid.
+func (s *Server) peerChainStatus(id peer.ID) (*structs.PeerChainStatus, error) {
+	peerStatus := s.PeersFetcher.Peers()
+	validationError := errorString(peerStatus.Scorers().ValidationError(id))
+	chainState, err := peerStatus.ChainState(id)
+	if errors.Is(err, peerdata.ErrNoPeerStatus) {
+		if validationError == "" {
+			return nil, nil
+		}
+		return &structs.PeerChainStatus{ValidationError: validationError}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	lastUpdated, err := peerStatus.ChainStateLastUpdated(id)
+	if err != nil {
+		return nil, err
+	}
+	return &structs.PeerChainStatus{
+		ForkDigest:      hexutil.Encode(chainState.ForkDigest),
+		FinalizedRoot:   hexutil.Encode(chainState.FinalizedRoot),
+		FinalizedEpoch:  strconv.FormatUint(uint64(chainState.FinalizedEpoch), 10),
+		HeadRoot:        hexutil.Encode(chainState.HeadRoot),
+		HeadSlot:        strconv.FormatUint(uint64(chainState.HeadSlot), 10),
+		LastUpdated:     formatTime(lastUpdated),
+		ValidationError: validationError,
+	}, nil
+}
+
+// peerScores returns the scores of the peer as computed by each scorer, with the gossip scores broken down by topic.
+func (s *Server) peerScores(id peer.ID) (*structs.PeerScores, error) {
+	scorers := s.PeersFetcher.Peers().Scorers()
+	badResponses, err := scorers.BadResponsesScorer().Count(id)
+	if err != nil {
+		return nil, err
+	}
+	gossipScore, behaviourPenalty, topicScores, err := scorers.GossipScorer().GossipData(id)
+	if err != nil {
+		return nil, err
+	}
+	topics := make([]*structs.PeerTopicScore, 0, len(topicScores))
+	for topic, snapshot := range topicScores {
+		if snapshot == nil {
+			continue
+		}
+		topicScore := &structs.PeerTopicScore{
+			Topic:                    topic,
+			TimeInMesh:               (time.Duration(snapshot.TimeInMesh) * time.Millisecond).String(),
+			FirstMessageDeliveries:   float64(snapshot.FirstMessageDeliveries),
+			MeshMessageDeliveries:    float64(snapshot.MeshMessageDeliveries),
+			InvalidMessageDeliveries: float64(snapshot.InvalidMessageDeliveries),
+		}
+		if s.GossipScoreInspector != nil {
+			// Topics without score parameters have no components.
+			if c, err := s.GossipScoreInspector.TopicScoreComponents(topic, snapshot); err == nil {
+				topicScore.Components = &structs.GossipScoreComponents{
+					TopicWeight:              c.TopicWeight,
+					TimeInMesh:               c.TimeInMesh,
+					FirstMessageDeliveries:   c.FirstMessageDeliveries,
+					MeshMessageDeliveries:    c.MeshMessageDeliveries,
+					InvalidMessageDeliveries: c.InvalidMessageDeliveries,
+					Score:                    c.Score,
+				}
+			}
+		}
+		topics = append(topics, topicScore)
+	}
+	sort.Slice(topics, func(i, j int) bool { return topics[i].Topic < topics[j].Topic })
+
+	return &structs.PeerScores{
+		Overall: scorers.Score(id),
+		BadResponses: &structs.PeerBadResponsesScore{
+			Count:     badResponses,
+			Threshold: scorers.BadResponsesScorer().Params().Threshold,
+			Score:     scorers.BadResponsesScorer().Score(id),
+		},
+		BlockProvider: &structs.PeerBlockProviderScore{
+			ProcessedBlocks: strconv.FormatUint(scorers.BlockProviderScorer().ProcessedBlocks(id), 10),
+			Score:           scorers.BlockProviderScorer().Score(id),
+		},
+		PeerStatus: scorers.PeerStatusScorer().Score(id),
+		Gossip: &structs.PeerGossipScore{
+			Score:            gossipScore,
+			BehaviourPenalty: behaviourPenalty,
+			Topics:           topics,
+		},
+	}, nil
+}
+
+// peerMetadata returns the subnets the peer advertises in its metadata.
+func peerMetadata(md metadata.Metadata) *structs.PeerMetadata {
+	if md == nil || md.IsNil() {
+		return nil
+	}
+	m := &structs.PeerMetadata{
+		SeqNumber:            strconv.FormatUint(md.SequenceNumber(), 10),
+		AttestationSubnets:   make([]string, 0),
+		SyncCommitteeSubnets: make([]string, 0),
+	}
+	if attnets := md.AttnetsBitfield(); attnets != nil {
+		for _, i := range attnets.BitIndices() {
+			m.AttestationSubnets = append(m.AttestationSubnets, strconv.Itoa(i))
+		}
+	}
+	if syncnets := md.SyncnetsBitfield(); syncnets != nil {
+		for _, i := range syncnets.BitIndices() {
+			m.SyncCommitteeSubnets = append(m.SyncCommitteeSubnets, strconv.Itoa(i))
+		}
+	}
+	return m
+}
+
+func matchesState(states []string, state string) bool {
+	for _, s := range states {
+		if strings.EqualFold(s, state) {
+			return true
+		}
+	}
+	return false
+}
+
+func formatTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
+
+func errorString(err error) string {
+	if err == nil {
+		return ""
+	}
+	return err.Error()
+}
@@ -0,0 +1,183 @@
+package node
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	corenet "github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/peer"
+	libp2ptest "github.com/libp2p/go-libp2p/p2p/host/peerstore/test"
+	ma "github.com/multiformats/go-multiaddr"
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/peers"
+	mockp2p "github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/testing"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/sync"
+	pb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+type mockGossipScoreInspector struct{}
+
+func (mockGossipScoreInspector) TopicScoreComponents(topic string, _ *pb.TopicScoreSnapshot) (*p2p.TopicScoreComponents, error) {
+	if topic != "topic-a" {
+		return nil, errors.New("no score parameters")
+	}
+	return &p2p.TopicScoreComponents{TopicWeight: 0.5, TimeInMesh: 1, Score: 2}, nil
+}
+
+type mockRateLimitFetcher struct {
+	limits map[peer.ID][]*sync.PeerRateLimit
+	status *sync.RateLimitStatus
+}
+
+func (m *mockRateLimitFetcher) PeerRateLimits(pid peer.ID) []*sync.PeerRateLimit {
+	return m.limits[pid]
+}
+
+func (m *mockRateLimitFetcher) RateLimitStatus() *sync.RateLimitStatus {
+	return m.status
+}
+
+func TestGetPeerDetails(t *testing.T) {
+	ids := libp2ptest.GeneratePeerIDs(3)
+	peerFetcher := &mockp2p.MockPeersProvider{}
+	peerFetcher.ClearPeers()
+	peerStatus := peerFetcher.Peers()
+	addr, err := ma.NewMultiaddr("/ip4/127.0.0.1/tcp/13000")
+	require.NoError(t, err)
+	for _, id := range ids {
+		peerStatus.Add(nil, id, addr, corenet.DirOutbound)
+	}
+	peerStatus.SetConnectionState(ids[0], peers.Connected)
+	peerStatus.SetConnectionState(ids[1], peers.Disconnected)
+	peerStatus.SetDisconnectReason(ids[1], "sent goodbye: client banned this node")
+	peerStatus.SetChainState(ids[0], &pb.Status{
+		ForkDigest:     []byte{1, 2, 3, 4},
+		FinalizedRoot:  make([]byte, 32),
+		FinalizedEpoch: 5,
+		HeadRoot:       make([]byte, 32),
+		HeadSlot:       200,
+	})
+	peerStatus.Scorers().BadResponsesScorer().Increment(ids[0])
+	peerStatus.Scorers().GossipScorer().SetGossipData(ids[0], 3, -1, map[string]*pb.TopicScoreSnapshot{
+		"topic-b": {TimeInMesh: uint64((2 * time.Second).Milliseconds())},
+		"topic-a": {FirstMessageDeliveries: 4},
+	})
+
+	s := &Server{
+		PeersFetcher:         peerFetcher,
+		PeerManager:          &mockp2p.MockPeerManager{},
+		GossipScoreInspector: mockGossipScoreInspector{},
+		RateLimitFetcher: &mockRateLimitFetcher{limits: map[peer.ID][]*sync.PeerRateLimit{
+			ids[0]: {{Topic: "/eth2/beacon_chain/req/status/1/ssz_snappy", Capacity: 5, Remaining: 4, TillEmpty: time.Second}},
+		}},
+	}
+
+	getPeerDetails := func(t *testing.T, id string) *httptest.ResponseRecorder {
+		request := httptest.NewRequest(http.MethodGet, "http://anything.is.fine/prysm/v1/node/peers/"+id, nil)
+		request.SetPathValue("peer_id", id)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		s.GetPeerDetails(writer, request)
+		return writer
+	}
+
+	t.Run("OK", func(t *testing.T) {
+		writer := getPeerDetails(t, ids[0].String())
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &structs.GetPeerDetailsResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		d := resp.Data
+		assert.Equal(t, ids[0].String(), d.PeerId)
+		assert.Equal(t, "CONNECTED", d.State)
+		assert.Equal(t, "OUTBOUND", d.Direction)
+		assert.DeepEqual(t, []string{"/ip4/127.0.0.1/tcp/13000"}, d.Addresses)
+		assert.Equal(t, "", d.DisconnectReason)
+		require.NotNil(t, d.ChainStatus)
+		assert.Equal(t, "0x01020304", d.ChainStatus.ForkDigest)
+		assert.Equal(t, "5", d.ChainStatus.FinalizedEpoch)
+		assert.Equal(t, "200", d.ChainStatus.HeadSlot)
+		require.NotNil(t, d.Scores)
+		assert.Equal(t, 1, d.Scores.BadResponses.Count)
+		assert.Equal(t, float64(3), d.Scores.Gossip.Score)
+		assert.Equal(t, float64(-1), d.Scores.Gossip.BehaviourPenalty)
+		require.Equal(t, 2, len(d.Scores.Gossip.Topics))
+		assert.Equal(t, "topic-a", d.Scores.Gossip.Topics[0].Topic)
+		assert.Equal(t, float64(4), d.Scores.Gossip.Topics[0].FirstMessageDeliveries)
+		require.NotNil(t, d.Scores.Gossip.Topics[0].Components)
+		assert.Equal(t, float64(2), d.Scores.Gossip.Topics[0].Components.Score)
+		assert.Equal(t, "topic-b", d.Scores.Gossip.Topics[1].Topic)
+		assert.Equal(t, "2s", d.Scores.Gossip.Topics[1].TimeInMesh)
+		assert.Equal(t, true, d.Scores.Gossip.Topics[1].Components == nil)
+		require.Equal(t, 1, len(d.RateLimits))
+		assert.Equal(t, "5", d.RateLimits[0].Capacity)
+		assert.Equal(t, "4", d.RateLimits[0].Remaining)
+		assert.Equal(t, "1s", d.RateLimits[0].TillEmpty)
+	})
+	t.Run("disconnect reason", func(t *testing.T) {
+		writer := getPeerDetails(t, ids[1].String())
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &structs.GetPeerDetailsResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		assert.Equal(t, "DISCONNECTED", resp.Data.State)
+		assert.Equal(t, "sent goodbye: client banned this node", resp.Data.DisconnectReason)
+		assert.Equal(t, true, resp.Data.ChainStatus == nil)
+		assert.Equal(t, 0, len(resp.Data.RateLimits))
+	})
+	t.Run("invalid peer ID", func(t *testing.T) {
+		writer := getPeerDetails(t, "foo")
+		assert.Equal(t, http.StatusBadRequest, writer.Code)
+	})
+	t.Run("unknown peer", func(t *testing.T) {
+		writer := getPeerDetails(t, libp2ptest.GeneratePeerIDs(1)[0].String())
+		assert.Equal(t, http.StatusNotFound, writer.Code)
+	})
+}
+
+func TestListPeerDetails(t *testing.T) {
+	ids := libp2ptest.GeneratePeerIDs(4)
+	peerFetcher := &mockp2p.MockPeersProvider{}
+	peerFetcher.ClearPeers()
+	peerStatus := peerFetcher.Peers()
+	addr, err := ma.NewMultiaddr("/ip4/127.0.0.1/tcp/13000")
+	require.NoError(t, err)
+	for i, id := range ids {
+		peerStatus.Add(nil, id, addr, corenet.DirInbound)
+		if i%2 == 0 {
+			peerStatus.SetConnectionState(id, peers.Connected)
+		} else {
+			peerStatus.SetConnectionState(id, peers.Disconnected)
+		}
+	}
+	s := &Server{PeersFetcher: peerFetcher}
+
+	listPeerDetails := func(t *testing.T, query string) *structs.ListPeerDetailsResponse {
+		request := httptest.NewRequest(http.MethodGet, "http://anything.is.fine/prysm/v1/node/peers"+query, nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		s.ListPeerDetails(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &structs.ListPeerDetailsResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		return resp
+	}
+
+	t.Run("all peers", func(t *testing.T) {
+		resp := listPeerDetails(t, "")
+		assert.Equal(t, 4, len(resp.Data))
+	})
+	t.Run("state filter", func(t *testing.T) {
+		resp := listPeerDetails(t, "?state=connected")
+		require.Equal(t, 2, len(resp.Data))
+		for _, d := range resp.Data {
+			assert.Equal(t, "CONNECTED", d.State)
+		}
+	})
+}
@@ -0,0 +1,52 @@
+package node
+
+import (
+	"net/http"
+	"sort"
+	"strconv"
+
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing/trace"
+	"github.com/prysmaticlabs/prysm/v5/network/httputil"
+)
+
+// GetRateLimits retrieves the state of the rate limits applied to the RPC requests of peers: the quota
+// of each peer class, how much the limits are lowered by the load of the node, and the peers whose
+// requests were recently rejected, the most recently rejected first.
+func (s *Server) GetRateLimits(w http.ResponseWriter, r *http.Request) {
+	_, span := trace.StartSpan(r.Context(), "node.GetRateLimits")
+	defer span.End()
+
+	if s.RateLimitFetcher == nil {
+		httputil.HandleError(w, "Rate limits are not supported", http.StatusNotImplemented)
+		return
+	}
+	status := s.RateLimitFetcher.RateLimitStatus()
+	if status == nil {
+		httputil.HandleError(w, "Rate limiter is not initialized", http.StatusServiceUnavailable)
+		return
+	}
+
+	data := &structs.RateLimits{
+		Adaptive:       status.Adaptive,
+		LoadFactor:     status.LoadFactor,
+		CpuUsage:       status.CPUUsage,
+		DbReadLatency:  status.DBReadLatency.String(),
+		Quotas:         make([]*structs.RateLimitQuota, 0, len(status.Quotas)),
+		ThrottledPeers: make([]*structs.ThrottledPeer, 0, len(status.ThrottledPeers)),
+	}
+	for class, factor := range status.Quotas {
+		data.Quotas = append(data.Quotas, &structs.RateLimitQuota{Class: class, Factor: factor})
+	}
+	sort.Slice(data.Quotas, func(i, j int) bool { return data.Quotas[i].Class < data.Quotas[j].Class })
+	for _, p := range status.ThrottledPeers {
+		data.ThrottledPeers = append(data.ThrottledPeers, &structs.ThrottledPeer{
+			PeerId:        p.PeerID.String(),
+			Class:         p.Class,
+			Topic:         p.Topic,
+			Count:         strconv.FormatUint(p.Count, 10),
+			LastThrottled: formatTime(p.LastThrottled),
+		})
+	}
+	httputil.WriteJson(w, &structs.GetRateLimitsResponse{Data: data})
+}
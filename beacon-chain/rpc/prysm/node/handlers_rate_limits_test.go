@@ -0,0 +1,72 @@
+package node
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	libp2ptest "github.com/libp2p/go-libp2p/p2p/host/peerstore/test"
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/sync"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func TestGetRateLimits(t *testing.T) {
+	ids := libp2ptest.GeneratePeerIDs(2)
+	lastThrottled := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	fetcher := &mockRateLimitFetcher{status: &sync.RateLimitStatus{
+		Adaptive:      true,
+		LoadFactor:    0.5,
+		CPUUsage:      0.9,
+		DBReadLatency: 30 * time.Millisecond,
+		Quotas:        map[string]float64{"trusted": 4, "inbound": 1, "outbound": 2},
+		ThrottledPeers: []*sync.ThrottledPeer{
+			{PeerID: ids[0], Class: "inbound", Topic: "blocks", Count: 3, LastThrottled: lastThrottled},
+			{PeerID: ids[1], Class: "outbound", Topic: "blobs", Count: 1, LastThrottled: lastThrottled.Add(-time.Minute)},
+		},
+	}}
+	s := &Server{RateLimitFetcher: fetcher}
+
+	request := httptest.NewRequest(http.MethodGet, "http://anything.is.fine", nil)
+	writer := httptest.NewRecorder()
+	writer.Body = &bytes.Buffer{}
+	s.GetRateLimits(writer, request)
+	require.Equal(t, http.StatusOK, writer.Code)
+	resp := &structs.GetRateLimitsResponse{}
+	require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+	d := resp.Data
+	assert.Equal(t, true, d.Adaptive)
+	assert.Equal(t, 0.5, d.LoadFactor)
+	assert.Equal(t, 0.9, d.CpuUsage)
+	assert.Equal(t, "30ms", d.DbReadLatency)
+	require.Equal(t, 3, len(d.Quotas))
+	assert.Equal(t, "inbound", d.Quotas[0].Class)
+	assert.Equal(t, "outbound", d.Quotas[1].Class)
+	assert.Equal(t, "trusted", d.Quotas[2].Class)
+	assert.Equal(t, float64(4), d.Quotas[2].Factor)
+	require.Equal(t, 2, len(d.ThrottledPeers))
+	assert.Equal(t, ids[0].String(), d.ThrottledPeers[0].PeerId)
+	assert.Equal(t, "inbound", d.ThrottledPeers[0].Class)
+	assert.Equal(t, "blocks", d.ThrottledPeers[0].Topic)
+	assert.Equal(t, "3", d.ThrottledPeers[0].Count)
+	assert.Equal(t, "2024-01-02T03:04:05Z", d.ThrottledPeers[0].LastThrottled)
+
+	t.Run("not initialized", func(t *testing.T) {
+		s := &Server{RateLimitFetcher: &mockRateLimitFetcher{}}
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		s.GetRateLimits(writer, request)
+		assert.Equal(t, http.StatusServiceUnavailable, writer.Code)
+	})
+	t.Run("not supported", func(t *testing.T) {
+		s := &Server{}
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		s.GetRateLimits(writer, request)
+		assert.Equal(t, http.StatusNotImplemented, writer.Code)
+	})
+}
@@ -15,6 +15,9 @@ type Server struct {
 	PeersFetcher              p2p.PeersProvider
 	PeerManager               p2p.PeerManager
 	MetadataProvider          p2p.MetadataProvider
+	GossipScoreInspector      p2p.GossipScoreInspector
+	PeerBanner                p2p.PeerBanner
+	RateLimitFetcher          sync.RateLimitFetcher
 	GenesisTimeFetcher        blockchain.TimeFetcher
 	HeadFetcher               blockchain.HeadFetcher
 	ExecutionChainInfoFetcher execution.ChainInfoFetcher
@@ -106,6 +106,9 @@ type Config struct {
 	PeersFetcher              p2p.PeersProvider
 	PeerManager               p2p.PeerManager
 	MetadataProvider          p2p.MetadataProvider
+	GossipScoreInspector      p2p.GossipScoreInspector
+	PeerBanner                p2p.PeerBanner
+	RateLimitFetcher          chainSync.RateLimitFetcher
 	DepositFetcher            cache.DepositFetcher
 	PendingDepositFetcher     depositsnapshot.PendingDepositsFetcher
 	StateNotifier             statefeed.Notifier
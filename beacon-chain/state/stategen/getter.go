@@ -217,11 +217,18 @@ func (s *State) loadStateByRoot(ctx context.Context, blockRoot [32]byte) (state.
 	}
 	targetSlot := summary.Slot
 
-	// Since the requested state is not in caches or DB, start replaying using the last
-	// available ancestor state which is retrieved using input block's root.
-	startState, err := s.latestAncestor(ctx, blockRoot)
+	// Finalized states are read from the state diff storage, or replayed from the closest stored state when the
+	// background routine has not reached them yet. Otherwise, since the requested state is not in caches or DB,
+	// start replaying using the last available ancestor state which is retrieved using input block's root.
+	startState, err := s.stateDiffAncestor(ctx, blockRoot, targetSlot)
 	if err != nil {
-		return nil, errors.Wrap(err, "could not get ancestor state")
+		return nil, err
+	}
+	if startState == nil {
+		startState, err = s.latestAncestor(ctx, blockRoot)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not get ancestor state")
+		}
 	}
 	if startState == nil || startState.IsNil() {
 		return nil, errUnknownBoundaryState
@@ -241,6 +248,23 @@ func (s *State) loadStateByRoot(ctx context.Context, blockRoot [32]byte) (state.
 	return s.replayBlocks(ctx, startState, blks, targetSlot)
 }
 
+// stateDiffAncestor returns the state of the state diff storage at the highest slot not above the slot of the
+// given finalized block, or nil if there is none or the block is not finalized.
+func (s *State) stateDiffAncestor(ctx context.Context, blockRoot [32]byte, slot primitives.Slot) (state.BeaconState, error) {
+	diffSlot, ok, err := s.beaconDB.HighestStateDiffSlot(ctx, slot)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not find state diff")
+	}
+	if !ok || !s.beaconDB.IsFinalizedBlock(ctx, blockRoot) {
+		return nil, nil
+	}
+	st, err := s.beaconDB.StateDiff(ctx, diffSlot)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not read state diff at slot %d", diffSlot)
+	}
+	return st, nil
+}
+
 // latestAncestor returns the highest available ancestor state of the input block root.
 // It recursively looks up block's parent until a corresponding state of the block root
 // is found in the caches or DB.
@@ -114,6 +114,16 @@ func (c *CanonicalHistory) chainForSlot(ctx context.Context, target primitives.S
 	if err != nil {
 		return nil, nil, errors.Wrapf(err, "unable to retrieve canonical block for slot, root=%#x", r)
 	}
+	// Finalized states are canonical, the replay can start from the closest one in the state diff storage.
+	if sd, ok := c.h.(stateDiffReader); ok {
+		diffSlot, ok, err := sd.HighestStateDiffSlot(ctx, target)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "could not find state diff below slot=%d", target)
+		}
+		if ok {
+			return c.stateDiffChain(ctx, sd, diffSlot, b)
+		}
+	}
 	s, descendants, err := c.ancestorChain(ctx, b)
 	if err != nil {
 		return nil, nil, errors.Wrap(err, "failed to query for ancestor and descendant blocks")
@@ -122,6 +132,37 @@ func (c *CanonicalHistory) chainForSlot(ctx context.Context, target primitives.S
 	return s, descendants, nil
 }
 
+// stateDiffChain returns the state at diffSlot from the state diff storage, and the canonical blocks after it up to
+// the tail block, in ascending order.
+func (c *CanonicalHistory) stateDiffChain(
+	ctx context.Context,
+	sd stateDiffReader,
+	diffSlot primitives.Slot,
+	tail interfaces.ReadOnlySignedBeaconBlock,
+) (state.BeaconState, []interfaces.ReadOnlySignedBeaconBlock, error) {
+	st, err := sd.StateDiff(ctx, diffSlot)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "could not read state diff at slot=%d", diffSlot)
+	}
+	chain := make([]interfaces.ReadOnlySignedBeaconBlock, 0)
+	for tail.Block().Slot() > diffSlot {
+		if err := ctx.Err(); err != nil {
+			return nil, nil, err
+		}
+		chain = append(chain, tail)
+		parent := tail.Block().ParentRoot()
+		tail, err = c.h.Block(ctx, parent)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "unable to retrieve parent block, root=%#x", parent)
+		}
+		if err := blocks.BeaconBlockIsNil(tail); err != nil {
+			return nil, nil, errors.Wrapf(err, "parent block of root=%#x is missing", parent)
+		}
+	}
+	reverseChain(chain)
+	return st, chain, nil
+}
+
 func (c *CanonicalHistory) getState(ctx context.Context, blockRoot [32]byte) (state.BeaconState, error) {
 	if c.cache != nil {
 		st, err := c.cache.ByBlockRoot(blockRoot)
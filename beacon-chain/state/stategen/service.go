@@ -56,6 +56,7 @@ type State struct {
 	avb                     coverage.AvailableBlocker
 	migrationLock           *sync.Mutex
 	fc                      forkchoice.ForkChoicer
+	stateDiffs              *stateDiffRoutine
 }
 
 // This tracks the config in the event of long non-finality,
@@ -101,6 +102,7 @@ func New(beaconDB db.NoHeadAccessDatabase, fc forkchoice.ForkChoicer, opts ...Op
 		},
 		migrationLock: new(sync.Mutex),
 		fc:            fc,
+		stateDiffs:    &stateDiffRoutine{},
 	}
 	for _, o := range opts {
 		o(s)
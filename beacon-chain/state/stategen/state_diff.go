@@ -0,0 +1,180 @@
+package stategen
+
+import (
+	"context"
+	"sync"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing/trace"
+	"github.com/sirupsen/logrus"
+)
+
+// stateDiffReader is implemented by databases that store finalized states in the hierarchical state diff storage.
+type stateDiffReader interface {
+	HighestStateDiffSlot(ctx context.Context, slot primitives.Slot) (primitives.Slot, bool, error)
+	StateDiff(ctx context.Context, slot primitives.Slot) (state.BeaconState, error)
+}
+
+// stateDiffRoutine generates the state diffs of the finalized slots in the background. Every finalized slot has
+// to be processed, which would otherwise hold the migration lock for as long as the state transitions take.
+type stateDiffRoutine struct {
+	sync.Mutex
+	running bool
+	stopped bool
+	cancel  context.CancelFunc
+	wg      sync.WaitGroup
+	// next is the first slot the diffs of which are not generated yet.
+	next primitives.Slot
+	// target is the latest finalized slot and root, the diffs are generated up to the slot before it.
+	target     primitives.Slot
+	targetRoot [32]byte
+	// last is the state at the slot before next, kept to continue where the previous run stopped.
+	last state.BeaconState
+}
+
+// scheduleStateDiffs records the new finalized block and starts the state diff routine unless it is running
+// already, in which case it picks up the new target once done with the current one. startSlot is the previous
+// finalized slot, where the routine starts if it has not run before.
+func (s *State) scheduleStateDiffs(ctx context.Context, startSlot, fSlot primitives.Slot, fRoot [32]byte) {
+	r := s.stateDiffs
+	r.Lock()
+	defer r.Unlock()
+	if r.last == nil && r.next < startSlot {
+		r.next = startSlot
+	}
+	if fSlot <= r.target {
+		return
+	}
+	r.target, r.targetRoot = fSlot, fRoot
+	if r.running || r.stopped {
+		return
+	}
+	r.running = true
+	ctx, cancel := context.WithCancel(ctx)
+	r.cancel = cancel
+	r.wg.Add(1)
+	go s.runStateDiffs(ctx, cancel)
+}
+
+// Stop interrupts the state diff routine and waits for it to return, so that it does not outlive the db.
+// The routine is not started again afterwards.
+func (s *State) Stop() {
+	r := s.stateDiffs
+	r.Lock()
+	r.stopped = true
+	if r.cancel != nil {
+		r.cancel()
+	}
+	r.Unlock()
+	r.wg.Wait()
+}
+
+func (s *State) runStateDiffs(ctx context.Context, cancel context.CancelFunc) {
+	r := s.stateDiffs
+	defer r.wg.Done()
+	defer cancel()
+	for {
+		r.Lock()
+		if r.next >= r.target || ctx.Err() != nil {
+			r.running = false
+			r.Unlock()
+			return
+		}
+		start, end, endRoot, last := r.next, r.target, r.targetRoot, r.last
+		r.Unlock()
+
+		st, err := s.saveStateDiffs(ctx, last, start, end, endRoot)
+
+		r.Lock()
+		if err != nil {
+			// Like a failed migration, the range is skipped and the next run starts at the finalized slot it
+			// is scheduled with.
+			log.WithError(err).Error("Could not save state diffs")
+			r.last = nil
+			r.running = false
+			r.Unlock()
+			return
+		}
+		r.next, r.last = end, st
+		r.Unlock()
+	}
+}
+
+// saveStateDiffs stores the canonical states of the slots in [startSlot, endSlot) which belong to the state diff
+// storage, endRoot being the finalized block at endSlot. The states are built by processing the finalized blocks
+// on top of last, the state at the slot before startSlot, or of the regenerated state at startSlot if last is nil.
+// The state at the slot before endSlot is returned.
+func (s *State) saveStateDiffs(
+	ctx context.Context,
+	last state.BeaconState,
+	startSlot, endSlot primitives.Slot,
+	endRoot [32]byte,
+) (state.BeaconState, error) {
+	ctx, span := trace.StartSpan(ctx, "stateGen.saveStateDiffs")
+	defer span.End()
+
+	st := last
+	if st == nil || st.Slot()+1 != startSlot {
+		var err error
+		st, err = s.stateDiffStart(ctx, startSlot)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not get state diff start state at slot %d", startSlot)
+		}
+	}
+	blks, err := s.loadBlocks(ctx, st.Slot()+1, endSlot, endRoot)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not load finalized blocks")
+	}
+	// Blocks are loaded in descending order, the last one being the block at endSlot which is handled by the
+	// next run.
+	next := len(blks) - 1
+	for slot := st.Slot() + 1; slot < endSlot; slot++ {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		if next >= 0 && blks[next].Block().Slot() == slot {
+			st, err = executeStateTransitionStateGen(ctx, st, blks[next])
+			next--
+		} else {
+			st, err = ReplayProcessSlots(ctx, st, slot)
+		}
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not compute state at slot %d", slot)
+		}
+		if err := s.beaconDB.SaveStateDiff(ctx, st); err != nil {
+			return nil, errors.Wrapf(err, "could not save state diff at slot %d", slot)
+		}
+	}
+	log.WithFields(logrus.Fields{
+		"startSlot": startSlot,
+		"endSlot":   endSlot - 1,
+	}).Debug("Saved state diffs")
+	return st, nil
+}
+
+// stateDiffStart regenerates the state at startSlot, e.g. after a restart or a checkpoint sync, and stores it.
+func (s *State) stateDiffStart(ctx context.Context, startSlot primitives.Slot) (state.BeaconState, error) {
+	_, roots, err := s.beaconDB.HighestRootsBelowSlot(ctx, startSlot+1)
+	if err != nil {
+		return nil, err
+	}
+	// Given the block has been finalized, the db should not have more than one block in a given slot.
+	if len(roots) != 1 {
+		return nil, errUnknownBlock
+	}
+	st, err := s.StateByRoot(ctx, roots[0])
+	if err != nil {
+		return nil, err
+	}
+	// The state may be shared with the caches, it must not be modified.
+	st, err = ReplayProcessSlots(ctx, st.Copy(), startSlot)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.beaconDB.SaveStateDiff(ctx, st); err != nil {
+		return nil, err
+	}
+	return st, nil
+}
@@ -0,0 +1,121 @@
+package stategen
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/blocks"
+	testDB "github.com/prysmaticlabs/prysm/v5/beacon-chain/db/testing"
+	doublylinkedtree "github.com/prysmaticlabs/prysm/v5/beacon-chain/forkchoice/doubly-linked-tree"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/v5/config/features"
+	consensusblocks "github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+)
+
+func TestMigrateToCold_SaveStateDiffs(t *testing.T) {
+	resetCfg := features.InitWithReset(&features.Flags{EnableStateDiff: true})
+	defer resetCfg()
+
+	ctx := context.Background()
+	beaconDB := testDB.SetupDB(t)
+	service := New(beaconDB, doublylinkedtree.New())
+
+	genesisState, pks := util.DeterministicGenesisState(t, 32)
+	// The latest block header has to match the genesis block for the following blocks to build on it.
+	bodyRoot, err := blocks.NewGenesisBlock(nil).Block.Body.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, genesisState.SetLatestBlockHeader(&ethpb.BeaconBlockHeader{
+		ParentRoot: make([]byte, 32),
+		StateRoot:  make([]byte, 32),
+		BodyRoot:   bodyRoot[:],
+	}))
+	genesisStateRoot, err := genesisState.HashTreeRoot(ctx)
+	require.NoError(t, err)
+	genesis := blocks.NewGenesisBlock(genesisStateRoot[:])
+	util.SaveBlock(t, ctx, beaconDB, genesis)
+	gRoot, err := genesis.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, beaconDB.SaveGenesisBlockRoot(ctx, gRoot))
+	// The genesis state is only cached, the states of the migrated slots can not be regenerated from the db
+	// without the state diffs.
+	require.NoError(t, service.epochBoundaryStateCache.put(gRoot, genesisState))
+
+	// Blocks at slots 1, 3 and 4, 4 being the new finalized block.
+	st := genesisState.Copy()
+	roots := make(map[primitives.Slot][32]byte)
+	want := make(map[primitives.Slot]state.BeaconState)
+	for _, slot := range []primitives.Slot{1, 3, 4} {
+		if slot == 3 {
+			want[2], err = ReplayProcessSlots(ctx, st.Copy(), 2)
+			require.NoError(t, err)
+		}
+		b, err := util.GenerateFullBlock(st, pks, util.DefaultBlockGenConfig(), slot)
+		require.NoError(t, err)
+		util.SaveBlock(t, ctx, beaconDB, b)
+		r, err := b.Block.HashTreeRoot()
+		require.NoError(t, err)
+		roots[slot] = r
+		require.NoError(t, beaconDB.SaveStateSummary(ctx, &ethpb.StateSummary{Slot: slot, Root: r[:]}))
+		wsb, err := consensusblocks.NewSignedBeaconBlock(b)
+		require.NoError(t, err)
+		st, err = executeStateTransitionStateGen(ctx, st, wsb)
+		require.NoError(t, err)
+		want[slot] = st.Copy()
+	}
+	want[0] = genesisState
+	service.finalizedInfo = &finalizedInfo{slot: 0, root: gRoot, state: genesisState}
+
+	require.NoError(t, service.MigrateToCold(ctx, roots[4]))
+	// The diffs are generated in the background, up to the slot before the new finalized block.
+	service.stateDiffs.wg.Wait()
+	for slot := primitives.Slot(0); slot < 4; slot++ {
+		require.Equal(t, true, beaconDB.HasStateDiff(ctx, slot), "slot %d", slot)
+		got, err := beaconDB.StateDiff(ctx, slot)
+		require.NoError(t, err)
+		requireSameState(t, want[slot], got)
+	}
+	require.Equal(t, false, beaconDB.HasStateDiff(ctx, 4))
+	service.stateDiffs.Lock()
+	require.Equal(t, primitives.Slot(4), service.stateDiffs.next)
+	requireSameState(t, want[3], service.stateDiffs.last)
+	service.stateDiffs.Unlock()
+
+	// The states are read from the state diff storage.
+	t.Run("state by root", func(t *testing.T) {
+		fRoot := roots[4]
+		require.NoError(t, beaconDB.SaveFinalizedCheckpoint(ctx, &ethpb.Checkpoint{Root: fRoot[:]}))
+		service := New(beaconDB, doublylinkedtree.New())
+		got, err := service.StateByRoot(ctx, roots[3])
+		require.NoError(t, err)
+		requireSameState(t, want[3], got)
+	})
+	t.Run("state by slot", func(t *testing.T) {
+		ch := NewCanonicalHistory(beaconDB, &mockCanonicalChecker{is: true}, &mockCurrentSlotter{Slot: 10})
+		got, err := ch.ReplayerForSlot(2).ReplayBlocks(ctx)
+		require.NoError(t, err)
+		requireSameState(t, want[2], got)
+	})
+}
+
+func requireSameState(t *testing.T, want, got state.BeaconState) {
+	wantRoot, err := want.HashTreeRoot(context.Background())
+	require.NoError(t, err)
+	gotRoot, err := got.HashTreeRoot(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, wantRoot, gotRoot)
+}
+
+func TestStop_StateDiffs(t *testing.T) {
+	service := New(testDB.SetupDB(t), doublylinkedtree.New())
+	service.Stop()
+	// The routine is not started once stopped.
+	service.scheduleStateDiffs(context.Background(), 0, 32, [32]byte{'a'})
+	service.stateDiffs.Lock()
+	require.Equal(t, false, service.stateDiffs.running)
+	service.stateDiffs.Unlock()
+	service.Stop()
+}
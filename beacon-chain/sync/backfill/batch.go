@@ -40,6 +40,8 @@ func (s batchState) String() string {
 		return "end_sequence"
 	case batchBlobSync:
 		return "blob_sync"
+	case batchColumnSync:
+		return "column_sync"
 	default:
 		return "unknown"
 	}
@@ -51,6 +53,7 @@ const (
 	batchSequenced
 	batchErrRetryable
 	batchBlobSync
+	batchColumnSync
 	batchImportable
 	batchImportComplete
 	batchEndSequence
@@ -74,7 +77,9 @@ type batch struct {
 	busy           peer.ID
 	blockPid       peer.ID
 	blobPid        peer.ID
+	columnPid      peer.ID
 	bs             *blobSync
+	cs             *columnSync
 }
 
 func (b batch) logFields() logrus.Fields {
@@ -89,6 +94,7 @@ func (b batch) logFields() logrus.Fields {
 		"busyPid":   b.busy,
 		"blockPid":  b.blockPid,
 		"blobPid":   b.blobPid,
+		"columnPid": b.columnPid,
 	}
 	if b.retries > 0 {
 		f["retryAfter"] = b.retryAfter.String()
@@ -136,19 +142,36 @@ func (b batch) blobRequest() *eth.BlobSidecarsByRangeRequest {
 	}
 }
 
-func (b batch) withResults(results verifiedROBlocks, bs *blobSync) batch {
+func (b batch) withResults(results verifiedROBlocks, bs *blobSync, cs *columnSync) batch {
 	b.results = results
 	b.bs = bs
+	b.cs = cs
 	if bs.blobsNeeded() > 0 {
 		return b.withState(batchBlobSync)
 	}
-	return b.withState(batchImportable)
+	return b.postBlobSync()
 }
 
 func (b batch) postBlobSync() batch {
 	if b.blobsNeeded() > 0 {
 		log.WithFields(b.logFields()).WithField("blobsMissing", b.blobsNeeded()).Error("Batch still missing blobs after downloading from peer")
 		b.bs = nil
+		b.cs = nil
+		b.results = []blocks.ROBlock{}
+		return b.withState(batchErrRetryable)
+	}
+	// A batch crossing the Fulu fork needs both the blobs of its first blocks and the data columns of the others.
+	if b.cs != nil && b.cs.columnsNeeded() > 0 {
+		return b.withState(batchColumnSync)
+	}
+	return b.withState(batchImportable)
+}
+
+func (b batch) postColumnSync() batch {
+	if b.cs.columnsNeeded() > 0 {
+		log.WithFields(b.logFields()).WithField("columnsMissing", b.cs.columnsNeeded()).Error("Batch still missing data columns after downloading from peer")
+		b.bs = nil
+		b.cs = nil
 		b.results = []blocks.ROBlock{}
 		return b.withState(batchErrRetryable)
 	}
@@ -195,8 +218,15 @@ func (b batch) blobResponseValidator() sync.BlobResponseValidation {
 	return b.bs.validateNext
 }
 
+func (b batch) columnRequest() *eth.DataColumnSidecarsByRangeRequest {
+	return b.cs.columnRequest()
+}
+
 func (b batch) availabilityStore() das.AvailabilityStore {
-	return b.bs.store
+	if b.cs == nil {
+		return b.bs.store
+	}
+	return das.NewForkAwareStore(b.bs.store, b.cs.store)
 }
 
 var batchBlockUntil = func(ctx context.Context, untilRetry time.Duration, b batch) error {
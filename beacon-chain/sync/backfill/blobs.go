@@ -90,7 +90,7 @@ func (bs *blobSync) validateNext(rb blocks.ROBlob) error {
 	if err := v.SidecarKzgProofVerified(); err != nil {
 		return err
 	}
-	if err := bs.store.Persist(bs.current, rb); err != nil {
+	if err := bs.store.Persist(bs.current, blocks.NewSidecarFromBlobSidecar(rb)); err != nil {
 		return err
 	}
 
@@ -0,0 +1,132 @@
+package backfill
+
+import (
+	"slices"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/das"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/filesystem"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/sync/verify"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/verification"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	eth "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/runtime/version"
+)
+
+// columnConfig holds what the workers need to download and verify the custody columns of the batches.
+type columnConfig struct {
+	ncv     verification.NewDataColumnVerifier
+	store   *filesystem.DataColumnStorage
+	custody map[uint64]bool
+}
+
+type columnSyncConfig struct {
+	columnConfig
+	retentionStart primitives.Slot
+}
+
+// newColumnSync prepares the download of the custody columns of the blocks from the Fulu fork on. The data columns
+// are checked against their block when downloaded, and fully verified by the availability store when the batch is imported.
+func newColumnSync(current primitives.Slot, vbs verifiedROBlocks, cfg *columnSyncConfig) (*columnSync, error) {
+	cv := verification.NewDataColumnBatchVerifier(cfg.ncv, verification.BackfillDataColumnSidecarRequirements)
+	cs := &columnSync{
+		current: current,
+		custody: cfg.custody,
+		blocks:  make(map[[32]byte]blocks.ROBlock),
+		missing: make(map[[32]byte]map[uint64]bool),
+		store:   das.NewLazilyPersistentStoreColumn(cfg.store, cv, cfg.custody),
+	}
+	if cfg.store == nil || len(cfg.custody) == 0 {
+		return cs, nil
+	}
+	for _, b := range vbs {
+		if b.Block().Slot() < cfg.retentionStart || b.Version() < version.Fulu {
+			continue
+		}
+		c, err := b.Block().Body().BlobKzgCommitments()
+		if err != nil {
+			return nil, errors.Wrapf(err, "unexpected error checking commitments for block root %#x", b.Root())
+		}
+		if len(c) == 0 {
+			continue
+		}
+		onDisk := cfg.store.Summary(b.Root())
+		missing := make(map[uint64]bool, len(cfg.custody))
+		for idx := range cfg.custody {
+			if !onDisk.HasIndex(idx) {
+				missing[idx] = true
+			}
+		}
+		if len(missing) == 0 {
+			continue
+		}
+		cs.blocks[b.Root()] = b
+		cs.missing[b.Root()] = missing
+	}
+	return cs, nil
+}
+
+type columnSync struct {
+	store   das.AvailabilityStore
+	custody map[uint64]bool
+	blocks  map[[32]byte]blocks.ROBlock
+	missing map[[32]byte]map[uint64]bool
+	current primitives.Slot
+}
+
+func (cs *columnSync) columnsNeeded() int {
+	n := 0
+	for _, m := range cs.missing {
+		n += len(m)
+	}
+	return n
+}
+
+// columnRequest requests the custody columns over the range of slots of the blocks which miss some of them.
+func (cs *columnSync) columnRequest() *eth.DataColumnSidecarsByRangeRequest {
+	var low, high primitives.Slot
+	first := true
+	for root := range cs.missing {
+		slot := cs.blocks[root].Block().Slot()
+		if first || slot < low {
+			low = slot
+		}
+		if first || slot > high {
+			high = slot
+		}
+		first = false
+	}
+	columns := make([]uint64, 0, len(cs.custody))
+	for idx := range cs.custody {
+		columns = append(columns, idx)
+	}
+	slices.Sort(columns)
+	return &eth.DataColumnSidecarsByRangeRequest{
+		StartSlot: low,
+		Count:     uint64(high.FlooredSubSlot(low)) + 1,
+		Columns:   columns,
+	}
+}
+
+// validate checks the given data columns against their block and adds the missing ones to the availability store.
+// The data columns which were not requested, or were already received, are ignored.
+func (cs *columnSync) validate(columns []blocks.RODataColumn) error {
+	for _, c := range columns {
+		missing, ok := cs.missing[c.BlockRoot()]
+		if !ok || !missing[c.ColumnIndex] {
+			continue
+		}
+		if err := verify.DataColumnAlignsWithBlock(c, cs.blocks[c.BlockRoot()]); err != nil {
+			return err
+		}
+		if err := cs.store.Persist(cs.current, blocks.NewSidecarFromDataColumnSidecar(c)); err != nil {
+			return err
+		}
+		delete(missing, c.ColumnIndex)
+		if len(missing) == 0 {
+			delete(cs.missing, c.BlockRoot())
+		}
+	}
+	return nil
+}
@@ -0,0 +1,131 @@
+package backfill
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/filesystem"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/sync/verify"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/verification"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+	"google.golang.org/protobuf/proto"
+)
+
+func testColumnGen(t *testing.T, start primitives.Slot, n int) ([]blocks.ROBlock, [][]blocks.RODataColumn) {
+	blks := make([]blocks.ROBlock, n)
+	columns := make([][]blocks.RODataColumn, n)
+	for i := 0; i < n; i++ {
+		blks[i], columns[i] = util.GenerateTestFuluBlockWithColumns(t, [32]byte{}, start+primitives.Slot(i), 1)
+	}
+	return blks, columns
+}
+
+func testNewDataColumnVerifier() verification.NewDataColumnVerifier {
+	return func(d blocks.RODataColumn, reqs []verification.Requirement) verification.DataColumnVerifier {
+		return &verification.MockDataColumnVerifier{
+			CbVerifiedRODataColumn: func() (blocks.VerifiedRODataColumn, error) {
+				return blocks.NewVerifiedRODataColumn(d), nil
+			},
+		}
+	}
+}
+
+func testColumnSyncConfig(store *filesystem.DataColumnStorage) *columnSyncConfig {
+	return &columnSyncConfig{
+		columnConfig: columnConfig{
+			ncv:     testNewDataColumnVerifier(),
+			store:   store,
+			custody: map[uint64]bool{0: true, 3: true},
+		},
+	}
+}
+
+func TestNewColumnSync(t *testing.T) {
+	current := primitives.Slot(128)
+	blks, columns := testColumnGen(t, 63, 3)
+	store := filesystem.NewEphemeralDataColumnStorage(t)
+	// The custody columns of the last block are already on disk.
+	for _, idx := range []uint64{0, 3} {
+		require.NoError(t, store.Save(blocks.NewVerifiedRODataColumn(columns[2][idx])))
+	}
+	// Blocks before the Fulu fork, or without commitments, don't need data columns.
+	deneb, _ := util.GenerateTestDenebBlockWithSidecar(t, [32]byte{}, 60, 1)
+	empty, _ := util.GenerateTestFuluBlockWithColumns(t, [32]byte{}, 61, 0)
+
+	cs, err := newColumnSync(current, append([]blocks.ROBlock{deneb, empty}, blks...), testColumnSyncConfig(store))
+	require.NoError(t, err)
+	require.Equal(t, 4, cs.columnsNeeded())
+	req := cs.columnRequest()
+	require.Equal(t, primitives.Slot(63), req.StartSlot)
+	require.Equal(t, uint64(2), req.Count)
+	require.DeepEqual(t, []uint64{0, 3}, req.Columns)
+
+	cfg := testColumnSyncConfig(store)
+	cfg.retentionStart = 64
+	cs, err = newColumnSync(current, blks, cfg)
+	require.NoError(t, err)
+	require.Equal(t, 2, cs.columnsNeeded())
+
+	// Nothing is needed without custody columns.
+	cfg = testColumnSyncConfig(store)
+	cfg.custody = nil
+	cs, err = newColumnSync(current, blks, cfg)
+	require.NoError(t, err)
+	require.Equal(t, 0, cs.columnsNeeded())
+}
+
+func TestColumnSyncValidate(t *testing.T) {
+	current := primitives.Slot(128)
+	t.Run("happy path", func(t *testing.T) {
+		blks, columns := testColumnGen(t, 63, 2)
+		store := filesystem.NewEphemeralDataColumnStorage(t)
+		cs, err := newColumnSync(current, blks, testColumnSyncConfig(store))
+		require.NoError(t, err)
+		// Columns which were not requested are ignored.
+		require.NoError(t, cs.validate([]blocks.RODataColumn{columns[0][0], columns[0][1], columns[0][3]}))
+		require.Equal(t, 2, cs.columnsNeeded())
+		// Duplicates are ignored.
+		require.NoError(t, cs.validate([]blocks.RODataColumn{columns[0][0], columns[1][0], columns[1][3]}))
+		require.Equal(t, 0, cs.columnsNeeded())
+
+		// The data columns are saved once their block is found available.
+		for _, b := range blks {
+			require.NoError(t, cs.store.IsDataAvailable(context.Background(), current, b))
+			require.Equal(t, true, store.Summary(b.Root()).AllAvailable(cs.custody))
+		}
+	})
+	t.Run("misaligned", func(t *testing.T) {
+		blks, columns := testColumnGen(t, 63, 1)
+		cs, err := newColumnSync(current, blks, testColumnSyncConfig(filesystem.NewEphemeralDataColumnStorage(t)))
+		require.NoError(t, err)
+		sidecar := proto.Clone(columns[0][0].DataColumnSidecar).(*ethpb.DataColumnSidecar)
+		sidecar.KzgCommitments = [][]byte{make([]byte, 48)}
+		column, err := blocks.NewRODataColumnWithRoot(sidecar, columns[0][0].BlockRoot())
+		require.NoError(t, err)
+		require.ErrorIs(t, cs.validate([]blocks.RODataColumn{column}), verify.ErrMismatchedDataColumnCommitments)
+		require.Equal(t, 2, cs.columnsNeeded())
+	})
+}
+
+func TestBatchColumnSyncState(t *testing.T) {
+	current := primitives.Slot(128)
+	blks, columns := testColumnGen(t, 63, 1)
+	cs, err := newColumnSync(current, blks, testColumnSyncConfig(filesystem.NewEphemeralDataColumnStorage(t)))
+	require.NoError(t, err)
+	bs, err := newBlobSync(current, blks, &blobSyncConfig{nbv: testNewBlobVerifier(), store: filesystem.NewEphemeralBlobStorage(t)})
+	require.NoError(t, err)
+
+	b := batch{}.withResults(blks, bs, cs)
+	require.Equal(t, batchColumnSync, b.state)
+	// A batch still missing data columns is retried.
+	require.Equal(t, batchErrRetryable, b.postColumnSync().state)
+
+	require.NoError(t, b.cs.validate([]blocks.RODataColumn{columns[0][0], columns[0][3]}))
+	b = b.postColumnSync()
+	require.Equal(t, batchImportable, b.state)
+	require.NoError(t, b.availabilityStore().IsDataAvailable(context.Background(), current, blks[0]))
+}
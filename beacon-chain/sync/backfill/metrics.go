@@ -51,6 +51,18 @@ var (
 			Help: "Number of BlobSidecar values downloaded from peers for backfill.",
 		},
 	)
+	backfillDataColumnsApproximateBytes = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "backfill_data_columns_bytes_downloaded",
+			Help: "DataColumnSidecar bytes downloaded from peers for backfill.",
+		},
+	)
+	backfillDataColumnsDownloadCount = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "backfill_data_columns_download_count",
+			Help: "Number of DataColumnSidecar values downloaded from peers for backfill.",
+		},
+	)
 	backfillBlocksDownloadCount = promauto.NewCounter(
 		prometheus.CounterOpts{
 			Name: "backfill_blocks_download_count",
@@ -85,6 +97,13 @@ var (
 			Buckets: []float64{100, 300, 1000, 2000, 4000, 8000},
 		},
 	)
+	backfillBatchTimeDownloadingDataColumns = promauto.NewHistogram(
+		prometheus.HistogramOpts{
+			Name:    "backfill_batch_data_columns_time_download",
+			Help:    "Time, in milliseconds, batch spent downloading data columns from peer.",
+			Buckets: []float64{100, 300, 1000, 2000, 4000, 8000},
+		},
+	)
 	backfillBatchTimeVerifying = promauto.NewHistogram(
 		prometheus.HistogramOpts{
 			Name:    "backfill_batch_time_verify",
@@ -28,6 +28,7 @@ type Service struct {
 	cw              startup.ClockWaiter
 	verifierWaiter  InitializerWaiter
 	newBlobVerifier verification.NewBlobVerifier
+	newColVerifier  verification.NewDataColumnVerifier
 	nWorkers        int
 	batchSeq        *batchSequencer
 	batchSize       uint64
@@ -38,6 +39,8 @@ type Service struct {
 	pa              PeerAssigner
 	batchImporter   batchImporter
 	blobStore       *filesystem.BlobStorage
+	columnStore     *filesystem.DataColumnStorage
+	custodyColumns  map[uint64]bool
 	initSyncWaiter  func() error
 	complete        chan struct{}
 }
@@ -103,6 +106,22 @@ func WithInitSyncWaiter(w func() error) ServiceOption {
 	}
 }
 
+// WithDataColumnStorage sets the storage of the data columns downloaded for the blocks from the Fulu fork on.
+func WithDataColumnStorage(cs *filesystem.DataColumnStorage) ServiceOption {
+	return func(s *Service) error {
+		s.columnStore = cs
+		return nil
+	}
+}
+
+// WithCustodyColumns sets the data columns which are downloaded for the blocks from the Fulu fork on.
+func WithCustodyColumns(columns map[uint64]bool) ServiceOption {
+	return func(s *Service) error {
+		s.custodyColumns = columns
+		return nil
+	}
+}
+
 // InitializerWaiter is an interface that is satisfied by verification.InitializerWaiter.
 // Using this interface enables node init to satisfy this requirement for the backfill service
 // while also allowing backfill to mock it in tests.
@@ -268,6 +287,7 @@ func (s *Service) Start() {
 	s.clock = clock
 	v, err := s.verifierWaiter.WaitForInitializer(ctx)
 	s.newBlobVerifier = newBlobVerifierFromInitializer(v)
+	s.newColVerifier = newDataColumnVerifierFromInitializer(v)
 
 	if err != nil {
 		log.WithError(err).Error("Could not initialize blob verifier in backfill service")
@@ -301,7 +321,8 @@ func (s *Service) Start() {
 			return
 		}
 	}
-	s.pool.spawn(ctx, s.nWorkers, clock, s.pa, s.verifier, s.ctxMap, s.newBlobVerifier, s.blobStore)
+	cc := &columnConfig{ncv: s.newColVerifier, store: s.columnStore, custody: s.custodyColumns}
+	s.pool.spawn(ctx, s.nWorkers, clock, s.pa, s.verifier, s.ctxMap, s.newBlobVerifier, s.blobStore, cc)
 	s.batchSeq = newBatchSequencer(s.nWorkers, s.ms(s.clock.CurrentSlot()), primitives.Slot(status.LowSlot), primitives.Slot(s.batchSize))
 	if err = s.initBatches(); err != nil {
 		log.WithError(err).Error("Non-recoverable error in backfill service")
@@ -370,6 +391,12 @@ func newBlobVerifierFromInitializer(ini *verification.Initializer) verification.
 	}
 }
 
+func newDataColumnVerifierFromInitializer(ini *verification.Initializer) verification.NewDataColumnVerifier {
+	return func(d blocks.RODataColumn, reqs []verification.Requirement) verification.DataColumnVerifier {
+		return ini.NewDataColumnVerifier(d, reqs)
+	}
+}
+
 func (s *Service) markComplete() {
 	close(s.complete)
 	log.Info("Backfill service marked as complete")
@@ -32,7 +32,8 @@ func (v verifiedROBlocks) blobIdents(retentionStart primitives.Slot) ([]blobSumm
 		if v[i].Block().Slot() < retentionStart {
 			continue
 		}
-		if v[i].Block().Version() < version.Deneb {
+		// The blocks from the Fulu fork on commit to data columns, see columnSync.
+		if v[i].Block().Version() < version.Deneb || v[i].Block().Version() >= version.Fulu {
 			continue
 		}
 		c, err := v[i].Block().Body().BlobKzgCommitments()
@@ -24,6 +24,7 @@ type p2pWorker struct {
 	cm   sync.ContextByteVersions
 	nbv  verification.NewBlobVerifier
 	bfs  *filesystem.BlobStorage
+	cc   *columnConfig
 }
 
 func (w *p2pWorker) run(ctx context.Context) {
@@ -31,9 +32,12 @@ func (w *p2pWorker) run(ctx context.Context) {
 		select {
 		case b := <-w.todo:
 			log.WithFields(b.logFields()).WithField("backfillWorker", w.id).Debug("Backfill worker received batch")
-			if b.state == batchBlobSync {
+			switch b.state {
+			case batchBlobSync:
 				w.done <- w.handleBlobs(ctx, b)
-			} else {
+			case batchColumnSync:
+				w.done <- w.handleColumns(ctx, b)
+			default:
 				w.done <- w.handleBlocks(ctx, b)
 			}
 		case <-ctx.Done():
@@ -77,7 +81,15 @@ func (w *p2pWorker) handleBlocks(ctx context.Context, b batch) batch {
 	if err != nil {
 		return b.withRetryableError(err)
 	}
-	return b.withResults(vb, bs)
+	columnRetentionStart, err := sync.DataColumnRPCMinValidSlot(cs)
+	if err != nil {
+		return b.withRetryableError(errors.Wrap(err, "configuration issue, could not compute minimum data column retention slot"))
+	}
+	cols, err := newColumnSync(cs, vb, &columnSyncConfig{columnConfig: *w.cc, retentionStart: columnRetentionStart})
+	if err != nil {
+		return b.withRetryableError(err)
+	}
+	return b.withResults(vb, bs, cols)
 }
 
 func (w *p2pWorker) handleBlobs(ctx context.Context, b batch) batch {
@@ -101,7 +113,36 @@ func (w *p2pWorker) handleBlobs(ctx context.Context, b batch) batch {
 	return b.postBlobSync()
 }
 
-func newP2pWorker(id workerId, p p2p.P2P, todo, done chan batch, c *startup.Clock, v *verifier, cm sync.ContextByteVersions, nbv verification.NewBlobVerifier, bfs *filesystem.BlobStorage) *p2pWorker {
+func (w *p2pWorker) handleColumns(ctx context.Context, b batch) batch {
+	b.columnPid = b.busy
+	start := time.Now()
+	columns, err := sync.SendDataColumnSidecarsByRangeRequest(ctx, w.c, w.p2p, b.columnPid, w.cm, b.columnRequest())
+	if err != nil {
+		b.bs = nil
+		b.cs = nil
+		return b.withRetryableError(err)
+	}
+	dlt := time.Now()
+	backfillBatchTimeDownloadingDataColumns.Observe(float64(dlt.Sub(start).Milliseconds()))
+	backfillDataColumnsDownloadCount.Add(float64(len(columns)))
+	if len(columns) > 0 {
+		sz := 0
+		for i := range columns {
+			sz += columns[i].SizeSSZ()
+		}
+		backfillDataColumnsApproximateBytes.Add(float64(sz))
+		log.WithFields(b.logFields()).WithField("dlbytes", sz).Debug("Backfill batch data column bytes downloaded")
+	}
+	// Like blobs, the data columns are added to the batch AvailabilityStore once they are checked against their block.
+	if err := b.cs.validate(columns); err != nil {
+		b.bs = nil
+		b.cs = nil
+		return b.withRetryableError(err)
+	}
+	return b.postColumnSync()
+}
+
+func newP2pWorker(id workerId, p p2p.P2P, todo, done chan batch, c *startup.Clock, v *verifier, cm sync.ContextByteVersions, nbv verification.NewBlobVerifier, bfs *filesystem.BlobStorage, cc *columnConfig) *p2pWorker {
 	return &p2pWorker{
 		id:   id,
 		todo: todo,
@@ -112,5 +153,6 @@ func newP2pWorker(id workerId, p p2p.P2P, todo, done chan batch, c *startup.Cloc
 		cm:   cm,
 		nbv:  nbv,
 		bfs:  bfs,
+		cc:   cc,
 	}
 }
@@ -87,10 +87,13 @@ func (bb *blockRangeBatcher) next(ctx context.Context, stream libp2pcore.Stream)
 		<-bb.ticker.C
 	}
 	filter := filters.NewFilter().SetStartSlot(nb.start).SetEndSlot(nb.end)
+	readStart := time.Now()
 	blks, roots, err := bb.db.Blocks(ctx, filter)
 	if err != nil {
 		return blockBatch{err: errors.Wrap(err, "Could not retrieve blocks")}, false
 	}
+	// The read latency lowers the rate limits while the database is slow to serve blocks.
+	bb.limiter.load.observeDBRead(time.Since(readStart), len(blks))
 
 	rob := make([]blocks.ROBlock, 0)
 	if nb.start == 0 {
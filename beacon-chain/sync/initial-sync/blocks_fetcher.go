@@ -77,6 +77,8 @@ type blocksFetcherConfig struct {
 	peerFilterCapacityWeight float64
 	mode                     syncMode
 	bs                       filesystem.BlobStorageSummarizer
+	cs                       filesystem.DataColumnStorageSummarizer
+	custodyColumns           map[uint64]bool
 }
 
 // blocksFetcher is a service to fetch chain data from peers.
@@ -93,6 +95,8 @@ type blocksFetcher struct {
 	p2p             p2p.P2P
 	db              db.ReadOnlyDatabase
 	bs              filesystem.BlobStorageSummarizer
+	cs              filesystem.DataColumnStorageSummarizer
+	custodyColumns  map[uint64]bool
 	blocksPerPeriod uint64
 	rateLimiter     *leakybucket.Collector
 	peerLocks       map[peer.ID]*peerLock
@@ -152,6 +156,8 @@ func newBlocksFetcher(ctx context.Context, cfg *blocksFetcherConfig) *blocksFetc
 		p2p:             cfg.p2p,
 		db:              cfg.db,
 		bs:              cfg.bs,
+		cs:              cfg.cs,
+		custodyColumns:  cfg.custodyColumns,
 		blocksPerPeriod: uint64(blocksPerPeriod),
 		rateLimiter:     rateLimiter,
 		peerLocks:       make(map[peer.ID]*peerLock),
@@ -321,6 +327,13 @@ func (f *blocksFetcher) handleRequest(ctx context.Context, start primitives.Slot
 		}
 		response.bwb = bwb
 	}
+	if response.err == nil {
+		bwb, err := f.fetchDataColumnsFromPeers(ctx, response.bwb, response.pid, peers)
+		if err != nil {
+			response.err = err
+		}
+		response.bwb = bwb
+	}
 	return response
 }
 
@@ -399,7 +412,8 @@ func countCommitments(bwb []blocks.BlockWithROBlobs, retentionStart primitives.S
 	for i := range bwb {
 		b := bwb[i]
 		slot := b.Block.Block().Slot()
-		if b.Block.Version() < version.Deneb {
+		// Blocks from the Fulu fork on come with data columns instead of blobs.
+		if b.Block.Version() < version.Deneb || b.Block.Version() >= version.Fulu {
 			continue
 		}
 		if slot < retentionStart {
@@ -493,7 +507,7 @@ var errDidntPopulate = errors.New("skipping population of block")
 
 func populateBlock(bw blocks.BlockWithROBlobs, blobs []blocks.ROBlob, req *p2ppb.BlobSidecarsByRangeRequest, bss filesystem.BlobStorageSummarizer) (blocks.BlockWithROBlobs, error) {
 	blk := bw.Block
-	if blk.Version() < version.Deneb || blk.Block().Slot() < req.StartSlot {
+	if blk.Version() < version.Deneb || blk.Version() >= version.Fulu || blk.Block().Slot() < req.StartSlot {
 		return bw, errDidntPopulate
 	}
 	commits, err := blk.Block().Body().BlobKzgCommitments()
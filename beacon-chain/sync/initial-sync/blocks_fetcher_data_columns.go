@@ -0,0 +1,177 @@
+package initialsync
+
+import (
+	"context"
+	"slices"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/peerdas"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/filesystem"
+	prysmsync "github.com/prysmaticlabs/prysm/v5/beacon-chain/sync"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/sync/verify"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing/trace"
+	p2ppb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/runtime/version"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+	"github.com/sirupsen/logrus"
+)
+
+var errMissingDataColumns = errors.New("data columns unavailable for processing block with kzg commitments")
+
+// dataColumnsNeeded returns the positions in bwb of the blocks in need of data columns: the blocks from the Fulu
+// fork on, within the data column retention period, which commit to blobs and miss custody columns on disk.
+func dataColumnsNeeded(bwb []blocks.BlockWithROBlobs, retentionStart primitives.Slot, custody map[uint64]bool, cs filesystem.DataColumnStorageSummarizer) []int {
+	needed := make([]int, 0, len(bwb))
+	for i := range bwb {
+		b := bwb[i].Block
+		if b.Version() < version.Fulu || b.Block().Slot() < retentionStart {
+			continue
+		}
+		commits, err := b.Block().Body().BlobKzgCommitments()
+		if err != nil || len(commits) == 0 {
+			continue
+		}
+		if cs != nil && cs.Summary(b.Root()).AllAvailable(custody) {
+			continue
+		}
+		needed = append(needed, i)
+	}
+	return needed
+}
+
+// dataColumnsComplete reports whether the data columns of the block are enough for its data availability check,
+// i.e. whether all of its custody columns are either fetched or on disk, or whether enough columns are fetched
+// to reconstruct the missing ones.
+func dataColumnsComplete(bw blocks.BlockWithROBlobs, custody map[uint64]bool, cs filesystem.DataColumnStorageSummarizer) bool {
+	if uint64(len(bw.Columns)) >= peerdas.MinimumColumnsCountToReconstruct() {
+		return true
+	}
+	fetched := make(map[uint64]bool, len(bw.Columns))
+	for _, c := range bw.Columns {
+		fetched[c.ColumnIndex] = true
+	}
+	for idx := range custody {
+		if fetched[idx] {
+			continue
+		}
+		if cs == nil || !cs.Summary(bw.Block.Root()).HasIndex(idx) {
+			return false
+		}
+	}
+	return true
+}
+
+// populateDataColumns adds the given data columns to the blocks in need of them. The whole response is rejected
+// if any data column does not align with its block.
+func populateDataColumns(bwb []blocks.BlockWithROBlobs, needed []int, columns []blocks.RODataColumn) error {
+	byRoot := make(map[[32]byte]int, len(needed))
+	for _, i := range needed {
+		byRoot[bwb[i].Block.Root()] = i
+	}
+	toAdd := make(map[int][]blocks.RODataColumn)
+	for _, c := range columns {
+		i, ok := byRoot[c.BlockRoot()]
+		if !ok {
+			continue
+		}
+		if err := verify.DataColumnAlignsWithBlock(c, bwb[i].Block); err != nil {
+			return err
+		}
+		toAdd[i] = append(toAdd[i], c)
+	}
+	for i, cs := range toAdd {
+		for _, c := range cs {
+			// Drop the data columns already fetched from another peer.
+			if slices.ContainsFunc(bwb[i].Columns, func(have blocks.RODataColumn) bool { return have.ColumnIndex == c.ColumnIndex }) {
+				continue
+			}
+			bwb[i].Columns = append(bwb[i].Columns, c)
+		}
+	}
+	return nil
+}
+
+// fetchDataColumnsFromPeers fetches the custody data columns of the blocks from the Fulu fork on, starting with
+// the peer which served the blocks. Other peers are asked in turn while some blocks lack data columns.
+func (f *blocksFetcher) fetchDataColumnsFromPeers(ctx context.Context, bwb []blocks.BlockWithROBlobs, pid peer.ID, peers []peer.ID) ([]blocks.BlockWithROBlobs, error) {
+	ctx, span := trace.StartSpan(ctx, "initialsync.fetchDataColumnsFromPeers")
+	defer span.End()
+	if len(f.custodyColumns) == 0 || slots.ToEpoch(f.clock.CurrentSlot()) < params.BeaconConfig().FuluForkEpoch {
+		return bwb, nil
+	}
+	retentionStart, err := prysmsync.DataColumnRPCMinValidSlot(f.clock.CurrentSlot())
+	if err != nil {
+		return nil, err
+	}
+	needed := dataColumnsNeeded(bwb, retentionStart, f.custodyColumns, f.cs)
+	if len(needed) == 0 {
+		return bwb, nil
+	}
+	columns := make([]uint64, 0, len(f.custodyColumns))
+	for idx := range f.custodyColumns {
+		columns = append(columns, idx)
+	}
+	slices.Sort(columns)
+	low, high := bwb[needed[0]].Block.Block().Slot(), bwb[needed[len(needed)-1]].Block.Block().Slot()
+	req := &p2ppb.DataColumnSidecarsByRangeRequest{
+		StartSlot: low,
+		Count:     uint64(high.FlooredSubSlot(low)) + 1,
+		Columns:   columns,
+	}
+
+	peers = f.filterPeers(ctx, peers, peersPercentagePerRequest)
+	// The peer which served the blocks is asked first, as it is the most likely to have their data columns.
+	peers = dedupPeers(append([]peer.ID{pid}, peers...))
+	for _, p := range peers {
+		fetched, err := f.requestDataColumns(ctx, req, p)
+		if err != nil {
+			log.WithField("peer", p).WithError(err).Debug("Could not request data columns by range from peer")
+			continue
+		}
+		f.p2p.Peers().Scorers().BlockProviderScorer().Touch(p)
+		if err := populateDataColumns(bwb, needed, fetched); err != nil {
+			log.WithField("peer", p).WithError(err).Debug("Invalid DataColumnSidecarsByRange response")
+			continue
+		}
+		incomplete := slices.DeleteFunc(slices.Clone(needed), func(i int) bool {
+			return dataColumnsComplete(bwb[i], f.custodyColumns, f.cs)
+		})
+		if len(incomplete) == 0 {
+			return bwb, nil
+		}
+		needed = incomplete
+	}
+	first := bwb[needed[0]].Block
+	return nil, errors.Wrapf(errMissingDataColumns, "%d blocks missing data columns, first block root %#x at slot %d",
+		len(needed), first.Root(), first.Block().Slot())
+}
+
+func (f *blocksFetcher) requestDataColumns(ctx context.Context, req *p2ppb.DataColumnSidecarsByRangeRequest, pid peer.ID) ([]blocks.RODataColumn, error) {
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+	l := f.peerLock(pid)
+	l.Lock()
+	log.WithFields(logrus.Fields{
+		"peer":     pid,
+		"start":    req.StartSlot,
+		"count":    req.Count,
+		"columns":  len(req.Columns),
+		"capacity": f.rateLimiter.Remaining(pid.String()),
+		"score":    f.p2p.Peers().Scorers().BlockProviderScorer().FormatScorePretty(pid),
+	}).Debug("Requesting data columns")
+	// Like blob requests, data column requests are accounted for as block requests.
+	if f.rateLimiter.Remaining(pid.String()) < int64(req.Count) {
+		if err := f.waitForBandwidth(pid, req.Count); err != nil {
+			l.Unlock()
+			return nil, err
+		}
+	}
+	f.rateLimiter.Add(pid.String(), int64(req.Count))
+	l.Unlock()
+	return prysmsync.SendDataColumnSidecarsByRangeRequest(ctx, f.clock, f.p2p, pid, f.ctxMap, req)
+}
@@ -0,0 +1,112 @@
+package initialsync
+
+import (
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/peerdas"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/filesystem"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/sync/verify"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+	"google.golang.org/protobuf/proto"
+)
+
+func testSequenceBlockWithColumns(t *testing.T, nblocks int) ([]blocks.BlockWithROBlobs, [][]blocks.RODataColumn) {
+	var parent [32]byte
+	bwb := make([]blocks.BlockWithROBlobs, nblocks)
+	columns := make([][]blocks.RODataColumn, nblocks)
+	for i := range bwb {
+		// The last block commits to no blob.
+		nblobs := 1
+		if i == nblocks-1 {
+			nblobs = 0
+		}
+		bwb[i].Block, columns[i] = util.GenerateTestFuluBlockWithColumns(t, parent, primitives.Slot(10+i), nblobs)
+		parent = bwb[i].Block.Root()
+	}
+	return bwb, columns
+}
+
+func TestDataColumnsNeeded(t *testing.T) {
+	bwb, _ := testSequenceBlockWithColumns(t, 4)
+	custody := map[uint64]bool{0: true, 1: true}
+
+	require.DeepEqual(t, []int{0, 1, 2}, dataColumnsNeeded(bwb, 0, custody, nil))
+	// The blocks before the retention period are skipped.
+	require.DeepEqual(t, []int{2}, dataColumnsNeeded(bwb, bwb[2].Block.Block().Slot(), custody, nil))
+
+	// The blocks with all their custody columns on disk are skipped.
+	cs := filesystem.NewMockDataColumnStorageSummarizer(t, map[[32]byte][]uint64{
+		bwb[0].Block.Root(): {0},
+		bwb[1].Block.Root(): {0, 1},
+	})
+	require.DeepEqual(t, []int{0, 2}, dataColumnsNeeded(bwb, 0, custody, cs))
+
+	// The blocks before the Fulu fork are skipped.
+	deneb, _ := util.GenerateTestDenebBlockWithSidecar(t, [32]byte{}, 1, 1)
+	require.Equal(t, 0, len(dataColumnsNeeded([]blocks.BlockWithROBlobs{{Block: deneb}}, 0, custody, nil)))
+}
+
+func TestPopulateDataColumns(t *testing.T) {
+	t.Run("happy path", func(t *testing.T) {
+		bwb, columns := testSequenceBlockWithColumns(t, 3)
+		fetched := []blocks.RODataColumn{columns[0][0], columns[0][1], columns[1][1]}
+		require.NoError(t, populateDataColumns(bwb, []int{0, 1}, fetched))
+		require.Equal(t, 2, len(bwb[0].Columns))
+		require.Equal(t, 1, len(bwb[1].Columns))
+
+		// The data columns already fetched are not added again.
+		require.NoError(t, populateDataColumns(bwb, []int{0, 1}, []blocks.RODataColumn{columns[0][1], columns[1][0]}))
+		require.Equal(t, 2, len(bwb[0].Columns))
+		require.Equal(t, 2, len(bwb[1].Columns))
+	})
+	t.Run("block not needed", func(t *testing.T) {
+		bwb, columns := testSequenceBlockWithColumns(t, 3)
+		require.NoError(t, populateDataColumns(bwb, []int{1}, []blocks.RODataColumn{columns[0][0]}))
+		require.Equal(t, 0, len(bwb[0].Columns))
+	})
+	t.Run("misaligned data column", func(t *testing.T) {
+		bwb, columns := testSequenceBlockWithColumns(t, 3)
+		sidecar := proto.Clone(columns[1][0].DataColumnSidecar).(*ethpb.DataColumnSidecar)
+		sidecar.KzgCommitments = [][]byte{make([]byte, 48)}
+		column, err := blocks.NewRODataColumnWithRoot(sidecar, columns[1][0].BlockRoot())
+		require.NoError(t, err)
+		err = populateDataColumns(bwb, []int{0, 1}, []blocks.RODataColumn{columns[0][0], column})
+		require.ErrorIs(t, err, verify.ErrMismatchedDataColumnCommitments)
+		// The whole response is rejected.
+		require.Equal(t, 0, len(bwb[0].Columns))
+	})
+	t.Run("invalid data column index", func(t *testing.T) {
+		bwb, columns := testSequenceBlockWithColumns(t, 3)
+		sidecar := proto.Clone(columns[0][0].DataColumnSidecar).(*ethpb.DataColumnSidecar)
+		sidecar.ColumnIndex = 1 << 20
+		column, err := blocks.NewRODataColumnWithRoot(sidecar, columns[0][0].BlockRoot())
+		require.NoError(t, err)
+		err = populateDataColumns(bwb, []int{0}, []blocks.RODataColumn{column})
+		require.ErrorIs(t, err, verify.ErrIncorrectDataColumnIndex)
+	})
+}
+
+func TestDataColumnsComplete(t *testing.T) {
+	bwb, columns := testSequenceBlockWithColumns(t, 1)
+	bw := bwb[0]
+	custody := map[uint64]bool{0: true, 1: true}
+
+	require.Equal(t, false, dataColumnsComplete(bw, custody, nil))
+	bw.Columns = columns[0][:1]
+	require.Equal(t, false, dataColumnsComplete(bw, custody, nil))
+
+	// The custody columns on disk don't need to be fetched.
+	cs := filesystem.NewMockDataColumnStorageSummarizer(t, map[[32]byte][]uint64{bw.Block.Root(): {1}})
+	require.Equal(t, true, dataColumnsComplete(bw, custody, cs))
+
+	bw.Columns = columns[0][:2]
+	require.Equal(t, true, dataColumnsComplete(bw, custody, nil))
+
+	// Enough data columns to reconstruct the missing ones make the block complete, whatever the custody.
+	bw.Columns = columns[0][:peerdas.MinimumColumnsCountToReconstruct()]
+	require.Equal(t, true, dataColumnsComplete(bw, map[uint64]bool{127: true}, nil))
+}
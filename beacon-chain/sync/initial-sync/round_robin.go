@@ -78,6 +78,8 @@ func (s *Service) startBlocksQueue(ctx context.Context, highestSlot primitives.S
 		highestExpectedSlot: highestSlot,
 		mode:                mode,
 		bs:                  s.cfg.BlobStorage,
+		cs:                  s.cfg.DataColumnStorage,
+		custodyColumns:      s.cfg.CustodyColumns,
 	}
 	queue := newBlocksQueue(ctx, cfg)
 	if err := queue.start(); err != nil {
@@ -163,17 +165,20 @@ func (s *Service) processFetchedDataRegSync(
 	if len(bwb) == 0 {
 		return
 	}
-	bv := verification.NewBlobBatchVerifier(s.newBlobVerifier, verification.InitsyncBlobSidecarRequirements)
-	avs := das.NewLazilyPersistentStore(s.cfg.BlobStorage, bv)
+	avs := s.availabilityStore()
 	batchFields := logrus.Fields{
 		"firstSlot":        data.bwb[0].Block.Block().Slot(),
 		"firstUnprocessed": bwb[0].Block.Block().Slot(),
 	}
 	for _, b := range bwb {
-		if err := avs.Persist(s.clock.CurrentSlot(), b.Blobs...); err != nil {
+		if err := avs.Persist(s.clock.CurrentSlot(), blocks.NewSidecarsFromBlobSidecars(b.Blobs)...); err != nil {
 			log.WithError(err).WithFields(batchFields).WithFields(syncFields(b.Block)).Warn("Batch failure due to BlobSidecar issues")
 			return
 		}
+		if err := avs.Persist(s.clock.CurrentSlot(), blocks.NewSidecarsFromDataColumnSidecars(b.Columns)...); err != nil {
+			log.WithError(err).WithFields(batchFields).WithFields(syncFields(b.Block)).Warn("Batch failure due to DataColumnSidecar issues")
+			return
+		}
 		if err := s.processBlock(ctx, genesis, b, s.cfg.Chain.ReceiveBlock, avs); err != nil {
 			switch {
 			case errors.Is(err, errParentDoesNotExist):
@@ -322,14 +327,13 @@ func (s *Service) processBatchedBlocks(ctx context.Context, genesis time.Time,
 			errParentDoesNotExist, first.Block().ParentRoot(), first.Block().Slot())
 	}
 
-	bv := verification.NewBlobBatchVerifier(s.newBlobVerifier, verification.InitsyncBlobSidecarRequirements)
-	avs := das.NewLazilyPersistentStore(s.cfg.BlobStorage, bv)
+	avs := s.availabilityStore()
 	s.logBatchSyncStatus(genesis, first, len(bwb))
 	for _, bb := range bwb {
-		if len(bb.Blobs) == 0 {
-			continue
+		if err := avs.Persist(s.clock.CurrentSlot(), blocks.NewSidecarsFromBlobSidecars(bb.Blobs)...); err != nil {
+			return err
 		}
-		if err := avs.Persist(s.clock.CurrentSlot(), bb.Blobs...); err != nil {
+		if err := avs.Persist(s.clock.CurrentSlot(), blocks.NewSidecarsFromDataColumnSidecars(bb.Columns)...); err != nil {
 			return err
 		}
 	}
@@ -337,6 +341,17 @@ func (s *Service) processBatchedBlocks(ctx context.Context, genesis time.Time,
 	return bFunc(ctx, blocks.BlockWithROBlobsSlice(bwb).ROBlocks(), avs)
 }
 
+// availabilityStore returns the availability store checking the blobs of the blocks before the Fulu fork,
+// and the custody data columns of the blocks from the Fulu fork on.
+func (s *Service) availabilityStore() das.AvailabilityStore {
+	bv := verification.NewBlobBatchVerifier(s.newBlobVerifier, verification.InitsyncBlobSidecarRequirements)
+	cv := verification.NewDataColumnBatchVerifier(s.newDataColumnVerifier, verification.InitsyncDataColumnSidecarRequirements)
+	return das.NewForkAwareStore(
+		das.NewLazilyPersistentStore(s.cfg.BlobStorage, bv),
+		das.NewLazilyPersistentStoreColumn(s.cfg.DataColumnStorage, cv, s.cfg.CustodyColumns),
+	)
+}
+
 // updatePeerScorerStats adjusts monitored metrics for a peer.
 func (s *Service) updatePeerScorerStats(pid peer.ID, startSlot primitives.Slot) {
 	if pid == "" {
@@ -53,21 +53,24 @@ type Config struct {
 	ClockWaiter         startup.ClockWaiter
 	InitialSyncComplete chan struct{}
 	BlobStorage         *filesystem.BlobStorage
+	DataColumnStorage   *filesystem.DataColumnStorage
+	CustodyColumns      map[uint64]bool
 }
 
 // Service service.
 type Service struct {
-	cfg             *Config
-	ctx             context.Context
-	cancel          context.CancelFunc
-	synced          *abool.AtomicBool
-	chainStarted    *abool.AtomicBool
-	counter         *ratecounter.RateCounter
-	genesisChan     chan time.Time
-	clock           *startup.Clock
-	verifierWaiter  *verification.InitializerWaiter
-	newBlobVerifier verification.NewBlobVerifier
-	ctxMap          sync.ContextByteVersions
+	cfg                   *Config
+	ctx                   context.Context
+	cancel                context.CancelFunc
+	synced                *abool.AtomicBool
+	chainStarted          *abool.AtomicBool
+	counter               *ratecounter.RateCounter
+	genesisChan           chan time.Time
+	clock                 *startup.Clock
+	verifierWaiter        *verification.InitializerWaiter
+	newBlobVerifier       verification.NewBlobVerifier
+	newDataColumnVerifier verification.NewDataColumnVerifier
+	ctxMap                sync.ContextByteVersions
 }
 
 // Option is a functional option for the initial-sync Service.
@@ -148,6 +151,7 @@ func (s *Service) Start() {
 		return
 	}
 	s.newBlobVerifier = newBlobVerifierFromInitializer(v)
+	s.newDataColumnVerifier = newDataColumnVerifierFromInitializer(v)
 
 	gt := clock.GenesisTime()
 	if gt.IsZero() {
@@ -281,7 +285,8 @@ func (s *Service) markSynced() {
 
 func missingBlobRequest(blk blocks.ROBlock, store *filesystem.BlobStorage) (p2ptypes.BlobSidecarsByRootReq, error) {
 	r := blk.Root()
-	if blk.Version() < version.Deneb {
+	// The blocks from the Fulu fork on commit to data columns rather than blob sidecars.
+	if blk.Version() < version.Deneb || blk.Version() >= version.Fulu {
 		return nil, nil
 	}
 	cmts, err := blk.Block().Body().BlobKzgCommitments()
@@ -340,7 +345,7 @@ func (s *Service) fetchOriginBlobs(pids []peer.ID) error {
 		bv := verification.NewBlobBatchVerifier(s.newBlobVerifier, verification.InitsyncBlobSidecarRequirements)
 		avs := das.NewLazilyPersistentStore(s.cfg.BlobStorage, bv)
 		current := s.clock.CurrentSlot()
-		if err := avs.Persist(current, sidecars...); err != nil {
+		if err := avs.Persist(current, blocks.NewSidecarsFromBlobSidecars(sidecars)...); err != nil {
 			return err
 		}
 		if err := avs.IsDataAvailable(s.ctx, current, rob); err != nil {
@@ -365,3 +370,9 @@ func newBlobVerifierFromInitializer(ini *verification.Initializer) verification.
 		return ini.NewBlobVerifier(b, reqs)
 	}
 }
+
+func newDataColumnVerifierFromInitializer(ini *verification.Initializer) verification.NewDataColumnVerifier {
+	return func(d blocks.RODataColumn, reqs []verification.Requirement) verification.DataColumnVerifier {
+		return ini.NewDataColumnVerifier(d, reqs)
+	}
+}
@@ -0,0 +1,131 @@
+package sync
+
+import (
+	"runtime/metrics"
+	"sync"
+	"time"
+)
+
+const (
+	// Interval at which the load of the node is sampled.
+	loadSampleInterval = 5 * time.Second
+	// CPU usage above which the rate limits are lowered.
+	cpuUsageThreshold = 0.8
+	// Database read latency per block above which the rate limits are lowered.
+	dbReadLatencyTarget = 20 * time.Millisecond
+	// Lowest factor the rate limits are scaled by.
+	minLoadFactor = 0.25
+	// Weight of the latest database read in the moving average of the read latency.
+	dbReadLatencyWeight = 0.2
+)
+
+const (
+	cpuTotalMetric = "/cpu/classes/total:cpu-seconds"
+	cpuIdleMetric  = "/cpu/classes/idle:cpu-seconds"
+)
+
+// loadMonitor estimates how loaded the node is, from its CPU usage and the latency of the database
+// reads serving the block requests of peers, to lower the rate limits while the node is under load.
+// Its methods are safe to call on a nil monitor, which reports no load.
+type loadMonitor struct {
+	sync.RWMutex
+	samples       []metrics.Sample
+	lastCPUTotal  float64
+	lastCPUIdle   float64
+	cpuUsage      float64
+	dbReadLatency time.Duration
+	dbReads       int
+	factor        float64
+}
+
+func newLoadMonitor() *loadMonitor {
+	m := &loadMonitor{
+		samples: []metrics.Sample{{Name: cpuTotalMetric}, {Name: cpuIdleMetric}},
+		factor:  1,
+	}
+	m.lastCPUTotal, m.lastCPUIdle = m.readCPU()
+	return m
+}
+
+// loadFactor returns the factor the rate limits are scaled by, 1 if the node is not under load.
+func (m *loadMonitor) loadFactor() float64 {
+	if m == nil {
+		return 1
+	}
+	m.RLock()
+	defer m.RUnlock()
+	return m.factor
+}
+
+// usage returns the last sampled CPU usage and the average database read latency per block.
+func (m *loadMonitor) usage() (float64, time.Duration) {
+	if m == nil {
+		return 0, 0
+	}
+	m.RLock()
+	defer m.RUnlock()
+	return m.cpuUsage, m.dbReadLatency
+}
+
+// observeDBRead records the time it took to read the given number of blocks from the database.
+func (m *loadMonitor) observeDBRead(elapsed time.Duration, blocks int) {
+	if m == nil || blocks == 0 {
+		return
+	}
+	perBlock := elapsed / time.Duration(blocks)
+	m.Lock()
+	defer m.Unlock()
+	m.dbReadLatency = movingAverage(m.dbReadLatency, perBlock)
+	m.dbReads++
+}
+
+// sample samples the CPU usage since the last sample and updates the load factor.
+func (m *loadMonitor) sample() {
+	total, idle := m.readCPU()
+
+	m.Lock()
+	defer m.Unlock()
+	if total > m.lastCPUTotal {
+		m.cpuUsage = 1 - (idle-m.lastCPUIdle)/(total-m.lastCPUTotal)
+	}
+	m.lastCPUTotal, m.lastCPUIdle = total, idle
+	// Without reads since the last sample, the read latency decays so that a slow read does not
+	// lower the rate limits indefinitely.
+	if m.dbReads == 0 {
+		m.dbReadLatency = movingAverage(m.dbReadLatency, 0)
+	}
+	m.dbReads = 0
+	m.factor = computeLoadFactor(m.cpuUsage, m.dbReadLatency)
+	rpcRateLimitLoadFactor.Set(m.factor)
+}
+
+// readCPU returns the total CPU time available to the process and the idle part of it, in seconds.
+func (m *loadMonitor) readCPU() (float64, float64) {
+	metrics.Read(m.samples)
+	var total, idle float64
+	if m.samples[0].Value.Kind() == metrics.KindFloat64 {
+		total = m.samples[0].Value.Float64()
+	}
+	if m.samples[1].Value.Kind() == metrics.KindFloat64 {
+		idle = m.samples[1].Value.Float64()
+	}
+	return total, idle
+}
+
+// computeLoadFactor returns the factor the rate limits are scaled by for the CPU usage and database read
+// latency per block. The factor decreases linearly from 1 to its minimum as the CPU usage goes from
+// its threshold to 1, and in inverse proportion to the read latency above its target.
+func computeLoadFactor(cpuUsage float64, dbReadLatency time.Duration) float64 {
+	factor := 1.0
+	if cpuUsage > cpuUsageThreshold {
+		factor = 1 - (1-minLoadFactor)*(cpuUsage-cpuUsageThreshold)/(1-cpuUsageThreshold)
+	}
+	if dbReadLatency > dbReadLatencyTarget {
+		factor = min(factor, float64(dbReadLatencyTarget)/float64(dbReadLatency))
+	}
+	return max(factor, minLoadFactor)
+}
+
+func movingAverage(avg, latest time.Duration) time.Duration {
+	return time.Duration(dbReadLatencyWeight*float64(latest) + (1-dbReadLatencyWeight)*float64(avg))
+}
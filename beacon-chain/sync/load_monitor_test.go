@@ -0,0 +1,54 @@
+package sync
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+)
+
+func TestComputeLoadFactor(t *testing.T) {
+	tests := []struct {
+		name          string
+		cpuUsage      float64
+		dbReadLatency time.Duration
+		want          float64
+	}{
+		{name: "idle", cpuUsage: 0.1, dbReadLatency: time.Millisecond, want: 1},
+		{name: "cpu at threshold", cpuUsage: cpuUsageThreshold, want: 1},
+		{name: "cpu half way", cpuUsage: 0.9, want: 0.625},
+		{name: "cpu saturated", cpuUsage: 1, want: minLoadFactor},
+		{name: "slow reads", dbReadLatency: 2 * dbReadLatencyTarget, want: 0.5},
+		{name: "very slow reads", dbReadLatency: 100 * dbReadLatencyTarget, want: minLoadFactor},
+		{name: "lowest factor wins", cpuUsage: 0.9, dbReadLatency: 2 * dbReadLatencyTarget, want: 0.5},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := computeLoadFactor(tt.cpuUsage, tt.dbReadLatency)
+			assert.Equal(t, true, math.Abs(got-tt.want) < 1e-9, "got %f, want %f", got, tt.want)
+		})
+	}
+}
+
+func TestLoadMonitor(t *testing.T) {
+	var nilMonitor *loadMonitor
+	assert.Equal(t, float64(1), nilMonitor.loadFactor())
+	nilMonitor.observeDBRead(time.Second, 1)
+
+	m := newLoadMonitor()
+	assert.Equal(t, float64(1), m.loadFactor())
+	m.observeDBRead(10*dbReadLatencyTarget*64, 64)
+	_, latency := m.usage()
+	assert.Equal(t, time.Duration(dbReadLatencyWeight*float64(10*dbReadLatencyTarget)), latency)
+
+	m.sample()
+	cpuUsage, latency := m.usage()
+	assert.Equal(t, true, cpuUsage >= 0 && cpuUsage <= 1)
+	assert.Equal(t, true, m.loadFactor() <= 1 && m.loadFactor() >= minLoadFactor)
+
+	// Without reads, the read latency decays.
+	m.sample()
+	_, decayed := m.usage()
+	assert.Equal(t, true, decayed < latency)
+}
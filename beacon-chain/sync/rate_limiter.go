@@ -1,19 +1,27 @@
 package sync
 
 import (
+	"math"
 	"reflect"
+	"sort"
 	"sync"
 	"time"
 
 	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/peer"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	"github.com/trailofbits/go-mutexasserts"
 
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain/kzg"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p"
 	p2ptypes "github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/types"
 	"github.com/prysmaticlabs/prysm/v5/cmd/beacon-chain/flags"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
 	leakybucket "github.com/prysmaticlabs/prysm/v5/container/leaky-bucket"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	prysmTime "github.com/prysmaticlabs/prysm/v5/time"
 )
 
 const defaultBurstLimit = 5
@@ -26,46 +34,192 @@ const blockBucketPeriod = 30 * time.Second
 // Dummy topic to validate all incoming rpc requests.
 const rpcLimiterTopic = "rpc-limiter-topic"
 
+// The unit of the cost of blob and data column responses, in bytes of SSZ payload.
+const responseCostUnit = 1024
+
+// The cost of a blob sidecar response, which the blob and data column budgets are expressed in.
+var blobSidecarCost = responseCost((&ethpb.BlobSidecar{}).SizeSSZ())
+
+// The cost of a data column sidecar response of a block with a single blob, the least a requested data column costs.
+var dataColumnSidecarCost = responseCost((&ethpb.DataColumnSidecar{
+	DataColumn: [][]byte{make([]byte, kzg.BytesPerCell)},
+	// KZG commitments and proofs are compressed G1 points, the size of a BLS public key.
+	KzgCommitments: [][]byte{make([]byte, fieldparams.BLSPubkeyLength)},
+	KzgProof:       [][]byte{make([]byte, fieldparams.BLSPubkeyLength)},
+}).SizeSSZ())
+
+// Peers whose requests were rate limited are reported for this long after their last rejected request.
+const throttledPeerRetention = 10 * time.Minute
+
+// Bounds the number of rate limited peers which are reported.
+const maxThrottledPeers = 1000
+
+// PeerRateLimit is the state of the rate limit applied to the RPC requests of a peer on a topic.
+// Topics which share a collector report the same state. The capacity of blob and data column topics
+// is in KiB of response.
+type PeerRateLimit struct {
+	Topic     string
+	Capacity  int64
+	Remaining int64
+	// TillEmpty is the time after which the full capacity is available again.
+	TillEmpty time.Duration
+}
+
+// ThrottledPeer is a peer whose RPC requests were recently rejected by the rate limiter.
+type ThrottledPeer struct {
+	PeerID peer.ID
+	Class  string
+	// Topic is the topic of the last rejected request.
+	Topic         string
+	Count         uint64
+	LastThrottled time.Time
+}
+
+// RateLimitStatus is the state of the rate limiter as a whole.
+type RateLimitStatus struct {
+	// Adaptive is true if the rate limits are lowered while the node is under load.
+	Adaptive bool
+	// LoadFactor is the factor the rate limits of block, blob and data column requests are scaled by,
+	// 1 if the node is not under load.
+	LoadFactor    float64
+	CPUUsage      float64
+	DBReadLatency time.Duration
+	// Quotas are the factors the rate limits of each peer class are multiplied by.
+	Quotas         map[string]float64
+	ThrottledPeers []*ThrottledPeer
+}
+
+// peerClass is the class of a peer, which determines the quota of its RPC rate limits.
+type peerClass int
+
+const (
+	// inboundPeer is a peer which dialed the local peer, or whose direction is unknown.
+	inboundPeer peerClass = iota
+	// outboundPeer is a peer the local peer dialed.
+	outboundPeer
+	// trustedPeer is a peer set as trusted, whatever its direction.
+	trustedPeer
+)
+
+var peerClasses = []peerClass{inboundPeer, outboundPeer, trustedPeer}
+
+func (c peerClass) String() string {
+	switch c {
+	case outboundPeer:
+		return "outbound"
+	case trustedPeer:
+		return "trusted"
+	default:
+		return "inbound"
+	}
+}
+
 type limiter struct {
+	// limiterMap holds the collectors of inbound peers.
 	limiterMap map[string]*leakybucket.Collector
-	p2p        p2p.P2P
+	// classMaps holds the collectors of the peer classes whose quota differs from the one of inbound
+	// peers, the other classes share the collectors of inbound peers.
+	classMaps map[peerClass]map[string]*leakybucket.Collector
+	quotas    map[peerClass]float64
+	// adaptiveTopics are the topics whose requests cost more while the node is under load.
+	adaptiveTopics map[string]bool
+	load           *loadMonitor
+	p2p            p2p.P2P
 	sync.RWMutex
+
+	throttledLock sync.Mutex
+	throttled     map[peer.ID]*ThrottledPeer
 }
 
 // Instantiates a multi-rpc protocol rate limiter, providing
-// separate collectors for each topic.
+// separate collectors for each topic and peer class.
 func newRateLimiter(p2pProvider p2p.P2P) *limiter {
 	// add encoding suffix
 	addEncoding := func(topic string) string {
 		return topic + p2pProvider.Encoding().ProtocolSuffix()
 	}
+	quotas := peerClassQuotas()
+	l := &limiter{
+		limiterMap: newTopicCollectors(addEncoding, quotas[inboundPeer]),
+		classMaps:  make(map[peerClass]map[string]*leakybucket.Collector),
+		quotas:     quotas,
+		adaptiveTopics: map[string]bool{
+			addEncoding(p2p.RPCBlocksByRangeTopicV1):             true,
+			addEncoding(p2p.RPCBlocksByRangeTopicV2):             true,
+			addEncoding(p2p.RPCBlocksByRootTopicV1):              true,
+			addEncoding(p2p.RPCBlocksByRootTopicV2):              true,
+			addEncoding(p2p.RPCBlobSidecarsByRangeTopicV1):       true,
+			addEncoding(p2p.RPCBlobSidecarsByRootTopicV1):        true,
+			addEncoding(p2p.RPCDataColumnSidecarsByRangeTopicV1): true,
+			addEncoding(p2p.RPCDataColumnSidecarsByRootTopicV1):  true,
+		},
+		p2p:       p2pProvider,
+		throttled: make(map[peer.ID]*ThrottledPeer),
+	}
+	for _, class := range []peerClass{outboundPeer, trustedPeer} {
+		if quotas[class] != quotas[inboundPeer] {
+			l.classMaps[class] = newTopicCollectors(addEncoding, quotas[class])
+		}
+	}
+	if flags.Get().AdaptiveRPCRateLimits {
+		l.load = newLoadMonitor()
+	}
+	return l
+}
+
+// peerClassQuotas returns the factors the rate limits of each peer class are multiplied by.
+func peerClassQuotas() map[peerClass]float64 {
+	cfg := flags.Get()
+	quotas := map[peerClass]float64{
+		inboundPeer:  cfg.InboundPeerRPCQuotaFactor,
+		outboundPeer: cfg.OutboundPeerRPCQuotaFactor,
+		trustedPeer:  cfg.TrustedPeerRPCQuotaFactor,
+	}
+	for class, quota := range quotas {
+		// Unset quotas leave the rate limits unchanged.
+		if quota <= 0 {
+			quotas[class] = 1
+		}
+	}
+	return quotas
+}
+
+// newTopicCollectors returns the collectors of all rpc topics, whose rates and bursts are multiplied by the quota.
+func newTopicCollectors(addEncoding func(string) string, quota float64) map[string]*leakybucket.Collector {
+	newCollector := func(rate float64, burst int64, period time.Duration) *leakybucket.Collector {
+		return leakybucket.NewCollector(rate*quota, max(int64(float64(burst)*quota), 1), period, false /* deleteEmptyBuckets */)
+	}
+
 	// Initialize block limits.
 	allowedBlocksPerSecond := float64(flags.Get().BlockBatchLimit)
 	allowedBlocksBurst := int64(flags.Get().BlockBatchLimitBurstFactor * flags.Get().BlockBatchLimit)
 
-	// Initialize blob limits.
-	allowedBlobsPerSecond := float64(flags.Get().BlobBatchLimit)
-	allowedBlobsBurst := int64(flags.Get().BlobBatchLimitBurstFactor * flags.Get().BlobBatchLimit)
+	// Initialize blob limits, in KiB of response so that data column sidecars cost according to
+	// the number of blobs of their block.
+	allowedBlobsPerSecond := float64(flags.Get().BlobBatchLimit) * float64(blobSidecarCost)
+	allowedBlobsBurst := int64(flags.Get().BlobBatchLimitBurstFactor*flags.Get().BlobBatchLimit) * blobSidecarCost
 
 	// Set topic map for all rpc topics.
 	topicMap := make(map[string]*leakybucket.Collector, len(p2p.RPCTopicMappings))
 	// Goodbye Message
-	topicMap[addEncoding(p2p.RPCGoodByeTopicV1)] = leakybucket.NewCollector(1, 1, leakyBucketPeriod, false /* deleteEmptyBuckets */)
+	topicMap[addEncoding(p2p.RPCGoodByeTopicV1)] = newCollector(1, 1, leakyBucketPeriod)
 	// MetadataV0 Message
-	topicMap[addEncoding(p2p.RPCMetaDataTopicV1)] = leakybucket.NewCollector(1, defaultBurstLimit, leakyBucketPeriod, false /* deleteEmptyBuckets */)
-	topicMap[addEncoding(p2p.RPCMetaDataTopicV2)] = leakybucket.NewCollector(1, defaultBurstLimit, leakyBucketPeriod, false /* deleteEmptyBuckets */)
+	topicMap[addEncoding(p2p.RPCMetaDataTopicV1)] = newCollector(1, defaultBurstLimit, leakyBucketPeriod)
+	topicMap[addEncoding(p2p.RPCMetaDataTopicV2)] = newCollector(1, defaultBurstLimit, leakyBucketPeriod)
 	// Ping Message
-	topicMap[addEncoding(p2p.RPCPingTopicV1)] = leakybucket.NewCollector(1, defaultBurstLimit, leakyBucketPeriod, false /* deleteEmptyBuckets */)
+	topicMap[addEncoding(p2p.RPCPingTopicV1)] = newCollector(1, defaultBurstLimit, leakyBucketPeriod)
 	// Status Message
-	topicMap[addEncoding(p2p.RPCStatusTopicV1)] = leakybucket.NewCollector(1, defaultBurstLimit, leakyBucketPeriod, false /* deleteEmptyBuckets */)
+	topicMap[addEncoding(p2p.RPCStatusTopicV1)] = newCollector(1, defaultBurstLimit, leakyBucketPeriod)
 
 	// Use a single collector for block requests
-	blockCollector := leakybucket.NewCollector(allowedBlocksPerSecond, allowedBlocksBurst, blockBucketPeriod, false /* deleteEmptyBuckets */)
+	blockCollector := newCollector(allowedBlocksPerSecond, allowedBlocksBurst, blockBucketPeriod)
 	// Collector for V2
-	blockCollectorV2 := leakybucket.NewCollector(allowedBlocksPerSecond, allowedBlocksBurst, blockBucketPeriod, false /* deleteEmptyBuckets */)
+	blockCollectorV2 := newCollector(allowedBlocksPerSecond, allowedBlocksBurst, blockBucketPeriod)
 
 	// for BlobSidecarsByRoot and BlobSidecarsByRange
-	blobCollector := leakybucket.NewCollector(allowedBlobsPerSecond, allowedBlobsBurst, blockBucketPeriod, false)
+	blobCollector := newCollector(allowedBlobsPerSecond, allowedBlobsBurst, blockBucketPeriod)
+	// for DataColumnSidecarsByRoot and DataColumnSidecarsByRange
+	dataColumnCollector := newCollector(allowedBlobsPerSecond, allowedBlobsBurst, blockBucketPeriod)
 
 	// BlocksByRoots requests
 	topicMap[addEncoding(p2p.RPCBlocksByRootTopicV1)] = blockCollector
@@ -80,17 +234,30 @@ func newRateLimiter(p2pProvider p2p.P2P) *limiter {
 	// BlobSidecarsByRangeV1
 	topicMap[addEncoding(p2p.RPCBlobSidecarsByRangeTopicV1)] = blobCollector
 
+	// DataColumnSidecarsByRootV1
+	topicMap[addEncoding(p2p.RPCDataColumnSidecarsByRootTopicV1)] = dataColumnCollector
+	// DataColumnSidecarsByRangeV1
+	topicMap[addEncoding(p2p.RPCDataColumnSidecarsByRangeTopicV1)] = dataColumnCollector
+
+	// LightClientBootstrapV1, LightClientFinalityUpdateV1 and LightClientOptimisticUpdateV1
+	topicMap[addEncoding(p2p.RPCLightClientBootstrapTopicV1)] = newCollector(1, defaultBurstLimit, leakyBucketPeriod)
+	topicMap[addEncoding(p2p.RPCLightClientFinalityUpdateTopicV1)] = newCollector(1, defaultBurstLimit, leakyBucketPeriod)
+	topicMap[addEncoding(p2p.RPCLightClientOptimisticUpdateTopicV1)] = newCollector(1, defaultBurstLimit, leakyBucketPeriod)
+	// LightClientUpdatesByRangeV1, allowing a full request per batch period.
+	allowedLightClientUpdates := params.BeaconConfig().MaxRequestLightClientUpdates
+	topicMap[addEncoding(p2p.RPCLightClientUpdatesByRangeTopicV1)] = newCollector(float64(allowedLightClientUpdates), int64(allowedLightClientUpdates), blockBucketPeriod)
+
 	// General topic for all rpc requests.
-	topicMap[rpcLimiterTopic] = leakybucket.NewCollector(5, defaultBurstLimit*2, leakyBucketPeriod, false /* deleteEmptyBuckets */)
+	topicMap[rpcLimiterTopic] = newCollector(5, defaultBurstLimit*2, leakyBucketPeriod)
 
-	return &limiter{limiterMap: topicMap, p2p: p2pProvider}
+	return topicMap
 }
 
-// Returns the current topic collector for the provided topic.
-func (l *limiter) topicCollector(topic string) (*leakybucket.Collector, error) {
+// Returns the current topic collector of the peer for the provided topic.
+func (l *limiter) topicCollector(pid peer.ID, topic string) (*leakybucket.Collector, error) {
 	l.RLock()
 	defer l.RUnlock()
-	return l.retrieveCollector(topic)
+	return l.retrieveClassCollector(l.peerClass(pid), topic)
 }
 
 // validates a request with the accompanying cost.
@@ -100,8 +267,9 @@ func (l *limiter) validateRequest(stream network.Stream, amt uint64) error {
 
 	topic := string(stream.Protocol())
 	remotePeer := stream.Conn().RemotePeer()
+	class := l.peerClass(remotePeer)
 
-	collector, err := l.retrieveCollector(topic)
+	collector, err := l.retrieveClassCollector(class, topic)
 	if err != nil {
 		return err
 	}
@@ -111,7 +279,9 @@ func (l *limiter) validateRequest(stream network.Stream, amt uint64) error {
 	if amt == 0 {
 		amt = 1
 	}
-	if amt > uint64(remaining) {
+	cost := l.cost(topic, int64(amt), collector.Capacity()) // lint:ignore uintcast -- Request amounts are bounded by the maximum request sizes.
+	if cost > remaining {
+		l.throttle(remotePeer, class, topic)
 		l.p2p.Peers().Scorers().BadResponsesScorer().Increment(remotePeer)
 		writeErrorResponseToStream(responseCodeInvalidRequest, p2ptypes.ErrRateLimited.Error(), stream, l.p2p)
 		return p2ptypes.ErrRateLimited
@@ -125,17 +295,20 @@ func (l *limiter) validateRawRpcRequest(stream network.Stream) error {
 	defer l.RUnlock()
 
 	topic := rpcLimiterTopic
+	remotePeer := stream.Conn().RemotePeer()
+	class := l.peerClass(remotePeer)
 
-	collector, err := l.retrieveCollector(topic)
+	collector, err := l.retrieveClassCollector(class, topic)
 	if err != nil {
 		return err
 	}
-	key := stream.Conn().RemotePeer().String()
+	key := remotePeer.String()
 	remaining := collector.Remaining(key)
 	// Treat each request as a minimum of 1.
 	amt := int64(1)
 	if amt > remaining {
-		l.p2p.Peers().Scorers().BadResponsesScorer().Increment(stream.Conn().RemotePeer())
+		l.throttle(remotePeer, class, topic)
+		l.p2p.Peers().Scorers().BadResponsesScorer().Increment(remotePeer)
 		writeErrorResponseToStream(responseCodeInvalidRequest, p2ptypes.ErrRateLimited.Error(), stream, l.p2p)
 		return p2ptypes.ErrRateLimited
 	}
@@ -150,13 +323,23 @@ func (l *limiter) add(stream network.Stream, amt int64) {
 	topic := string(stream.Protocol())
 	log := l.topicLogger(topic)
 
-	collector, err := l.retrieveCollector(topic)
+	remotePeer := stream.Conn().RemotePeer()
+	collector, err := l.retrieveClassCollector(l.peerClass(remotePeer), topic)
 	if err != nil {
 		log.Errorf("collector with topic '%s' does not exist", topic)
 		return
 	}
-	key := stream.Conn().RemotePeer().String()
-	collector.Add(key, amt)
+	collector.Add(remotePeer.String(), l.cost(topic, amt, collector.Capacity()))
+}
+
+// adds the cost of a response of the given size in bytes to our leaky bucket for the topic.
+func (l *limiter) addResponse(stream network.Stream, size int) {
+	l.add(stream, responseCost(size))
+}
+
+// returns the cost of a response of the given size in bytes, a unit per started KiB.
+func responseCost(size int) int64 {
+	return int64(max((size+responseCostUnit-1)/responseCostUnit, 1))
 }
 
 // adds the cost to our leaky bucket for the peer.
@@ -167,13 +350,124 @@ func (l *limiter) addRawStream(stream network.Stream) {
 	topic := rpcLimiterTopic
 	log := l.topicLogger(topic)
 
-	collector, err := l.retrieveCollector(topic)
+	remotePeer := stream.Conn().RemotePeer()
+	collector, err := l.retrieveClassCollector(l.peerClass(remotePeer), topic)
 	if err != nil {
 		log.Errorf("collector with topic '%s' does not exist", topic)
 		return
 	}
-	key := stream.Conn().RemotePeer().String()
-	collector.Add(key, 1)
+	collector.Add(remotePeer.String(), 1)
+}
+
+// returns the cost of a request of the given amount on the topic. Requests served from the database
+// cost more while the node is under load, up to the capacity of the collector, so that a request
+// which fits an empty bucket can still be served.
+func (l *limiter) cost(topic string, amt, capacity int64) int64 {
+	factor := l.load.loadFactor()
+	if factor >= 1 || amt <= 0 || !l.adaptiveTopics[topic] {
+		return amt
+	}
+	return min(int64(math.Ceil(float64(amt)/factor)), max(capacity, amt))
+}
+
+// returns the class of the peer, which determines the quota of its rate limits.
+func (l *limiter) peerClass(pid peer.ID) peerClass {
+	peers := l.p2p.Peers()
+	if peers.IsTrustedPeers(pid) {
+		return trustedPeer
+	}
+	if direction, err := peers.Direction(pid); err == nil && direction == network.DirOutbound {
+		return outboundPeer
+	}
+	return inboundPeer
+}
+
+// records that a request of the peer was rejected.
+func (l *limiter) throttle(pid peer.ID, class peerClass, topic string) {
+	rpcRateLimitedRequests.WithLabelValues(topic, class.String()).Inc()
+
+	l.throttledLock.Lock()
+	defer l.throttledLock.Unlock()
+
+	now := prysmTime.Now()
+	tp, ok := l.throttled[pid]
+	if !ok {
+		l.pruneThrottled(now)
+		if len(l.throttled) >= maxThrottledPeers {
+			return
+		}
+		tp = &ThrottledPeer{PeerID: pid}
+		l.throttled[pid] = tp
+	}
+	tp.Class = class.String()
+	tp.Topic = topic
+	tp.Count++
+	tp.LastThrottled = now
+}
+
+// returns the peers whose requests were recently rejected, the most recently rejected first.
+func (l *limiter) throttledPeers() []*ThrottledPeer {
+	l.throttledLock.Lock()
+	defer l.throttledLock.Unlock()
+
+	l.pruneThrottled(prysmTime.Now())
+	peers := make([]*ThrottledPeer, 0, len(l.throttled))
+	for _, tp := range l.throttled {
+		cp := *tp
+		peers = append(peers, &cp)
+	}
+	sort.Slice(peers, func(i, j int) bool { return peers[i].LastThrottled.After(peers[j].LastThrottled) })
+	return peers
+}
+
+// drops the peers whose last rejected request is older than the retention period, the caller must hold the throttled lock.
+func (l *limiter) pruneThrottled(now time.Time) {
+	for pid, tp := range l.throttled {
+		if now.Sub(tp.LastThrottled) > throttledPeerRetention {
+			delete(l.throttled, pid)
+		}
+	}
+}
+
+// returns the state of the rate limiter as a whole.
+func (l *limiter) status() *RateLimitStatus {
+	quotas := make(map[string]float64, len(l.quotas))
+	for class, quota := range l.quotas {
+		quotas[class.String()] = quota
+	}
+
+	st := &RateLimitStatus{
+		Adaptive:       l.load != nil,
+		LoadFactor:     l.load.loadFactor(),
+		Quotas:         quotas,
+		ThrottledPeers: l.throttledPeers(),
+	}
+	if l.load != nil {
+		st.CPUUsage, st.DBReadLatency = l.load.usage()
+	}
+	return st
+}
+
+// returns the state of the rate limits of the peer, for the topics it has recently sent requests on.
+func (l *limiter) peerRateLimits(pid peer.ID) []*PeerRateLimit {
+	l.RLock()
+	defer l.RUnlock()
+
+	key := pid.String()
+	limits := make([]*PeerRateLimit, 0)
+	for topic, collector := range l.classCollectors(l.peerClass(pid)) {
+		if collector.Count(key) == 0 {
+			continue
+		}
+		limits = append(limits, &PeerRateLimit{
+			Topic:     topic,
+			Capacity:  collector.Capacity(),
+			Remaining: collector.Remaining(key),
+			TillEmpty: collector.TillEmpty(key),
+		})
+	}
+	sort.Slice(limits, func(i, j int) bool { return limits[i].Topic < limits[j].Topic })
+	return limits
 }
 
 // frees all the collectors and removes them.
@@ -182,35 +476,56 @@ func (l *limiter) free() {
 	defer l.Unlock()
 
 	tempMap := map[uintptr]bool{}
-	for t, collector := range l.limiterMap {
-		// Check if collector has already been cleared off
-		// as all collectors are not distinct from each other.
-		ptr := reflect.ValueOf(collector).Pointer()
-		if tempMap[ptr] {
+	freeMap := func(topicMap map[string]*leakybucket.Collector) {
+		for t, collector := range topicMap {
+			// Check if collector has already been cleared off
+			// as all collectors are not distinct from each other.
+			ptr := reflect.ValueOf(collector).Pointer()
+			if tempMap[ptr] {
+				// Remove from map
+				delete(topicMap, t)
+				continue
+			}
+			collector.Free()
 			// Remove from map
-			delete(l.limiterMap, t)
-			continue
+			delete(topicMap, t)
+			tempMap[ptr] = true
 		}
-		collector.Free()
-		// Remove from map
-		delete(l.limiterMap, t)
-		tempMap[ptr] = true
+	}
+	freeMap(l.limiterMap)
+	for class, topicMap := range l.classMaps {
+		freeMap(topicMap)
+		delete(l.classMaps, class)
 	}
 }
 
 // not to be used outside the rate limiter file as it is unsafe for concurrent usage
 // and is protected by a lock on all of its usages here.
 func (l *limiter) retrieveCollector(topic string) (*leakybucket.Collector, error) {
+	return l.retrieveClassCollector(inboundPeer, topic)
+}
+
+// not to be used outside the rate limiter file as it is unsafe for concurrent usage
+// and is protected by a lock on all of its usages here.
+func (l *limiter) retrieveClassCollector(class peerClass, topic string) (*leakybucket.Collector, error) {
 	if !mutexasserts.RWMutexLocked(&l.RWMutex) && !mutexasserts.RWMutexRLocked(&l.RWMutex) {
 		return nil, errors.New("limiter.retrieveCollector: caller must hold read/write lock")
 	}
-	collector, ok := l.limiterMap[topic]
+	collector, ok := l.classCollectors(class)[topic]
 	if !ok {
 		return nil, errors.Errorf("collector does not exist for topic %s", topic)
 	}
 	return collector, nil
 }
 
+// returns the collectors of the peer class, the caller must hold the lock.
+func (l *limiter) classCollectors(class peerClass) map[string]*leakybucket.Collector {
+	if topicMap, ok := l.classMaps[class]; ok {
+		return topicMap
+	}
+	return l.limiterMap
+}
+
 func (_ *limiter) topicLogger(topic string) *logrus.Entry {
 	return log.WithField("rateLimiter", topic)
 }
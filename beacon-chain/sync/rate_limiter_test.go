@@ -7,10 +7,13 @@ import (
 	"time"
 
 	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/peer"
 	"github.com/libp2p/go-libp2p/core/protocol"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p"
 	mockp2p "github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/testing"
 	p2ptypes "github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/types"
+	"github.com/prysmaticlabs/prysm/v5/cmd/beacon-chain/flags"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v5/testing/assert"
 	"github.com/prysmaticlabs/prysm/v5/testing/require"
 	"github.com/prysmaticlabs/prysm/v5/testing/util"
@@ -18,7 +21,7 @@ import (
 
 func TestNewRateLimiter(t *testing.T) {
 	rlimiter := newRateLimiter(mockp2p.NewTestP2P(t))
-	assert.Equal(t, len(rlimiter.limiterMap), 12, "correct number of topics not registered")
+	assert.Equal(t, len(rlimiter.limiterMap), 18, "correct number of topics not registered")
 }
 
 func TestNewRateLimiter_FreeCorrectly(t *testing.T) {
@@ -27,6 +30,166 @@ func TestNewRateLimiter_FreeCorrectly(t *testing.T) {
 	assert.Equal(t, len(rlimiter.limiterMap), 0, "rate limiter not freed correctly")
 }
 
+func TestRateLimiter_PeerRateLimits(t *testing.T) {
+	p1 := mockp2p.NewTestP2P(t)
+	rlimiter := newRateLimiter(p1)
+	pid := peer.ID("peer")
+	assert.Equal(t, 0, len(rlimiter.peerRateLimits(pid)))
+
+	statusTopic := p2p.RPCStatusTopicV1 + p1.Encoding().ProtocolSuffix()
+	rlimiter.limiterMap[statusTopic].Add(pid.String(), 2)
+	rlimiter.limiterMap[rpcLimiterTopic].Add(pid.String(), 3)
+	// Requests of other peers are not reported.
+	rlimiter.limiterMap[statusTopic].Add("other", 1)
+
+	limits := rlimiter.peerRateLimits(pid)
+	require.Equal(t, 2, len(limits))
+	assert.Equal(t, statusTopic, limits[0].Topic)
+	assert.Equal(t, int64(defaultBurstLimit), limits[0].Capacity)
+	assert.Equal(t, int64(defaultBurstLimit-2), limits[0].Remaining)
+	assert.Equal(t, true, limits[0].TillEmpty > 0)
+	assert.Equal(t, rpcLimiterTopic, limits[1].Topic)
+	assert.Equal(t, int64(defaultBurstLimit*2-3), limits[1].Remaining)
+}
+
+func TestRateLimiter_PeerClasses(t *testing.T) {
+	resetCfg := flags.Get()
+	cfg := *resetCfg
+	cfg.TrustedPeerRPCQuotaFactor = 4
+	cfg.OutboundPeerRPCQuotaFactor = 2
+	cfg.InboundPeerRPCQuotaFactor = 1
+	flags.Init(&cfg)
+	defer flags.Init(resetCfg)
+
+	p1 := mockp2p.NewTestP2P(t)
+	inbound, outbound, trusted, unknown := peer.ID("inbound"), peer.ID("outbound"), peer.ID("trusted"), peer.ID("unknown")
+	p1.Peers().Add(nil, inbound, nil, network.DirInbound)
+	p1.Peers().Add(nil, outbound, nil, network.DirOutbound)
+	p1.Peers().Add(nil, trusted, nil, network.DirInbound)
+	p1.Peers().SetTrustedPeers([]peer.ID{trusted})
+	rlimiter := newRateLimiter(p1)
+
+	assert.Equal(t, inboundPeer, rlimiter.peerClass(inbound))
+	assert.Equal(t, outboundPeer, rlimiter.peerClass(outbound))
+	assert.Equal(t, trustedPeer, rlimiter.peerClass(trusted))
+	assert.Equal(t, inboundPeer, rlimiter.peerClass(unknown))
+
+	topic := p2p.RPCBlocksByRangeTopicV2 + p1.Encoding().ProtocolSuffix()
+	capacity := int64(cfg.BlockBatchLimit * cfg.BlockBatchLimitBurstFactor)
+	assert.Equal(t, capacity, rlimiter.classCollectors(inboundPeer)[topic].Capacity())
+	assert.Equal(t, 2*capacity, rlimiter.classCollectors(outboundPeer)[topic].Capacity())
+	assert.Equal(t, 4*capacity, rlimiter.classCollectors(trustedPeer)[topic].Capacity())
+	assert.Equal(t, float64(4*cfg.BlockBatchLimit), rlimiter.classCollectors(trustedPeer)[topic].Rate())
+
+	// The rate limits of a peer are reported from the collectors of its class.
+	statusTopic := p2p.RPCStatusTopicV1 + p1.Encoding().ProtocolSuffix()
+	rlimiter.classCollectors(outboundPeer)[statusTopic].Add(outbound.String(), 1)
+	limits := rlimiter.peerRateLimits(outbound)
+	require.Equal(t, 1, len(limits))
+	assert.Equal(t, int64(2*defaultBurstLimit), limits[0].Capacity)
+	assert.Equal(t, int64(2*defaultBurstLimit-1), limits[0].Remaining)
+	assert.Equal(t, 0, len(rlimiter.peerRateLimits(inbound)))
+	rlimiter.classCollectors(outboundPeer)[topic].Add(outbound.String(), 10)
+	limits = rlimiter.peerRateLimits(outbound)
+	// The topics sharing the collector of blocks by range requests are reported too.
+	require.Equal(t, 3, len(limits))
+	assert.Equal(t, topic, limits[0].Topic)
+	assert.Equal(t, 2*capacity, limits[0].Capacity)
+	assert.Equal(t, 2*capacity-10, limits[0].Remaining)
+
+	rlimiter.free()
+	assert.Equal(t, 0, len(rlimiter.limiterMap))
+	assert.Equal(t, 0, len(rlimiter.classMaps))
+}
+
+func TestRateLimiter_PeerClassesShareCollectors(t *testing.T) {
+	rlimiter := newRateLimiter(mockp2p.NewTestP2P(t))
+	// Without quotas, all peer classes share the collectors of inbound peers.
+	assert.Equal(t, 0, len(rlimiter.classMaps))
+	topic := p2p.RPCStatusTopicV1 + rlimiter.p2p.Encoding().ProtocolSuffix()
+	assert.Equal(t, rlimiter.limiterMap[topic], rlimiter.classCollectors(trustedPeer)[topic])
+}
+
+func TestRateLimiter_Cost(t *testing.T) {
+	p1 := mockp2p.NewTestP2P(t)
+	rlimiter := newRateLimiter(p1)
+	blockTopic := p2p.RPCBlocksByRangeTopicV2 + p1.Encoding().ProtocolSuffix()
+	statusTopic := p2p.RPCStatusTopicV1 + p1.Encoding().ProtocolSuffix()
+
+	// Without load monitor, costs are unchanged.
+	assert.Equal(t, int64(10), rlimiter.cost(blockTopic, 10, 128))
+
+	rlimiter.load = newLoadMonitor()
+	rlimiter.load.factor = 0.5
+	assert.Equal(t, int64(20), rlimiter.cost(blockTopic, 10, 128))
+	assert.Equal(t, int64(2), rlimiter.cost(blockTopic, 1, 128))
+	// Requests which are not served from the database are not affected by the load.
+	assert.Equal(t, int64(10), rlimiter.cost(statusTopic, 10, 128))
+	// The cost is capped to the capacity, unless the request exceeds it on its own.
+	assert.Equal(t, int64(128), rlimiter.cost(blockTopic, 100, 128))
+	assert.Equal(t, int64(1000), rlimiter.cost(blockTopic, 1000, 128))
+}
+
+func TestResponseCost(t *testing.T) {
+	assert.Equal(t, int64(1), responseCost(0))
+	assert.Equal(t, int64(1), responseCost(responseCostUnit))
+	assert.Equal(t, int64(2), responseCost(responseCostUnit+1))
+	assert.Equal(t, int64(129), blobSidecarCost)
+
+	const bytesPerCell = 2048
+	dataColumn := func(blobs int) *ethpb.DataColumnSidecar {
+		sc := &ethpb.DataColumnSidecar{
+			SignedBlockHeader:            &ethpb.SignedBeaconBlockHeader{Header: &ethpb.BeaconBlockHeader{}},
+			KzgCommitmentsInclusionProof: make([][]byte, 4),
+		}
+		for i := 0; i < blobs; i++ {
+			sc.DataColumn = append(sc.DataColumn, make([]byte, bytesPerCell))
+			sc.KzgCommitments = append(sc.KzgCommitments, make([]byte, 48))
+			sc.KzgProof = append(sc.KzgProof, make([]byte, 48))
+		}
+		return sc
+	}
+	// Data column sidecars cost according to the number of blobs of their block, a fraction of a blob sidecar.
+	oneBlob, sixBlobs := responseCost(dataColumn(1).SizeSSZ()), responseCost(dataColumn(6).SizeSSZ())
+	assert.Equal(t, true, oneBlob < sixBlobs, "one blob: %d, six blobs: %d", oneBlob, sixBlobs)
+	assert.Equal(t, true, sixBlobs < blobSidecarCost, "six blobs: %d", sixBlobs)
+}
+
+func TestRateLimiter_ThrottledPeers(t *testing.T) {
+	rlimiter := newRateLimiter(mockp2p.NewTestP2P(t))
+	assert.Equal(t, 0, len(rlimiter.throttledPeers()))
+
+	rlimiter.throttle("a", inboundPeer, "topic-1")
+	rlimiter.throttle("b", outboundPeer, "topic-2")
+	rlimiter.throttle("a", inboundPeer, "topic-3")
+	rlimiter.throttledLock.Lock()
+	rlimiter.throttled["b"].LastThrottled = time.Now().Add(-time.Minute)
+	rlimiter.throttledLock.Unlock()
+
+	throttled := rlimiter.throttledPeers()
+	require.Equal(t, 2, len(throttled))
+	assert.Equal(t, peer.ID("a"), throttled[0].PeerID)
+	assert.Equal(t, "inbound", throttled[0].Class)
+	assert.Equal(t, "topic-3", throttled[0].Topic)
+	assert.Equal(t, uint64(2), throttled[0].Count)
+	assert.Equal(t, peer.ID("b"), throttled[1].PeerID)
+	assert.Equal(t, "outbound", throttled[1].Class)
+
+	// Peers are no longer reported after the retention period.
+	rlimiter.throttledLock.Lock()
+	rlimiter.throttled["b"].LastThrottled = time.Now().Add(-throttledPeerRetention - time.Minute)
+	rlimiter.throttledLock.Unlock()
+	throttled = rlimiter.throttledPeers()
+	require.Equal(t, 1, len(throttled))
+	assert.Equal(t, peer.ID("a"), throttled[0].PeerID)
+
+	status := rlimiter.status()
+	assert.Equal(t, false, status.Adaptive)
+	assert.Equal(t, float64(1), status.LoadFactor)
+	assert.Equal(t, float64(1), status.Quotas["trusted"])
+	assert.Equal(t, 1, len(status.ThrottledPeers))
+}
+
 func TestRateLimiter_ExceedCapacity(t *testing.T) {
 	p1 := mockp2p.NewTestP2P(t)
 	p2 := mockp2p.NewTestP2P(t)
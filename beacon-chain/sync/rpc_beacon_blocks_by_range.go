@@ -55,7 +55,7 @@ func (s *Service) beaconBlocksByRangeRPCHandler(ctx context.Context, msg interfa
 		return nil
 	}
 
-	blockLimiter, err := s.rateLimiter.topicCollector(string(stream.Protocol()))
+	blockLimiter, err := s.rateLimiter.topicCollector(remotePeer, string(stream.Protocol()))
 	if err != nil {
 		return err
 	}
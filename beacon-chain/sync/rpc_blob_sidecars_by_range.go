@@ -46,7 +46,7 @@ func (s *Service) streamBlobBatch(ctx context.Context, batch blockBatch, wQuota
 				tracing.AnnotateError(span, chunkErr)
 				return wQuota, chunkErr
 			}
-			s.rateLimiter.add(stream, 1)
+			s.rateLimiter.addResponse(stream, sc.SizeSSZ())
 			wQuota -= 1
 			// Stop streaming results once the quota of writes for the request is consumed.
 			if wQuota == 0 {
@@ -71,7 +71,8 @@ func (s *Service) blobSidecarsByRangeRPCHandler(ctx context.Context, msg interfa
 	if !ok {
 		return errors.New("message is not type *pb.BlobsSidecarsByRangeRequest")
 	}
-	if err := s.rateLimiter.validateRequest(stream, 1); err != nil {
+	// Require room for at least one blob sidecar.
+	if err := s.rateLimiter.validateRequest(stream, uint64(blobSidecarCost)); err != nil {
 		return err
 	}
 	rp, err := validateBlobsByRange(r, s.cfg.chain.CurrentSlot())
@@ -67,6 +67,7 @@ func (s *Service) blobSidecarByRootRPCHandler(ctx context.Context, msg interface
 		if i != 0 && i%batchSize == 0 && ticker != nil {
 			<-ticker.C
 		}
+		// Each lookup costs a unit, and each response its size.
 		s.rateLimiter.add(stream, 1)
 		root, idx := bytesutil.ToBytes32(blobIdents[i].BlockRoot), blobIdents[i].Index
 		sc, err := s.cfg.blobStorage.Get(root, idx)
@@ -101,6 +102,7 @@ func (s *Service) blobSidecarByRootRPCHandler(ctx context.Context, msg interface
 			tracing.AnnotateError(span, chunkErr)
 			return chunkErr
 		}
+		s.rateLimiter.addResponse(stream, sc.SizeSSZ())
 	}
 	closeStream(stream, log)
 	return nil
@@ -0,0 +1,98 @@
+package sync
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/protocol"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p"
+	p2ptypes "github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/types"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+)
+
+func TestDataColumnSidecarsByRangeRPCHandler(t *testing.T) {
+	s := setupDataColumnsTest(t)
+	start, err := slots.EpochStart(dataColumnTestFuluEpoch)
+	require.NoError(t, err)
+
+	var parent [32]byte
+	expected := make([]blocks.RODataColumn, 0)
+	for i := primitives.Slot(0); i < 3; i++ {
+		block, columns := util.GenerateTestFuluBlockWithColumns(t, parent, start+i, 1)
+		require.NoError(t, s.cfg.beaconDB.SaveBlock(context.Background(), block))
+		// The block in the middle has no column stored, the others only part of the requested ones.
+		if i != 1 {
+			saveDataColumns(t, s, columns, 2, 5, 7)
+			expected = append(expected, columns[2], columns[7])
+		}
+		parent = block.Root()
+	}
+
+	req := &ethpb.DataColumnSidecarsByRangeRequest{StartSlot: start, Count: 3, Columns: []uint64{2, 7, 9}}
+	rht := &rpcHandlerTest{
+		t:       t,
+		topic:   protocol.ID(p2p.RPCDataColumnSidecarsByRangeTopicV1 + s.cfg.p2p.Encoding().ProtocolSuffix()),
+		timeout: 10 * time.Second,
+		s:       s,
+	}
+	rht.testHandler(dataColumnStreamReader(t, s, dataColumnValidatorFromRangeReq(req), expected), s.dataColumnSidecarsByRangeRPCHandler, req)
+}
+
+func TestValidateDataColumnsByRange(t *testing.T) {
+	s := setupDataColumnsTest(t)
+	current := s.cfg.clock.CurrentSlot()
+	fuluStart, err := slots.EpochStart(dataColumnTestFuluEpoch)
+	require.NoError(t, err)
+	numberOfColumns := params.BeaconConfig().NumberOfColumns
+
+	t.Run("zero count", func(t *testing.T) {
+		_, err := validateDataColumnsByRange(&ethpb.DataColumnSidecarsByRangeRequest{StartSlot: fuluStart}, current)
+		require.ErrorIs(t, err, p2ptypes.ErrInvalidRequest)
+	})
+	t.Run("column out of range", func(t *testing.T) {
+		req := &ethpb.DataColumnSidecarsByRangeRequest{StartSlot: fuluStart, Count: 1, Columns: []uint64{numberOfColumns}}
+		_, err := validateDataColumnsByRange(req, current)
+		require.ErrorIs(t, err, p2ptypes.ErrInvalidRequest)
+	})
+	t.Run("start before fulu", func(t *testing.T) {
+		req := &ethpb.DataColumnSidecarsByRangeRequest{StartSlot: 0, Count: 64, Columns: []uint64{0}}
+		rp, err := validateDataColumnsByRange(req, current)
+		require.NoError(t, err)
+		require.Equal(t, fuluStart, rp.start)
+	})
+	t.Run("future start is a noop", func(t *testing.T) {
+		req := &ethpb.DataColumnSidecarsByRangeRequest{StartSlot: current + 1, Count: 1, Columns: []uint64{0}}
+		rp, err := validateDataColumnsByRange(req, current)
+		require.NoError(t, err)
+		require.Equal(t, uint64(0), rp.size)
+	})
+	t.Run("size limited by sidecar count", func(t *testing.T) {
+		columns := make([]uint64, numberOfColumns)
+		for i := range columns {
+			columns[i] = uint64(i)
+		}
+		req := &ethpb.DataColumnSidecarsByRangeRequest{StartSlot: fuluStart, Count: 1024, Columns: columns}
+		rp, err := validateDataColumnsByRange(req, current)
+		require.NoError(t, err)
+		require.Equal(t, params.BeaconConfig().MaxRequestDataColumnSidecars/numberOfColumns, rp.size)
+	})
+}
+
+func TestDataColumnsByRangeCost(t *testing.T) {
+	maxSidecars := params.BeaconConfig().MaxRequestDataColumnSidecars
+	cost := uint64(dataColumnSidecarCost)
+	require.Equal(t, true, cost > 1)
+	require.Equal(t, 6*cost, dataColumnsByRangeCost(&ethpb.DataColumnSidecarsByRangeRequest{Count: 3, Columns: []uint64{2, 7}}))
+	require.Equal(t, uint64(0), dataColumnsByRangeCost(&ethpb.DataColumnSidecarsByRangeRequest{Count: 3}))
+	// The cost is bounded by the number of data column sidecars a response is limited to.
+	columns := make([]uint64, params.BeaconConfig().NumberOfColumns)
+	require.Equal(t, maxSidecars*cost, dataColumnsByRangeCost(&ethpb.DataColumnSidecarsByRangeRequest{Count: maxSidecars, Columns: columns}))
+	require.Equal(t, maxSidecars*cost, dataColumnsByRangeCost(&ethpb.DataColumnSidecarsByRangeRequest{Count: 1 << 63, Columns: columns}))
+}
@@ -0,0 +1,127 @@
+package sync
+
+import (
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/protocol"
+	mock "github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/filesystem"
+	db "github.com/prysmaticlabs/prysm/v5/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p"
+	p2ptest "github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/testing"
+	p2ptypes "github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/types"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/startup"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/verification"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+)
+
+const dataColumnTestFuluEpoch = primitives.Epoch(1)
+
+// setupDataColumnsTest schedules every fork up to Electra at genesis and Fulu at dataColumnTestFuluEpoch, and returns
+// a service whose clock is a couple of epochs past the Fulu fork.
+func setupDataColumnsTest(t *testing.T) *Service {
+	params.SetupTestConfigCleanup(t)
+	cfg := params.BeaconConfig().Copy()
+	cfg.AltairForkEpoch = 0
+	cfg.BellatrixForkEpoch = 0
+	cfg.CapellaForkEpoch = 0
+	cfg.DenebForkEpoch = 0
+	cfg.ElectraForkEpoch = 0
+	cfg.FuluForkEpoch = dataColumnTestFuluEpoch
+	cfg.InitializeForkSchedule()
+	params.OverrideBeaconConfig(cfg)
+
+	current, err := slots.EpochStart(dataColumnTestFuluEpoch + 2)
+	require.NoError(t, err)
+	genesis := time.Now().Add(-1 * time.Second * time.Duration(uint64(current)*params.BeaconConfig().SecondsPerSlot))
+	chain := &mock.ChainService{Genesis: genesis, FinalizedCheckPoint: &ethpb.Checkpoint{}}
+	client := p2ptest.NewTestP2P(t)
+	return &Service{
+		cfg: &config{
+			p2p:               client,
+			chain:             chain,
+			clock:             startup.NewClock(genesis, chain.ValidatorsRoot),
+			beaconDB:          db.SetupDB(t),
+			dataColumnStorage: filesystem.NewEphemeralDataColumnStorage(t),
+		},
+		rateLimiter: newRateLimiter(client),
+	}
+}
+
+// saveDataColumns stores the data columns with the given indices in the service's data column storage.
+func saveDataColumns(t *testing.T, s *Service, columns []blocks.RODataColumn, indices ...uint64) {
+	toSave := make([]blocks.RODataColumn, 0, len(indices))
+	for _, idx := range indices {
+		toSave = append(toSave, columns[idx])
+	}
+	for _, dc := range verification.FakeVerifyDataColumnSliceForTest(t, toSave) {
+		require.NoError(t, s.cfg.dataColumnStorage.Save(dc))
+	}
+}
+
+// dataColumnStreamReader reads the data column sidecars of a response and requires them to match the expected ones.
+func dataColumnStreamReader(t *testing.T, s *Service, vf DataColumnResponseValidation, expected []blocks.RODataColumn) network.StreamHandler {
+	return func(stream network.Stream) {
+		ctxMap, err := ContextByteVersionsForValRoot(s.cfg.clock.GenesisValidatorsRoot())
+		require.NoError(t, err)
+		got, err := readChunkEncodedDataColumns(stream, s.cfg.p2p.Encoding(), ctxMap, vf, uint64(len(expected)))
+		require.NoError(t, err)
+		require.Equal(t, len(expected), len(got))
+		for i := range expected {
+			require.Equal(t, expected[i].BlockRoot(), got[i].BlockRoot())
+			require.Equal(t, expected[i].ColumnIndex, got[i].ColumnIndex)
+		}
+	}
+}
+
+func TestDataColumnSidecarsByRootRPCHandler(t *testing.T) {
+	s := setupDataColumnsTest(t)
+	slot, err := slots.EpochStart(dataColumnTestFuluEpoch)
+	require.NoError(t, err)
+	_, columns := util.GenerateTestFuluBlockWithColumns(t, [32]byte{}, slot+1, 2)
+	saveDataColumns(t, s, columns, 1, 3, 4)
+	root := columns[0].BlockRoot()
+
+	req := &p2ptypes.DataColumnSidecarsByRootReq{
+		{BlockRoot: root[:], ColumnIndex: 4},
+		{BlockRoot: root[:], ColumnIndex: 1},
+		// Not stored, so it is skipped.
+		{BlockRoot: root[:], ColumnIndex: 2},
+	}
+	rht := &rpcHandlerTest{
+		t:       t,
+		topic:   protocol.ID(p2p.RPCDataColumnSidecarsByRootTopicV1 + s.cfg.p2p.Encoding().ProtocolSuffix()),
+		timeout: 10 * time.Second,
+		s:       s,
+	}
+	// The response is sorted by column index.
+	expected := []blocks.RODataColumn{columns[1], columns[4]}
+	rht.testHandler(dataColumnStreamReader(t, s, dataColumnValidatorFromRootReq(req), expected), s.dataColumnSidecarByRootRPCHandler, req)
+}
+
+func TestValidateDataColumnsByRootRequest(t *testing.T) {
+	numberOfColumns := params.BeaconConfig().NumberOfColumns
+	require.NoError(t, validateDataColumnsByRootRequest(p2ptypes.DataColumnSidecarsByRootReq{{BlockRoot: make([]byte, 32), ColumnIndex: numberOfColumns - 1}}))
+	err := validateDataColumnsByRootRequest(p2ptypes.DataColumnSidecarsByRootReq{{BlockRoot: make([]byte, 32), ColumnIndex: numberOfColumns}})
+	require.ErrorIs(t, err, p2ptypes.ErrInvalidRequest)
+
+	tooMany := make(p2ptypes.DataColumnSidecarsByRootReq, params.BeaconConfig().MaxRequestDataColumnSidecars+1)
+	require.ErrorIs(t, validateDataColumnsByRootRequest(tooMany), p2ptypes.ErrMaxDataColumnReqExceeded)
+}
+
+func TestDataColumnValidatorFromRootReq(t *testing.T) {
+	_, columns := util.GenerateTestFuluBlockWithColumns(t, [32]byte{}, 1, 1)
+	root := columns[0].BlockRoot()
+	vf := dataColumnValidatorFromRootReq(&p2ptypes.DataColumnSidecarsByRootReq{{BlockRoot: root[:], ColumnIndex: 2}})
+	require.NoError(t, vf(columns[2]))
+	require.ErrorIs(t, vf(columns[3]), errUnrequestedDataColumn)
+	require.ErrorIs(t, vf(columns[3]), ErrInvalidFetchedData)
+}
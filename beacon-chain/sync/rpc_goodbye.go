@@ -48,6 +48,7 @@ func (s *Service) goodbyeRPCHandler(_ context.Context, msg interface{}, stream l
 	}
 	log := log.WithField("Reason", goodbyeMessage(*m))
 	log.WithField("peer", stream.Conn().RemotePeer()).Trace("Peer has sent a goodbye message")
+	s.cfg.p2p.Peers().SetDisconnectReason(stream.Conn().RemotePeer(), "received goodbye: "+goodbyeMessage(*m))
 	s.cfg.p2p.Peers().SetNextValidTime(stream.Conn().RemotePeer(), goodByeBackoff(*m))
 	// closes all streams with the peer
 	return s.cfg.p2p.Disconnect(stream.Conn().RemotePeer())
@@ -64,6 +65,9 @@ func (s *Service) disconnectBadPeer(ctx context.Context, id peer.ID, badPeerErr
 	if err := s.sendGoodByeAndDisconnect(ctx, goodbyeCode, id); err != nil {
 		log.WithError(err).Debug("Error when disconnecting with bad peer")
 	}
+	if badPeerErr != nil {
+		s.cfg.p2p.Peers().SetDisconnectReason(id, fmt.Sprintf("sent goodbye: %s: %v", goodbyeMessage(goodbyeCode), badPeerErr))
+	}
 
 	log.WithError(badPeerErr).WithField("peerID", id).Debug("Initiate peer disconnection")
 }
@@ -83,6 +87,7 @@ func (s *Service) sendGoodByeAndDisconnect(ctx context.Context, code p2ptypes.RP
 	if s.cfg.p2p.Host().Network().Connectedness(id) == network.NotConnected {
 		return nil
 	}
+	s.cfg.p2p.Peers().SetDisconnectReason(id, "sent goodbye: "+goodbyeMessage(code))
 	if err := s.sendGoodByeMessage(ctx, code, id); err != nil {
 		log.WithFields(logrus.Fields{
 			"error": err,
@@ -66,6 +66,9 @@ func TestGoodByeRPCHandler_Disconnects_With_Peer(t *testing.T) {
 	if len(conns) > 0 {
 		t.Error("Peer is still not disconnected despite sending a goodbye message")
 	}
+	reason, err := p1.Peers().DisconnectReason(p2.BHost.ID())
+	require.NoError(t, err)
+	assert.Equal(t, "received goodbye: "+goodbyeMessage(failureCode), reason)
 }
 
 func TestGoodByeRPCHandler_BackOffPeer(t *testing.T) {
@@ -236,5 +239,7 @@ func TestSendGoodbye_DisconnectWithPeer(t *testing.T) {
 	if util.WaitTimeout(&wg, 1*time.Second) {
 		t.Fatal("Did not receive stream within 1 sec")
 	}
-
+	reason, err := p1.Peers().DisconnectReason(p2.BHost.ID())
+	require.NoError(t, err)
+	assert.Equal(t, "sent goodbye: "+goodbyeMessage(failureCode), reason)
 }
@@ -0,0 +1,175 @@
+package sync
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/protocol"
+	mock "github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain/testing"
+	db "github.com/prysmaticlabs/prysm/v5/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p"
+	p2ptest "github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/testing"
+	p2ptypes "github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/types"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/startup"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/interfaces"
+	light_client "github.com/prysmaticlabs/prysm/v5/consensus-types/light-client"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	leakybucket "github.com/prysmaticlabs/prysm/v5/container/leaky-bucket"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+)
+
+// lightClientRPCTest connects two peers and returns the service of the first one, handling requests on the
+// given topic, along with the second peer.
+func lightClientRPCTest(t *testing.T, topic string, chain *mock.ChainService) (*Service, *p2ptest.TestP2P) {
+	p1 := p2ptest.NewTestP2P(t)
+	p2 := p2ptest.NewTestP2P(t)
+	p1.Connect(p2)
+	assert.Equal(t, 1, len(p1.BHost.Network().Peers()), "Expected peers to be connected")
+
+	r := &Service{
+		cfg: &config{
+			beaconDB: db.SetupDB(t),
+			p2p:      p1,
+			chain:    chain,
+			clock:    startup.NewClock(time.Now(), chain.ValidatorsRoot),
+		},
+		rateLimiter: newRateLimiter(p1),
+	}
+	r.rateLimiter.limiterMap[topic] = leakybucket.NewCollector(10, 10, time.Second, false)
+	return r, p2
+}
+
+func TestLightClientFinalityUpdateRPCHandler(t *testing.T) {
+	update := testLightClientFinalityUpdate(t, 5, 1, 400)
+	r, p2 := lightClientRPCTest(t, p2p.RPCLightClientFinalityUpdateTopicV1, &mock.ChainService{LCFinalityUpdate: update})
+
+	pcl := protocol.ID(p2p.RPCLightClientFinalityUpdateTopicV1)
+	var wg sync.WaitGroup
+	wg.Add(1)
+	p2.BHost.SetStreamHandler(pcl, func(stream network.Stream) {
+		defer wg.Done()
+		expectSuccess(t, stream)
+		ctxBytes, err := readContextFromStream(stream)
+		require.NoError(t, err)
+		require.Equal(t, fieldparams.VersionLength, len(ctxBytes))
+		out := new(ethpb.LightClientFinalityUpdateAltair)
+		require.NoError(t, r.cfg.p2p.Encoding().DecodeWithMaxLength(stream, out))
+		require.DeepSSZEqual(t, update.Proto(), out)
+	})
+	stream, err := r.cfg.p2p.(*p2ptest.TestP2P).BHost.NewStream(context.Background(), p2.BHost.ID(), pcl)
+	require.NoError(t, err)
+	require.NoError(t, r.lightClientFinalityUpdateRPCHandler(context.Background(), new(interface{}), stream))
+
+	if util.WaitTimeout(&wg, 1*time.Second) {
+		t.Fatal("Did not receive stream within 1 sec")
+	}
+}
+
+func TestLightClientOptimisticUpdateRPCHandler_Unavailable(t *testing.T) {
+	r, p2 := lightClientRPCTest(t, p2p.RPCLightClientOptimisticUpdateTopicV1, &mock.ChainService{})
+
+	pcl := protocol.ID(p2p.RPCLightClientOptimisticUpdateTopicV1)
+	var wg sync.WaitGroup
+	wg.Add(1)
+	p2.BHost.SetStreamHandler(pcl, func(stream network.Stream) {
+		defer wg.Done()
+		expectFailure(t, responseCodeResourceUnavailable, p2ptypes.ErrResourceUnavailable.Error(), stream)
+	})
+	stream, err := r.cfg.p2p.(*p2ptest.TestP2P).BHost.NewStream(context.Background(), p2.BHost.ID(), pcl)
+	require.NoError(t, err)
+	require.NoError(t, r.lightClientOptimisticUpdateRPCHandler(context.Background(), new(interface{}), stream))
+
+	if util.WaitTimeout(&wg, 1*time.Second) {
+		t.Fatal("Did not receive stream within 1 sec")
+	}
+}
+
+func TestLightClientBootstrapRPCHandler_NotFound(t *testing.T) {
+	r, p2 := lightClientRPCTest(t, p2p.RPCLightClientBootstrapTopicV1, &mock.ChainService{})
+
+	pcl := protocol.ID(p2p.RPCLightClientBootstrapTopicV1)
+	var wg sync.WaitGroup
+	wg.Add(1)
+	p2.BHost.SetStreamHandler(pcl, func(stream network.Stream) {
+		defer wg.Done()
+		expectFailure(t, responseCodeResourceUnavailable, p2ptypes.ErrResourceUnavailable.Error(), stream)
+	})
+	stream, err := r.cfg.p2p.(*p2ptest.TestP2P).BHost.NewStream(context.Background(), p2.BHost.ID(), pcl)
+	require.NoError(t, err)
+	req := p2ptypes.LightClientBootstrapReq{'a'}
+	require.NoError(t, r.lightClientBootstrapRPCHandler(context.Background(), &req, stream))
+
+	if util.WaitTimeout(&wg, 1*time.Second) {
+		t.Fatal("Did not receive stream within 1 sec")
+	}
+}
+
+func TestLightClientUpdatesByRangeRPCHandler(t *testing.T) {
+	r, p2 := lightClientRPCTest(t, p2p.RPCLightClientUpdatesByRangeTopicV1, &mock.ChainService{})
+
+	// Periods 1 and 2 are available, period 3 is missing and ends the response.
+	updates := []interfaces.LightClientUpdate{testLightClientUpdate(t, 10), testLightClientUpdate(t, 20)}
+	for i, update := range updates {
+		require.NoError(t, r.cfg.beaconDB.SaveLightClientUpdate(context.Background(), uint64(i+1), update))
+	}
+	require.NoError(t, r.cfg.beaconDB.SaveLightClientUpdate(context.Background(), 4, testLightClientUpdate(t, 40)))
+
+	pcl := protocol.ID(p2p.RPCLightClientUpdatesByRangeTopicV1)
+	var wg sync.WaitGroup
+	wg.Add(1)
+	p2.BHost.SetStreamHandler(pcl, func(stream network.Stream) {
+		defer wg.Done()
+		for _, update := range updates {
+			expectSuccess(t, stream)
+			_, err := readContextFromStream(stream)
+			require.NoError(t, err)
+			out := new(ethpb.LightClientUpdateAltair)
+			require.NoError(t, r.cfg.p2p.Encoding().DecodeWithMaxLength(stream, out))
+			require.DeepSSZEqual(t, update.Proto(), out)
+		}
+		_, _, err := ReadStatusCode(stream, r.cfg.p2p.Encoding())
+		require.ErrorContains(t, "EOF", err)
+	})
+	stream, err := r.cfg.p2p.(*p2ptest.TestP2P).BHost.NewStream(context.Background(), p2.BHost.ID(), pcl)
+	require.NoError(t, err)
+	req := &p2ptypes.LightClientUpdatesByRangeReq{StartPeriod: 1, Count: 4}
+	require.NoError(t, r.lightClientUpdatesByRangeRPCHandler(context.Background(), req, stream))
+
+	if util.WaitTimeout(&wg, 1*time.Second) {
+		t.Fatal("Did not receive stream within 1 sec")
+	}
+}
+
+func testLightClientUpdate(t *testing.T, attestedSlot primitives.Slot) interfaces.LightClientUpdate {
+	pubkeys := make([][]byte, params.BeaconConfig().SyncCommitteeSize)
+	for i := range pubkeys {
+		pubkeys[i] = make([]byte, fieldparams.BLSPubkeyLength)
+	}
+	syncCommitteeBranch := make([][]byte, fieldparams.SyncCommitteeBranchDepth)
+	for i := range syncCommitteeBranch {
+		syncCommitteeBranch[i] = make([]byte, fieldparams.RootLength)
+	}
+	finalityBranch := make([][]byte, fieldparams.FinalityBranchDepth)
+	for i := range finalityBranch {
+		finalityBranch[i] = make([]byte, fieldparams.RootLength)
+	}
+	update, err := light_client.NewWrappedUpdateAltair(&ethpb.LightClientUpdateAltair{
+		AttestedHeader:          &ethpb.LightClientHeaderAltair{Beacon: util.HydrateBeaconHeader(&ethpb.BeaconBlockHeader{Slot: attestedSlot})},
+		NextSyncCommittee:       &ethpb.SyncCommittee{Pubkeys: pubkeys, AggregatePubkey: make([]byte, fieldparams.BLSPubkeyLength)},
+		NextSyncCommitteeBranch: syncCommitteeBranch,
+		FinalizedHeader:         &ethpb.LightClientHeaderAltair{Beacon: util.HydrateBeaconHeader(&ethpb.BeaconBlockHeader{})},
+		FinalityBranch:          finalityBranch,
+		SyncAggregate:           testLightClientSyncAggregate(400),
+		SignatureSlot:           attestedSlot + 1,
+	})
+	require.NoError(t, err)
+	return update
+}
@@ -25,6 +25,8 @@ import (
 
 var errBlobChunkedReadFailure = errors.New("failed to read stream of chunk-encoded blobs")
 var errBlobUnmarshal = errors.New("Could not unmarshal chunk-encoded blob")
+var errDataColumnChunkedReadFailure = errors.New("failed to read stream of chunk-encoded data columns")
+var errDataColumnUnmarshal = errors.New("could not unmarshal chunk-encoded data column")
 
 // Any error from the following declaration block should result in peer downscoring.
 var (
@@ -38,6 +40,10 @@ var (
 	errBlobResponseOutOfBounds        = errors.Wrap(ErrInvalidFetchedData, "received BlobSidecar with slot outside BlobSidecarsByRangeRequest bounds")
 	errChunkResponseBlockMismatch     = errors.Wrap(ErrInvalidFetchedData, "blob block details do not match")
 	errChunkResponseParentMismatch    = errors.Wrap(ErrInvalidFetchedData, "parent root for response element doesn't match previous element root")
+
+	errMaxRequestDataColumnSidecarsExceeded = errors.Wrap(ErrInvalidFetchedData, "peer exceeded req data column chunk tx limit")
+	errUnrequestedDataColumn                = errors.Wrap(ErrInvalidFetchedData, "received DataColumnSidecar in response that was not requested")
+	errDataColumnResponseOutOfBounds        = errors.Wrap(ErrInvalidFetchedData, "received DataColumnSidecar with slot outside DataColumnSidecarsByRangeRequest bounds")
 )
 
 // BeaconBlockProcessor defines a block processing function, which allows to start utilizing
@@ -382,3 +388,169 @@ func readChunkedBlobSidecar(stream network.Stream, encoding encoder.NetworkEncod
 
 	return rob, nil
 }
+
+// SendDataColumnSidecarsByRangeRequest sends a DataColumnSidecarsByRange request and returns the fetched data columns.
+func SendDataColumnSidecarsByRangeRequest(
+	ctx context.Context, tor blockchain.TemporalOracle, p2pApi p2p.SenderEncoder, pid peer.ID,
+	ctxMap ContextByteVersions, req *ethpb.DataColumnSidecarsByRangeRequest, dvs ...DataColumnResponseValidation,
+) ([]blocks.RODataColumn, error) {
+	topic, err := p2p.TopicFromMessage(p2p.DataColumnSidecarsByRangeName, slots.ToEpoch(tor.CurrentSlot()))
+	if err != nil {
+		return nil, err
+	}
+	log.WithFields(logrus.Fields{
+		"topic":     topic,
+		"startSlot": req.StartSlot,
+		"count":     req.Count,
+		"columns":   req.Columns,
+	}).Debug("Sending data column by range request")
+	stream, err := p2pApi.Send(ctx, req, topic, pid)
+	if err != nil {
+		return nil, err
+	}
+	defer closeStream(stream, log)
+
+	max := params.BeaconConfig().MaxRequestDataColumnSidecars
+	if requested := req.Count * uint64(len(req.Columns)); max > requested {
+		max = requested
+	}
+	vfuncs := []DataColumnResponseValidation{dataColumnValidatorFromRangeReq(req)}
+	if len(dvs) > 0 {
+		vfuncs = append(vfuncs, dvs...)
+	}
+	return readChunkEncodedDataColumns(stream, p2pApi.Encoding(), ctxMap, composeDataColumnValidations(vfuncs...), max)
+}
+
+// SendDataColumnSidecarsByRootRequest sends a DataColumnSidecarsByRoot request and returns the fetched data columns.
+func SendDataColumnSidecarsByRootRequest(
+	ctx context.Context, tor blockchain.TemporalOracle, p2pApi p2p.P2P, pid peer.ID,
+	ctxMap ContextByteVersions, req *p2ptypes.DataColumnSidecarsByRootReq,
+) ([]blocks.RODataColumn, error) {
+	if uint64(len(*req)) > params.BeaconConfig().MaxRequestDataColumnSidecars {
+		return nil, errors.Wrapf(p2ptypes.ErrMaxDataColumnReqExceeded, "length=%d", len(*req))
+	}
+
+	topic, err := p2p.TopicFromMessage(p2p.DataColumnSidecarsByRootName, slots.ToEpoch(tor.CurrentSlot()))
+	if err != nil {
+		return nil, err
+	}
+	log.WithField("topic", topic).Debug("Sending data column sidecar request")
+	stream, err := p2pApi.Send(ctx, req, topic, pid)
+	if err != nil {
+		return nil, err
+	}
+	defer closeStream(stream, log)
+
+	return readChunkEncodedDataColumns(stream, p2pApi.Encoding(), ctxMap, dataColumnValidatorFromRootReq(req), uint64(len(*req)))
+}
+
+// DataColumnResponseValidation represents a function that can validate aspects of a single unmarshaled data column
+// that was received from a peer in response to an rpc request.
+type DataColumnResponseValidation func(blocks.RODataColumn) error
+
+func composeDataColumnValidations(vf ...DataColumnResponseValidation) DataColumnResponseValidation {
+	return func(dc blocks.RODataColumn) error {
+		for i := range vf {
+			if err := vf[i](dc); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+func dataColumnValidatorFromRootReq(req *p2ptypes.DataColumnSidecarsByRootReq) DataColumnResponseValidation {
+	columnIds := make(map[[32]byte]map[uint64]bool)
+	for _, sc := range *req {
+		blockRoot := bytesutil.ToBytes32(sc.BlockRoot)
+		if columnIds[blockRoot] == nil {
+			columnIds[blockRoot] = make(map[uint64]bool)
+		}
+		columnIds[blockRoot][sc.ColumnIndex] = true
+	}
+	return func(dc blocks.RODataColumn) error {
+		columnIndices := columnIds[dc.BlockRoot()]
+		if columnIndices == nil {
+			return errors.Wrapf(errUnrequestedDataColumn, "root=%#x", dc.BlockRoot())
+		}
+		if !columnIndices[dc.ColumnIndex] {
+			return errors.Wrapf(errUnrequestedDataColumn, "root=%#x index=%d", dc.BlockRoot(), dc.ColumnIndex)
+		}
+		return nil
+	}
+}
+
+func dataColumnValidatorFromRangeReq(req *ethpb.DataColumnSidecarsByRangeRequest) DataColumnResponseValidation {
+	end := req.StartSlot + primitives.Slot(req.Count)
+	columns := make(map[uint64]bool, len(req.Columns))
+	for _, c := range req.Columns {
+		columns[c] = true
+	}
+	return func(dc blocks.RODataColumn) error {
+		if dc.Slot() < req.StartSlot || dc.Slot() >= end {
+			return errors.Wrapf(errDataColumnResponseOutOfBounds, "req start,end:%d,%d, resp:%d", req.StartSlot, end, dc.Slot())
+		}
+		if !columns[dc.ColumnIndex] {
+			return errors.Wrapf(errUnrequestedDataColumn, "index=%d", dc.ColumnIndex)
+		}
+		return nil
+	}
+}
+
+func readChunkEncodedDataColumns(stream network.Stream, encoding encoder.NetworkEncoding, ctxMap ContextByteVersions, vf DataColumnResponseValidation, max uint64) ([]blocks.RODataColumn, error) {
+	sidecars := make([]blocks.RODataColumn, 0)
+	// Attempt an extra read beyond max to check if the peer is violating the spec by
+	// sending more than MAX_REQUEST_DATA_COLUMN_SIDECARS, or more data columns than requested.
+	for i := uint64(0); i < max+1; i++ {
+		sc, err := readChunkedDataColumnSidecar(stream, encoding, ctxMap, vf)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return nil, err
+		}
+		if i == max {
+			return nil, errMaxRequestDataColumnSidecarsExceeded
+		}
+		sidecars = append(sidecars, sc)
+	}
+
+	return sidecars, nil
+}
+
+func readChunkedDataColumnSidecar(stream network.Stream, encoding encoder.NetworkEncoding, ctxMap ContextByteVersions, vf DataColumnResponseValidation) (blocks.RODataColumn, error) {
+	var dc blocks.RODataColumn
+	pb := &ethpb.DataColumnSidecar{}
+	code, msg, err := ReadStatusCode(stream, encoding)
+	if err != nil {
+		return dc, err
+	}
+	if code != 0 {
+		return dc, errors.Wrap(errDataColumnChunkedReadFailure, msg)
+	}
+	ctxb, err := readContextFromStream(stream)
+	if err != nil {
+		return dc, errors.Wrap(err, "error reading chunk context bytes from stream")
+	}
+
+	v, found := ctxMap[bytesutil.ToBytes4(ctxb)]
+	if !found {
+		return dc, errors.Wrapf(errDataColumnUnmarshal, "unrecognized fork digest %#x", ctxb)
+	}
+	if v < version.Fulu {
+		return dc, fmt.Errorf("unexpected context bytes for DataColumnSidecar, ctx=%#x, v=%s", ctxb, version.String(v))
+	}
+	if err := encoding.DecodeWithMaxLength(stream, pb); err != nil {
+		return dc, errors.Wrap(err, "failed to decode the protobuf-encoded DataColumnSidecar message from RPC chunk stream")
+	}
+
+	rodc, err := blocks.NewRODataColumn(pb)
+	if err != nil {
+		return dc, errors.Wrap(err, "unexpected error initializing RODataColumn")
+	}
+	if err := vf(rodc); err != nil {
+		return dc, errors.Wrap(err, "validation failure decoding data column RPC response")
+	}
+
+	return rodc, nil
+}
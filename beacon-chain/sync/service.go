@@ -102,12 +102,14 @@ type config struct {
 	clock                   *startup.Clock
 	stateNotifier           statefeed.Notifier
 	blobStorage             *filesystem.BlobStorage
+	dataColumnStorage       *filesystem.DataColumnStorage
 }
 
 // This defines the interface for interacting with block chain service
 type blockchainService interface {
 	blockchain.BlockReceiver
 	blockchain.BlobReceiver
+	blockchain.DataColumnReceiver
 	blockchain.HeadFetcher
 	blockchain.FinalizationFetcher
 	blockchain.ForkFetcher
@@ -118,6 +120,7 @@ type blockchainService interface {
 	blockchain.OptimisticModeFetcher
 	blockchain.SlashingReceiver
 	blockchain.ForkchoiceFetcher
+	blockchain.LightClientUpdateFetcher
 }
 
 // Service is responsible for handling all run time p2p related operations as the
@@ -139,6 +142,8 @@ type Service struct {
 	seenBlockCache                   *lru.Cache
 	seenBlobLock                     sync.RWMutex
 	seenBlobCache                    *lru.Cache
+	seenDataColumnLock               sync.RWMutex
+	seenDataColumnCache              *lru.Cache
 	seenAggregatedAttestationLock    sync.RWMutex
 	seenAggregatedAttestationCache   *lru.Cache
 	seenUnAggregatedAttestationLock  sync.RWMutex
@@ -157,11 +162,15 @@ type Service struct {
 	badBlockLock                     sync.RWMutex
 	syncContributionBitsOverlapLock  sync.RWMutex
 	syncContributionBitsOverlapCache *lru.Cache
+	seenLightClientUpdatesLock       sync.RWMutex
+	seenLCFinalityUpdate             interfaces.LightClientFinalityUpdate
+	seenLCOptimisticUpdate           interfaces.LightClientOptimisticUpdate
 	signatureChan                    chan *signatureVerifier
 	clockWaiter                      startup.ClockWaiter
 	initialSyncComplete              chan struct{}
 	verifierWaiter                   *verification.InitializerWaiter
 	newBlobVerifier                  verification.NewBlobVerifier
+	newDataColumnVerifier            verification.NewDataColumnVerifier
 	availableBlocker                 coverage.AvailableBlocker
 	ctxMap                           ContextByteVersions
 }
@@ -220,6 +229,12 @@ func newBlobVerifierFromInitializer(ini *verification.Initializer) verification.
 	}
 }
 
+func newDataColumnVerifierFromInitializer(ini *verification.Initializer) verification.NewDataColumnVerifier {
+	return func(d blocks.RODataColumn, reqs []verification.Requirement) verification.DataColumnVerifier {
+		return ini.NewDataColumnVerifier(d, reqs)
+	}
+}
+
 // Start the regular sync service.
 func (s *Service) Start() {
 	v, err := s.verifierWaiter.WaitForInitializer(s.ctx)
@@ -228,6 +243,7 @@ func (s *Service) Start() {
 		return
 	}
 	s.newBlobVerifier = newBlobVerifierFromInitializer(v)
+	s.newDataColumnVerifier = newDataColumnVerifierFromInitializer(v)
 
 	go s.verifierRoutine()
 	go s.startTasksPostInitialSync()
@@ -245,6 +261,11 @@ func (s *Service) Start() {
 
 	// Update sync metrics.
 	async.RunEvery(s.ctx, syncMetricsInterval, s.updateMetrics)
+
+	// Sample the load of the node, which the rate limits adapt to.
+	if s.rateLimiter != nil && s.rateLimiter.load != nil {
+		async.RunEvery(s.ctx, loadSampleInterval, s.rateLimiter.load.sample)
+	}
 }
 
 // Stop the regular sync service.
@@ -282,6 +303,7 @@ func (s *Service) Status() error {
 func (s *Service) initCaches() {
 	s.seenBlockCache = lruwrpr.New(seenBlockSize)
 	s.seenBlobCache = lruwrpr.New(seenBlobSize)
+	s.seenDataColumnCache = lruwrpr.New(seenDataColumnSize)
 	s.seenAggregatedAttestationCache = lruwrpr.New(seenAggregatedAttSize)
 	s.seenUnAggregatedAttestationCache = lruwrpr.New(seenUnaggregatedAttSize)
 	s.seenSyncMessageCache = lruwrpr.New(seenSyncMsgSize)
@@ -382,3 +404,27 @@ type Checker interface {
 	Status() error
 	Resync() error
 }
+
+// RateLimitFetcher provides the state of the rate limits applied to the RPC requests of peers.
+type RateLimitFetcher interface {
+	PeerRateLimits(pid peer.ID) []*PeerRateLimit
+	RateLimitStatus() *RateLimitStatus
+}
+
+// PeerRateLimits returns the state of the rate limits applied to the RPC requests of the peer,
+// for the topics it has recently sent requests on.
+func (s *Service) PeerRateLimits(pid peer.ID) []*PeerRateLimit {
+	if s.rateLimiter == nil {
+		return nil
+	}
+	return s.rateLimiter.peerRateLimits(pid)
+}
+
+// RateLimitStatus returns the state of the rate limiter as a whole, including the quotas of each peer
+// class, the current load factor and the peers whose requests were recently rejected.
+func (s *Service) RateLimitStatus() *RateLimitStatus {
+	if s.rateLimiter == nil {
+		return nil
+	}
+	return s.rateLimiter.status()
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/cache"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/altair"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/peerdas"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/peers"
 	"github.com/prysmaticlabs/prysm/v5/cmd/beacon-chain/flags"
@@ -77,6 +78,27 @@ func (s *Service) activeSyncSubnetIndices(currentSlot primitives.Slot) []uint64
 	return slice.SetUint64(subs)
 }
 
+// dataColumnSubnetIndices returns the data column subnets matching the custody groups of the node,
+// which are derived from its node ID.
+func (s *Service) dataColumnSubnetIndices(_ primitives.Slot) []uint64 {
+	columns, err := s.custodyColumns()
+	if err != nil {
+		log.WithError(err).Error("Could not compute custody columns")
+		return []uint64{}
+	}
+	return peerdas.DataColumnSubnets(columns)
+}
+
+// custodyColumns returns the data columns the node is required to custody.
+func (s *Service) custodyColumns() (map[uint64]bool, error) {
+	custodyGroupCount := peerdas.CustodyGroupCount(flags.Get().SubscribeAllDataSubnets)
+	groups, err := peerdas.CustodyGroups(s.cfg.p2p.NodeID(), custodyGroupCount)
+	if err != nil {
+		return nil, err
+	}
+	return peerdas.CustodyColumns(groups)
+}
+
 // Register PubSub subscribers
 func (s *Service) registerSubscribers(epoch primitives.Epoch, digest [4]byte) {
 	s.subscribe(
@@ -133,6 +155,20 @@ func (s *Service) registerSubscribers(epoch primitives.Epoch, digest [4]byte) {
 			s.activeSyncSubnetIndices,
 			func(currentSlot primitives.Slot) []uint64 { return []uint64{} },
 		)
+		if features.Get().EnableLightClient {
+			s.subscribe(
+				p2p.LightClientFinalityUpdateTopicFormat,
+				s.validateLightClientFinalityUpdate,
+				s.lightClientFinalityUpdateSubscriber,
+				digest,
+			)
+			s.subscribe(
+				p2p.LightClientOptimisticUpdateTopicFormat,
+				s.validateLightClientOptimisticUpdate,
+				s.lightClientOptimisticUpdateSubscriber,
+				digest,
+			)
+		}
 	}
 
 	// New gossip topic in Capella
@@ -160,7 +196,7 @@ func (s *Service) registerSubscribers(epoch primitives.Epoch, digest [4]byte) {
 	}
 
 	// Modified gossip topic in Electra
-	if params.BeaconConfig().ElectraForkEpoch <= epoch {
+	if params.BeaconConfig().ElectraForkEpoch <= epoch && epoch < params.BeaconConfig().FuluForkEpoch {
 		s.subscribeWithParameters(
 			p2p.BlobSubnetTopicFormat,
 			s.validateBlob,
@@ -172,6 +208,18 @@ func (s *Service) registerSubscribers(epoch primitives.Epoch, digest [4]byte) {
 			func(currentSlot primitives.Slot) []uint64 { return []uint64{} },
 		)
 	}
+
+	// New gossip topic in Fulu, replacing the blob sidecar topic.
+	if params.BeaconConfig().FuluForkEpoch <= epoch {
+		s.subscribeWithParameters(
+			p2p.DataColumnSubnetTopicFormat,
+			s.validateDataColumn,
+			s.dataColumnSubscriber,
+			digest,
+			s.dataColumnSubnetIndices,
+			func(currentSlot primitives.Slot) []uint64 { return []uint64{} },
+		)
+	}
 }
 
 // subscribe to a given topic with a given validator and subscription handler.
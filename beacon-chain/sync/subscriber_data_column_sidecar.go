@@ -0,0 +1,29 @@
+package sync
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+	"google.golang.org/protobuf/proto"
+)
+
+func (s *Service) dataColumnSubscriber(ctx context.Context, msg proto.Message) error {
+	dc, ok := msg.(blocks.VerifiedRODataColumn)
+	if !ok {
+		return fmt.Errorf("message was not type blocks.VerifiedRODataColumn, type=%T", msg)
+	}
+
+	return s.subscribeDataColumn(ctx, dc)
+}
+
+func (s *Service) subscribeDataColumn(ctx context.Context, dc blocks.VerifiedRODataColumn) error {
+	s.setSeenDataColumnIndex(dc.Slot(), dc.ProposerIndex(), dc.ColumnIndex)
+
+	if err := s.cfg.chain.ReceiveDataColumn(ctx, dc); err != nil {
+		return errors.Wrap(err, "receive data column sidecar")
+	}
+
+	return nil
+}
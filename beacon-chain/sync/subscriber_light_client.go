@@ -0,0 +1,26 @@
+package sync
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+)
+
+// lightClientFinalityUpdateSubscriber handles finality updates received over gossip. Valid updates match the one
+// computed locally by the node, and seen ones are tracked during validation, so there is nothing left to do.
+func (s *Service) lightClientFinalityUpdateSubscriber(_ context.Context, msg proto.Message) error {
+	if msg == nil {
+		return errors.New("nil light client finality update")
+	}
+	return nil
+}
+
+// lightClientOptimisticUpdateSubscriber handles optimistic updates received over gossip. Valid updates match the
+// one computed locally by the node, and seen ones are tracked during validation, so there is nothing left to do.
+func (s *Service) lightClientOptimisticUpdateSubscriber(_ context.Context, msg proto.Message) error {
+	if msg == nil {
+		return errors.New("nil light client optimistic update")
+	}
+	return nil
+}
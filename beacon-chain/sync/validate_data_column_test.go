@@ -0,0 +1,153 @@
+package sync
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	pb "github.com/libp2p/go-libp2p-pubsub/pb"
+	"github.com/pkg/errors"
+	mock "github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p"
+	p2ptest "github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/testing"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/startup"
+	mockSync "github.com/prysmaticlabs/prysm/v5/beacon-chain/sync/initial-sync/testing"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/verification"
+	lruwrpr "github.com/prysmaticlabs/prysm/v5/cache/lru"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+)
+
+func TestValidateDataColumn_FromSelf(t *testing.T) {
+	ctx := context.Background()
+	p := p2ptest.NewTestP2P(t)
+	s := &Service{cfg: &config{p2p: p}}
+	result, err := s.validateDataColumn(ctx, s.cfg.p2p.PeerID(), nil)
+	require.NoError(t, err)
+	require.Equal(t, result, pubsub.ValidationAccept)
+}
+
+func TestValidateDataColumn_InitSync(t *testing.T) {
+	ctx := context.Background()
+	p := p2ptest.NewTestP2P(t)
+	s := &Service{cfg: &config{p2p: p, initialSync: &mockSync.Sync{IsSyncing: true}}}
+	result, err := s.validateDataColumn(ctx, "", nil)
+	require.NoError(t, err)
+	require.Equal(t, result, pubsub.ValidationIgnore)
+}
+
+// dataColumnValidationTest gossips the first data column of a test block on the given subnet to a service using the
+// given verifier.
+func dataColumnValidationTest(t *testing.T, subnet uint64, verifier verification.NewDataColumnVerifier) (*Service, *pubsub.Message, pubsub.ValidationResult, error) {
+	ctx := context.Background()
+	p := p2ptest.NewTestP2P(t)
+	chainService := &mock.ChainService{Genesis: time.Unix(time.Now().Unix()-int64(params.BeaconConfig().SecondsPerSlot), 0)}
+	s := &Service{
+		seenDataColumnCache: lruwrpr.New(10),
+		seenPendingBlocks:   make(map[[32]byte]bool),
+		cfg:                 &config{chain: chainService, p2p: p, initialSync: &mockSync.Sync{}, clock: startup.NewClock(chainService.Genesis, chainService.ValidatorsRoot)}}
+	s.newDataColumnVerifier = verifier
+
+	_, columns := util.GenerateTestFuluBlockWithColumns(t, [32]byte{}, chainService.CurrentSlot()+1, 1)
+	msg := columns[0].DataColumnSidecar
+	buf := new(bytes.Buffer)
+	_, err := p.Encoding().EncodeGossip(buf, msg)
+	require.NoError(t, err)
+
+	topic := p2p.GossipTypeMapping[reflect.TypeOf(msg)]
+	digest, err := s.currentForkDigest()
+	require.NoError(t, err)
+	topic = s.addDigestAndIndexToTopic(topic, digest, subnet)
+	m := &pubsub.Message{
+		Message: &pb.Message{
+			Data:  buf.Bytes(),
+			Topic: &topic,
+		}}
+	result, err := s.validateDataColumn(ctx, "", m)
+	return s, m, result, err
+}
+
+func TestValidateDataColumn_InvalidTopicIndex(t *testing.T) {
+	_, _, result, err := dataColumnValidationTest(t, 1, func(_ blocks.RODataColumn, _ []verification.Requirement) verification.DataColumnVerifier {
+		return &verification.MockDataColumnVerifier{}
+	})
+	require.ErrorContains(t, "wrong topic name", err)
+	require.Equal(t, result, pubsub.ValidationReject)
+}
+
+func TestValidateDataColumn_Accept(t *testing.T) {
+	s, msg, result, err := dataColumnValidationTest(t, 0, func(d blocks.RODataColumn, _ []verification.Requirement) verification.DataColumnVerifier {
+		return &verification.MockDataColumnVerifier{CbVerifiedRODataColumn: func() (blocks.VerifiedRODataColumn, error) {
+			return blocks.NewVerifiedRODataColumn(d), nil
+		}}
+	})
+	require.NoError(t, err)
+	require.Equal(t, result, pubsub.ValidationAccept)
+	verified, ok := msg.ValidatorData.(blocks.VerifiedRODataColumn)
+	require.Equal(t, true, ok)
+	require.Equal(t, uint64(0), verified.ColumnIndex)
+
+	s.setSeenDataColumnIndex(verified.Slot(), verified.ProposerIndex(), verified.ColumnIndex)
+	require.Equal(t, true, s.hasSeenDataColumnIndex(verified.Slot(), verified.ProposerIndex(), verified.ColumnIndex))
+	require.Equal(t, false, s.hasSeenDataColumnIndex(verified.Slot(), verified.ProposerIndex(), 1))
+}
+
+func TestValidateDataColumn_ErrorPathsWithMock(t *testing.T) {
+	tests := []struct {
+		name   string
+		mock   *verification.MockDataColumnVerifier
+		error  string
+		result pubsub.ValidationResult
+	}{
+		{
+			name:   "index out of bound",
+			mock:   &verification.MockDataColumnVerifier{ErrDataColumnIndexInBounds: errors.New("index out of bound")},
+			error:  "index out of bound",
+			result: pubsub.ValidationReject,
+		},
+		{
+			name:   "slot too early",
+			mock:   &verification.MockDataColumnVerifier{ErrSlotTooEarly: errors.New("slot too early")},
+			error:  "slot too early",
+			result: pubsub.ValidationIgnore,
+		},
+		{
+			name:   "sidecar parent seen",
+			mock:   &verification.MockDataColumnVerifier{ErrSidecarParentSeen: errors.New("sidecar parent seen")},
+			error:  "sidecar parent seen",
+			result: pubsub.ValidationIgnore,
+		},
+		{
+			name:   "inclusion proven",
+			mock:   &verification.MockDataColumnVerifier{ErrSidecarInclusionProven: errors.New("inclusion proven")},
+			error:  "inclusion proven",
+			result: pubsub.ValidationReject,
+		},
+		{
+			name:   "kzg proof verified",
+			mock:   &verification.MockDataColumnVerifier{ErrSidecarKzgProofVerified: errors.New("kzg proof verified")},
+			error:  "kzg proof verified",
+			result: pubsub.ValidationReject,
+		},
+		{
+			name:   "sidecar proposer expected",
+			mock:   &verification.MockDataColumnVerifier{ErrSidecarProposerExpected: errors.New("sidecar proposer expected")},
+			error:  "sidecar proposer expected",
+			result: pubsub.ValidationReject,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, _, result, err := dataColumnValidationTest(t, 0, func(_ blocks.RODataColumn, _ []verification.Requirement) verification.DataColumnVerifier {
+				return tt.mock
+			})
+			require.ErrorContains(t, tt.error, err)
+			require.Equal(t, result, tt.result)
+		})
+	}
+}
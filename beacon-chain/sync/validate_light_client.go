@@ -0,0 +1,152 @@
+package sync
+
+import (
+	"bytes"
+	"context"
+	"time"
+
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	"github.com/libp2p/go-libp2p/core/peer"
+	ssz "github.com/prysmaticlabs/fastssz"
+	lightclient "github.com/prysmaticlabs/prysm/v5/beacon-chain/core/light-client"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/interfaces"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing"
+	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing/trace"
+	prysmTime "github.com/prysmaticlabs/prysm/v5/time"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+)
+
+// validateLightClientFinalityUpdate validates a light client finality update received over gossip.
+// spec: https://github.com/ethereum/consensus-specs/blob/dev/specs/altair/light-client/p2p-interface.md#light_client_finality_update
+func (s *Service) validateLightClientFinalityUpdate(ctx context.Context, pid peer.ID, msg *pubsub.Message) (pubsub.ValidationResult, error) {
+	// Validation runs on publish (not just subscriptions), so we should approve any message from
+	// ourselves.
+	if pid == s.cfg.p2p.PeerID() {
+		return pubsub.ValidationAccept, nil
+	}
+
+	_, span := trace.StartSpan(ctx, "sync.validateLightClientFinalityUpdate")
+	defer span.End()
+
+	m, err := s.decodePubsubMessage(msg)
+	if err != nil {
+		tracing.AnnotateError(span, err)
+		return pubsub.ValidationReject, err
+	}
+	update, ok := m.(interfaces.LightClientFinalityUpdate)
+	if !ok {
+		return pubsub.ValidationReject, errWrongMessage
+	}
+
+	// [IGNORE] The finalized header is greater than that of all previously forwarded finality updates,
+	// or it matches the highest previously forwarded slot and has a sync committee supermajority.
+	s.seenLightClientUpdatesLock.RLock()
+	seen := s.seenLCFinalityUpdate
+	s.seenLightClientUpdatesLock.RUnlock()
+	if !lightclient.IsNewerFinalityUpdate(update, seen) {
+		return pubsub.ValidationIgnore, nil
+	}
+
+	// [IGNORE] The update is received after the block at signature_slot was given enough time to propagate.
+	if !s.lightClientUpdateTimely(update.SignatureSlot()) {
+		return pubsub.ValidationIgnore, nil
+	}
+
+	// [IGNORE] The received update matches the locally computed one exactly.
+	local := s.cfg.chain.LightClientFinalityUpdate()
+	if local == nil {
+		return pubsub.ValidationIgnore, nil
+	}
+	if equal, err := sszEqual(update, local); err != nil || !equal {
+		return pubsub.ValidationIgnore, err
+	}
+
+	s.seenLightClientUpdatesLock.Lock()
+	if lightclient.IsNewerFinalityUpdate(update, s.seenLCFinalityUpdate) {
+		s.seenLCFinalityUpdate = update
+	}
+	s.seenLightClientUpdatesLock.Unlock()
+
+	msg.ValidatorData = update.Proto() // Used in downstream subscriber
+	return pubsub.ValidationAccept, nil
+}
+
+// validateLightClientOptimisticUpdate validates a light client optimistic update received over gossip.
+// spec: https://github.com/ethereum/consensus-specs/blob/dev/specs/altair/light-client/p2p-interface.md#light_client_optimistic_update
+func (s *Service) validateLightClientOptimisticUpdate(ctx context.Context, pid peer.ID, msg *pubsub.Message) (pubsub.ValidationResult, error) {
+	// Validation runs on publish (not just subscriptions), so we should approve any message from
+	// ourselves.
+	if pid == s.cfg.p2p.PeerID() {
+		return pubsub.ValidationAccept, nil
+	}
+
+	_, span := trace.StartSpan(ctx, "sync.validateLightClientOptimisticUpdate")
+	defer span.End()
+
+	m, err := s.decodePubsubMessage(msg)
+	if err != nil {
+		tracing.AnnotateError(span, err)
+		return pubsub.ValidationReject, err
+	}
+	update, ok := m.(interfaces.LightClientOptimisticUpdate)
+	if !ok {
+		return pubsub.ValidationReject, errWrongMessage
+	}
+
+	// [IGNORE] The attested header is greater than that of all previously forwarded optimistic updates.
+	s.seenLightClientUpdatesLock.RLock()
+	seen := s.seenLCOptimisticUpdate
+	s.seenLightClientUpdatesLock.RUnlock()
+	if !lightclient.IsNewerOptimisticUpdate(update, seen) {
+		return pubsub.ValidationIgnore, nil
+	}
+
+	// [IGNORE] The update is received after the block at signature_slot was given enough time to propagate.
+	if !s.lightClientUpdateTimely(update.SignatureSlot()) {
+		return pubsub.ValidationIgnore, nil
+	}
+
+	// [IGNORE] The received update matches the locally computed one exactly.
+	local := s.cfg.chain.LightClientOptimisticUpdate()
+	if local == nil {
+		return pubsub.ValidationIgnore, nil
+	}
+	if equal, err := sszEqual(update, local); err != nil || !equal {
+		return pubsub.ValidationIgnore, err
+	}
+
+	s.seenLightClientUpdatesLock.Lock()
+	if lightclient.IsNewerOptimisticUpdate(update, s.seenLCOptimisticUpdate) {
+		s.seenLCOptimisticUpdate = update
+	}
+	s.seenLightClientUpdatesLock.Unlock()
+
+	msg.ValidatorData = update.Proto() // Used in downstream subscriber
+	return pubsub.ValidationAccept, nil
+}
+
+// lightClientUpdateTimely returns true when one third of the signature slot, minus the allowed clock disparity,
+// has elapsed. Light client updates are only broadcast at that point of the slot.
+func (s *Service) lightClientUpdateTimely(signatureSlot primitives.Slot) bool {
+	genesis := uint64(s.cfg.clock.GenesisTime().Unix())
+	cfg := params.BeaconConfig()
+	due := slots.StartTime(genesis, signatureSlot).
+		Add(time.Duration(cfg.SecondsPerSlot/cfg.IntervalsPerSlot) * time.Second).
+		Add(-cfg.MaximumGossipClockDisparityDuration())
+	return !prysmTime.Now().Before(due)
+}
+
+// sszEqual returns true when both light client updates have the same SSZ encoding.
+func sszEqual(a, b ssz.Marshaler) (bool, error) {
+	aBytes, err := a.MarshalSSZ()
+	if err != nil {
+		return false, err
+	}
+	bBytes, err := b.MarshalSSZ()
+	if err != nil {
+		return false, err
+	}
+	return bytes.Equal(aBytes, bBytes), nil
+}
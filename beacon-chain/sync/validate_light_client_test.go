@@ -0,0 +1,219 @@
+package sync
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	pb "github.com/libp2p/go-libp2p-pubsub/pb"
+	ssz "github.com/prysmaticlabs/fastssz"
+	"github.com/prysmaticlabs/go-bitfield"
+	mock "github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/signing"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p"
+	p2ptest "github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/testing"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/startup"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/interfaces"
+	light_client "github.com/prysmaticlabs/prysm/v5/consensus-types/light-client"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+)
+
+func TestValidateLightClientFinalityUpdate_FromSelf(t *testing.T) {
+	ctx := context.Background()
+	p := p2ptest.NewTestP2P(t)
+	s := &Service{cfg: &config{p2p: p}}
+	result, err := s.validateLightClientFinalityUpdate(ctx, s.cfg.p2p.PeerID(), nil)
+	require.NoError(t, err)
+	require.Equal(t, pubsub.ValidationAccept, result)
+}
+
+func TestValidateLightClientFinalityUpdate(t *testing.T) {
+	tests := []struct {
+		name   string
+		local  func(received interfaces.LightClientFinalityUpdate) interfaces.LightClientFinalityUpdate
+		seen   interfaces.LightClientFinalityUpdate
+		slot   primitives.Slot
+		result pubsub.ValidationResult
+	}{
+		{
+			name: "matches local update",
+			local: func(received interfaces.LightClientFinalityUpdate) interfaces.LightClientFinalityUpdate {
+				return received
+			},
+			slot:   5,
+			result: pubsub.ValidationAccept,
+		},
+		{
+			name:   "no local update",
+			local:  func(interfaces.LightClientFinalityUpdate) interfaces.LightClientFinalityUpdate { return nil },
+			slot:   5,
+			result: pubsub.ValidationIgnore,
+		},
+		{
+			name: "differs from local update",
+			local: func(interfaces.LightClientFinalityUpdate) interfaces.LightClientFinalityUpdate {
+				return testLightClientFinalityUpdate(t, 5, 2, 400)
+			},
+			slot:   5,
+			result: pubsub.ValidationIgnore,
+		},
+		{
+			name: "not newer than forwarded update",
+			local: func(received interfaces.LightClientFinalityUpdate) interfaces.LightClientFinalityUpdate {
+				return received
+			},
+			seen:   testLightClientFinalityUpdate(t, 6, 3, 400),
+			slot:   5,
+			result: pubsub.ValidationIgnore,
+		},
+		{
+			name: "received too early",
+			local: func(received interfaces.LightClientFinalityUpdate) interfaces.LightClientFinalityUpdate {
+				return received
+			},
+			slot:   20,
+			result: pubsub.ValidationIgnore,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			update := testLightClientFinalityUpdate(t, tt.slot, 1, 400)
+			s, msg := lightClientValidationTest(t, p2p.LightClientFinalityUpdateTopicFormat, update)
+			s.cfg.chain.(*mock.ChainService).LCFinalityUpdate = tt.local(update)
+			s.seenLCFinalityUpdate = tt.seen
+
+			result, err := s.validateLightClientFinalityUpdate(context.Background(), "foobar", msg)
+			require.NoError(t, err)
+			require.Equal(t, tt.result, result)
+			if tt.result == pubsub.ValidationAccept {
+				require.DeepEqual(t, update.Proto(), msg.ValidatorData)
+				require.Equal(t, update.FinalizedHeader().Beacon().Slot, s.seenLCFinalityUpdate.FinalizedHeader().Beacon().Slot)
+			}
+		})
+	}
+}
+
+func TestValidateLightClientOptimisticUpdate(t *testing.T) {
+	tests := []struct {
+		name   string
+		local  func(received interfaces.LightClientOptimisticUpdate) interfaces.LightClientOptimisticUpdate
+		seen   interfaces.LightClientOptimisticUpdate
+		slot   primitives.Slot
+		result pubsub.ValidationResult
+	}{
+		{
+			name: "matches local update",
+			local: func(received interfaces.LightClientOptimisticUpdate) interfaces.LightClientOptimisticUpdate {
+				return received
+			},
+			slot:   5,
+			result: pubsub.ValidationAccept,
+		},
+		{
+			name:   "no local update",
+			local:  func(interfaces.LightClientOptimisticUpdate) interfaces.LightClientOptimisticUpdate { return nil },
+			slot:   5,
+			result: pubsub.ValidationIgnore,
+		},
+		{
+			name: "differs from local update",
+			local: func(interfaces.LightClientOptimisticUpdate) interfaces.LightClientOptimisticUpdate {
+				return testLightClientOptimisticUpdate(t, 6)
+			},
+			slot:   5,
+			result: pubsub.ValidationIgnore,
+		},
+		{
+			name: "not newer than forwarded update",
+			local: func(received interfaces.LightClientOptimisticUpdate) interfaces.LightClientOptimisticUpdate {
+				return received
+			},
+			seen:   testLightClientOptimisticUpdate(t, 5),
+			slot:   5,
+			result: pubsub.ValidationIgnore,
+		},
+		{
+			name: "received too early",
+			local: func(received interfaces.LightClientOptimisticUpdate) interfaces.LightClientOptimisticUpdate {
+				return received
+			},
+			slot:   20,
+			result: pubsub.ValidationIgnore,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			update := testLightClientOptimisticUpdate(t, tt.slot)
+			s, msg := lightClientValidationTest(t, p2p.LightClientOptimisticUpdateTopicFormat, update)
+			s.cfg.chain.(*mock.ChainService).LCOptimisticUpdate = tt.local(update)
+			s.seenLCOptimisticUpdate = tt.seen
+
+			result, err := s.validateLightClientOptimisticUpdate(context.Background(), "foobar", msg)
+			require.NoError(t, err)
+			require.Equal(t, tt.result, result)
+			if tt.result == pubsub.ValidationAccept {
+				require.DeepEqual(t, update.Proto(), msg.ValidatorData)
+				require.Equal(t, update.AttestedHeader().Beacon().Slot, s.seenLCOptimisticUpdate.AttestedHeader().Beacon().Slot)
+			}
+		})
+	}
+}
+
+// lightClientValidationTest returns a service whose clock is ten slots past genesis, and the gossip message
+// carrying the given update on the altair topic.
+func lightClientValidationTest(t *testing.T, topicFormat string, update ssz.Marshaler) (*Service, *pubsub.Message) {
+	p := p2ptest.NewTestP2P(t)
+	genesis := time.Now().Add(-10 * time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second)
+	chainService := &mock.ChainService{Genesis: genesis}
+	s := &Service{cfg: &config{chain: chainService, p2p: p, clock: startup.NewClock(genesis, chainService.ValidatorsRoot)}}
+
+	digest, err := signing.ComputeForkDigest(params.BeaconConfig().AltairForkVersion, chainService.ValidatorsRoot[:])
+	require.NoError(t, err)
+	buf := new(bytes.Buffer)
+	_, err = p.Encoding().EncodeGossip(buf, update)
+	require.NoError(t, err)
+	topic := fmt.Sprintf(topicFormat, digest) + p.Encoding().ProtocolSuffix()
+	return s, &pubsub.Message{Message: &pb.Message{Data: buf.Bytes(), Topic: &topic}}
+}
+
+func testLightClientFinalityUpdate(t *testing.T, attestedSlot, finalizedSlot primitives.Slot, participants uint64) interfaces.LightClientFinalityUpdate {
+	branch := make([][]byte, fieldparams.FinalityBranchDepth)
+	for i := range branch {
+		branch[i] = make([]byte, fieldparams.RootLength)
+	}
+	update, err := light_client.NewWrappedFinalityUpdateAltair(&ethpb.LightClientFinalityUpdateAltair{
+		AttestedHeader:  &ethpb.LightClientHeaderAltair{Beacon: util.HydrateBeaconHeader(&ethpb.BeaconBlockHeader{Slot: attestedSlot})},
+		FinalizedHeader: &ethpb.LightClientHeaderAltair{Beacon: util.HydrateBeaconHeader(&ethpb.BeaconBlockHeader{Slot: finalizedSlot})},
+		FinalityBranch:  branch,
+		SyncAggregate:   testLightClientSyncAggregate(participants),
+		SignatureSlot:   attestedSlot + 1,
+	})
+	require.NoError(t, err)
+	return update
+}
+
+func testLightClientOptimisticUpdate(t *testing.T, attestedSlot primitives.Slot) interfaces.LightClientOptimisticUpdate {
+	update, err := light_client.NewWrappedOptimisticUpdateAltair(&ethpb.LightClientOptimisticUpdateAltair{
+		AttestedHeader: &ethpb.LightClientHeaderAltair{Beacon: util.HydrateBeaconHeader(&ethpb.BeaconBlockHeader{Slot: attestedSlot})},
+		SyncAggregate:  testLightClientSyncAggregate(400),
+		SignatureSlot:  attestedSlot + 1,
+	})
+	require.NoError(t, err)
+	return update
+}
+
+func testLightClientSyncAggregate(participants uint64) *ethpb.SyncAggregate {
+	bits := bitfield.NewBitvector512()
+	for i := uint64(0); i < participants; i++ {
+		bits.SetBitAt(i, true)
+	}
+	return &ethpb.SyncAggregate{SyncCommitteeBits: bits, SyncCommitteeSignature: make([]byte, fieldparams.BLSSignatureLength)}
+}
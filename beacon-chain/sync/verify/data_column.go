@@ -0,0 +1,47 @@
+package verify
+
+import (
+	"bytes"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v5/runtime/version"
+)
+
+var (
+	errDataColumnVerification          = errors.New("unable to verify data columns")
+	ErrIncorrectDataColumnIndex        = errors.New("incorrect data column index")
+	ErrDataColumnBlockMisaligned       = errors.Wrap(errDataColumnVerification, "root of block header in data column sidecar does not match block root")
+	ErrMismatchedDataColumnCommitments = errors.Wrap(errDataColumnVerification, "commitments of data column sidecar do not match block commitments")
+)
+
+// DataColumnAlignsWithBlock verifies if the data column aligns with the block.
+func DataColumnAlignsWithBlock(column blocks.RODataColumn, block blocks.ROBlock) error {
+	if block.Version() < version.Fulu {
+		return nil
+	}
+	numberOfColumns := params.BeaconConfig().NumberOfColumns
+	if column.ColumnIndex >= numberOfColumns {
+		return errors.Wrapf(ErrIncorrectDataColumnIndex, "index %d exceeds NUMBER_OF_COLUMNS %d", column.ColumnIndex, numberOfColumns)
+	}
+
+	if column.BlockRoot() != block.Root() {
+		return ErrDataColumnBlockMisaligned
+	}
+
+	// Verify commitment byte values match
+	commits, err := block.Block().Body().BlobKzgCommitments()
+	if err != nil {
+		return err
+	}
+	if len(commits) != len(column.KzgCommitments) {
+		return errors.Wrapf(ErrMismatchedDataColumnCommitments, "%d commitments != %d block commitments for block root %#x at slot %d", len(column.KzgCommitments), len(commits), block.Root(), column.Slot())
+	}
+	for i := range commits {
+		if !bytes.Equal(commits[i], column.KzgCommitments[i]) {
+			return errors.Wrapf(ErrMismatchedDataColumnCommitments, "commitment %#x != block commitment %#x, at index %d for block root %#x at slot %d", column.KzgCommitments[i], commits[i], i, block.Root(), column.Slot())
+		}
+	}
+	return nil
+}
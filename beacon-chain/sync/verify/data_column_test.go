@@ -0,0 +1,55 @@
+package verify
+
+import (
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestDataColumnAlignsWithBlock(t *testing.T) {
+	blk, columns := util.GenerateTestFuluBlockWithColumns(t, [32]byte{}, 0, 2)
+	_, otherColumns := util.GenerateTestFuluBlockWithColumns(t, [32]byte{}, 1, 2)
+	tweak := func(t *testing.T, f func(*ethpb.DataColumnSidecar)) blocks.RODataColumn {
+		tweaked := proto.Clone(columns[1].DataColumnSidecar).(*ethpb.DataColumnSidecar)
+		f(tweaked)
+		column, err := blocks.NewRODataColumnWithRoot(tweaked, blk.Root())
+		require.NoError(t, err)
+		return column
+	}
+
+	t.Run("happy path", func(t *testing.T) {
+		for _, column := range columns[:4] {
+			require.NoError(t, DataColumnAlignsWithBlock(column, blk))
+		}
+	})
+	t.Run("pre fulu block", func(t *testing.T) {
+		denebBlk, _ := util.GenerateTestDenebBlockWithSidecar(t, [32]byte{}, 0, 1)
+		require.NoError(t, DataColumnAlignsWithBlock(columns[0], denebBlk))
+	})
+	t.Run("mismatched roots", func(t *testing.T) {
+		require.ErrorIs(t, DataColumnAlignsWithBlock(otherColumns[0], blk), ErrDataColumnBlockMisaligned)
+	})
+	t.Run("index too large", func(t *testing.T) {
+		column := tweak(t, func(sc *ethpb.DataColumnSidecar) {
+			sc.ColumnIndex = 128
+		})
+		require.ErrorIs(t, DataColumnAlignsWithBlock(column, blk), ErrIncorrectDataColumnIndex)
+	})
+	t.Run("mismatched commitments", func(t *testing.T) {
+		column := tweak(t, func(sc *ethpb.DataColumnSidecar) {
+			sc.KzgCommitments[1] = bytesutil.PadTo([]byte("nope"), 48)
+		})
+		require.ErrorIs(t, DataColumnAlignsWithBlock(column, blk), ErrMismatchedDataColumnCommitments)
+	})
+	t.Run("missing commitments", func(t *testing.T) {
+		column := tweak(t, func(sc *ethpb.DataColumnSidecar) {
+			sc.KzgCommitments = sc.KzgCommitments[:1]
+		})
+		require.ErrorIs(t, DataColumnAlignsWithBlock(column, blk), ErrMismatchedDataColumnCommitments)
+	})
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain/kzg"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/peerdas"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
 	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
 )
@@ -92,3 +93,71 @@ func (batch *BlobBatchVerifier) verifyOneBlob(sc blocks.ROBlob) (blocks.Verified
 
 	return bv.VerifiedROBlob()
 }
+
+// NewDataColumnBatchVerifier initializes a data column batch verifier. Like NewBlobBatchVerifier, it requires the
+// caller to specify the verification Requirements and a NewDataColumnVerifier callback to verify each data column.
+func NewDataColumnBatchVerifier(newVerifier NewDataColumnVerifier, reqs []Requirement) *DataColumnBatchVerifier {
+	return &DataColumnBatchVerifier{
+		verifyKzg:   peerdas.VerifyDataColumnsSidecarKZGProofs,
+		newVerifier: newVerifier,
+		reqs:        reqs,
+	}
+}
+
+// DataColumnBatchVerifier is the data column counterpart of BlobBatchVerifier. The cell KZG proofs of all the data
+// columns of a block are verified together before the remaining requirements are checked for each data column.
+type DataColumnBatchVerifier struct {
+	verifyKzg   rodataColumnCommitmentVerifier
+	newVerifier NewDataColumnVerifier
+	reqs        []Requirement
+}
+
+// VerifiedRODataColumns satisfies the das.DataColumnBatchVerifier interface, used by das.AvailabilityStore.
+func (batch *DataColumnBatchVerifier) VerifiedRODataColumns(_ context.Context, blk blocks.ROBlock, scs []blocks.RODataColumn) ([]blocks.VerifiedRODataColumn, error) {
+	if len(scs) == 0 {
+		return nil, nil
+	}
+	blkSig := blk.Signature()
+	// As for blobs, the proposer is validated wrt the block before the DA check, so the sidecars only need to carry
+	// the signature and root of the block.
+	for i := range scs {
+		columnSig := bytesutil.ToBytes96(scs[i].SignedBlockHeader.Signature)
+		if blkSig != columnSig {
+			return nil, ErrBatchSignatureMismatch
+		}
+		if blk.Root() != scs[i].BlockRoot() {
+			return nil, ErrBatchBlockRootMismatch
+		}
+	}
+	// Verify the proofs of all data columns at once. verifyOneDataColumn assumes it is only called once this check succeeds.
+	if err := batch.verifyKzg(scs); err != nil {
+		return nil, err
+	}
+	vs := make([]blocks.VerifiedRODataColumn, len(scs))
+	for i := range scs {
+		vc, err := batch.verifyOneDataColumn(scs[i])
+		if err != nil {
+			return nil, err
+		}
+		vs[i] = vc
+	}
+	return vs, nil
+}
+
+func (batch *DataColumnBatchVerifier) verifyOneDataColumn(sc blocks.RODataColumn) (blocks.VerifiedRODataColumn, error) {
+	vc := blocks.VerifiedRODataColumn{}
+	dv := batch.newVerifier(sc, batch.reqs)
+	// VerifiedRODataColumns always verifies the proofs and block signature for all data columns in the batch
+	// before calling verifyOneDataColumn.
+	dv.SatisfyRequirement(RequireSidecarKzgProofVerified)
+	dv.SatisfyRequirement(RequireValidProposerSignature)
+
+	if err := dv.DataColumnIndexInBounds(); err != nil {
+		return vc, err
+	}
+	if err := dv.SidecarInclusionProven(); err != nil {
+		return vc, err
+	}
+
+	return dv.VerifiedRODataColumn()
+}
@@ -187,3 +187,56 @@ func TestBatchVerifier(t *testing.T) {
 		})
 	}
 }
+
+func TestDataColumnBatchVerifier(t *testing.T) {
+	ctx := context.Background()
+	mockCV := func(err error) rodataColumnCommitmentVerifier {
+		return func([]blocks.RODataColumn) error {
+			return err
+		}
+	}
+	passing := func() NewDataColumnVerifier {
+		return func(dc blocks.RODataColumn, _ []Requirement) DataColumnVerifier {
+			return &MockDataColumnVerifier{CbVerifiedRODataColumn: func() (blocks.VerifiedRODataColumn, error) {
+				return blocks.NewVerifiedRODataColumn(dc), nil
+			}}
+		}
+	}
+	blk, columns := util.GenerateTestFuluBlockWithColumns(t, [32]byte{}, 1, 2)
+	_, other := util.GenerateTestFuluBlockWithColumns(t, [32]byte{}, 2, 2)
+
+	t.Run("no columns", func(t *testing.T) {
+		bv := &DataColumnBatchVerifier{newVerifier: passing(), verifyKzg: mockCV(errors.New("unexpected"))}
+		vs, err := bv.VerifiedRODataColumns(ctx, blk, nil)
+		require.NoError(t, err)
+		require.Equal(t, 0, len(vs))
+	})
+	t.Run("root mismatch", func(t *testing.T) {
+		bv := &DataColumnBatchVerifier{newVerifier: passing(), verifyKzg: mockCV(nil)}
+		_, err := bv.VerifiedRODataColumns(ctx, blk, other[:1])
+		require.ErrorIs(t, err, ErrBatchBlockRootMismatch)
+	})
+	t.Run("kzg failure", func(t *testing.T) {
+		kzgErr := errors.New("mock kzg failure")
+		bv := &DataColumnBatchVerifier{newVerifier: passing(), verifyKzg: mockCV(kzgErr)}
+		_, err := bv.VerifiedRODataColumns(ctx, blk, columns)
+		require.ErrorIs(t, err, kzgErr)
+	})
+	t.Run("inclusion proof failure", func(t *testing.T) {
+		nv := func(dc blocks.RODataColumn, _ []Requirement) DataColumnVerifier {
+			return &MockDataColumnVerifier{ErrSidecarInclusionProven: ErrSidecarInclusionProofInvalid}
+		}
+		bv := &DataColumnBatchVerifier{newVerifier: nv, verifyKzg: mockCV(nil)}
+		_, err := bv.VerifiedRODataColumns(ctx, blk, columns)
+		require.ErrorIs(t, err, ErrSidecarInclusionProofInvalid)
+	})
+	t.Run("happy path", func(t *testing.T) {
+		bv := &DataColumnBatchVerifier{newVerifier: passing(), verifyKzg: mockCV(nil)}
+		vs, err := bv.VerifiedRODataColumns(ctx, blk, columns[:3])
+		require.NoError(t, err)
+		require.Equal(t, 3, len(vs))
+		for i := range vs {
+			require.Equal(t, columns[i].ColumnIndex, vs[i].ColumnIndex)
+		}
+	})
+}
@@ -26,6 +26,7 @@ const (
 	RequireSidecarInclusionProven
 	RequireSidecarKzgProofVerified
 	RequireSidecarProposerExpected
+	RequireDataColumnIndexInBounds
 )
 
 var allBlobSidecarRequirements = []Requirement{
@@ -0,0 +1,108 @@
+package verification
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+)
+
+func TestDataColumnIndexInBounds(t *testing.T) {
+	ini := &Initializer{}
+	_, columns := util.GenerateTestFuluBlockWithColumns(t, [32]byte{}, 0, 1)
+	c := columns[0]
+	v := ini.NewDataColumnVerifier(c, GossipDataColumnSidecarRequirements)
+	require.NoError(t, v.DataColumnIndexInBounds())
+	require.Equal(t, true, v.results.executed(RequireDataColumnIndexInBounds))
+	require.NoError(t, v.results.result(RequireDataColumnIndexInBounds))
+
+	// set Index to a value that is out of bounds
+	c.ColumnIndex = params.BeaconConfig().NumberOfColumns
+	v = ini.NewDataColumnVerifier(c, GossipDataColumnSidecarRequirements)
+	require.ErrorIs(t, v.DataColumnIndexInBounds(), ErrDataColumnIndexInvalid)
+	require.Equal(t, true, v.results.executed(RequireDataColumnIndexInBounds))
+	require.NotNil(t, v.results.result(RequireDataColumnIndexInBounds))
+}
+
+func TestDataColumnSidecarInclusionProven(t *testing.T) {
+	// GenerateTestFuluBlockWithColumns is supposed to generate valid inclusion proofs
+	_, columns := util.GenerateTestFuluBlockWithColumns(t, [32]byte{}, 1, 2)
+	c := columns[3]
+
+	ini := Initializer{}
+	v := ini.NewDataColumnVerifier(c, GossipDataColumnSidecarRequirements)
+	require.NoError(t, v.SidecarInclusionProven())
+	require.Equal(t, true, v.results.executed(RequireSidecarInclusionProven))
+	require.NoError(t, v.results.result(RequireSidecarInclusionProven))
+
+	// Invert bits of the first byte of the body root to mess up the proof
+	byte0 := c.SignedBlockHeader.Header.BodyRoot[0]
+	c.SignedBlockHeader.Header.BodyRoot[0] = byte0 ^ 255
+	v = ini.NewDataColumnVerifier(c, GossipDataColumnSidecarRequirements)
+	require.ErrorIs(t, v.SidecarInclusionProven(), ErrSidecarInclusionProofInvalid)
+	require.Equal(t, true, v.results.executed(RequireSidecarInclusionProven))
+	require.NotNil(t, v.results.result(RequireSidecarInclusionProven))
+}
+
+func TestDataColumnSidecarKzgProofVerified(t *testing.T) {
+	_, columns := util.GenerateTestFuluBlockWithColumns(t, [32]byte{}, 1, 1)
+	c := columns[0]
+	passes := func(dcs []blocks.RODataColumn) error {
+		require.Equal(t, c.ColumnIndex, dcs[0].ColumnIndex)
+		return nil
+	}
+	v := &RODataColumnVerifier{verifyDataColumnCommitment: passes, results: newResults(), dataColumn: c}
+	require.NoError(t, v.SidecarKzgProofVerified())
+	require.Equal(t, true, v.results.executed(RequireSidecarKzgProofVerified))
+	require.NoError(t, v.results.result(RequireSidecarKzgProofVerified))
+
+	fails := func(_ []blocks.RODataColumn) error {
+		return errors.New("bad column")
+	}
+	v = &RODataColumnVerifier{verifyDataColumnCommitment: fails, results: newResults(), dataColumn: c}
+	err := v.SidecarKzgProofVerified()
+	require.ErrorIs(t, err, ErrSidecarKzgProofInvalid)
+	require.NotNil(t, v.results.result(RequireSidecarKzgProofVerified))
+
+	// The initializer verifier checks the cell proofs of the sidecar.
+	ini := Initializer{}
+	v = ini.NewDataColumnVerifier(c, GossipDataColumnSidecarRequirements)
+	require.NoError(t, v.SidecarKzgProofVerified())
+	require.NoError(t, v.results.result(RequireSidecarKzgProofVerified))
+}
+
+func TestDataColumnRequirementSatisfaction(t *testing.T) {
+	_, columns := util.GenerateTestFuluBlockWithColumns(t, [32]byte{}, 1, 1)
+	ini := Initializer{}
+	v := ini.NewDataColumnVerifier(columns[0], GossipDataColumnSidecarRequirements)
+
+	_, err := v.VerifiedRODataColumn()
+	require.ErrorIs(t, err, ErrDataColumnInvalid)
+	var me VerificationMultiError
+	require.Equal(t, true, errors.As(err, &me))
+	for _, v := range me.Failures() {
+		require.ErrorIs(t, v, ErrMissingVerification)
+	}
+
+	// satisfy everything through the backdoor and ensure we get the verified ro data column at the end
+	for _, r := range GossipDataColumnSidecarRequirements {
+		v.SatisfyRequirement(r)
+	}
+	require.Equal(t, true, v.results.allSatisfied())
+	_, err = v.VerifiedRODataColumn()
+	require.NoError(t, err)
+}
+
+func TestDataColumnByRangeRequirements(t *testing.T) {
+	reqs := make(map[Requirement]bool)
+	for _, r := range ByRangeRequestDataColumnSidecarRequirements {
+		reqs[r] = true
+	}
+	require.Equal(t, true, reqs[RequireSidecarInclusionProven])
+	require.Equal(t, true, reqs[RequireSidecarKzgProofVerified])
+	require.Equal(t, false, reqs[RequireSidecarParentSeen])
+	require.Equal(t, false, reqs[RequireValidProposerSignature])
+}
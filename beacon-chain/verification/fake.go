@@ -25,3 +25,15 @@ func FakeVerifySliceForTest(t *testing.T, b []blocks.ROBlob) []blocks.VerifiedRO
 	}
 	return vbs
 }
+
+// FakeVerifyDataColumnSliceForTest can be used by tests that need a []VerifiedRODataColumn but don't want to do all
+// the expensive set up to perform full validation.
+func FakeVerifyDataColumnSliceForTest(t *testing.T, dcs []blocks.RODataColumn) []blocks.VerifiedRODataColumn {
+	// log so that t is truly required
+	t.Log("producing fake []VerifiedRODataColumn for a test")
+	vdcs := make([]blocks.VerifiedRODataColumn, len(dcs))
+	for i := range dcs {
+		vdcs[i] = blocks.NewVerifiedRODataColumn(dcs[i])
+	}
+	return vdcs
+}
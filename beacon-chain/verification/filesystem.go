@@ -21,3 +21,21 @@ func VerifiedROBlobFromDisk(fs afero.Fs, root [32]byte, path string) (blocks.Ver
 	}
 	return blocks.NewVerifiedROBlob(ro), nil
 }
+
+// VerifiedRODataColumnFromDisk reads a data column sidecar written by the data column storage. Only verified data
+// columns are written to disk, so the result is a VerifiedRODataColumn.
+func VerifiedRODataColumnFromDisk(fs afero.Fs, root [32]byte, path string) (blocks.VerifiedRODataColumn, error) {
+	encoded, err := afero.ReadFile(fs, path)
+	if err != nil {
+		return VerifiedRODataColumnError(err)
+	}
+	s := &ethpb.DataColumnSidecar{}
+	if err := s.UnmarshalSSZ(encoded); err != nil {
+		return VerifiedRODataColumnError(err)
+	}
+	ro, err := blocks.NewRODataColumnWithRoot(s, root)
+	if err != nil {
+		return VerifiedRODataColumnError(err)
+	}
+	return blocks.NewVerifiedRODataColumn(ro), nil
+}
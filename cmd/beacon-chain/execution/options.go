@@ -18,7 +18,7 @@ func FlagOptions(c *cli.Context) ([]execution.Option, error) {
 	if err != nil {
 		return nil, err
 	}
-	jwtSecret, err := parseJWTSecretFromFile(c)
+	jwtSecret, err := ParseJWTSecretFromFile(c)
 	if err != nil {
 		return nil, errors.Wrap(err, "could not read JWT secret file for authenticating execution API")
 	}
@@ -34,7 +34,7 @@ func FlagOptions(c *cli.Context) ([]execution.Option, error) {
 	return opts, nil
 }
 
-// Parses a JWT secret from a file path. This secret is required when connecting to execution nodes
+// ParseJWTSecretFromFile parses a JWT secret from a file path. This secret is required when connecting to execution nodes
 // over HTTP, and must be the same one used in Prysm and the execution node server Prysm is connecting to.
 // The engine API specification here https://github.com/ethereum/execution-apis/blob/main/src/engine/authentication.md
 // Explains how we should validate this secret and the format of the file a user can specify.
@@ -42,7 +42,7 @@ func FlagOptions(c *cli.Context) ([]execution.Option, error) {
 // The secret must be stored as a hex-encoded string within a file in the filesystem.
 // If the --jwt-secret flag is provided to Prysm, but the file cannot be read, or does not contain a hex-encoded
 // key of at least 256 bits, the client should treat this as an error and abort the startup.
-func parseJWTSecretFromFile(c *cli.Context) ([]byte, error) {
+func ParseJWTSecretFromFile(c *cli.Context) ([]byte, error) {
 	jwtSecretFile := c.String(flags.ExecutionJWTSecretFlag.Name)
 	if jwtSecretFile == "" {
 		return nil, nil
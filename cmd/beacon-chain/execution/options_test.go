@@ -25,13 +25,13 @@ func TestExecutionchainCmd(t *testing.T) {
 	assert.Equal(t, "primary", endpoints)
 }
 
-func Test_parseJWTSecretFromFile(t *testing.T) {
+func Test_ParseJWTSecretFromFile(t *testing.T) {
 	t.Run("no flag value specified leads to nil secret", func(t *testing.T) {
 		app := cli.App{}
 		set := flag.NewFlagSet("test", 0)
 		set.String(flags.ExecutionJWTSecretFlag.Name, "", "")
 		ctx := cli.NewContext(&app, set, nil)
-		secret, err := parseJWTSecretFromFile(ctx)
+		secret, err := ParseJWTSecretFromFile(ctx)
 		require.NoError(t, err)
 		require.Equal(t, true, secret == nil)
 	})
@@ -40,7 +40,7 @@ func Test_parseJWTSecretFromFile(t *testing.T) {
 		set := flag.NewFlagSet("test", 0)
 		set.String(flags.ExecutionJWTSecretFlag.Name, "/tmp/askdjkajsd", "")
 		ctx := cli.NewContext(&app, set, nil)
-		_, err := parseJWTSecretFromFile(ctx)
+		_, err := ParseJWTSecretFromFile(ctx)
 		require.ErrorContains(t, "no such file", err)
 	})
 	t.Run("empty string in file", func(t *testing.T) {
@@ -50,7 +50,7 @@ func Test_parseJWTSecretFromFile(t *testing.T) {
 		require.NoError(t, file.WriteFile(fullPath, []byte{}))
 		set.String(flags.ExecutionJWTSecretFlag.Name, fullPath, "")
 		ctx := cli.NewContext(&app, set, nil)
-		_, err := parseJWTSecretFromFile(ctx)
+		_, err := ParseJWTSecretFromFile(ctx)
 		require.ErrorContains(t, "cannot be empty", err)
 	})
 	t.Run("less than 32 bytes", func(t *testing.T) {
@@ -62,7 +62,7 @@ func Test_parseJWTSecretFromFile(t *testing.T) {
 		require.NoError(t, file.WriteFile(fullPath, []byte(hexData)))
 		set.String(flags.ExecutionJWTSecretFlag.Name, fullPath, "")
 		ctx := cli.NewContext(&app, set, nil)
-		_, err := parseJWTSecretFromFile(ctx)
+		_, err := ParseJWTSecretFromFile(ctx)
 		require.ErrorContains(t, "should be a hex string of at least 32 bytes", err)
 	})
 	t.Run("bad data", func(t *testing.T) {
@@ -73,7 +73,7 @@ func Test_parseJWTSecretFromFile(t *testing.T) {
 		require.NoError(t, file.WriteFile(fullPath, secret))
 		set.String(flags.ExecutionJWTSecretFlag.Name, fullPath, "")
 		ctx := cli.NewContext(&app, set, nil)
-		_, err := parseJWTSecretFromFile(ctx)
+		_, err := ParseJWTSecretFromFile(ctx)
 		require.ErrorContains(t, "invalid byte", err)
 	})
 	t.Run("correct format", func(t *testing.T) {
@@ -85,7 +85,7 @@ func Test_parseJWTSecretFromFile(t *testing.T) {
 		require.NoError(t, file.WriteFile(fullPath, []byte(secretHex)))
 		set.String(flags.ExecutionJWTSecretFlag.Name, fullPath, "")
 		ctx := cli.NewContext(&app, set, nil)
-		got, err := parseJWTSecretFromFile(ctx)
+		got, err := ParseJWTSecretFromFile(ctx)
 		require.NoError(t, err)
 		require.DeepEqual(t, secret[:], got)
 	})
@@ -204,6 +204,30 @@ var (
 		Usage: "The factor by which blob batch limit may increase on burst.",
 		Value: 3,
 	}
+	// TrustedPeerRPCQuotaFactor specifies the factor by which the RPC rate limits of trusted peers are multiplied.
+	TrustedPeerRPCQuotaFactor = &cli.Float64Flag{
+		Name:  "trusted-peer-rpc-quota-factor",
+		Usage: "The factor by which the RPC request rate limits of trusted peers are multiplied.",
+		Value: 1,
+	}
+	// OutboundPeerRPCQuotaFactor specifies the factor by which the RPC rate limits of outbound peers are multiplied.
+	OutboundPeerRPCQuotaFactor = &cli.Float64Flag{
+		Name:  "outbound-peer-rpc-quota-factor",
+		Usage: "The factor by which the RPC request rate limits of peers the local peer dialed are multiplied.",
+		Value: 1,
+	}
+	// InboundPeerRPCQuotaFactor specifies the factor by which the RPC rate limits of inbound peers are multiplied.
+	InboundPeerRPCQuotaFactor = &cli.Float64Flag{
+		Name:  "inbound-peer-rpc-quota-factor",
+		Usage: "The factor by which the RPC request rate limits of peers which dialed the local peer are multiplied.",
+		Value: 1,
+	}
+	// AdaptiveRPCRateLimits lowers the RPC rate limits while the node is under load.
+	AdaptiveRPCRateLimits = &cli.BoolFlag{
+		Name: "adaptive-rpc-rate-limits",
+		Usage: "Lowers the RPC request rate limits of peers, down to a quarter of their quota, while the CPU usage " +
+			"or the database read latency of the local peer is high.",
+	}
 	// DisableDebugRPCEndpoints disables the debug Beacon API namespace.
 	DisableDebugRPCEndpoints = &cli.BoolFlag{
 		Name:  "disable-debug-rpc-endpoints",
@@ -214,6 +238,11 @@ var (
 		Name:  "subscribe-all-subnets",
 		Usage: "Subscribe to all possible attestation and sync subnets.",
 	}
+	// SubscribeAllDataSubnets defines a flag to specify whether to custody all data columns and subscribe to all data column subnets.
+	SubscribeAllDataSubnets = &cli.BoolFlag{
+		Name:  "subscribe-all-data-subnets",
+		Usage: "Custody all data columns and subscribe to all data column sidecar subnets, instead of the ones derived from the node ID.",
+	}
 	// HistoricalSlasherNode is a set of beacon node flags required for performing historical detection with a slasher.
 	HistoricalSlasherNode = &cli.BoolFlag{
 		Name:  "historical-slasher-node",
@@ -308,4 +337,11 @@ var (
 		Usage: "Specifies the retention period for the pruner service in terms of epochs. " +
 			"If this value is less than MIN_EPOCHS_FOR_BLOCK_REQUESTS, it will be ignored.",
 	}
+	// BeaconDBBackend selects the storage engine of the beacon db.
+	BeaconDBBackend = &cli.StringFlag{
+		Name: "db-backend",
+		Usage: "Storage engine of the beacon db, either bolt or pebble. Pebble is better suited to large archive databases. " +
+			"An existing database can be converted with `prysmctl db migrate-backend`.",
+		Value: "bolt",
+	}
 )
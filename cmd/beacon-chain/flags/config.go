@@ -9,6 +9,7 @@ import (
 // beacon node.
 type GlobalFlags struct {
 	SubscribeToAllSubnets      bool
+	SubscribeAllDataSubnets    bool
 	MinimumSyncPeers           int
 	MinimumPeersPerSubnet      int
 	MaxConcurrentDials         int
@@ -16,6 +17,10 @@ type GlobalFlags struct {
 	BlockBatchLimitBurstFactor int
 	BlobBatchLimit             int
 	BlobBatchLimitBurstFactor  int
+	TrustedPeerRPCQuotaFactor  float64
+	OutboundPeerRPCQuotaFactor float64
+	InboundPeerRPCQuotaFactor  float64
+	AdaptiveRPCRateLimits      bool
 }
 
 var globalConfig *GlobalFlags
@@ -41,10 +46,18 @@ func ConfigureGlobalFlags(ctx *cli.Context) {
 		log.Warn("Subscribing to All Attestation Subnets")
 		cfg.SubscribeToAllSubnets = true
 	}
+	if ctx.Bool(SubscribeAllDataSubnets.Name) {
+		log.Warn("Subscribing to all data column subnets")
+		cfg.SubscribeAllDataSubnets = true
+	}
 	cfg.BlockBatchLimit = ctx.Int(BlockBatchLimit.Name)
 	cfg.BlockBatchLimitBurstFactor = ctx.Int(BlockBatchLimitBurstFactor.Name)
 	cfg.BlobBatchLimit = ctx.Int(BlobBatchLimit.Name)
 	cfg.BlobBatchLimitBurstFactor = ctx.Int(BlobBatchLimitBurstFactor.Name)
+	cfg.TrustedPeerRPCQuotaFactor = ctx.Float64(TrustedPeerRPCQuotaFactor.Name)
+	cfg.OutboundPeerRPCQuotaFactor = ctx.Float64(OutboundPeerRPCQuotaFactor.Name)
+	cfg.InboundPeerRPCQuotaFactor = ctx.Float64(InboundPeerRPCQuotaFactor.Name)
+	cfg.AdaptiveRPCRateLimits = ctx.Bool(AdaptiveRPCRateLimits.Name)
 	cfg.MinimumPeersPerSubnet = ctx.Int(MinPeersPerSubnet.Name)
 	cfg.MaxConcurrentDials = ctx.Int(MaxConcurrentDials.Name)
 	configureMinimumPeers(ctx, cfg)
@@ -0,0 +1,182 @@
+package lightclient
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"strconv"
+	"syscall"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/api/server/httprest"
+	lightclient "github.com/prysmaticlabs/prysm/v5/beacon-chain/light-client"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state/genesis"
+	"github.com/prysmaticlabs/prysm/v5/cmd"
+	"github.com/prysmaticlabs/prysm/v5/cmd/beacon-chain/execution"
+	"github.com/prysmaticlabs/prysm/v5/cmd/beacon-chain/flags"
+	"github.com/prysmaticlabs/prysm/v5/config/features"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v5/network"
+	"github.com/prysmaticlabs/prysm/v5/network/authorization"
+	"github.com/prysmaticlabs/prysm/v5/runtime"
+	"github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+)
+
+var log = logrus.WithField("prefix", "light-client")
+
+var (
+	// TrustedBlockRootFlag is the root of the checkpoint block the light client starts from.
+	TrustedBlockRootFlag = &cli.StringFlag{
+		Name: "trusted-block-root",
+		Usage: "Hex encoded root of a recent finalized block to start the light client from. It must be obtained " +
+			"from a trusted source, as everything the light client verifies derives from it.",
+		Required: true,
+	}
+	// SourceURLFlag is the beacon API serving light client updates.
+	SourceURLFlag = &cli.StringFlag{
+		Name: "light-client-source-url",
+		Usage: "URL of a beacon node API serving light client data. The data is verified, the node does not need to be trusted. " +
+			"Either this flag or --light-client-peer must be set.",
+	}
+	// PeerFlag is a beacon node serving light client updates over p2p.
+	PeerFlag = &cli.StringSliceFlag{
+		Name: "light-client-peer",
+		Usage: "Multiaddr, including the peer ID, of a beacon node serving light client data over p2p. Can be used " +
+			"multiple times, the peers are tried in order. Only networks with a known genesis are supported.",
+	}
+)
+
+// Commands for running the beacon node as a light client.
+var Commands = &cli.Command{
+	Name:     "light-client",
+	Category: "light-client",
+	Usage:    "Runs a light client following the chain from a trusted block root",
+	Description: `Runs a light client which follows the chain from a trusted block root by verifying the sync committee
+signatures of light client updates served by a beacon API or by beacon nodes over p2p. The verified finalized and optimistic headers are served
+under /prysm/v1/light_client and, when --execution-endpoint is set, sent to the execution client through the engine API.`,
+	Flags: cmd.WrapFlags([]cli.Flag{
+		TrustedBlockRootFlag,
+		SourceURLFlag,
+		PeerFlag,
+		flags.ExecutionEngineEndpoint,
+		flags.ExecutionJWTSecretFlag,
+		flags.HTTPServerHost,
+		flags.HTTPServerPort,
+		cmd.ChainConfigFileFlag,
+	}),
+	Action: func(cliCtx *cli.Context) error {
+		if err := run(cliCtx); err != nil {
+			log.WithError(err).Fatal("Could not run light client")
+		}
+		return nil
+	},
+}
+
+func run(cliCtx *cli.Context) error {
+	if err := features.ConfigureBeaconChain(cliCtx); err != nil {
+		return err
+	}
+	if cliCtx.IsSet(cmd.ChainConfigFileFlag.Name) {
+		if err := params.LoadChainConfigFile(cliCtx.String(cmd.ChainConfigFileFlag.Name), nil); err != nil {
+			return err
+		}
+	}
+
+	opts, err := serviceOptions(cliCtx)
+	if err != nil {
+		return err
+	}
+	service, err := lightclient.NewService(cliCtx.Context, opts...)
+	if err != nil {
+		return errors.Wrap(err, "could not create light client service")
+	}
+
+	router := http.NewServeMux()
+	(&lightclient.Server{Service: service}).RegisterRoutes(router)
+	address := net.JoinHostPort(cliCtx.String(flags.HTTPServerHost.Name), strconv.Itoa(cliCtx.Int(flags.HTTPServerPort.Name)))
+	server, err := httprest.New(cliCtx.Context, httprest.WithRouter(router), httprest.WithHTTPAddr(address))
+	if err != nil {
+		return errors.Wrap(err, "could not create HTTP server")
+	}
+
+	services := runtime.NewServiceRegistry()
+	if err := services.RegisterService(service); err != nil {
+		return err
+	}
+	if err := services.RegisterService(server); err != nil {
+		return err
+	}
+	services.StartAll()
+	defer services.StopAll()
+
+	sigc := make(chan os.Signal, 1)
+	signal.Notify(sigc, syscall.SIGINT, syscall.SIGTERM)
+	defer signal.Stop(sigc)
+	select {
+	case <-sigc:
+		log.Info("Got interrupt, shutting down...")
+	case <-cliCtx.Context.Done():
+	}
+	return nil
+}
+
+func serviceOptions(cliCtx *cli.Context) ([]lightclient.Option, error) {
+	root, err := hexutil.Decode(cliCtx.String(TrustedBlockRootFlag.Name))
+	if err != nil || len(root) != 32 {
+		return nil, fmt.Errorf("invalid --%s, expected a 32 bytes hex encoded root", TrustedBlockRootFlag.Name)
+	}
+	source, err := newSource(cliCtx)
+	if err != nil {
+		return nil, err
+	}
+	opts := []lightclient.Option{
+		lightclient.WithTrustedBlockRoot(bytesutil.ToBytes32(root)),
+		lightclient.WithSource(source),
+	}
+
+	// Unlike the full beacon node, the light client does not need an execution client, only use one when asked to.
+	if cliCtx.IsSet(flags.ExecutionEngineEndpoint.Name) {
+		endpoint := network.HttpEndpoint(cliCtx.String(flags.ExecutionEngineEndpoint.Name))
+		secret, err := execution.ParseJWTSecretFromFile(cliCtx)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not read JWT secret file for authenticating execution API")
+		}
+		if len(secret) > 0 {
+			endpoint.Auth.Method = authorization.Bearer
+			endpoint.Auth.Value = string(secret)
+		}
+		opts = append(opts, lightclient.WithExecutionEndpoint(endpoint))
+	}
+	return opts, nil
+}
+
+// newSource creates the source of light client data, either a beacon API or beacon nodes reached over p2p.
+func newSource(cliCtx *cli.Context) (lightclient.Source, error) {
+	url := cliCtx.String(SourceURLFlag.Name)
+	peers := cliCtx.StringSlice(PeerFlag.Name)
+	switch {
+	case url != "" && len(peers) > 0:
+		return nil, fmt.Errorf("only one of --%s and --%s can be set", SourceURLFlag.Name, PeerFlag.Name)
+	case url != "":
+		return lightclient.NewAPISource(url)
+	case len(peers) > 0:
+		// The genesis cannot be requested over p2p, take it from the genesis state of the network.
+		st, err := genesis.State(params.BeaconConfig().ConfigName)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not load genesis state")
+		}
+		if st == nil {
+			return nil, fmt.Errorf("the genesis of the %s network is not known, use --%s", params.BeaconConfig().ConfigName, SourceURLFlag.Name)
+		}
+		genesisTime := time.Unix(int64(st.GenesisTime()), 0) // lint:ignore uintcast -- Genesis time will not exceed int64 in your lifetime.
+		return lightclient.NewP2PSource(cliCtx.Context, genesisTime, bytesutil.ToBytes32(st.GenesisValidatorsRoot()), peers)
+	default:
+		return nil, fmt.Errorf("one of --%s and --%s must be set", SourceURLFlag.Name, PeerFlag.Name)
+	}
+}
@@ -0,0 +1,92 @@
+package lightclient
+
+import (
+	"flag"
+	"testing"
+
+	lightclient "github.com/prysmaticlabs/prysm/v5/beacon-chain/light-client"
+	"github.com/prysmaticlabs/prysm/v5/cmd/beacon-chain/flags"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/urfave/cli/v2"
+)
+
+func Test_serviceOptions(t *testing.T) {
+	newContext := func(t *testing.T, root string, executionEndpoint string) *cli.Context {
+		app := cli.App{}
+		set := flag.NewFlagSet("test", 0)
+		set.String(TrustedBlockRootFlag.Name, "", "")
+		set.String(SourceURLFlag.Name, "", "")
+		set.String(flags.ExecutionEngineEndpoint.Name, "", "")
+		set.String(flags.ExecutionJWTSecretFlag.Name, "", "")
+		require.NoError(t, set.Set(TrustedBlockRootFlag.Name, root))
+		require.NoError(t, set.Set(SourceURLFlag.Name, "http://localhost:3500"))
+		if executionEndpoint != "" {
+			require.NoError(t, set.Set(flags.ExecutionEngineEndpoint.Name, executionEndpoint))
+		}
+		return cli.NewContext(&app, set, nil)
+	}
+	root := "0x" + "ab00000000000000000000000000000000000000000000000000000000000000"
+
+	t.Run("invalid trusted block root", func(t *testing.T) {
+		_, err := serviceOptions(newContext(t, "0xab", ""))
+		require.ErrorContains(t, "invalid --trusted-block-root", err)
+	})
+	t.Run("without execution endpoint", func(t *testing.T) {
+		opts, err := serviceOptions(newContext(t, root, ""))
+		require.NoError(t, err)
+		require.Equal(t, 2, len(opts))
+	})
+	t.Run("with execution endpoint", func(t *testing.T) {
+		opts, err := serviceOptions(newContext(t, root, "http://localhost:8551"))
+		require.NoError(t, err)
+		require.Equal(t, 3, len(opts))
+	})
+}
+
+func Test_newSource(t *testing.T) {
+	newContext := func(t *testing.T, url string, peers ...string) *cli.Context {
+		app := cli.App{}
+		set := flag.NewFlagSet("test", 0)
+		set.String(SourceURLFlag.Name, "", "")
+		set.Var(&cli.StringSlice{}, PeerFlag.Name, "")
+		if url != "" {
+			require.NoError(t, set.Set(SourceURLFlag.Name, url))
+		}
+		for _, p := range peers {
+			require.NoError(t, set.Set(PeerFlag.Name, p))
+		}
+		return cli.NewContext(&app, set, nil)
+	}
+	peer := "/ip4/127.0.0.1/tcp/13000/p2p/16Uiu2HAmPz5xFhmWW3CzNkZLrBvtAHjXjEeSGhHNSwcxAqnXbJAd"
+
+	t.Run("no source", func(t *testing.T) {
+		_, err := newSource(newContext(t, ""))
+		require.ErrorContains(t, "one of --light-client-source-url and --light-client-peer must be set", err)
+	})
+	t.Run("both sources", func(t *testing.T) {
+		_, err := newSource(newContext(t, "http://localhost:3500", peer))
+		require.ErrorContains(t, "only one of", err)
+	})
+	t.Run("api source", func(t *testing.T) {
+		source, err := newSource(newContext(t, "http://localhost:3500"))
+		require.NoError(t, err)
+		_, ok := source.(*lightclient.APISource)
+		require.Equal(t, true, ok)
+	})
+	t.Run("p2p source", func(t *testing.T) {
+		source, err := newSource(newContext(t, "", peer))
+		require.NoError(t, err)
+		p2pSource, ok := source.(*lightclient.P2PSource)
+		require.Equal(t, true, ok)
+		require.NoError(t, p2pSource.Close())
+	})
+	t.Run("p2p source on unknown network", func(t *testing.T) {
+		params.SetupTestConfigCleanup(t)
+		cfg := params.BeaconConfig().Copy()
+		cfg.ConfigName = "unknown"
+		params.OverrideBeaconConfig(cfg)
+		_, err := newSource(newContext(t, "", peer))
+		require.ErrorContains(t, "the genesis of the unknown network is not known", err)
+	})
+}
@@ -21,6 +21,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/cmd/beacon-chain/execution"
 	"github.com/prysmaticlabs/prysm/v5/cmd/beacon-chain/flags"
 	jwtcommands "github.com/prysmaticlabs/prysm/v5/cmd/beacon-chain/jwt"
+	lightclientcommands "github.com/prysmaticlabs/prysm/v5/cmd/beacon-chain/light-client"
 	"github.com/prysmaticlabs/prysm/v5/cmd/beacon-chain/storage"
 	backfill "github.com/prysmaticlabs/prysm/v5/cmd/beacon-chain/sync/backfill"
 	bflags "github.com/prysmaticlabs/prysm/v5/cmd/beacon-chain/sync/backfill/flags"
@@ -60,10 +61,15 @@ var appFlags = []cli.Flag{
 	flags.BlockBatchLimitBurstFactor,
 	flags.BlobBatchLimit,
 	flags.BlobBatchLimitBurstFactor,
+	flags.TrustedPeerRPCQuotaFactor,
+	flags.OutboundPeerRPCQuotaFactor,
+	flags.InboundPeerRPCQuotaFactor,
+	flags.AdaptiveRPCRateLimits,
 	flags.InteropMockEth1DataVotesFlag,
 	flags.SlotsPerArchivedPoint,
 	flags.DisableDebugRPCEndpoints,
 	flags.SubscribeToAllSubnets,
+	flags.SubscribeAllDataSubnets,
 	flags.HistoricalSlasherNode,
 	flags.ChainID,
 	flags.NetworkID,
@@ -84,6 +90,7 @@ var appFlags = []cli.Flag{
 	flags.MinBuilderDiff,
 	flags.BeaconDBPruning,
 	flags.PrunerRetentionEpochs,
+	flags.BeaconDBBackend,
 	cmd.BackupWebhookOutputDir,
 	cmd.MinimalConfigFlag,
 	cmd.E2EConfigFlag,
@@ -99,12 +106,17 @@ var appFlags = []cli.Flag{
 	cmd.P2PHost,
 	cmd.P2PHostDNS,
 	cmd.P2PMaxPeers,
+	cmd.P2PPeerDBMaxPeers,
+	cmd.P2PPeerDBExpiry,
 	cmd.P2PPrivKey,
 	cmd.P2PStaticID,
 	cmd.P2PMetadata,
 	cmd.P2PAllowList,
 	cmd.P2PDenyList,
 	cmd.PubsubQueueSize,
+	cmd.P2PGossipTraceDir,
+	cmd.P2PGossipTraceMaxFileSize,
+	cmd.P2PGossipTraceMaxFiles,
 	cmd.DataDirFlag,
 	cmd.VerbosityFlag,
 	cmd.EnableTracingFlag,
@@ -146,6 +158,7 @@ var appFlags = []cli.Flag{
 	storage.BlobStoragePathFlag,
 	storage.BlobRetentionEpochFlag,
 	storage.BlobStorageLayout,
+	storage.DataColumnStoragePathFlag,
 	bflags.EnableExperimentalBackfill,
 	bflags.BackfillBatchSize,
 	bflags.BackfillWorkerCount,
@@ -240,6 +253,7 @@ func main() {
 		Commands: []*cli.Command{
 			dbcommands.Commands,
 			jwtcommands.Commands,
+			lightclientcommands.Commands,
 		},
 		Flags:  appFlags,
 		Before: before,
@@ -30,6 +30,10 @@ var (
 		Usage: layoutFlagUsage(),
 		Value: filesystem.LayoutNameFlat,
 	}
+	DataColumnStoragePathFlag = &cli.PathFlag{
+		Name:  "data-column-path",
+		Usage: "Location for data column storage. Default location will be a 'data-columns' directory next to the beacon db.",
+	}
 )
 
 func layoutOptions() string {
@@ -62,6 +66,9 @@ func BeaconNodeOptions(c *cli.Context) ([]node.Option, error) {
 		filesystem.WithBlobRetentionEpochs(e),
 		filesystem.WithBasePath(blobStoragePath(c)),
 		filesystem.WithLayout(c.String(BlobStorageLayout.Name)), // This is validated in the Action func for BlobStorageLayout.
+	), node.WithDataColumnStorageOptions(
+		filesystem.WithDataColumnRetentionEpochs(params.BeaconConfig().MinEpochsForDataColumnSidecarsRequest),
+		filesystem.WithDataColumnBasePath(dataColumnStoragePath(c)),
 	)}
 	return opts, nil
 }
@@ -75,6 +82,15 @@ func blobStoragePath(c *cli.Context) string {
 	return blobsPath
 }
 
+func dataColumnStoragePath(c *cli.Context) string {
+	dataColumnsPath := c.Path(DataColumnStoragePathFlag.Name)
+	if dataColumnsPath == "" {
+		// append a "data-columns" subdir to the end of the data dir path
+		dataColumnsPath = path.Join(c.String(cmd.DataDirFlag.Name), "data-columns")
+	}
+	return dataColumnsPath
+}
+
 var errInvalidBlobRetentionEpochs = errors.New("value is smaller than spec minimum")
 
 // blobRetentionEpoch returns the spec default MIN_EPOCHS_FOR_BLOB_SIDECARS_REQUEST
@@ -33,6 +33,17 @@ func TestBlobStoragePath_FlagSpecified(t *testing.T) {
 	assert.Equal(t, "/blah/blah", storagePath)
 }
 
+func TestDataColumnStoragePath(t *testing.T) {
+	app := cli.App{}
+	set := flag.NewFlagSet("test", 0)
+	set.String(cmd.DataDirFlag.Name, cmd.DataDirFlag.Value, cmd.DataDirFlag.Usage)
+	cliCtx := cli.NewContext(&app, set, nil)
+	assert.Equal(t, cmd.DefaultDataDir()+"/data-columns", dataColumnStoragePath(cliCtx))
+
+	set.String(DataColumnStoragePathFlag.Name, "/blah/blah", DataColumnStoragePathFlag.Usage)
+	assert.Equal(t, "/blah/blah", dataColumnStoragePath(cliCtx))
+}
+
 func TestConfigureBlobRetentionEpoch(t *testing.T) {
 	params.SetupTestConfigCleanup(t)
 	specMinEpochs := params.BeaconConfig().MinEpochsForBlobsSidecarsRequest
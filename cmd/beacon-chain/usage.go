@@ -115,8 +115,13 @@ var appHelpFlagGroups = []flagGroup{
 			flags.BlockBatchLimitBurstFactor,
 			flags.BlobBatchLimit,
 			flags.BlobBatchLimitBurstFactor,
+			flags.TrustedPeerRPCQuotaFactor,
+			flags.OutboundPeerRPCQuotaFactor,
+			flags.InboundPeerRPCQuotaFactor,
+			flags.AdaptiveRPCRateLimits,
 			flags.DisableDebugRPCEndpoints,
 			flags.SubscribeToAllSubnets,
+			flags.SubscribeAllDataSubnets,
 			flags.HistoricalSlasherNode,
 			flags.ChainID,
 			flags.NetworkID,
@@ -135,6 +140,7 @@ var appHelpFlagGroups = []flagGroup{
 			flags.JwtId,
 			flags.BeaconDBPruning,
 			flags.PrunerRetentionEpochs,
+			flags.BeaconDBBackend,
 			checkpoint.BlockPath,
 			checkpoint.StatePath,
 			checkpoint.RemoteURL,
@@ -143,6 +149,7 @@ var appHelpFlagGroups = []flagGroup{
 			storage.BlobStoragePathFlag,
 			storage.BlobRetentionEpochFlag,
 			storage.BlobStorageLayout,
+			storage.DataColumnStoragePathFlag,
 			backfill.EnableExperimentalBackfill,
 			backfill.BackfillWorkerCount,
 			backfill.BackfillBatchSize,
@@ -165,12 +172,17 @@ var appHelpFlagGroups = []flagGroup{
 			cmd.P2PHost,
 			cmd.P2PHostDNS,
 			cmd.P2PMaxPeers,
+			cmd.P2PPeerDBMaxPeers,
+			cmd.P2PPeerDBExpiry,
 			cmd.P2PPrivKey,
 			cmd.P2PStaticID,
 			cmd.P2PMetadata,
 			cmd.P2PAllowList,
 			cmd.P2PDenyList,
 			cmd.PubsubQueueSize,
+			cmd.P2PGossipTraceDir,
+			cmd.P2PGossipTraceMaxFileSize,
+			cmd.P2PGossipTraceMaxFiles,
 			cmd.StaticPeers,
 			cmd.EnableUPnPFlag,
 			flags.MinSyncPeers,
@@ -170,6 +170,37 @@ var (
 		Usage: "The max number of p2p peers to maintain.",
 		Value: 70,
 	}
+	// P2PPeerDBMaxPeers defines a flag to specify the max number of peers kept in the peer database.
+	P2PPeerDBMaxPeers = &cli.IntFlag{
+		Name: "p2p-peer-db-max-peers",
+		Usage: "The max number of peers, with their addresses, scores and bad peer verdicts, kept in the peer " +
+			"database of the data directory across restarts. Peers are not kept with 0, manual bans still are.",
+		Value: 1000,
+	}
+	// P2PPeerDBExpiry defines a flag to specify how long a peer which has not been seen is kept in the peer database.
+	P2PPeerDBExpiry = &cli.DurationFlag{
+		Name:  "p2p-peer-db-expiry",
+		Usage: "The duration after which a peer which has not been seen is removed from the peer database.",
+		Value: 72 * time.Hour,
+	}
+	// P2PGossipTraceDir defines a flag to specify the directory gossipsub events are traced to.
+	P2PGossipTraceDir = &cli.StringFlag{
+		Name: "p2p-gossip-trace-dir",
+		Usage: "The directory gossipsub message and mesh events are traced to, as rotating JSON lines files, to debug " +
+			"the propagation of messages with the prysmctl p2p trace-analyze command. Tracing is disabled when empty.",
+	}
+	// P2PGossipTraceMaxFileSize defines a flag to specify the size above which a gossipsub trace file is rotated.
+	P2PGossipTraceMaxFileSize = &cli.Uint64Flag{
+		Name:  "p2p-gossip-trace-max-file-size",
+		Usage: "The size, in bytes, above which the current gossipsub trace file is rotated.",
+		Value: 64 << 20,
+	}
+	// P2PGossipTraceMaxFiles defines a flag to specify the number of rotated gossipsub trace files kept.
+	P2PGossipTraceMaxFiles = &cli.IntFlag{
+		Name:  "p2p-gossip-trace-max-files",
+		Usage: "The number of rotated gossipsub trace files kept, the oldest ones are deleted first.",
+		Value: 16,
+	}
 	// P2PAllowList defines a CIDR subnet to exclusively allow connections.
 	P2PAllowList = &cli.StringFlag{
 		Name: "p2p-allowlist",
@@ -10,6 +10,9 @@ var Commands = []*cli.Command{
 			queryCmd,
 			bucketsCmd,
 			spanCmd,
+			exportEraCmd,
+			importEraCmd,
+			migrateBackendCmd,
 		},
 	},
 }
@@ -0,0 +1,191 @@
+package db
+
+import (
+	"path/filepath"
+	"sort"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/era"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/kv"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	log "github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+)
+
+var exportEraFlags = struct {
+	Path            string
+	OutputDir       string
+	StartEra        uint64
+	EndEra          uint64
+	Network         string
+	ChainConfigFile string
+}{}
+
+var importEraFlags = struct {
+	Path            string
+	EraDir          string
+	Network         string
+	ChainConfigFile string
+}{}
+
+var exportEraCmd = &cli.Command{
+	Name:  "export-era",
+	Usage: "export finalized blocks and states from the beacon db to era files",
+	Action: func(cliCtx *cli.Context) error {
+		if err := exportEraAction(cliCtx); err != nil {
+			log.WithError(err).Fatal("Could not export era files")
+		}
+		return nil
+	},
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:        "path",
+			Usage:       "path to directory containing beaconchain.db",
+			Destination: &exportEraFlags.Path,
+			Required:    true,
+		},
+		&cli.StringFlag{
+			Name:        "output-dir",
+			Usage:       "directory where era files are written",
+			Destination: &exportEraFlags.OutputDir,
+			Value:       ".",
+		},
+		&cli.Uint64Flag{
+			Name:        "start-era",
+			Usage:       "first era to export",
+			Destination: &exportEraFlags.StartEra,
+		},
+		&cli.Uint64Flag{
+			Name:        "end-era",
+			Usage:       "last era to export (default: last finalized era)",
+			Destination: &exportEraFlags.EndEra,
+		},
+		&cli.StringFlag{
+			Name:        "network",
+			Usage:       "network the db belongs to (mainnet, sepolia, holesky)",
+			Destination: &exportEraFlags.Network,
+			Value:       params.MainnetName,
+		},
+		&cli.StringFlag{
+			Name:        "chain-config-file",
+			Usage:       "path to a chain config file, overriding --network",
+			Destination: &exportEraFlags.ChainConfigFile,
+		},
+	},
+}
+
+var importEraCmd = &cli.Command{
+	Name:  "import-era",
+	Usage: "import blocks and states from era files into the beacon db",
+	Action: func(cliCtx *cli.Context) error {
+		if err := importEraAction(cliCtx); err != nil {
+			log.WithError(err).Fatal("Could not import era files")
+		}
+		return nil
+	},
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:        "path",
+			Usage:       "path to directory containing beaconchain.db, created if it does not exist",
+			Destination: &importEraFlags.Path,
+			Required:    true,
+		},
+		&cli.StringFlag{
+			Name:        "era-dir",
+			Usage:       "directory containing the era files to import, imported in era order",
+			Destination: &importEraFlags.EraDir,
+			Required:    true,
+		},
+		&cli.StringFlag{
+			Name:        "network",
+			Usage:       "network the db belongs to (mainnet, sepolia, holesky)",
+			Destination: &importEraFlags.Network,
+			Value:       params.MainnetName,
+		},
+		&cli.StringFlag{
+			Name:        "chain-config-file",
+			Usage:       "path to a chain config file, overriding --network",
+			Destination: &importEraFlags.ChainConfigFile,
+		},
+	},
+}
+
+func exportEraAction(cliCtx *cli.Context) error {
+	flags := exportEraFlags
+	if err := setNetworkConfig(flags.Network, flags.ChainConfigFile); err != nil {
+		return err
+	}
+	ctx := cliCtx.Context
+	db, err := kv.NewKVStore(ctx, flags.Path)
+	if err != nil {
+		return errors.Wrapf(err, "could not open db at %s", flags.Path)
+	}
+	defer closeDB(db)
+
+	endEra := flags.EndEra
+	if !cliCtx.IsSet("end-era") {
+		endEra, err = era.LastFinalizedEra(ctx, db)
+		if err != nil {
+			return err
+		}
+	}
+	paths, err := era.Export(ctx, db, flags.OutputDir, flags.StartEra, endEra)
+	if err != nil {
+		return err
+	}
+	log.WithField("files", len(paths)).Info("Export complete")
+	return nil
+}
+
+func importEraAction(cliCtx *cli.Context) error {
+	flags := importEraFlags
+	if err := setNetworkConfig(flags.Network, flags.ChainConfigFile); err != nil {
+		return err
+	}
+	paths, err := eraFiles(flags.EraDir)
+	if err != nil {
+		return err
+	}
+	if len(paths) == 0 {
+		return errors.Errorf("no era files found in %s", flags.EraDir)
+	}
+	db, err := kv.NewKVStore(cliCtx.Context, flags.Path)
+	if err != nil {
+		return errors.Wrapf(err, "could not open db at %s", flags.Path)
+	}
+	defer closeDB(db)
+
+	if err := era.Import(cliCtx.Context, db, paths); err != nil {
+		return err
+	}
+	log.WithField("files", len(paths)).Info("Import complete")
+	return nil
+}
+
+// eraFiles lists the era files of a directory. Era file names start with the config name followed by
+// the zero padded era number, so sorting by name sorts them by era.
+func eraFiles(dir string) ([]string, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "*"+era.Extension))
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(paths)
+	return paths, nil
+}
+
+func setNetworkConfig(network, chainConfigFile string) error {
+	if chainConfigFile != "" {
+		return params.LoadChainConfigFile(chainConfigFile, nil)
+	}
+	cfg, err := params.ByName(network)
+	if err != nil {
+		return errors.Wrapf(err, "unknown network %s", network)
+	}
+	return params.SetActive(cfg)
+}
+
+func closeDB(db *kv.Store) {
+	if err := db.Close(); err != nil {
+		log.WithError(err).Error("Could not close db")
+	}
+}
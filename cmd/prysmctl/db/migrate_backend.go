@@ -0,0 +1,65 @@
+package db
+
+import (
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/backend"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/kv"
+	log "github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+)
+
+var migrateBackendFlags = struct {
+	Path string
+	From string
+	To   string
+}{}
+
+var migrateBackendCmd = &cli.Command{
+	Name:  "migrate-backend",
+	Usage: "copy the beacon db into a database of another storage backend, to be used with the beacon node --db-backend flag",
+	Action: func(cliCtx *cli.Context) error {
+		if err := migrateBackendAction(cliCtx); err != nil {
+			log.WithError(err).Fatal("Could not migrate database backend")
+		}
+		return nil
+	},
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:        "path",
+			Usage:       "path to the beaconchaindata directory of the beacon node, which must not be running",
+			Destination: &migrateBackendFlags.Path,
+			Required:    true,
+		},
+		&cli.StringFlag{
+			Name:        "from",
+			Usage:       "backend of the existing database (bolt, pebble)",
+			Destination: &migrateBackendFlags.From,
+			Value:       string(backend.Bolt),
+		},
+		&cli.StringFlag{
+			Name:        "to",
+			Usage:       "backend of the new database (bolt, pebble)",
+			Destination: &migrateBackendFlags.To,
+			Value:       string(backend.Pebble),
+		},
+	},
+}
+
+func migrateBackendAction(cliCtx *cli.Context) error {
+	flags := migrateBackendFlags
+	from, err := backend.ParseKind(flags.From)
+	if err != nil {
+		return err
+	}
+	to, err := backend.ParseKind(flags.To)
+	if err != nil {
+		return err
+	}
+	log.WithField("path", flags.Path).WithField("from", from).WithField("to", to).Info("Migrating database backend")
+	if err := kv.MigrateBackend(cliCtx.Context, flags.Path, from, to); err != nil {
+		return errors.Wrapf(err, "could not migrate db at %s", flags.Path)
+	}
+	log.WithField("backend", to).Info("Migration complete, the previous database can be removed once the beacon node " +
+		"runs with --db-backend=" + string(to))
+	return nil
+}
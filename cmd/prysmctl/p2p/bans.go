@@ -0,0 +1,178 @@
+package p2p
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/api"
+	apiClient "github.com/prysmaticlabs/prysm/v5/api/client"
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	"github.com/urfave/cli/v2"
+)
+
+const bansPath = "/prysm/v1/node/bans"
+
+var banFlags = struct {
+	BeaconNodeHost string
+	Target         string
+	Reason         string
+	Duration       time.Duration
+}{}
+
+var banBeaconNodeHostFlag = &cli.StringFlag{
+	Name:        "beacon-node-host",
+	Usage:       "host:port of the HTTP API of the beacon node",
+	Destination: &banFlags.BeaconNodeHost,
+	Value:       "localhost:3500",
+}
+
+var banTargetFlag = &cli.StringFlag{
+	Name:        "target",
+	Usage:       "peer ID, IP address or CIDR range",
+	Destination: &banFlags.Target,
+	Required:    true,
+}
+
+var banCmd = &cli.Command{
+	Name: "ban",
+	Usage: "Ban a peer ID, IP address or CIDR range from a running beacon node. Connected peers matching the ban " +
+		"are disconnected, and the ban is kept across restarts of the beacon node",
+	Action: func(cliCtx *cli.Context) error {
+		if err := cliActionBan(cliCtx); err != nil {
+			log.WithError(err).Fatal("Could not ban peer")
+		}
+		return nil
+	},
+	Flags: []cli.Flag{
+		banBeaconNodeHostFlag,
+		banTargetFlag,
+		&cli.StringFlag{
+			Name:        "reason",
+			Usage:       "reason of the ban, logged by the beacon node",
+			Destination: &banFlags.Reason,
+			Required:    true,
+		},
+		&cli.DurationFlag{
+			Name:        "duration",
+			Usage:       "duration of the ban, rounded down to the second, the ban is permanent if not set",
+			Destination: &banFlags.Duration,
+		},
+	},
+}
+
+var unbanCmd = &cli.Command{
+	Name:  "unban",
+	Usage: "Lift the ban of a peer ID, IP address or CIDR range from a running beacon node",
+	Action: func(cliCtx *cli.Context) error {
+		if err := cliActionUnban(cliCtx); err != nil {
+			log.WithError(err).Fatal("Could not unban peer")
+		}
+		return nil
+	},
+	Flags: []cli.Flag{banBeaconNodeHostFlag, banTargetFlag},
+}
+
+var listBansCmd = &cli.Command{
+	Name:  "bans",
+	Usage: "List the bans of a running beacon node",
+	Action: func(cliCtx *cli.Context) error {
+		if err := cliActionListBans(cliCtx); err != nil {
+			log.WithError(err).Fatal("Could not list bans")
+		}
+		return nil
+	},
+	Flags: []cli.Flag{banBeaconNodeHostFlag},
+}
+
+func cliActionBan(cliCtx *cli.Context) error {
+	req := &structs.AddBanRequest{Target: banFlags.Target, Reason: banFlags.Reason}
+	if banFlags.Duration > 0 {
+		seconds := uint64(banFlags.Duration / time.Second)
+		if seconds == 0 {
+			return errors.New("duration must be at least one second")
+		}
+		req.Duration = strconv.FormatUint(seconds, 10)
+	}
+	body, err := json.Marshal(req)
+	if err != nil {
+		return errors.Wrap(err, "could not marshal ban request")
+	}
+	resp := &structs.AddBanResponse{}
+	if err := doBansRequest(cliCtx.Context, http.MethodPost, &url.URL{Path: bansPath}, body, resp); err != nil {
+		return err
+	}
+	printBan(resp.Data)
+	return nil
+}
+
+func cliActionUnban(cliCtx *cli.Context) error {
+	// The slash of a CIDR range is escaped, so that the range is a single path segment.
+	u := &url.URL{
+		Path:    bansPath + "/" + banFlags.Target,
+		RawPath: bansPath + "/" + url.PathEscape(banFlags.Target),
+	}
+	if err := doBansRequest(cliCtx.Context, http.MethodDelete, u, nil, nil); err != nil {
+		return err
+	}
+	log.WithField("target", banFlags.Target).Info("Unbanned peer")
+	return nil
+}
+
+func cliActionListBans(cliCtx *cli.Context) error {
+	resp := &structs.ListBansResponse{}
+	if err := doBansRequest(cliCtx.Context, http.MethodGet, &url.URL{Path: bansPath}, nil, resp); err != nil {
+		return err
+	}
+	for _, b := range resp.Data {
+		printBan(b)
+	}
+	log.WithField("bans", len(resp.Data)).Info("Listed bans")
+	return nil
+}
+
+// doBansRequest sends the request to the bans endpoint of the beacon node, and decodes the response into resp if it is not nil.
+func doBansRequest(ctx context.Context, method string, u *url.URL, body []byte, resp interface{}) error {
+	c, err := apiClient.NewClient(banFlags.BeaconNodeHost)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, method, c.BaseURL().ResolveReference(u).String(), bytes.NewReader(body))
+	if err != nil {
+		return errors.Wrap(err, "could not create request")
+	}
+	req.Header.Set("Accept", api.JsonMediaType)
+	if body != nil {
+		req.Header.Set("Content-Type", api.JsonMediaType)
+	}
+	r, err := c.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := r.Body.Close(); err != nil {
+			log.WithError(err).Error("Could not close response body")
+		}
+	}()
+	if r.StatusCode != http.StatusOK {
+		return apiClient.Non200Err(r)
+	}
+	if resp == nil {
+		return nil
+	}
+	return errors.Wrap(json.NewDecoder(r.Body).Decode(resp), "could not decode response")
+}
+
+func printBan(b *structs.Ban) {
+	expiry := "never"
+	if b.Expiry != "" {
+		expiry = b.Expiry
+	}
+	fmt.Printf("%s kind=%s created=%s expiry=%s reason=%q\n", b.Target, b.Kind, b.Created, expiry, b.Reason)
+}
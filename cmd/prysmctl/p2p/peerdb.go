@@ -0,0 +1,112 @@
+package p2p
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/peers/peerdb"
+	"github.com/prysmaticlabs/prysm/v5/cmd"
+	"github.com/urfave/cli/v2"
+)
+
+var peerDBFlags = struct {
+	DataDir string
+}{}
+
+var peerDBDataDirFlag = &cli.StringFlag{
+	Name:        cmd.DataDirFlag.Name,
+	Usage:       "data directory of the beacon node holding the peer database, the beacon node must be stopped",
+	Destination: &peerDBFlags.DataDir,
+	Value:       cmd.DefaultDataDir(),
+}
+
+var peerDBCmd = &cli.Command{
+	Name:        "peerdb",
+	Usage:       "commands for inspecting and clearing the peer database of a beacon node",
+	Subcommands: []*cli.Command{peerDBInspectCmd, peerDBClearCmd},
+}
+
+var peerDBInspectCmd = &cli.Command{
+	Name:  "inspect",
+	Usage: "List the peers kept in the peer database, the most recently seen first",
+	Action: func(cliCtx *cli.Context) error {
+		if err := cliActionInspectPeerDB(cliCtx); err != nil {
+			log.WithError(err).Fatal("Could not inspect peer database")
+		}
+		return nil
+	},
+	Flags: []cli.Flag{peerDBDataDirFlag},
+}
+
+var peerDBClearCmd = &cli.Command{
+	Name:  "clear",
+	Usage: "Delete all the peers kept in the peer database",
+	Action: func(cliCtx *cli.Context) error {
+		if err := cliActionClearPeerDB(cliCtx); err != nil {
+			log.WithError(err).Fatal("Could not clear peer database")
+		}
+		return nil
+	},
+	Flags: []cli.Flag{peerDBDataDirFlag},
+}
+
+func cliActionInspectPeerDB(cliCtx *cli.Context) error {
+	store, err := openPeerDB()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := store.Close(); err != nil {
+			log.WithError(err).Error("Could not close peer database")
+		}
+	}()
+	records, err := store.Records(cliCtx.Context)
+	if err != nil {
+		return errors.Wrap(err, "could not read peer database")
+	}
+	for _, record := range records {
+		fmt.Printf(
+			"%s last_seen=%s direction=%s bad=%t score=%.2f bad_responses=%d addrs=%s\n",
+			record.ID,
+			record.LastSeen.Format(time.RFC3339),
+			record.Direction,
+			record.Bad,
+			record.Score,
+			record.BadResponses,
+			strings.Join(record.Addrs, ","),
+		)
+		if record.ENR != "" {
+			fmt.Printf("  enr=%s\n", record.ENR)
+		}
+	}
+	log.WithField("peers", len(records)).Info("Read peer database")
+	return nil
+}
+
+func cliActionClearPeerDB(cliCtx *cli.Context) error {
+	store, err := openPeerDB()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := store.Close(); err != nil {
+			log.WithError(err).Error("Could not close peer database")
+		}
+	}()
+	if err := store.Clear(cliCtx.Context); err != nil {
+		return errors.Wrap(err, "could not clear peer database")
+	}
+	log.Info("Cleared peer database")
+	return nil
+}
+
+// openPeerDB opens the peer database without any limit, so that reading it does not drop records.
+func openPeerDB() (*peerdb.Store, error) {
+	store, err := peerdb.NewStore(peerDBFlags.DataDir, &peerdb.Config{})
+	if err != nil {
+		return nil, errors.Wrap(err, "could not open peer database")
+	}
+	return store, nil
+}
@@ -0,0 +1,86 @@
+package p2p
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/gossiptrace"
+	"github.com/urfave/cli/v2"
+)
+
+var traceAnalyzeFlags = struct {
+	TraceDirs cli.StringSlice
+}{}
+
+var traceAnalyzeCmd = &cli.Command{
+	Name: "trace-analyze",
+	Usage: "Compute per-topic arrival latency distributions and duplicate ratios from the gossipsub traces of " +
+		"beacon nodes. The traces of several nodes are needed to measure arrival latencies",
+	Action: func(cliCtx *cli.Context) error {
+		if err := cliActionTraceAnalyze(cliCtx); err != nil {
+			log.WithError(err).Fatal("Could not analyze gossipsub traces")
+		}
+		return nil
+	},
+	Flags: []cli.Flag{
+		&cli.StringSliceFlag{
+			Name:        "trace-dir",
+			Usage:       "gossipsub trace directory of a beacon node, as set with --p2p-gossip-trace-dir, may be repeated",
+			Destination: &traceAnalyzeFlags.TraceDirs,
+			Required:    true,
+		},
+	},
+}
+
+func cliActionTraceAnalyze(_ *cli.Context) error {
+	analyzer := gossiptrace.NewAnalyzer()
+	events := 0
+	for _, dir := range traceAnalyzeFlags.TraceDirs.Value() {
+		paths, err := gossiptrace.Files(dir)
+		if err != nil {
+			return err
+		}
+		if len(paths) == 0 {
+			return fmt.Errorf("no gossipsub trace file in %s", dir)
+		}
+		for _, p := range paths {
+			if err := gossiptrace.ReadFile(p, func(e *gossiptrace.Event) {
+				analyzer.Add(e)
+				events++
+			}); err != nil {
+				return err
+			}
+		}
+	}
+	log.WithField("events", events).Info("Read gossipsub traces")
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "TOPIC\tPUBLISHED\tDELIVERED\tDUPLICATES\tDUP RATIO\tREJECTED\tGRAFTS\tPRUNES\tLATENCIES\tMIN\tP50\tP90\tP99\tMAX")
+	for _, r := range analyzer.Report() {
+		fmt.Fprintf(w, "%s\t%d\t%d\t%d\t%.2f\t%s\t%d\t%d\t%d\t%s\t%s\t%s\t%s\t%s\n",
+			r.Topic, r.Published, r.Delivered, r.Duplicates, r.DuplicateRatio, formatRejected(r.Rejected),
+			r.Grafts, r.Prunes, r.Latency.Count, r.Latency.Min, r.Latency.P50, r.Latency.P90, r.Latency.P99, r.Latency.Max)
+	}
+	return errors.Wrap(w.Flush(), "could not write report")
+}
+
+// formatRejected formats the number of rejected messages by reason, sorted by reason.
+func formatRejected(rejected map[string]int) string {
+	if len(rejected) == 0 {
+		return "0"
+	}
+	reasons := make([]string, 0, len(rejected))
+	for reason := range rejected {
+		reasons = append(reasons, reason)
+	}
+	sort.Strings(reasons)
+	parts := make([]string, 0, len(reasons))
+	for _, reason := range reasons {
+		parts = append(parts, fmt.Sprintf("%s=%d", reason, rejected[reason]))
+	}
+	return strings.Join(parts, ",")
+}
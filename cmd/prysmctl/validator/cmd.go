@@ -70,6 +70,47 @@ var (
 		Aliases: []string{"t"},
 		Usage:   "keymanager API bearer token, note: currently required but may be removed in the future, this is the same token as the web ui token.",
 	}
+
+	ExecutionEndpointFlag = &cli.StringFlag{
+		Name:  "execution-endpoint",
+		Usage: "execution node JSON-RPC endpoint the requests are submitted to",
+		Value: "http://127.0.0.1:8545",
+	}
+
+	PrivateKeyFileFlag = &cli.StringFlag{
+		Name:  "private-key-file",
+		Usage: "path to a file containing the hex encoded private key of the execution address sending the requests, which must be the withdrawal address of the validators",
+	}
+
+	FromFlag = &cli.StringFlag{
+		Name:  "from",
+		Usage: "execution address sending the requests, to review requests without providing --private-key-file",
+	}
+
+	SubmitFlag = &cli.BoolFlag{
+		Name:  "submit",
+		Usage: "submits the requests to the execution node, otherwise the requests are only built and reviewed",
+	}
+
+	ValidatorsFlag = &cli.StringSliceFlag{
+		Name:  "validators",
+		Usage: "comma separated list of indices or hex encoded public keys of the validators",
+	}
+
+	AmountFlag = &cli.Uint64Flag{
+		Name:  "amount-gwei",
+		Usage: "amount to withdraw from each validator in gwei, WARNING: an amount of 0 requests the full exit of the validators",
+	}
+
+	SourceValidatorsFlag = &cli.StringSliceFlag{
+		Name:  "source-validators",
+		Usage: "comma separated list of indices or hex encoded public keys of the validators to consolidate into the target validator",
+	}
+
+	TargetValidatorFlag = &cli.StringFlag{
+		Name:  "target-validator",
+		Usage: "index or hex encoded public key of the validator to consolidate into, use it as the only source validator to switch it to compounding withdrawal credentials",
+	}
 )
 
 var Commands = []*cli.Command{
@@ -144,6 +185,62 @@ var Commands = []*cli.Command{
 					return nil
 				},
 			},
+			{
+				Name:    "withdrawal-request",
+				Aliases: []string{"wr"},
+				Usage:   "Build, review and submit EIP-7002 execution layer withdrawal requests, for partial withdrawals or full exits of validators.",
+				Flags: []cli.Flag{
+					BeaconHostFlag,
+					ExecutionEndpointFlag,
+					PrivateKeyFileFlag,
+					FromFlag,
+					ValidatorsFlag,
+					AmountFlag,
+					SubmitFlag,
+					cmd.ConfigFileFlag,
+					cmd.AcceptTosFlag,
+				},
+				Before: func(cliCtx *cli.Context) error {
+					if err := cmd.LoadFlagsFromConfig(cliCtx, cliCtx.Command.Flags); err != nil {
+						return err
+					}
+					return tos.VerifyTosAcceptedOrPrompt(cliCtx)
+				},
+				Action: func(cliCtx *cli.Context) error {
+					if err := submitWithdrawalRequests(cliCtx); err != nil {
+						log.WithError(err).Fatal("Could not submit withdrawal requests")
+					}
+					return nil
+				},
+			},
+			{
+				Name:    "consolidation-request",
+				Aliases: []string{"consolidate", "cr"},
+				Usage:   "Build, review and submit EIP-7251 consolidation requests, to consolidate validators or switch them to compounding withdrawal credentials.",
+				Flags: []cli.Flag{
+					BeaconHostFlag,
+					ExecutionEndpointFlag,
+					PrivateKeyFileFlag,
+					FromFlag,
+					SourceValidatorsFlag,
+					TargetValidatorFlag,
+					SubmitFlag,
+					cmd.ConfigFileFlag,
+					cmd.AcceptTosFlag,
+				},
+				Before: func(cliCtx *cli.Context) error {
+					if err := cmd.LoadFlagsFromConfig(cliCtx, cliCtx.Command.Flags); err != nil {
+						return err
+					}
+					return tos.VerifyTosAcceptedOrPrompt(cliCtx)
+				},
+				Action: func(cliCtx *cli.Context) error {
+					if err := submitConsolidationRequests(cliCtx); err != nil {
+						log.WithError(err).Fatal("Could not submit consolidation requests")
+					}
+					return nil
+				},
+			},
 			{
 				Name:    "exit",
 				Aliases: []string{"e", "voluntary-exit"},
@@ -0,0 +1,92 @@
+package validator
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing/trace"
+	log "github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+)
+
+// submitConsolidationRequests builds an EIP-7251 consolidation request of each of the given source validators into
+// the target validator, checks them against the head state and submits them to the consolidation request contract.
+// A source validator which is also the target is switched to compounding withdrawal credentials.
+func submitConsolidationRequests(c *cli.Context) error {
+	ctx, span := trace.StartSpan(c.Context, "validator.submitConsolidationRequests")
+	defer span.End()
+	if !c.IsSet(SourceValidatorsFlag.Name) {
+		return errNoFlag(SourceValidatorsFlag.Name)
+	}
+	if !c.IsSet(TargetValidatorFlag.Name) {
+		return errNoFlag(TargetValidatorFlag.Name)
+	}
+	env, err := newRequestEnv(ctx, c)
+	if err != nil {
+		return err
+	}
+	defer env.close()
+	sources, err := env.validators(ctx, c.StringSlice(SourceValidatorsFlag.Name))
+	if err != nil {
+		return err
+	}
+	targets, err := env.validators(ctx, []string{c.String(TargetValidatorFlag.Name)})
+	if err != nil {
+		return err
+	}
+	target := targets[0]
+	st := env.state
+	log.WithFields(log.Fields{
+		"pendingConsolidations":          fmt.Sprintf("%d/%d", len(st.pendingConsolidations), st.spec.pendingConsolidationsLimit),
+		"consolidationChurnPerEpochGwei": st.spec.consolidationChurnLimit(st.activeBalance),
+	}).Info("Consolidation queue of the beacon chain")
+
+	requests := make([]*executionRequest, len(sources))
+	for i, source := range sources {
+		description := fmt.Sprintf("Consolidation of validator %d (%#x) into validator %d (%#x)", source.index, source.pubkey, target.index, target.pubkey)
+		if source.index == target.index {
+			description = fmt.Sprintf("Switch of validator %d (%#x) to compounding withdrawal credentials", source.index, source.pubkey)
+		}
+		requests[i] = &executionRequest{
+			description: description,
+			data:        consolidationRequestData(source.pubkey, target.pubkey),
+			problems:    st.checkConsolidationRequest(source, target, env.sender),
+		}
+	}
+	return env.processRequests(ctx, c, consolidationRequestContract, requests)
+}
+
+// consolidationRequestData encodes the call data of a consolidation request: the source public key followed by the
+// target public key.
+func consolidationRequestData(source, target []byte) []byte {
+	return append(append([]byte{}, source...), target...)
+}
+
+// checkConsolidationRequest returns the reasons the beacon chain would ignore the consolidation request, following
+// process_consolidation_request of the consensus specification.
+func (s *requestState) checkConsolidationRequest(source, target *validatorInfo, sender common.Address) []string {
+	problems := s.checkSender(source, sender)
+	if source.index == target.index {
+		if source.withdrawalCredentials[0] == s.spec.compoundingWithdrawalPrefixByte {
+			problems = append(problems, fmt.Sprintf("validator %d already has compounding withdrawal credentials", source.index))
+		}
+		return problems
+	}
+	if uint64(len(s.pendingConsolidations)) >= s.spec.pendingConsolidationsLimit {
+		problems = append(problems, "the pending consolidations queue is full")
+	}
+	if churn := s.spec.consolidationChurnLimit(s.activeBalance); churn <= s.spec.minActivationBalance {
+		problems = append(problems, fmt.Sprintf("the consolidation churn limit of %d gwei is too low for consolidations", churn))
+	}
+	if target.withdrawalCredentials[0] != s.spec.compoundingWithdrawalPrefixByte {
+		problems = append(problems, fmt.Sprintf("target validator %d does not have compounding withdrawal credentials", target.index))
+	}
+	if target.status != "active_ongoing" {
+		problems = append(problems, fmt.Sprintf("target validator %d is %s, not active_ongoing", target.index, target.status))
+	}
+	problems = append(problems, s.checkActiveLongEnough(source)...)
+	if pending := s.pendingBalanceToWithdraw(source.index); pending > 0 {
+		problems = append(problems, fmt.Sprintf("validator %d can not be consolidated before its pending partial withdrawals of %d gwei", source.index, pending))
+	}
+	return problems
+}
@@ -0,0 +1,414 @@
+package validator
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/logrusorgru/aurora"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/api/client"
+	"github.com/prysmaticlabs/prysm/v5/api/client/beacon"
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	log "github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+)
+
+var (
+	// withdrawalRequestContract is the address of the EIP-7002 execution layer withdrawal request contract.
+	withdrawalRequestContract = common.HexToAddress("0x00000961Ef480Eb55e80D19ad83579A64c007002")
+	// consolidationRequestContract is the address of the EIP-7251 consolidation request contract.
+	consolidationRequestContract = common.HexToAddress("0x0000BBdDc7CE488642fb579F8B00f3a590007251")
+)
+
+// executionRequest is the call data of a request to one of the execution layer request contracts, along with
+// the reasons the beacon chain would ignore it, if any.
+type executionRequest struct {
+	description string
+	data        []byte
+	problems    []string
+}
+
+// electraSpec holds the configuration values of the beacon node which are needed to check execution layer requests.
+type electraSpec struct {
+	electraForkEpoch                uint64
+	slotsPerEpoch                   uint64
+	secondsPerSlot                  uint64
+	shardCommitteePeriod            uint64
+	minActivationBalance            uint64
+	effectiveBalanceIncrement       uint64
+	minPerEpochChurnLimitElectra    uint64
+	maxPerEpochActivationExitChurn  uint64
+	churnLimitQuotient              uint64
+	pendingPartialWithdrawalsLimit  uint64
+	pendingConsolidationsLimit      uint64
+	compoundingWithdrawalPrefixByte byte
+	eth1AddressWithdrawalPrefixByte byte
+}
+
+func specFromConfig(data map[string]interface{}) (*electraSpec, error) {
+	s := &electraSpec{
+		compoundingWithdrawalPrefixByte: params.BeaconConfig().CompoundingWithdrawalPrefixByte,
+		eth1AddressWithdrawalPrefixByte: params.BeaconConfig().ETH1AddressWithdrawalPrefixByte,
+	}
+	for key, field := range map[string]*uint64{
+		"ELECTRA_FORK_EPOCH":                        &s.electraForkEpoch,
+		"SLOTS_PER_EPOCH":                           &s.slotsPerEpoch,
+		"SECONDS_PER_SLOT":                          &s.secondsPerSlot,
+		"SHARD_COMMITTEE_PERIOD":                    &s.shardCommitteePeriod,
+		"MIN_ACTIVATION_BALANCE":                    &s.minActivationBalance,
+		"EFFECTIVE_BALANCE_INCREMENT":               &s.effectiveBalanceIncrement,
+		"MIN_PER_EPOCH_CHURN_LIMIT_ELECTRA":         &s.minPerEpochChurnLimitElectra,
+		"MAX_PER_EPOCH_ACTIVATION_EXIT_CHURN_LIMIT": &s.maxPerEpochActivationExitChurn,
+		"CHURN_LIMIT_QUOTIENT":                      &s.churnLimitQuotient,
+		"PENDING_PARTIAL_WITHDRAWALS_LIMIT":         &s.pendingPartialWithdrawalsLimit,
+		"PENDING_CONSOLIDATIONS_LIMIT":              &s.pendingConsolidationsLimit,
+	} {
+		v, ok := data[key].(string)
+		if !ok {
+			return nil, fmt.Errorf("configs used on beacon node do not contain %s", key)
+		}
+		n, err := strconv.ParseUint(v, 10, 64)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not convert %s to a number", key)
+		}
+		*field = n
+	}
+	if s.slotsPerEpoch == 0 || s.secondsPerSlot == 0 || s.churnLimitQuotient == 0 || s.effectiveBalanceIncrement == 0 {
+		return nil, errors.New("configs used on beacon node are invalid")
+	}
+	return s, nil
+}
+
+// balanceChurnLimit, activationExitChurnLimit and consolidationChurnLimit follow the consensus specification
+// functions of the same name, for the given total active balance.
+func (s *electraSpec) balanceChurnLimit(activeBalance uint64) uint64 {
+	churn := max(s.minPerEpochChurnLimitElectra, activeBalance/s.churnLimitQuotient)
+	return churn - churn%s.effectiveBalanceIncrement
+}
+
+func (s *electraSpec) activationExitChurnLimit(activeBalance uint64) uint64 {
+	return min(s.maxPerEpochActivationExitChurn, s.balanceChurnLimit(activeBalance))
+}
+
+func (s *electraSpec) consolidationChurnLimit(activeBalance uint64) uint64 {
+	return s.balanceChurnLimit(activeBalance) - s.activationExitChurnLimit(activeBalance)
+}
+
+// validatorInfo is the decoded form of a validator returned by the beacon API.
+type validatorInfo struct {
+	index                 uint64
+	pubkey                []byte
+	withdrawalCredentials []byte
+	balance               uint64
+	effectiveBalance      uint64
+	activationEpoch       uint64
+	status                string
+}
+
+func parseValidator(v *structs.ValidatorContainer) (*validatorInfo, error) {
+	if v == nil || v.Validator == nil {
+		return nil, errors.New("empty validator")
+	}
+	var err error
+	info := &validatorInfo{status: v.Status}
+	if info.index, err = strconv.ParseUint(v.Index, 10, 64); err != nil {
+		return nil, errors.Wrap(err, "invalid validator index")
+	}
+	if info.pubkey, err = hexutil.Decode(v.Validator.Pubkey); err != nil {
+		return nil, errors.Wrap(err, "invalid validator public key")
+	}
+	if info.withdrawalCredentials, err = hexutil.Decode(v.Validator.WithdrawalCredentials); err != nil || len(info.withdrawalCredentials) != 32 {
+		return nil, errors.Errorf("invalid withdrawal credentials %s", v.Validator.WithdrawalCredentials)
+	}
+	if info.balance, err = strconv.ParseUint(v.Balance, 10, 64); err != nil {
+		return nil, errors.Wrap(err, "invalid validator balance")
+	}
+	if info.effectiveBalance, err = strconv.ParseUint(v.Validator.EffectiveBalance, 10, 64); err != nil {
+		return nil, errors.Wrap(err, "invalid validator effective balance")
+	}
+	if info.activationEpoch, err = strconv.ParseUint(v.Validator.ActivationEpoch, 10, 64); err != nil {
+		return nil, errors.Wrap(err, "invalid validator activation epoch")
+	}
+	return info, nil
+}
+
+// requestState is the part of the beacon state execution layer requests are checked against.
+type requestState struct {
+	spec                      *electraSpec
+	epoch                     uint64
+	activeBalance             uint64
+	pendingPartialWithdrawals []*structs.PendingPartialWithdrawal
+	pendingConsolidations     []*structs.PendingConsolidation
+}
+
+// pendingBalanceToWithdraw returns the amount queued for partial withdrawal from the given validator.
+func (s *requestState) pendingBalanceToWithdraw(index uint64) uint64 {
+	total := uint64(0)
+	for _, w := range s.pendingPartialWithdrawals {
+		if w.Index != strconv.FormatUint(index, 10) {
+			continue
+		}
+		amount, err := strconv.ParseUint(w.Amount, 10, 64)
+		if err != nil {
+			continue
+		}
+		total += amount
+	}
+	return total
+}
+
+// checkSender verifies that the validator has execution withdrawal credentials for the sender's address, and is
+// active and not exiting. Requests of any other sender or for any other validator are ignored by the beacon chain.
+func (s *requestState) checkSender(v *validatorInfo, sender common.Address) []string {
+	var problems []string
+	prefix := v.withdrawalCredentials[0]
+	if prefix != s.spec.eth1AddressWithdrawalPrefixByte && prefix != s.spec.compoundingWithdrawalPrefixByte {
+		problems = append(problems, fmt.Sprintf("validator %d does not have execution withdrawal credentials", v.index))
+	} else if !bytes.Equal(v.withdrawalCredentials[12:], sender.Bytes()) {
+		problems = append(problems, fmt.Sprintf("withdrawal address of validator %d is %#x, not the sender %s", v.index, v.withdrawalCredentials[12:], sender.Hex()))
+	}
+	if v.status != "active_ongoing" {
+		problems = append(problems, fmt.Sprintf("validator %d is %s, not active_ongoing", v.index, v.status))
+	}
+	return problems
+}
+
+// checkActiveLongEnough verifies that the validator has been active for the shard committee period, as required
+// to exit.
+func (s *requestState) checkActiveLongEnough(v *validatorInfo) []string {
+	if s.epoch < v.activationEpoch+s.spec.shardCommitteePeriod {
+		return []string{fmt.Sprintf("validator %d can not exit before epoch %d", v.index, v.activationEpoch+s.spec.shardCommitteePeriod)}
+	}
+	return nil
+}
+
+// requestEnv holds the clients and the state shared by the execution layer request commands.
+type requestEnv struct {
+	beacon *beacon.Client
+	el     *ethclient.Client
+	sender common.Address
+	key    *ecdsa.PrivateKey
+	state  *requestState
+}
+
+// newRequestEnv connects to the beacon and execution nodes, and loads the part of the beacon state needed to check
+// the requests.
+func newRequestEnv(ctx context.Context, c *cli.Context) (*requestEnv, error) {
+	env := &requestEnv{}
+	if c.IsSet(PrivateKeyFileFlag.Name) {
+		key, err := crypto.LoadECDSA(c.String(PrivateKeyFileFlag.Name))
+		if err != nil {
+			return nil, errors.Wrap(err, "could not load private key")
+		}
+		env.key = key
+		env.sender = crypto.PubkeyToAddress(key.PublicKey)
+		if c.IsSet(FromFlag.Name) && common.HexToAddress(c.String(FromFlag.Name)) != env.sender {
+			return nil, fmt.Errorf("--%s does not match the address of the private key %s", FromFlag.Name, env.sender.Hex())
+		}
+	} else if c.IsSet(FromFlag.Name) {
+		if !common.IsHexAddress(c.String(FromFlag.Name)) {
+			return nil, fmt.Errorf("invalid --%s address", FromFlag.Name)
+		}
+		env.sender = common.HexToAddress(c.String(FromFlag.Name))
+	} else {
+		return nil, fmt.Errorf("one of the --%s or --%s flags is required", PrivateKeyFileFlag.Name, FromFlag.Name)
+	}
+	if c.Bool(SubmitFlag.Name) && env.key == nil {
+		return nil, errNoFlag(PrivateKeyFileFlag.Name)
+	}
+
+	// The total active balance is computed from the list of active validators, which can be large.
+	bc, err := beacon.NewClient(c.String(BeaconHostFlag.Name), client.WithMaxBodySize(client.MaxBodySizeState))
+	if err != nil {
+		return nil, err
+	}
+	env.beacon = bc
+	spec, err := bc.GetConfigSpec(ctx)
+	if err != nil {
+		return nil, err
+	}
+	data, ok := spec.Data.(map[string]interface{})
+	if !ok {
+		return nil, errors.New("config has incorrect structure")
+	}
+	st := &requestState{}
+	if st.spec, err = specFromConfig(data); err != nil {
+		return nil, err
+	}
+	genesis, err := bc.GetGenesis(ctx)
+	if err != nil {
+		return nil, err
+	}
+	genesisTime, err := strconv.ParseInt(genesis.GenesisTime, 10, 64)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid genesis time")
+	}
+	if now := time.Now().Unix(); now > genesisTime {
+		st.epoch = uint64(now-genesisTime) / st.spec.secondsPerSlot / st.spec.slotsPerEpoch
+	}
+	if st.epoch < st.spec.electraForkEpoch {
+		return nil, errors.New("execution layer requests are only available after the Electra/Prague hard fork")
+	}
+	active, err := bc.GetValidators(ctx, beacon.IdHead, nil, []string{"active"})
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get active validators")
+	}
+	for _, v := range active {
+		if v.Validator == nil {
+			continue
+		}
+		b, err := strconv.ParseUint(v.Validator.EffectiveBalance, 10, 64)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid validator effective balance")
+		}
+		st.activeBalance += b
+	}
+	if st.pendingPartialWithdrawals, err = bc.GetPendingPartialWithdrawals(ctx, beacon.IdHead); err != nil {
+		return nil, err
+	}
+	if st.pendingConsolidations, err = bc.GetPendingConsolidations(ctx, beacon.IdHead); err != nil {
+		return nil, err
+	}
+	env.state = st
+
+	env.el, err = ethclient.DialContext(ctx, c.String(ExecutionEndpointFlag.Name))
+	if err != nil {
+		return nil, errors.Wrap(err, "could not connect to the execution node")
+	}
+	return env, nil
+}
+
+func (env *requestEnv) close() {
+	if env.el != nil {
+		env.el.Close()
+	}
+}
+
+// validators looks up the given validators, by index or hex encoded public key, in the head state.
+func (env *requestEnv) validators(ctx context.Context, ids []string) ([]*validatorInfo, error) {
+	resp, err := env.beacon.GetValidators(ctx, beacon.IdHead, ids, nil)
+	if err != nil {
+		return nil, err
+	}
+	byId := make(map[string]*validatorInfo, 2*len(resp))
+	for _, v := range resp {
+		info, err := parseValidator(v)
+		if err != nil {
+			return nil, err
+		}
+		byId[strconv.FormatUint(info.index, 10)] = info
+		byId[hexutil.Encode(info.pubkey)] = info
+	}
+	validators := make([]*validatorInfo, len(ids))
+	for i, id := range ids {
+		v, ok := byId[strings.ToLower(id)]
+		if !ok {
+			return nil, fmt.Errorf("validator %s not found", id)
+		}
+		validators[i] = v
+	}
+	return validators, nil
+}
+
+// requestFee returns the fee currently charged by the given request contract, in wei.
+func requestFee(ctx context.Context, el *ethclient.Client, contract common.Address) (*big.Int, error) {
+	b, err := el.CallContract(ctx, ethereum.CallMsg{To: &contract}, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not get the fee of request contract %s", contract.Hex())
+	}
+	if len(b) != 32 {
+		return nil, errors.Errorf("unexpected fee of request contract %s: %#x", contract.Hex(), b)
+	}
+	return new(big.Int).SetBytes(b), nil
+}
+
+// processRequests prints the review of the requests and the fee the contract charges for each of them, and
+// submits them as transactions when the submit flag is given and none of the requests would be ignored.
+func (env *requestEnv) processRequests(ctx context.Context, c *cli.Context, contract common.Address, requests []*executionRequest) error {
+	au := aurora.NewAurora(true)
+	fee, err := requestFee(ctx, env.el, contract)
+	if err != nil {
+		return err
+	}
+	rejected := 0
+	for _, r := range requests {
+		fmt.Println(r.description)
+		fmt.Printf("  contract: %s\n  call data: %#x\n", contract.Hex(), r.data)
+		for _, p := range r.problems {
+			fmt.Println("  " + au.Red("WILL BE IGNORED: "+p).String())
+		}
+		if len(r.problems) > 0 {
+			rejected++
+		}
+	}
+	fmt.Printf("Request fee: %s wei per request, %s wei in total, sent from %s\n", fee, new(big.Int).Mul(fee, big.NewInt(int64(len(requests)))), env.sender.Hex())
+	if rejected > 0 {
+		return fmt.Errorf("%d of the %d requests would be ignored by the beacon chain, the fee would be lost", rejected, len(requests))
+	}
+	if !c.Bool(SubmitFlag.Name) {
+		log.Infof("Requests were not submitted, use --%s to send them to the execution node.", SubmitFlag.Name)
+		return nil
+	}
+
+	chainID, err := env.el.ChainID(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not get chain id")
+	}
+	nonce, err := env.el.PendingNonceAt(ctx, env.sender)
+	if err != nil {
+		return errors.Wrap(err, "could not get nonce")
+	}
+	for _, r := range requests {
+		tx, err := env.sendRequest(ctx, chainID, nonce, contract, r.data, fee)
+		if err != nil {
+			return errors.Wrapf(err, "could not submit request: %s", r.description)
+		}
+		nonce++
+		log.WithField("txHash", tx.Hash().Hex()).Info("Submitted request: " + r.description)
+	}
+	return nil
+}
+
+// sendRequest signs and sends a transaction calling the request contract with the given data and fee.
+func (env *requestEnv) sendRequest(ctx context.Context, chainID *big.Int, nonce uint64, contract common.Address, data []byte, fee *big.Int) (*types.Transaction, error) {
+	gas, err := env.el.EstimateGas(ctx, ethereum.CallMsg{From: env.sender, To: &contract, Value: fee, Data: data})
+	if err != nil {
+		return nil, errors.Wrap(err, "could not estimate gas")
+	}
+	tip, err := env.el.SuggestGasTipCap(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get gas tip")
+	}
+	head, err := env.el.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get head block")
+	}
+	feeCap := new(big.Int).Add(tip, new(big.Int).Mul(head.BaseFee, big.NewInt(2)))
+	tx, err := types.SignNewTx(env.key, types.LatestSignerForChainID(chainID), &types.DynamicFeeTx{
+		ChainID:   chainID,
+		Nonce:     nonce,
+		GasTipCap: tip,
+		GasFeeCap: feeCap,
+		Gas:       gas,
+		To:        &contract,
+		Value:     fee,
+		Data:      data,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "could not sign transaction")
+	}
+	return tx, env.el.SendTransaction(ctx, tx)
+}
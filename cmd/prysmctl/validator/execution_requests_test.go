@@ -0,0 +1,219 @@
+package validator
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func testElectraSpec(t *testing.T) *electraSpec {
+	cfg := params.MainnetConfig()
+	data := map[string]interface{}{}
+	for k, v := range map[string]uint64{
+		"ELECTRA_FORK_EPOCH":                        0,
+		"SLOTS_PER_EPOCH":                           uint64(cfg.SlotsPerEpoch),
+		"SECONDS_PER_SLOT":                          cfg.SecondsPerSlot,
+		"SHARD_COMMITTEE_PERIOD":                    uint64(cfg.ShardCommitteePeriod),
+		"MIN_ACTIVATION_BALANCE":                    cfg.MinActivationBalance,
+		"EFFECTIVE_BALANCE_INCREMENT":               cfg.EffectiveBalanceIncrement,
+		"MIN_PER_EPOCH_CHURN_LIMIT_ELECTRA":         cfg.MinPerEpochChurnLimitElectra,
+		"MAX_PER_EPOCH_ACTIVATION_EXIT_CHURN_LIMIT": cfg.MaxPerEpochActivationExitChurnLimit,
+		"CHURN_LIMIT_QUOTIENT":                      cfg.ChurnLimitQuotient,
+		"PENDING_PARTIAL_WITHDRAWALS_LIMIT":         cfg.PendingPartialWithdrawalsLimit,
+		"PENDING_CONSOLIDATIONS_LIMIT":              cfg.PendingConsolidationsLimit,
+	} {
+		data[k] = strconv.FormatUint(v, 10)
+	}
+	s, err := specFromConfig(data)
+	require.NoError(t, err)
+	return s
+}
+
+func TestSpecFromConfig(t *testing.T) {
+	s := testElectraSpec(t)
+	assert.Equal(t, params.MainnetConfig().MinActivationBalance, s.minActivationBalance)
+	assert.Equal(t, params.MainnetConfig().CompoundingWithdrawalPrefixByte, s.compoundingWithdrawalPrefixByte)
+
+	_, err := specFromConfig(map[string]interface{}{"ELECTRA_FORK_EPOCH": "1"})
+	require.ErrorContains(t, "configs used on beacon node do not contain", err)
+}
+
+func TestElectraSpec_ChurnLimits(t *testing.T) {
+	s := testElectraSpec(t)
+	gwei := uint64(1_000_000_000)
+	// 5M ETH staked: the balance churn is at its minimum, and all of it goes to activations and exits.
+	assert.Equal(t, 128*gwei, s.balanceChurnLimit(5_000_000*gwei))
+	assert.Equal(t, uint64(0), s.consolidationChurnLimit(5_000_000*gwei))
+	// 34M ETH staked: 34M / 65536 = 518.8 ETH, of which 256 ETH go to activations and exits.
+	assert.Equal(t, 518*gwei, s.balanceChurnLimit(34_000_000*gwei))
+	assert.Equal(t, 256*gwei, s.activationExitChurnLimit(34_000_000*gwei))
+	assert.Equal(t, 262*gwei, s.consolidationChurnLimit(34_000_000*gwei))
+}
+
+func testValidator(index uint64, prefix byte, address common.Address, balance uint64) *validatorInfo {
+	creds := make([]byte, 32)
+	creds[0] = prefix
+	copy(creds[12:], address.Bytes())
+	pubkey := make([]byte, 48)
+	pubkey[0] = byte(index)
+	return &validatorInfo{
+		index:                 index,
+		pubkey:                pubkey,
+		withdrawalCredentials: creds,
+		balance:               balance,
+		effectiveBalance:      min(balance, 2048_000_000_000),
+		activationEpoch:       0,
+		status:                "active_ongoing",
+	}
+}
+
+func TestCheckWithdrawalRequest(t *testing.T) {
+	sender := common.HexToAddress("0x1111111111111111111111111111111111111111")
+	other := common.HexToAddress("0x2222222222222222222222222222222222222222")
+	st := &requestState{spec: testElectraSpec(t), epoch: 1000}
+	minBalance := st.spec.minActivationBalance
+
+	tests := []struct {
+		name      string
+		validator *validatorInfo
+		amount    uint64
+		pending   []*structs.PendingPartialWithdrawal
+		problems  int
+	}{
+		{name: "partial withdrawal", validator: testValidator(1, 0x02, sender, minBalance+10), amount: 5},
+		{name: "full exit of 0x01 validator", validator: testValidator(1, 0x01, sender, minBalance), amount: 0},
+		{name: "partial withdrawal of 0x01 validator", validator: testValidator(1, 0x01, sender, minBalance+10), amount: 5, problems: 1},
+		{name: "other withdrawal address", validator: testValidator(1, 0x02, other, minBalance+10), amount: 5, problems: 1},
+		{name: "no excess balance", validator: testValidator(1, 0x02, sender, minBalance), amount: 5, problems: 1},
+		{
+			name:      "excess balance already pending",
+			validator: testValidator(1, 0x02, sender, minBalance+10),
+			amount:    5,
+			pending:   []*structs.PendingPartialWithdrawal{{Index: "1", Amount: "10", WithdrawableEpoch: "1001"}},
+			problems:  1,
+		},
+		{
+			name:      "full exit with pending withdrawals",
+			validator: testValidator(1, 0x02, sender, minBalance+10),
+			amount:    0,
+			pending:   []*structs.PendingPartialWithdrawal{{Index: "1", Amount: "10", WithdrawableEpoch: "1001"}},
+			problems:  1,
+		},
+		{
+			name: "exiting validator",
+			validator: func() *validatorInfo {
+				v := testValidator(1, 0x02, sender, minBalance+10)
+				v.status = "active_exiting"
+				return v
+			}(),
+			amount:   5,
+			problems: 1,
+		},
+		{
+			name: "recently activated validator",
+			validator: func() *validatorInfo {
+				v := testValidator(1, 0x02, sender, minBalance+10)
+				v.activationEpoch = 900
+				return v
+			}(),
+			amount:   0,
+			problems: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			st.pendingPartialWithdrawals = tt.pending
+			problems := st.checkWithdrawalRequest(tt.validator, sender, tt.amount)
+			require.Equal(t, tt.problems, len(problems), fmt.Sprintf("%v", problems))
+		})
+	}
+}
+
+func TestCheckConsolidationRequest(t *testing.T) {
+	sender := common.HexToAddress("0x1111111111111111111111111111111111111111")
+	gwei := uint64(1_000_000_000)
+	st := &requestState{spec: testElectraSpec(t), epoch: 1000, activeBalance: 34_000_000 * gwei}
+	minBalance := st.spec.minActivationBalance
+
+	source := testValidator(1, 0x01, sender, minBalance)
+	target := testValidator(2, 0x02, sender, minBalance)
+	assert.Equal(t, 0, len(st.checkConsolidationRequest(source, target, sender)))
+	// Switch to compounding withdrawal credentials.
+	assert.Equal(t, 0, len(st.checkConsolidationRequest(source, source, sender)))
+	assert.Equal(t, 1, len(st.checkConsolidationRequest(target, target, sender)))
+	// The target must have compounding withdrawal credentials.
+	assert.Equal(t, 1, len(st.checkConsolidationRequest(source, testValidator(3, 0x01, sender, minBalance), sender)))
+	// The request is sent by the withdrawal address of the source, the target may have any other address.
+	assert.Equal(t, 0, len(st.checkConsolidationRequest(source, testValidator(3, 0x02, common.Address{}, minBalance), sender)))
+	assert.Equal(t, 1, len(st.checkConsolidationRequest(source, target, common.Address{})))
+
+	st.pendingPartialWithdrawals = []*structs.PendingPartialWithdrawal{{Index: "1", Amount: "10", WithdrawableEpoch: "1001"}}
+	assert.Equal(t, 1, len(st.checkConsolidationRequest(source, target, sender)))
+	st.pendingPartialWithdrawals = nil
+
+	// Consolidations are disabled when the churn is too low.
+	st.activeBalance = 5_000_000 * gwei
+	assert.Equal(t, 1, len(st.checkConsolidationRequest(source, target, sender)))
+}
+
+func TestRequestData(t *testing.T) {
+	pubkey := make([]byte, 48)
+	pubkey[0] = 0xaa
+	data := withdrawalRequestData(pubkey, 1_000_000_000)
+	require.Equal(t, 56, len(data))
+	assert.DeepEqual(t, pubkey, data[:48])
+	assert.DeepEqual(t, []byte{0, 0, 0, 0, 0x3b, 0x9a, 0xca, 0x00}, data[48:])
+
+	target := make([]byte, 48)
+	target[0] = 0xbb
+	data = consolidationRequestData(pubkey, target)
+	require.Equal(t, 96, len(data))
+	assert.DeepEqual(t, pubkey, data[:48])
+	assert.DeepEqual(t, target, data[48:])
+}
+
+func TestRequestFee(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			ID     json.RawMessage   `json:"id"`
+			Method string            `json:"method"`
+			Params []json.RawMessage `json:"params"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		require.Equal(t, "eth_call", req.Method)
+		var call struct {
+			To common.Address `json:"to"`
+		}
+		require.NoError(t, json.Unmarshal(req.Params[0], &call))
+		require.Equal(t, withdrawalRequestContract, call.To)
+		fee := make([]byte, 32)
+		fee[31] = 3
+		w.Header().Set("Content-Type", "application/json")
+		require.NoError(t, json.NewEncoder(w).Encode(map[string]interface{}{
+			"jsonrpc": "2.0",
+			"id":      req.ID,
+			"result":  hexutil.Encode(fee),
+		}))
+	}))
+	defer srv.Close()
+	el, err := ethclient.Dial(srv.URL)
+	require.NoError(t, err)
+	defer el.Close()
+
+	fee, err := requestFee(context.Background(), el, withdrawalRequestContract)
+	require.NoError(t, err)
+	assert.Equal(t, 0, fee.Cmp(big.NewInt(3)))
+}
@@ -0,0 +1,88 @@
+package validator
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing/trace"
+	log "github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+)
+
+// submitWithdrawalRequests builds an EIP-7002 withdrawal request for each of the given validators, checks them
+// against the head state and submits them to the withdrawal request contract.
+func submitWithdrawalRequests(c *cli.Context) error {
+	ctx, span := trace.StartSpan(c.Context, "validator.submitWithdrawalRequests")
+	defer span.End()
+	if !c.IsSet(ValidatorsFlag.Name) {
+		return errNoFlag(ValidatorsFlag.Name)
+	}
+	if !c.IsSet(AmountFlag.Name) {
+		return errNoFlag(AmountFlag.Name)
+	}
+	env, err := newRequestEnv(ctx, c)
+	if err != nil {
+		return err
+	}
+	defer env.close()
+	validators, err := env.validators(ctx, c.StringSlice(ValidatorsFlag.Name))
+	if err != nil {
+		return err
+	}
+	st := env.state
+	log.WithFields(log.Fields{
+		"pendingPartialWithdrawals": fmt.Sprintf("%d/%d", len(st.pendingPartialWithdrawals), st.spec.pendingPartialWithdrawalsLimit),
+		"exitChurnPerEpochGwei":     st.spec.activationExitChurnLimit(st.activeBalance),
+	}).Info("Withdrawal queue of the beacon chain")
+
+	amount := c.Uint64(AmountFlag.Name)
+	requests := make([]*executionRequest, len(validators))
+	for i, v := range validators {
+		description := fmt.Sprintf("FULL EXIT of validator %d (%#x)", v.index, v.pubkey)
+		if amount > 0 {
+			description = fmt.Sprintf("Withdrawal of %d gwei from validator %d (%#x)", amount, v.index, v.pubkey)
+		}
+		requests[i] = &executionRequest{
+			description: description,
+			data:        withdrawalRequestData(v.pubkey, amount),
+			problems:    st.checkWithdrawalRequest(v, env.sender, amount),
+		}
+	}
+	return env.processRequests(ctx, c, withdrawalRequestContract, requests)
+}
+
+// withdrawalRequestData encodes the call data of a withdrawal request: the validator public key followed by the
+// amount in gwei, as a big endian integer. An amount of 0 requests the full exit of the validator.
+func withdrawalRequestData(pubkey []byte, amount uint64) []byte {
+	return binary.BigEndian.AppendUint64(append([]byte{}, pubkey...), amount)
+}
+
+// checkWithdrawalRequest returns the reasons the beacon chain would ignore the withdrawal request, following
+// process_withdrawal_request of the consensus specification.
+func (s *requestState) checkWithdrawalRequest(v *validatorInfo, sender common.Address, amount uint64) []string {
+	problems := s.checkSender(v, sender)
+	problems = append(problems, s.checkActiveLongEnough(v)...)
+	pending := s.pendingBalanceToWithdraw(v.index)
+	if amount == 0 {
+		if pending > 0 {
+			problems = append(problems, fmt.Sprintf("validator %d can not exit before its pending partial withdrawals of %d gwei", v.index, pending))
+		}
+		return problems
+	}
+	if v.withdrawalCredentials[0] != s.spec.compoundingWithdrawalPrefixByte {
+		problems = append(problems, fmt.Sprintf("validator %d does not have compounding withdrawal credentials, required for partial withdrawals", v.index))
+	}
+	if uint64(len(s.pendingPartialWithdrawals)) >= s.spec.pendingPartialWithdrawalsLimit {
+		problems = append(problems, "the pending partial withdrawals queue is full")
+	}
+	if v.effectiveBalance < s.spec.minActivationBalance {
+		problems = append(problems, fmt.Sprintf("effective balance of validator %d is below %d gwei", v.index, s.spec.minActivationBalance))
+	}
+	if v.balance <= s.spec.minActivationBalance+pending {
+		problems = append(problems, fmt.Sprintf("validator %d has no balance above %d gwei to withdraw", v.index, s.spec.minActivationBalance))
+	} else if excess := v.balance - s.spec.minActivationBalance - pending; amount > excess {
+		log.Warnf("Only %d gwei can be withdrawn from validator %d, the withdrawal will be capped", excess, v.index)
+	}
+	return problems
+}
@@ -81,6 +81,13 @@ var (
 		Name:  "disable-rewards-penalties-logging",
 		Usage: "Disables reward/penalty logging during cluster deployment.",
 	}
+	// PerformanceHistoryRetentionFlag defines the number of epochs of performance history kept in the validator database.
+	PerformanceHistoryRetentionFlag = &cli.Uint64Flag{
+		Name: "performance-history-retention",
+		Usage: "Number of epochs for which the per epoch duty outcomes and balance changes of the validator keys " +
+			"are kept in the validator database. 0 disables the performance history.",
+		Value: 6750, // 30 days.
+	}
 	// GraffitiFlag defines the graffiti value included in proposed blocks
 	GraffitiFlag = &cli.StringFlag{
 		Name:  "graffiti",
@@ -283,10 +290,13 @@ var (
 	}
 	// Web3SignerURLFlag defines the URL for a web3signer to connect to.
 	// example:--validators-external-signer-url=http://localhost:9000
+	// example with failover:--validators-external-signer-url=https://signer-a:9000,https://signer-b:9000
 	// web3signer documentation can be found in Consensys' web3signer project docs
 	Web3SignerURLFlag = &cli.StringFlag{
-		Name:    "validators-external-signer-url",
-		Usage:   "URL for consensys' web3signer software to use with the Prysm validator client.",
+		Name: "validators-external-signer-url",
+		Usage: "URL for consensys' web3signer software to use with the Prysm validator client. " +
+			"A comma separated list of URLs may be provided, in which case the signers are health checked and " +
+			"requests fail over to the next healthy signer in order. The signers must share a slashing protection database.",
 		Value:   "",
 		Aliases: []string{"remote-signer-url"},
 	}
@@ -308,6 +318,28 @@ var (
 		Value:   "",
 		Aliases: []string{"remote-signer-keys-file"},
 	}
+	// Web3SignerPublicKeysPollIntervalFlag enables polling web3signer for added and removed public keys.
+	// example:--validators-external-signer-public-keys-poll-interval=1m
+	Web3SignerPublicKeysPollIntervalFlag = &cli.DurationFlag{
+		Name: "validators-external-signer-public-keys-poll-interval",
+		Usage: "Interval at which web3signer's public keys api is polled so that duties start and stop for keys added to or removed from the signer. " +
+			"Polls the url given to --validators-external-signer-public-keys if set, otherwise the signer itself. Cannot be combined with a static list of public keys or a key file. Disabled when 0.",
+	}
+	// Web3SignerTLSClientCertFlag defines the client certificate presented to web3signer for mutual TLS.
+	Web3SignerTLSClientCertFlag = &cli.StringFlag{
+		Name:  "validators-external-signer-tls-client-cert",
+		Usage: "/path/to/client.crt presented to web3signer for mutual TLS. Requires --validators-external-signer-tls-client-key.",
+	}
+	// Web3SignerTLSClientKeyFlag defines the key of the client certificate presented to web3signer.
+	Web3SignerTLSClientKeyFlag = &cli.StringFlag{
+		Name:  "validators-external-signer-tls-client-key",
+		Usage: "/path/to/client.key of the certificate presented to web3signer for mutual TLS.",
+	}
+	// Web3SignerTLSCACertFlag defines the CA certificate the web3signer server certificates must be issued by.
+	Web3SignerTLSCACertFlag = &cli.StringFlag{
+		Name:  "validators-external-signer-tls-ca-cert",
+		Usage: "/path/to/ca.crt used to verify web3signer server certificates instead of the system certificate pool.",
+	}
 
 	// KeymanagerKindFlag defines the kind of keymanager desired by a user during wallet creation.
 	KeymanagerKindFlag = &cli.StringFlag{
@@ -334,6 +366,21 @@ var (
 		Usage: "Allows users to specify the output directory to export their slashing protection EIP-3076 standard JSON File.",
 		Value: "",
 	}
+	// SlashingProtectionExportPublicKeysFlag restricts a slashing protection export to a set of public keys.
+	SlashingProtectionExportPublicKeysFlag = &cli.StringFlag{
+		Name:  "slashing-protection-export-public-keys",
+		Usage: "Comma separated list of 0x-prefixed public keys to export the slashing protection history of. All keys are exported if not set.",
+	}
+	// SlashingProtectionExportMinimalFlag exports the minimal EIP-3076 interchange format.
+	SlashingProtectionExportMinimalFlag = &cli.BoolFlag{
+		Name:  "slashing-protection-export-minimal",
+		Usage: "Exports only the latest signed block and attestation of every public key, following the minimal EIP-3076 interchange format.",
+	}
+	// GenesisValidatorsRootFlag sets the genesis validators root a slashing protection file is verified against.
+	GenesisValidatorsRootFlag = &cli.StringFlag{
+		Name:  "genesis-validators-root",
+		Usage: "0x-prefixed genesis validators root of the chain. Defaults to the genesis validators root of the selected network.",
+	}
 	// GraffitiFileFlag specifies the file path to load graffiti values.
 	GraffitiFileFlag = &cli.StringFlag{
 		Name:  "graffiti-file",
@@ -389,6 +436,47 @@ var (
 		Usage: "To enable the use of prysm validator client in Distributed Validator Cluster",
 		Value: false,
 	}
+	// BeaconNodeQuorumFlag enables the usage of all the beacon nodes given to the validator client at the same time.
+	BeaconNodeQuorumFlag = &cli.BoolFlag{
+		Name: "beacon-node-quorum",
+		Usage: "Uses all the comma separated beacon nodes of --beacon-rpc-provider, or --beacon-rest-api-provider, at the same time " +
+			"instead of failing over from one to the next. Attestation data is selected by majority vote, the most valuable block " +
+			"is proposed, and signed objects are published to every healthy beacon node.",
+	}
+	// SlashingProtectionURLFlag sets the URL of a slashing protection server shared with other validator clients.
+	SlashingProtectionURLFlag = &cli.StringFlag{
+		Name: "slashing-protection-url",
+		Usage: "URL of a slashing protection server, started with the slashing-protection-server command, shared with " +
+			"other validator clients. Slashing protection data is held by the server instead of the local database.",
+	}
+	// SlashingProtectionClientIDFlag identifies the validator client to the slashing protection server.
+	SlashingProtectionClientIDFlag = &cli.StringFlag{
+		Name: "slashing-protection-client-id",
+		Usage: "Identifier of the validator client on the slashing protection server, which leases keys to it. " +
+			"Must be unique among the validator clients sharing the server. Defaults to the host name.",
+	}
+	// SlashingProtectionTokenFileFlag sets the file holding the bearer token of the slashing protection server.
+	SlashingProtectionTokenFileFlag = &cli.StringFlag{
+		Name:  "slashing-protection-token-file",
+		Usage: "Path to a file holding the bearer token shared by the slashing protection server and its validator clients.",
+	}
+	// SlashingProtectionCACertFlag sets the CA certificate the TLS certificate of the slashing protection server is verified against.
+	SlashingProtectionCACertFlag = &cli.StringFlag{
+		Name:  "slashing-protection-ca-cert",
+		Usage: "Path to the CA certificate the TLS certificate of the slashing protection server is verified against, for an https --slashing-protection-url. Defaults to the system's root CAs.",
+	}
+	// AuditLogDirFlag enables the signing audit log, and sets its directory.
+	AuditLogDirFlag = &cli.StringFlag{
+		Name: "audit-log-dir",
+		Usage: "Directory of an append-only, hash-chained audit log of every signing decision of the validator client. " +
+			"The log is disabled if this flag is not set.",
+	}
+	// AuditLogMaxSizeFlag sets the size above which the signing audit log file is rotated.
+	AuditLogMaxSizeFlag = &cli.IntFlag{
+		Name:  "audit-log-max-size",
+		Usage: "Size in megabytes above which the signing audit log file is rotated. Rotated files are kept.",
+		Value: 64,
+	}
 )
 
 // DefaultValidatorDir returns OS-specific default validator directory.
@@ -17,6 +17,7 @@ import (
 	dbcommands "github.com/prysmaticlabs/prysm/v5/cmd/validator/db"
 	"github.com/prysmaticlabs/prysm/v5/cmd/validator/flags"
 	slashingprotectioncommands "github.com/prysmaticlabs/prysm/v5/cmd/validator/slashing-protection"
+	protectionserver "github.com/prysmaticlabs/prysm/v5/cmd/validator/slashing-protection-server"
 	walletcommands "github.com/prysmaticlabs/prysm/v5/cmd/validator/wallet"
 	"github.com/prysmaticlabs/prysm/v5/cmd/validator/web"
 	"github.com/prysmaticlabs/prysm/v5/config/features"
@@ -55,6 +56,7 @@ var appFlags = []cli.Flag{
 	flags.CertFlag,
 	flags.GraffitiFlag,
 	flags.DisablePenaltyRewardLogFlag,
+	flags.PerformanceHistoryRetentionFlag,
 	flags.InteropStartIndex,
 	flags.InteropNumValidators,
 	flags.EnableRPCFlag,
@@ -75,11 +77,22 @@ var appFlags = []cli.Flag{
 	flags.EnableWebFlag,
 	flags.GraffitiFileFlag,
 	flags.EnableDistributed,
+	flags.BeaconNodeQuorumFlag,
+	flags.SlashingProtectionURLFlag,
+	flags.SlashingProtectionClientIDFlag,
+	flags.SlashingProtectionTokenFileFlag,
+	flags.SlashingProtectionCACertFlag,
+	flags.AuditLogDirFlag,
+	flags.AuditLogMaxSizeFlag,
 	flags.AuthTokenPathFlag,
 	// Consensys' Web3Signer flags
 	flags.Web3SignerURLFlag,
 	flags.Web3SignerPublicValidatorKeysFlag,
 	flags.Web3SignerKeyFileFlag,
+	flags.Web3SignerPublicKeysPollIntervalFlag,
+	flags.Web3SignerTLSClientCertFlag,
+	flags.Web3SignerTLSClientKeyFlag,
+	flags.Web3SignerTLSCACertFlag,
 	flags.SuggestedFeeRecipientFlag,
 	flags.ProposerSettingsURLFlag,
 	flags.ProposerSettingsFlag,
@@ -138,6 +151,7 @@ func main() {
 			walletcommands.Commands,
 			accountcommands.Commands,
 			slashingprotectioncommands.Commands,
+			protectionserver.Command,
 			dbcommands.Commands,
 			web.Commands,
 		},
@@ -0,0 +1,175 @@
+// Package protectionserver defines the command running a slashing protection server shared by validator clients.
+package protectionserver
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/cmd"
+	"github.com/prysmaticlabs/prysm/v5/cmd/validator/flags"
+	"github.com/prysmaticlabs/prysm/v5/config/features"
+	"github.com/prysmaticlabs/prysm/v5/runtime/tos"
+	"github.com/prysmaticlabs/prysm/v5/validator/db/filesystem"
+	"github.com/prysmaticlabs/prysm/v5/validator/db/iface"
+	"github.com/prysmaticlabs/prysm/v5/validator/db/kv"
+	"github.com/prysmaticlabs/prysm/v5/validator/db/remote"
+	"github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+)
+
+var log = logrus.WithField("prefix", "slashing-protection-server")
+
+var (
+	// HostFlag sets the host the slashing protection server listens on.
+	HostFlag = &cli.StringFlag{
+		Name:  "slashing-protection-server-host",
+		Usage: "Host on which the slashing protection server listens.",
+		Value: "127.0.0.1",
+	}
+	// PortFlag sets the port the slashing protection server listens on.
+	PortFlag = &cli.IntFlag{
+		Name:  "slashing-protection-server-port",
+		Usage: "Port on which the slashing protection server listens.",
+		Value: 7600,
+	}
+	// TLSCertFlag sets the TLS certificate of the slashing protection server.
+	TLSCertFlag = &cli.StringFlag{
+		Name:  "tls-cert",
+		Usage: "Path to the TLS certificate of the slashing protection server. Pass this and --tls-key to serve over https.",
+	}
+	// TLSKeyFlag sets the TLS key of the slashing protection server.
+	TLSKeyFlag = &cli.StringFlag{
+		Name:  "tls-key",
+		Usage: "Path to the TLS key of the slashing protection server. Pass this and --tls-cert to serve over https.",
+	}
+	// LeaseDurationFlag sets how long a validator client holds the keys it signs with.
+	LeaseDurationFlag = &cli.DurationFlag{
+		Name: "slashing-protection-lease-duration",
+		Usage: "Duration for which a validator client holds a key after signing with it. Other validator clients " +
+			"can not sign with the key until the lease expires, or is released by the validator client when it stops.",
+		Value: 384 * time.Second,
+	}
+)
+
+// Command runs a slashing protection server.
+var Command = &cli.Command{
+	Name:     "slashing-protection-server",
+	Category: "slashing-protection-server",
+	Usage: "Serves the slashing protection database of the data directory to validator clients sharing keys, " +
+		"for instance during an active/passive handover. Validator clients use it with --slashing-protection-url.",
+	Flags: cmd.WrapFlags([]cli.Flag{
+		cmd.DataDirFlag,
+		features.EnableMinimalSlashingProtection,
+		HostFlag,
+		PortFlag,
+		TLSCertFlag,
+		TLSKeyFlag,
+		LeaseDurationFlag,
+		flags.SlashingProtectionTokenFileFlag,
+		cmd.AcceptTosFlag,
+	}),
+	Before: func(cliCtx *cli.Context) error {
+		if err := cmd.LoadFlagsFromConfig(cliCtx, cliCtx.Command.Flags); err != nil {
+			return err
+		}
+		return tos.VerifyTosAcceptedOrPrompt(cliCtx)
+	},
+	Action: func(cliCtx *cli.Context) error {
+		if err := features.ConfigureValidator(cliCtx); err != nil {
+			return err
+		}
+		if err := serve(cliCtx); err != nil {
+			log.WithError(err).Fatal("Could not run slashing protection server")
+		}
+		return nil
+	},
+}
+
+func serve(cliCtx *cli.Context) error {
+	ctx, cancel := signal.NotifyContext(cliCtx.Context, os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+
+	dataDir := cliCtx.String(cmd.DataDirFlag.Name)
+	var (
+		db  iface.ValidatorDB
+		err error
+	)
+	if cliCtx.Bool(features.EnableMinimalSlashingProtection.Name) {
+		db, err = filesystem.NewStore(dataDir, nil)
+	} else {
+		db, err = kv.NewKVStore(ctx, dataDir, nil)
+	}
+	if err != nil {
+		return errors.Wrap(err, "could not open validator database")
+	}
+	defer func() {
+		if err := db.Close(); err != nil {
+			log.WithError(err).Error("Could not close validator database")
+		}
+	}()
+	if err := db.RunUpMigrations(ctx); err != nil {
+		return errors.Wrap(err, "could not run database migration")
+	}
+
+	host := cliCtx.String(HostFlag.Name)
+	certPath, keyPath := cliCtx.String(TLSCertFlag.Name), cliCtx.String(TLSKeyFlag.Name)
+	if (certPath == "") != (keyPath == "") {
+		return errors.New("--tls-cert and --tls-key must be set together")
+	}
+	loopback := false
+	if ip := net.ParseIP(host); ip != nil && ip.IsLoopback() {
+		loopback = true
+	}
+	// The bearer token and the slashing protection data must not cross the network in cleartext.
+	if !loopback && certPath == "" {
+		return errors.Errorf("the slashing protection server must be served over TLS with --tls-cert and --tls-key to listen on the non-loopback address %s", host)
+	}
+	var token string
+	if cliCtx.IsSet(flags.SlashingProtectionTokenFileFlag.Name) {
+		token, err = remote.ReadToken(cliCtx.String(flags.SlashingProtectionTokenFileFlag.Name))
+		if err != nil {
+			return err
+		}
+	} else if !loopback {
+		log.Warn("The slashing protection server is not bound to a loopback address and no token file is set, " +
+			"anyone able to reach it can use your validator keys' slashing protection data")
+	}
+
+	srv := &http.Server{
+		Addr:              net.JoinHostPort(host, fmt.Sprintf("%d", cliCtx.Int(PortFlag.Name))),
+		Handler:           remote.NewServer(db, cliCtx.Duration(LeaseDurationFlag.Name), token),
+		ReadHeaderTimeout: 10 * time.Second,
+		TLSConfig:         &tls.Config{MinVersion: tls.VersionTLS12},
+	}
+	errCh := make(chan error, 1)
+	go func() {
+		log.WithFields(logrus.Fields{
+			"address":       srv.Addr,
+			"databasePath":  db.DatabasePath(),
+			"leaseDuration": cliCtx.Duration(LeaseDurationFlag.Name),
+			"tls":           certPath != "",
+		}).Info("Starting slashing protection server")
+		if certPath != "" {
+			errCh <- srv.ListenAndServeTLS(certPath, keyPath)
+			return
+		}
+		errCh <- srv.ListenAndServe()
+	}()
+	select {
+	case err := <-errCh:
+		return err
+	case <-ctx.Done():
+	}
+	log.Info("Stopping slashing protection server")
+	shutdownCtx, shutdownCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer shutdownCancel()
+	return srv.Shutdown(shutdownCtx)
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"path/filepath"
+	"strings"
 
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/v5/cmd"
@@ -14,6 +15,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/validator/db/filesystem"
 	"github.com/prysmaticlabs/prysm/v5/validator/db/iface"
 	"github.com/prysmaticlabs/prysm/v5/validator/db/kv"
+	"github.com/prysmaticlabs/prysm/v5/validator/helpers"
 	slashingprotection "github.com/prysmaticlabs/prysm/v5/validator/slashing-protection-history"
 	"github.com/prysmaticlabs/prysm/v5/validator/slashing-protection-history/format"
 	"github.com/urfave/cli/v2"
@@ -94,11 +96,25 @@ func exportSlashingProtectionJSON(cliCtx *cli.Context) error {
 		}
 	}()
 
+	filteredKeys, err := exportFilteredKeys(cliCtx)
+	if err != nil {
+		return err
+	}
+
 	// Export the slashing protection history from the validator's database.
-	eipJSON, err := slashingprotection.ExportStandardProtectionJSON(cliCtx.Context, validatorDB)
+	eipJSON, err := slashingprotection.ExportStandardProtectionJSON(cliCtx.Context, validatorDB, filteredKeys...)
 	if err != nil {
 		return errors.Wrap(err, "could not export slashing protection history")
 	}
+	if cliCtx.Bool(flags.SlashingProtectionExportMinimalFlag.Name) {
+		eipJSON, err = slashingprotection.MinimizeStandardProtectionJSON(eipJSON)
+		if err != nil {
+			return errors.Wrap(err, "could not minimize slashing protection history")
+		}
+	}
+	if len(filteredKeys) > len(eipJSON.Data) {
+		log.Warnf("Slashing protection history was found for %d of the %d requested public keys", len(eipJSON.Data), len(filteredKeys))
+	}
 
 	// Check if JSON data is empty and issue a warning about common problems to the user.
 	if eipJSON == nil || len(eipJSON.Data) == 0 {
@@ -119,6 +135,22 @@ func exportSlashingProtectionJSON(cliCtx *cli.Context) error {
 	return nil
 }
 
+// exportFilteredKeys parses the public keys the export is restricted to, if any.
+func exportFilteredKeys(cliCtx *cli.Context) ([][]byte, error) {
+	if !cliCtx.IsSet(flags.SlashingProtectionExportPublicKeysFlag.Name) {
+		return nil, nil
+	}
+	var keys [][]byte
+	for _, hexKey := range strings.Split(cliCtx.String(flags.SlashingProtectionExportPublicKeysFlag.Name), ",") {
+		pubKey, err := helpers.PubKeyFromHex(strings.TrimSpace(hexKey))
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not parse public key %s", hexKey)
+		}
+		keys = append(keys, pubKey[:])
+	}
+	return keys, nil
+}
+
 func writeToOutput(cliCtx *cli.Context, eipJSON *format.EIPSlashingProtectionFormat) error {
 	// Get the output directory where the slashing protection history file will be stored
 	outputDir, err := userprompt.InputDirectory(
@@ -21,6 +21,8 @@ var Commands = &cli.Command{
 			Flags: cmd.WrapFlags([]cli.Flag{
 				cmd.DataDirFlag,
 				flags.SlashingProtectionExportDirFlag,
+				flags.SlashingProtectionExportPublicKeysFlag,
+				flags.SlashingProtectionExportMinimalFlag,
 				features.Mainnet,
 				features.SepoliaTestnet,
 				features.HoleskyTestnet,
@@ -72,5 +74,30 @@ var Commands = &cli.Command{
 				return nil
 			},
 		},
+		{
+			Name:        "verify",
+			Description: `checks a selected EIP-3076 compliant slashing protection JSON against a genesis validators root, and the validator database if a datadir is specified, and reports conflicts before import`,
+			Flags: cmd.WrapFlags([]cli.Flag{
+				cmd.DataDirFlag,
+				flags.SlashingProtectionJSONFileFlag,
+				flags.GenesisValidatorsRootFlag,
+				features.Mainnet,
+				features.SepoliaTestnet,
+				features.HoleskyTestnet,
+				features.EnableMinimalSlashingProtection,
+			}),
+			Before: func(cliCtx *cli.Context) error {
+				return cmd.LoadFlagsFromConfig(cliCtx, cliCtx.Command.Flags)
+			},
+			Action: func(cliCtx *cli.Context) error {
+				if err := features.ConfigureValidator(cliCtx); err != nil {
+					return err
+				}
+				if err := verifySlashingProtectionJSON(cliCtx); err != nil {
+					logrus.Fatalf("Could not verify slashing protection file: %v", err)
+				}
+				return nil
+			},
+		},
 	},
 }
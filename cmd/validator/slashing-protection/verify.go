@@ -0,0 +1,139 @@
+package historycmd
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/cmd"
+	"github.com/prysmaticlabs/prysm/v5/cmd/validator/flags"
+	"github.com/prysmaticlabs/prysm/v5/config/features"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v5/io/file"
+	"github.com/prysmaticlabs/prysm/v5/validator/accounts/userprompt"
+	"github.com/prysmaticlabs/prysm/v5/validator/db/filesystem"
+	"github.com/prysmaticlabs/prysm/v5/validator/db/iface"
+	"github.com/prysmaticlabs/prysm/v5/validator/db/kv"
+	"github.com/prysmaticlabs/prysm/v5/validator/helpers"
+	slashingprotection "github.com/prysmaticlabs/prysm/v5/validator/slashing-protection-history"
+	"github.com/prysmaticlabs/prysm/v5/validator/slashing-protection-history/format"
+	"github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+)
+
+// Checks an EIP-3076 standard JSON file before it is imported.
+//
+// Steps:
+// 1. Read the JSON file from user input.
+// 2. Open the validator database if a datadir is specified.
+// 3. Determine the expected genesis validators root from the CLI context, the database or the selected network.
+// 4. Verify the file metadata and report the public keys with slashable entries in the file, or
+// conflicting with the slashing protection history of the database.
+func verifySlashingProtectionJSON(cliCtx *cli.Context) error {
+	protectionFilePath, err := userprompt.InputDirectory(cliCtx, userprompt.SlashingProtectionJSONPromptText, flags.SlashingProtectionJSONFileFlag)
+	if err != nil {
+		return errors.Wrap(err, "could not get slashing protection json file")
+	}
+	if protectionFilePath == "" {
+		return fmt.Errorf(
+			"no path to a slashing_protection.json file specified, please retry or "+
+				"you can also specify it with the %s flag",
+			flags.SlashingProtectionJSONFileFlag.Name,
+		)
+	}
+	enc, err := file.ReadFileAsBytes(protectionFilePath)
+	if err != nil {
+		return err
+	}
+	interchangeJSON := &format.EIPSlashingProtectionFormat{}
+	if err := json.Unmarshal(enc, interchangeJSON); err != nil {
+		return errors.Wrap(err, "could not unmarshal slashing protection JSON file")
+	}
+
+	var valDB iface.ValidatorDB
+	if cliCtx.IsSet(cmd.DataDirFlag.Name) {
+		valDB, err = openExistingValidatorDB(cliCtx)
+		if err != nil {
+			return err
+		}
+		defer func() {
+			if err := valDB.Close(); err != nil {
+				log.WithError(err).Errorf("Could not close validator DB")
+			}
+		}()
+	}
+
+	genesisValidatorsRoot := params.BeaconConfig().GenesisValidatorsRoot
+	if valDB != nil {
+		dbRoot, err := valDB.GenesisValidatorsRoot(cliCtx.Context)
+		if err != nil {
+			return errors.Wrap(err, "could not get genesis validators root from DB")
+		}
+		if len(dbRoot) != 0 {
+			genesisValidatorsRoot = bytesutil.ToBytes32(dbRoot)
+		}
+	}
+	if cliCtx.IsSet(flags.GenesisValidatorsRootFlag.Name) {
+		genesisValidatorsRoot, err = helpers.RootFromHex(cliCtx.String(flags.GenesisValidatorsRootFlag.Name))
+		if err != nil {
+			return errors.Wrap(err, "could not parse genesis validators root")
+		}
+	}
+	if !bytesutil.IsValidRoot(genesisValidatorsRoot[:]) {
+		return fmt.Errorf("no genesis validators root is known for this network, please specify it with the %s flag", flags.GenesisValidatorsRootFlag.Name)
+	}
+
+	var conflicts []*slashingprotection.Conflict
+	if valDB != nil {
+		conflicts, err = slashingprotection.VerifyStandardProtectionJSONAgainstDB(cliCtx.Context, interchangeJSON, genesisValidatorsRoot[:], valDB)
+	} else {
+		conflicts, err = slashingprotection.VerifyStandardProtectionJSON(interchangeJSON, genesisValidatorsRoot[:])
+	}
+	if err != nil {
+		return errors.Wrapf(err, "slashing protection JSON file %s is invalid", protectionFilePath)
+	}
+	for _, conflict := range conflicts {
+		log.WithFields(logrus.Fields{
+			"pubkey": conflict.Pubkey,
+			"reason": conflict.Reason,
+		}).Warn("Slashable entry found in slashing protection JSON file")
+	}
+	if len(conflicts) > 0 {
+		return fmt.Errorf("found %d conflicts in %s, the affected public keys would be blacklisted on import", len(conflicts), protectionFilePath)
+	}
+	log.WithField("validators", len(interchangeJSON.Data)).Infof("Slashing protection JSON file %s is valid and has no conflicts", protectionFilePath)
+	return nil
+}
+
+// openExistingValidatorDB opens the validator database under the datadir of the CLI context. Unlike
+// the import command, no database is created if none is found, as verifying a file never writes.
+func openExistingValidatorDB(cliCtx *cli.Context) (iface.ValidatorDB, error) {
+	var (
+		found bool
+		err   error
+	)
+	isDatabaseMinimal := cliCtx.Bool(features.EnableMinimalSlashingProtection.Name)
+	dataDir := cliCtx.String(cmd.DataDirFlag.Name)
+	if isDatabaseMinimal {
+		found, _, err = file.RecursiveDirFind(filesystem.DatabaseDirName, dataDir)
+	} else {
+		found, _, err = file.RecursiveFileFind(kv.ProtectionDbFileName, dataDir)
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "error finding validator database at path %s", dataDir)
+	}
+	if !found {
+		return nil, fmt.Errorf("no validator database found inside of %s", dataDir)
+	}
+	var valDB iface.ValidatorDB
+	if isDatabaseMinimal {
+		valDB, err = filesystem.NewStore(dataDir, nil)
+	} else {
+		valDB, err = kv.NewKVStore(cliCtx.Context, dataDir, nil)
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not access validator database at path: %s", dataDir)
+	}
+	return valDB, nil
+}
@@ -0,0 +1,138 @@
+package historycmd
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/cmd"
+	"github.com/prysmaticlabs/prysm/v5/cmd/validator/flags"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v5/io/file"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	dbTest "github.com/prysmaticlabs/prysm/v5/validator/db/testing"
+	"github.com/prysmaticlabs/prysm/v5/validator/slashing-protection-history/format"
+	mocks "github.com/prysmaticlabs/prysm/v5/validator/testing"
+	"github.com/urfave/cli/v2"
+)
+
+func TestExportSlashingProtectionCli_FilteredMinimal(t *testing.T) {
+	outputPath := filepath.Join(t.TempDir(), "slashing-exports")
+	require.NoError(t, file.MkdirAll(outputPath))
+
+	pubKeys, err := mocks.CreateRandomPubKeys(3)
+	require.NoError(t, err)
+	attestingHistory, proposalHistory := mocks.MockAttestingAndProposalHistories(pubKeys)
+	mockJSON, err := mocks.MockSlashingProtectionJSON(pubKeys, attestingHistory, proposalHistory)
+	require.NoError(t, err)
+	encoded, err := json.Marshal(mockJSON)
+	require.NoError(t, err)
+	protectionFilePath := filepath.Join(outputPath, "slashing_history_import.json")
+	require.NoError(t, file.WriteFile(protectionFilePath, encoded))
+
+	validatorDB := dbTest.SetupDB(t, pubKeys, false)
+	dbPath := validatorDB.DatabasePath()
+	require.NoError(t, validatorDB.Close())
+	require.NoError(t, importSlashingProtectionJSON(setupCliCtx(t, dbPath, protectionFilePath, outputPath)))
+
+	app := cli.App{}
+	set := flag.NewFlagSet("test", 0)
+	set.String(cmd.DataDirFlag.Name, dbPath, "")
+	set.String(flags.SlashingProtectionExportDirFlag.Name, outputPath, "")
+	set.String(flags.SlashingProtectionExportPublicKeysFlag.Name, "", "")
+	set.Bool(flags.SlashingProtectionExportMinimalFlag.Name, false, "")
+	require.NoError(t, set.Set(cmd.DataDirFlag.Name, dbPath))
+	require.NoError(t, set.Set(flags.SlashingProtectionExportDirFlag.Name, outputPath))
+	require.NoError(t, set.Set(flags.SlashingProtectionExportPublicKeysFlag.Name, fmt.Sprintf("%#x, %#x", pubKeys[0], pubKeys[2])))
+	require.NoError(t, set.Set(flags.SlashingProtectionExportMinimalFlag.Name, "true"))
+	require.NoError(t, exportSlashingProtectionJSON(cli.NewContext(&app, set, nil)))
+
+	enc, err := file.ReadFileAsBytes(filepath.Join(outputPath, jsonExportFileName))
+	require.NoError(t, err)
+	receivedJSON := &format.EIPSlashingProtectionFormat{}
+	require.NoError(t, json.Unmarshal(enc, receivedJSON))
+	require.Equal(t, 2, len(receivedJSON.Data))
+	for _, item := range receivedJSON.Data {
+		require.NotEqual(t, fmt.Sprintf("%#x", pubKeys[1]), item.Pubkey)
+		require.Equal(t, true, len(item.SignedBlocks) <= 1)
+		require.Equal(t, 1, len(item.SignedAttestations))
+	}
+}
+
+func TestVerifySlashingProtectionCli(t *testing.T) {
+	pubKeys, err := mocks.CreateRandomPubKeys(2)
+	require.NoError(t, err)
+	attestingHistory, proposalHistory := mocks.MockAttestingAndProposalHistories(pubKeys)
+	mockJSON, err := mocks.MockSlashingProtectionJSON(pubKeys, attestingHistory, proposalHistory)
+	require.NoError(t, err)
+	genesisValidatorsRoot := fmt.Sprintf("%#x", bytesutil.PadTo([]byte{32}, 32))
+
+	verify := func(t *testing.T, interchangeJSON *format.EIPSlashingProtectionFormat, root string) error {
+		encoded, err := json.Marshal(interchangeJSON)
+		require.NoError(t, err)
+		protectionFilePath := filepath.Join(t.TempDir(), "slashing_protection.json")
+		require.NoError(t, file.WriteFile(protectionFilePath, encoded))
+		app := cli.App{}
+		set := flag.NewFlagSet("test", 0)
+		set.String(flags.SlashingProtectionJSONFileFlag.Name, protectionFilePath, "")
+		set.String(flags.GenesisValidatorsRootFlag.Name, root, "")
+		require.NoError(t, set.Set(flags.SlashingProtectionJSONFileFlag.Name, protectionFilePath))
+		require.NoError(t, set.Set(flags.GenesisValidatorsRootFlag.Name, root))
+		return verifySlashingProtectionJSON(cli.NewContext(&app, set, nil))
+	}
+
+	require.NoError(t, verify(t, mockJSON, genesisValidatorsRoot))
+	require.ErrorContains(t, "does not match the expected", verify(t, mockJSON, fmt.Sprintf("%#x", bytesutil.PadTo([]byte{33}, 32))))
+
+	mockJSON.Data[1].SignedBlocks = append(mockJSON.Data[1].SignedBlocks, &format.SignedBlock{
+		Slot:        mockJSON.Data[1].SignedBlocks[0].Slot,
+		SigningRoot: fmt.Sprintf("%#x", bytesutil.PadTo([]byte{0xff}, 32)),
+	})
+	require.ErrorContains(t, "found 1 conflicts", verify(t, mockJSON, genesisValidatorsRoot))
+}
+
+func TestVerifySlashingProtectionCli_AgainstDB(t *testing.T) {
+	pubKeys, err := mocks.CreateRandomPubKeys(2)
+	require.NoError(t, err)
+	attestingHistory, proposalHistory := mocks.MockAttestingAndProposalHistories(pubKeys)
+	mockJSON, err := mocks.MockSlashingProtectionJSON(pubKeys, attestingHistory, proposalHistory)
+	require.NoError(t, err)
+	encoded, err := json.Marshal(mockJSON)
+	require.NoError(t, err)
+	protectionFilePath := filepath.Join(t.TempDir(), "slashing_protection.json")
+	require.NoError(t, file.WriteFile(protectionFilePath, encoded))
+
+	validatorDB := dbTest.SetupDB(t, pubKeys, false)
+	dbPath := validatorDB.DatabasePath()
+	require.NoError(t, validatorDB.Close())
+	require.NoError(t, importSlashingProtectionJSON(setupCliCtx(t, dbPath, protectionFilePath, "")))
+
+	verify := func(t *testing.T, interchangeJSON *format.EIPSlashingProtectionFormat) error {
+		encoded, err := json.Marshal(interchangeJSON)
+		require.NoError(t, err)
+		protectionFilePath := filepath.Join(t.TempDir(), "slashing_protection.json")
+		require.NoError(t, file.WriteFile(protectionFilePath, encoded))
+		app := cli.App{}
+		set := flag.NewFlagSet("test", 0)
+		set.String(cmd.DataDirFlag.Name, dbPath, "")
+		set.String(flags.SlashingProtectionJSONFileFlag.Name, protectionFilePath, "")
+		require.NoError(t, set.Set(cmd.DataDirFlag.Name, dbPath))
+		require.NoError(t, set.Set(flags.SlashingProtectionJSONFileFlag.Name, protectionFilePath))
+		return verifySlashingProtectionJSON(cli.NewContext(&app, set, nil))
+	}
+
+	// The genesis validators root is taken from the database, and the imported history does not
+	// conflict with itself.
+	require.NoError(t, verify(t, mockJSON))
+
+	// A block signed with another signing root at a slot of the history is a double proposal.
+	mockJSON.Data[0].SignedAttestations = nil
+	mockJSON.Data[0].SignedBlocks = []*format.SignedBlock{{
+		Slot:        mockJSON.Data[0].SignedBlocks[0].Slot,
+		SigningRoot: fmt.Sprintf("%#x", bytesutil.PadTo([]byte{0xff}, 32)),
+	}}
+	mockJSON.Data = mockJSON.Data[:1]
+	require.ErrorContains(t, "found 1 conflicts", verify(t, mockJSON))
+}
@@ -127,6 +127,10 @@ var appHelpFlagGroups = []flagGroup{
 			flags.Web3SignerURLFlag,
 			flags.Web3SignerPublicValidatorKeysFlag,
 			flags.Web3SignerKeyFileFlag,
+			flags.Web3SignerPublicKeysPollIntervalFlag,
+			flags.Web3SignerTLSClientCertFlag,
+			flags.Web3SignerTLSClientKeyFlag,
+			flags.Web3SignerTLSCACertFlag,
 		},
 	},
 	{
@@ -141,8 +145,16 @@ var appHelpFlagGroups = []flagGroup{
 		Flags: []cli.Flag{
 			flags.EnableWebFlag,
 			flags.DisablePenaltyRewardLogFlag,
+			flags.PerformanceHistoryRetentionFlag,
 			flags.DisableAccountMetricsFlag,
 			flags.EnableDistributed,
+			flags.BeaconNodeQuorumFlag,
+			flags.SlashingProtectionURLFlag,
+			flags.SlashingProtectionClientIDFlag,
+			flags.SlashingProtectionTokenFileFlag,
+			flags.SlashingProtectionCACertFlag,
+			flags.AuditLogDirFlag,
+			flags.AuditLogMaxSizeFlag,
 			flags.AuthTokenPathFlag,
 		},
 	},
@@ -178,6 +178,11 @@ var (
 		Name:  "enable-experimental-attestation-pool",
 		Usage: "Enables an experimental attestation pool design.",
 	}
+	enableStateDiff = &cli.BoolFlag{
+		Name: "enable-state-diff",
+		Usage: "Stores every finalized state as a snapshot or a diff against an ancestor state, so that any historical " +
+			"state is served without replaying blocks. Intended for archive nodes, it uses more disk space.",
+	}
 )
 
 // devModeFlags holds list of flags that are set when development mode is on.
@@ -235,6 +240,7 @@ var BeaconChainFlags = combinedFlags([]cli.Flag{
 	DisableCommitteeAwarePacking,
 	EnableDiscoveryReboot,
 	enableExperimentalAttestationPool,
+	enableStateDiff,
 }, deprecatedBeaconFlags, deprecatedFlags, upcomingDeprecation)
 
 func combinedFlags(flags ...[]cli.Flag) []cli.Flag {
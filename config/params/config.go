@@ -273,6 +273,7 @@ type BeaconChainConfig struct {
 	MaxCellsInExtendedMatrix              uint64           `yaml:"MAX_CELLS_IN_EXTENDED_MATRIX" spec:"true"`     // MaxCellsInExtendedMatrix is the full data of one-dimensional erasure coding extended blobs (in row major format).
 	NumberOfColumns                       uint64           `yaml:"NUMBER_OF_COLUMNS" spec:"true"`                // NumberOfColumns in the extended data matrix.
 	DataColumnSidecarSubnetCount          uint64           `yaml:"DATA_COLUMN_SIDECAR_SUBNET_COUNT" spec:"true"` // DataColumnSidecarSubnetCount is the number of data column sidecar subnets used in the gossipsub protocol
+	NumberOfCustodyGroups                 uint64           `yaml:"NUMBER_OF_CUSTODY_GROUPS" spec:"true"`         // NumberOfCustodyGroups is the number of custody groups available for nodes to custody.
 
 	// Networking Specific Parameters
 	GossipMaxSize                   uint64          `yaml:"GOSSIP_MAX_SIZE" spec:"true"`                    // GossipMaxSize is the maximum allowed size of uncompressed gossip messages.
@@ -443,3 +444,8 @@ func PeerDASEnabled() bool {
 func WithinDAPeriod(block, current primitives.Epoch) bool {
 	return block+BeaconConfig().MinEpochsForBlobsSidecarsRequest >= current
 }
+
+// WithinDataColumnDAPeriod checks if the block epoch is within MIN_EPOCHS_FOR_DATA_COLUMN_SIDECARS_REQUESTS of the given current epoch.
+func WithinDataColumnDAPeriod(block, current primitives.Epoch) bool {
+	return block+BeaconConfig().MinEpochsForDataColumnSidecarsRequest >= current
+}
@@ -47,6 +47,35 @@ func VerifyKZGInclusionProof(blob ROBlob) error {
 	return nil
 }
 
+// VerifyKZGInclusionProofColumn verifies the Merkle proof in a data column sidecar against
+// the beacon block body root.
+func VerifyKZGInclusionProofColumn(sc RODataColumn) error {
+	if sc.SignedBlockHeader == nil {
+		return errNilBlockHeader
+	}
+	if sc.SignedBlockHeader.Header == nil {
+		return errNilBlockHeader
+	}
+	root := sc.SignedBlockHeader.Header.BodyRoot
+	if len(root) != field_params.RootLength {
+		return errInvalidBodyRoot
+	}
+	leaves := leavesFromCommitments(sc.KzgCommitments)
+	sparse, err := trie.GenerateTrieFromItems(leaves, field_params.LogMaxBlobCommitments)
+	if err != nil {
+		return err
+	}
+	commitmentsRoot, err := sparse.HashTreeRoot()
+	if err != nil {
+		return err
+	}
+	verified := trie.VerifyMerkleProof(root, commitmentsRoot[:], kzgPosition, sc.KzgCommitmentsInclusionProof)
+	if !verified {
+		return errInvalidInclusionProof
+	}
+	return nil
+}
+
 // MerkleProofKZGCommitment constructs a Merkle proof of inclusion of the KZG
 // commitment of index `index` into the Beacon Block with the given `body`
 func MerkleProofKZGCommitment(body interfaces.ReadOnlyBeaconBlockBody, index int) ([][]byte, error) {
@@ -80,6 +109,28 @@ func MerkleProofKZGCommitment(body interfaces.ReadOnlyBeaconBlockBody, index int
 	return proof, nil
 }
 
+// MerkleProofKZGCommitments constructs a Merkle proof of inclusion of the KZG
+// commitment list into the Beacon Block with the given `body`
+func MerkleProofKZGCommitments(body interfaces.ReadOnlyBeaconBlockBody) ([][]byte, error) {
+	if body.Version() < version.Deneb {
+		return nil, errUnsupportedBeaconBlockBody
+	}
+	membersRoots, err := topLevelRoots(body)
+	if err != nil {
+		return nil, err
+	}
+	sparse, err := trie.GenerateTrieFromItems(membersRoots, logBodyLength)
+	if err != nil {
+		return nil, err
+	}
+	proof, err := sparse.MerkleProof(kzgPosition)
+	if err != nil {
+		return nil, err
+	}
+	// sparse.MerkleProof always includes the length of the slice, which is not part of the proof.
+	return proof[:len(proof)-1], nil
+}
+
 // leavesFromCommitments hashes each commitment to construct a slice of roots
 func leavesFromCommitments(commitments [][]byte) [][]byte {
 	leaves := make([][]byte, len(commitments))
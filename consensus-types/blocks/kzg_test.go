@@ -259,3 +259,66 @@ func Test_VerifyKZGInclusionProof(t *testing.T) {
 	proof[2] = make([]byte, 32)
 	require.ErrorIs(t, errInvalidInclusionProof, VerifyKZGInclusionProof(blob))
 }
+
+func Test_VerifyKZGInclusionProofColumn(t *testing.T) {
+	kzgs := make([][]byte, 3)
+	for i := range kzgs {
+		kzgs[i] = make([]byte, 48)
+		_, err := rand.Read(kzgs[i])
+		require.NoError(t, err)
+	}
+	pbBody := &ethpb.BeaconBlockBodyElectra{
+		SyncAggregate: &ethpb.SyncAggregate{
+			SyncCommitteeBits:      make([]byte, fieldparams.SyncAggregateSyncCommitteeBytesLength),
+			SyncCommitteeSignature: make([]byte, fieldparams.BLSSignatureLength),
+		},
+		ExecutionPayload: &enginev1.ExecutionPayloadDeneb{
+			ParentHash:    make([]byte, fieldparams.RootLength),
+			FeeRecipient:  make([]byte, 20),
+			StateRoot:     make([]byte, fieldparams.RootLength),
+			ReceiptsRoot:  make([]byte, fieldparams.RootLength),
+			LogsBloom:     make([]byte, 256),
+			PrevRandao:    make([]byte, fieldparams.RootLength),
+			BaseFeePerGas: make([]byte, fieldparams.RootLength),
+			BlockHash:     make([]byte, fieldparams.RootLength),
+			Transactions:  make([][]byte, 0),
+			ExtraData:     make([]byte, 0),
+		},
+		Eth1Data: &ethpb.Eth1Data{
+			DepositRoot: make([]byte, fieldparams.RootLength),
+			BlockHash:   make([]byte, fieldparams.RootLength),
+		},
+		BlobKzgCommitments: kzgs,
+		ExecutionRequests:  &enginev1.ExecutionRequests{},
+	}
+
+	body, err := NewBeaconBlockBody(pbBody)
+	require.NoError(t, err)
+	root, err := body.HashTreeRoot()
+	require.NoError(t, err)
+	proof, err := MerkleProofKZGCommitments(body)
+	require.NoError(t, err)
+	require.Equal(t, 4, len(proof))
+
+	sidecar := &ethpb.DataColumnSidecar{
+		ColumnIndex:    1,
+		DataColumn:     [][]byte{make([]byte, 2048), make([]byte, 2048), make([]byte, 2048)},
+		KzgCommitments: kzgs,
+		KzgProof:       [][]byte{make([]byte, 48), make([]byte, 48), make([]byte, 48)},
+		SignedBlockHeader: &ethpb.SignedBeaconBlockHeader{
+			Header: &ethpb.BeaconBlockHeader{
+				BodyRoot:   root[:],
+				ParentRoot: make([]byte, 32),
+				StateRoot:  make([]byte, 32),
+			},
+			Signature: make([]byte, fieldparams.BLSSignatureLength),
+		},
+		KzgCommitmentsInclusionProof: proof,
+	}
+	column, err := NewRODataColumn(sidecar)
+	require.NoError(t, err)
+	require.NoError(t, VerifyKZGInclusionProofColumn(column))
+
+	sidecar.KzgCommitments = kzgs[:2]
+	require.ErrorIs(t, VerifyKZGInclusionProofColumn(column), errInvalidInclusionProof)
+}
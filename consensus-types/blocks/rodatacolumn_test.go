@@ -0,0 +1,76 @@
+package blocks
+
+import (
+	"testing"
+
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func TestRODataColumnNilChecks(t *testing.T) {
+	header := &ethpb.BeaconBlockHeader{
+		Slot:          3,
+		ProposerIndex: 4,
+		ParentRoot:    bytesutil.PadTo([]byte("parent"), fieldparams.RootLength),
+		StateRoot:     make([]byte, fieldparams.RootLength),
+		BodyRoot:      bytesutil.PadTo([]byte("body"), fieldparams.RootLength),
+	}
+	cases := []struct {
+		name string
+		dc   *ethpb.DataColumnSidecar
+		err  error
+	}{
+		{
+			name: "nil data column",
+			err:  errNilDataColumn,
+		},
+		{
+			name: "nil signed block header",
+			dc:   &ethpb.DataColumnSidecar{},
+			err:  errNilBlockHeader,
+		},
+		{
+			name: "nil inner header",
+			dc:   &ethpb.DataColumnSidecar{SignedBlockHeader: &ethpb.SignedBeaconBlockHeader{}},
+			err:  errNilBlockHeader,
+		},
+		{
+			name: "nil signature",
+			dc:   &ethpb.DataColumnSidecar{SignedBlockHeader: &ethpb.SignedBeaconBlockHeader{Header: header}},
+			err:  errMissingBlockSignature,
+		},
+		{
+			name: "valid",
+			dc: &ethpb.DataColumnSidecar{SignedBlockHeader: &ethpb.SignedBeaconBlockHeader{
+				Header:    header,
+				Signature: make([]byte, fieldparams.BLSSignatureLength),
+			}},
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			dc, err := NewRODataColumn(c.dc)
+			if c.err != nil {
+				require.ErrorIs(t, err, c.err)
+				_, err = NewRODataColumnWithRoot(c.dc, [32]byte{1})
+				require.ErrorIs(t, err, c.err)
+				return
+			}
+			require.NoError(t, err)
+			want, err := header.HashTreeRoot()
+			require.NoError(t, err)
+			assert.Equal(t, want, dc.BlockRoot())
+			assert.Equal(t, header.Slot, dc.Slot())
+			assert.Equal(t, header.ProposerIndex, dc.ProposerIndex())
+			assert.Equal(t, bytesutil.ToBytes32(header.ParentRoot), dc.ParentRoot())
+			assert.Equal(t, bytesutil.ToBytes32(header.BodyRoot), dc.BodyRoot())
+
+			dc, err = NewRODataColumnWithRoot(c.dc, [32]byte{1})
+			require.NoError(t, err)
+			assert.Equal(t, [32]byte{1}, dc.BlockRoot())
+		})
+	}
+}
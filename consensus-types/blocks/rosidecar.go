@@ -0,0 +1,91 @@
+package blocks
+
+import (
+	"github.com/pkg/errors"
+)
+
+var (
+	// ErrNotBlobSidecar is returned when the blob of a sidecar holding a data column is requested.
+	ErrNotBlobSidecar = errors.New("sidecar is not a blob sidecar")
+	// ErrNotDataColumnSidecar is returned when the data column of a sidecar holding a blob is requested.
+	ErrNotDataColumnSidecar = errors.New("sidecar is not a data column sidecar")
+)
+
+// ROSidecar holds either a read-only blob sidecar or a read-only data column sidecar. It lets components
+// that deal with the sidecars of a block, like availability stores, accept the sidecar type of any fork.
+type ROSidecar struct {
+	blob       *ROBlob
+	dataColumn *RODataColumn
+}
+
+// NewSidecarFromBlobSidecar wraps a blob sidecar into a ROSidecar.
+func NewSidecarFromBlobSidecar(blob ROBlob) ROSidecar {
+	return ROSidecar{blob: &blob}
+}
+
+// NewSidecarFromDataColumnSidecar wraps a data column sidecar into a ROSidecar.
+func NewSidecarFromDataColumnSidecar(dataColumn RODataColumn) ROSidecar {
+	return ROSidecar{dataColumn: &dataColumn}
+}
+
+// NewSidecarsFromBlobSidecars wraps each of the given blob sidecars into a ROSidecar.
+func NewSidecarsFromBlobSidecars(blobs []ROBlob) []ROSidecar {
+	sidecars := make([]ROSidecar, 0, len(blobs))
+	for _, blob := range blobs {
+		sidecars = append(sidecars, NewSidecarFromBlobSidecar(blob))
+	}
+	return sidecars
+}
+
+// NewSidecarsFromDataColumnSidecars wraps each of the given data column sidecars into a ROSidecar.
+func NewSidecarsFromDataColumnSidecars(dataColumns []RODataColumn) []ROSidecar {
+	sidecars := make([]ROSidecar, 0, len(dataColumns))
+	for _, dataColumn := range dataColumns {
+		sidecars = append(sidecars, NewSidecarFromDataColumnSidecar(dataColumn))
+	}
+	return sidecars
+}
+
+// Blob returns the blob sidecar held by the ROSidecar, or ErrNotBlobSidecar if it holds a data column.
+func (sc ROSidecar) Blob() (ROBlob, error) {
+	if sc.blob == nil {
+		return ROBlob{}, ErrNotBlobSidecar
+	}
+	return *sc.blob, nil
+}
+
+// DataColumn returns the data column sidecar held by the ROSidecar, or ErrNotDataColumnSidecar if it holds a blob.
+func (sc ROSidecar) DataColumn() (RODataColumn, error) {
+	if sc.dataColumn == nil {
+		return RODataColumn{}, ErrNotDataColumnSidecar
+	}
+	return *sc.dataColumn, nil
+}
+
+// BlobSidecarsFromSidecars returns the blob sidecars held by the given sidecars.
+// It fails if any of the sidecars does not hold a blob.
+func BlobSidecarsFromSidecars(sidecars []ROSidecar) ([]ROBlob, error) {
+	blobs := make([]ROBlob, 0, len(sidecars))
+	for i, sidecar := range sidecars {
+		blob, err := sidecar.Blob()
+		if err != nil {
+			return nil, errors.Wrapf(err, "sidecar %d", i)
+		}
+		blobs = append(blobs, blob)
+	}
+	return blobs, nil
+}
+
+// DataColumnSidecarsFromSidecars returns the data column sidecars held by the given sidecars.
+// It fails if any of the sidecars does not hold a data column.
+func DataColumnSidecarsFromSidecars(sidecars []ROSidecar) ([]RODataColumn, error) {
+	dataColumns := make([]RODataColumn, 0, len(sidecars))
+	for i, sidecar := range sidecars {
+		dataColumn, err := sidecar.DataColumn()
+		if err != nil {
+			return nil, errors.Wrapf(err, "sidecar %d", i)
+		}
+		dataColumns = append(dataColumns, dataColumn)
+	}
+	return dataColumns, nil
+}
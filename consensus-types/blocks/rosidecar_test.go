@@ -0,0 +1,33 @@
+package blocks
+
+import (
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func TestROSidecar(t *testing.T) {
+	blobs := []ROBlob{{root: [32]byte{1}}, {root: [32]byte{2}}}
+	dataColumns := []RODataColumn{{root: [32]byte{3}}}
+
+	blobSidecars := NewSidecarsFromBlobSidecars(blobs)
+	require.Equal(t, len(blobs), len(blobSidecars))
+	_, err := blobSidecars[0].DataColumn()
+	require.ErrorIs(t, err, ErrNotDataColumnSidecar)
+	gotBlobs, err := BlobSidecarsFromSidecars(blobSidecars)
+	require.NoError(t, err)
+	require.Equal(t, blobs[1].BlockRoot(), gotBlobs[1].BlockRoot())
+
+	dataColumnSidecars := NewSidecarsFromDataColumnSidecars(dataColumns)
+	_, err = dataColumnSidecars[0].Blob()
+	require.ErrorIs(t, err, ErrNotBlobSidecar)
+	gotDataColumns, err := DataColumnSidecarsFromSidecars(dataColumnSidecars)
+	require.NoError(t, err)
+	require.Equal(t, dataColumns[0].BlockRoot(), gotDataColumns[0].BlockRoot())
+
+	mixed := append(blobSidecars, dataColumnSidecars...)
+	_, err = BlobSidecarsFromSidecars(mixed)
+	require.ErrorIs(t, err, ErrNotBlobSidecar)
+	_, err = DataColumnSidecarsFromSidecars(mixed)
+	require.ErrorIs(t, err, ErrNotDataColumnSidecar)
+}
@@ -130,6 +130,11 @@ func NewWrappedFinalityUpdateAltair(p *pb.LightClientFinalityUpdateAltair) (inte
 	}, nil
 }
 
+// NewEmptyFinalityUpdateAltair returns an empty Altair light client finality update, meant to be filled by UnmarshalSSZ.
+func NewEmptyFinalityUpdateAltair() interfaces.LightClientFinalityUpdate {
+	return &finalityUpdateAltair{}
+}
+
 func (u *finalityUpdateAltair) MarshalSSZTo(dst []byte) ([]byte, error) {
 	return u.p.MarshalSSZTo(dst)
 }
@@ -232,6 +237,11 @@ func NewWrappedFinalityUpdateCapella(p *pb.LightClientFinalityUpdateCapella) (in
 	}, nil
 }
 
+// NewEmptyFinalityUpdateCapella returns an empty Capella light client finality update, meant to be filled by UnmarshalSSZ.
+func NewEmptyFinalityUpdateCapella() interfaces.LightClientFinalityUpdate {
+	return &finalityUpdateCapella{}
+}
+
 func (u *finalityUpdateCapella) MarshalSSZTo(dst []byte) ([]byte, error) {
 	return u.p.MarshalSSZTo(dst)
 }
@@ -334,6 +344,11 @@ func NewWrappedFinalityUpdateDeneb(p *pb.LightClientFinalityUpdateDeneb) (interf
 	}, nil
 }
 
+// NewEmptyFinalityUpdateDeneb returns an empty Deneb light client finality update, meant to be filled by UnmarshalSSZ.
+func NewEmptyFinalityUpdateDeneb() interfaces.LightClientFinalityUpdate {
+	return &finalityUpdateDeneb{}
+}
+
 func (u *finalityUpdateDeneb) MarshalSSZTo(dst []byte) ([]byte, error) {
 	return u.p.MarshalSSZTo(dst)
 }
@@ -437,6 +452,11 @@ func NewWrappedFinalityUpdateElectra(p *pb.LightClientFinalityUpdateElectra) (in
 	}, nil
 }
 
+// NewEmptyFinalityUpdateElectra returns an empty Electra light client finality update, meant to be filled by UnmarshalSSZ.
+func NewEmptyFinalityUpdateElectra() interfaces.LightClientFinalityUpdate {
+	return &finalityUpdateElectra{}
+}
+
 func (u *finalityUpdateElectra) MarshalSSZTo(dst []byte) ([]byte, error) {
 	return u.p.MarshalSSZTo(dst)
 }
@@ -761,6 +761,15 @@ def prysm_deps():
         sum = "h1:0X1LBXxaEtYD9xsyj9B9ctQEZIpnvVDeoBx8aHEwTNA=",
         version = "v1.0.0",
     )
+    go_repository(
+        name = "com_github_ethereum_c_kzg_4844_v2",
+        build_directives = [
+            "gazelle:resolve go github.com/supranational/blst/bindings/go @com_github_supranational_blst//:go_default_library",
+        ],
+        importpath = "github.com/ethereum/c-kzg-4844/v2",
+        sum = "h1:gQropX9YFBhl3g4HYhwE70zq3IHFRgbbNPw0Shwzf5w=",
+        version = "v2.1.0",
+    )
     go_repository(
         name = "com_github_ethereum_go_ethereum",
         build_directives = [
@@ -0,0 +1,98 @@
+package ssz
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	fastssz "github.com/prysmaticlabs/fastssz"
+)
+
+// bytesPerOffset is the size of the offsets preceding the items of a list of variable size items.
+const bytesPerOffset = 4
+
+// MarshalFixedList serializes a list of fixed size SSZ objects, which is the concatenation of their encodings.
+func MarshalFixedList[T fastssz.Marshaler](items []T) ([]byte, error) {
+	var buf []byte
+	for i, item := range items {
+		var err error
+		if buf, err = item.MarshalSSZTo(buf); err != nil {
+			return nil, fmt.Errorf("could not marshal item at index %d: %w", i, err)
+		}
+	}
+	return buf, nil
+}
+
+// MarshalVariableList serializes a list of variable size SSZ objects: the offsets of the items, followed by their
+// encodings.
+func MarshalVariableList[T fastssz.Marshaler](items []T) ([]byte, error) {
+	size := bytesPerOffset * len(items)
+	for _, item := range items {
+		size += item.SizeSSZ()
+	}
+	buf := make([]byte, bytesPerOffset*len(items), size)
+	offset := bytesPerOffset * len(items)
+	for i, item := range items {
+		binary.LittleEndian.PutUint32(buf[i*bytesPerOffset:], uint32(offset))
+		offset += item.SizeSSZ()
+	}
+	for i, item := range items {
+		var err error
+		if buf, err = item.MarshalSSZTo(buf); err != nil {
+			return nil, fmt.Errorf("could not marshal item at index %d: %w", i, err)
+		}
+	}
+	return buf, nil
+}
+
+// UnmarshalFixedList deserializes a list of fixed size SSZ objects.
+func UnmarshalFixedList[T any, PT interface {
+	*T
+	fastssz.Marshaler
+	fastssz.Unmarshaler
+}](buf []byte) ([]PT, error) {
+	size := PT(new(T)).SizeSSZ()
+	if size == 0 || len(buf)%size != 0 {
+		return nil, fmt.Errorf("list of %d bytes is not a multiple of the item size %d", len(buf), size)
+	}
+	items := make([]PT, len(buf)/size)
+	for i := range items {
+		items[i] = new(T)
+		if err := items[i].UnmarshalSSZ(buf[i*size : (i+1)*size]); err != nil {
+			return nil, fmt.Errorf("could not unmarshal item at index %d: %w", i, err)
+		}
+	}
+	return items, nil
+}
+
+// UnmarshalVariableList deserializes a list of variable size SSZ objects.
+func UnmarshalVariableList[T any, PT interface {
+	*T
+	fastssz.Unmarshaler
+}](buf []byte) ([]PT, error) {
+	if len(buf) == 0 {
+		return []PT{}, nil
+	}
+	if len(buf) < bytesPerOffset {
+		return nil, fmt.Errorf("list of %d bytes is too short for an offset", len(buf))
+	}
+	first := int(binary.LittleEndian.Uint32(buf))
+	if first%bytesPerOffset != 0 || first > len(buf) || first == 0 {
+		return nil, fmt.Errorf("invalid first offset %d", first)
+	}
+	items := make([]PT, first/bytesPerOffset)
+	for i := range items {
+		start := int(binary.LittleEndian.Uint32(buf[i*bytesPerOffset:]))
+		end := len(buf)
+		if i+1 < len(items) {
+			end = int(binary.LittleEndian.Uint32(buf[(i+1)*bytesPerOffset:]))
+		}
+		if start < first || start > end || end > len(buf) {
+			return nil, fmt.Errorf("invalid offsets %d and %d of item at index %d", start, end, i)
+		}
+		items[i] = new(T)
+		if err := items[i].UnmarshalSSZ(buf[start:end]); err != nil {
+			return nil, fmt.Errorf("could not unmarshal item at index %d: %w", i, err)
+		}
+	}
+	return items, nil
+}
@@ -0,0 +1,73 @@
+package ssz_test
+
+import (
+	"testing"
+
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v5/encoding/ssz"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func TestFixedList(t *testing.T) {
+	items := []*ethpb.Checkpoint{
+		{Epoch: 1, Root: bytesutil.PadTo([]byte{1}, 32)},
+		{Epoch: 2, Root: bytesutil.PadTo([]byte{2}, 32)},
+	}
+	enc, err := ssz.MarshalFixedList(items)
+	require.NoError(t, err)
+	assert.Equal(t, 2*items[0].SizeSSZ(), len(enc))
+
+	got, err := ssz.UnmarshalFixedList[ethpb.Checkpoint](enc)
+	require.NoError(t, err)
+	require.Equal(t, len(items), len(got))
+	for i := range items {
+		assert.DeepSSZEqual(t, items[i], got[i])
+	}
+
+	empty, err := ssz.MarshalFixedList([]*ethpb.Checkpoint{})
+	require.NoError(t, err)
+	got, err = ssz.UnmarshalFixedList[ethpb.Checkpoint](empty)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(got))
+
+	_, err = ssz.UnmarshalFixedList[ethpb.Checkpoint](enc[1:])
+	require.ErrorContains(t, "is not a multiple of the item size", err)
+}
+
+func TestVariableList(t *testing.T) {
+	att := func(bits uint64) *ethpb.Attestation {
+		return &ethpb.Attestation{
+			AggregationBits: bitfield.NewBitlist(bits),
+			Data: &ethpb.AttestationData{
+				BeaconBlockRoot: make([]byte, 32),
+				Source:          &ethpb.Checkpoint{Root: make([]byte, 32)},
+				Target:          &ethpb.Checkpoint{Root: make([]byte, 32)},
+			},
+			Signature: make([]byte, 96),
+		}
+	}
+	items := []*ethpb.Attestation{att(8), att(100), att(3)}
+	enc, err := ssz.MarshalVariableList(items)
+	require.NoError(t, err)
+	assert.Equal(t, 3*4+items[0].SizeSSZ()+items[1].SizeSSZ()+items[2].SizeSSZ(), len(enc))
+
+	got, err := ssz.UnmarshalVariableList[ethpb.Attestation](enc)
+	require.NoError(t, err)
+	require.Equal(t, len(items), len(got))
+	for i := range items {
+		assert.DeepSSZEqual(t, items[i], got[i])
+	}
+
+	empty, err := ssz.MarshalVariableList([]*ethpb.Attestation{})
+	require.NoError(t, err)
+	got, err = ssz.UnmarshalVariableList[ethpb.Attestation](empty)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(got))
+
+	enc[6] = 0xff
+	_, err = ssz.UnmarshalVariableList[ethpb.Attestation](enc)
+	require.ErrorContains(t, "invalid offsets", err)
+}
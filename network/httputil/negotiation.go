@@ -0,0 +1,120 @@
+package httputil
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// mediaRange is a single entry of an Accept header, as defined in https://www.rfc-editor.org/rfc/rfc9110#name-accept.
+type mediaRange struct {
+	typ     string
+	subtype string
+	q       float64
+}
+
+// matches returns the specificity of the match between the media range and the given media type.
+// Zero means there is no match, higher values mean a more specific match.
+func (m mediaRange) matches(mediaType string) int {
+	typ, subtype, ok := strings.Cut(mediaType, "/")
+	if !ok {
+		return 0
+	}
+	switch {
+	case m.typ == typ && m.subtype == subtype:
+		return 3
+	case m.typ == typ && m.subtype == "*":
+		return 2
+	case m.typ == "*" && m.subtype == "*":
+		return 1
+	default:
+		return 0
+	}
+}
+
+// parseAccept parses the values of an Accept header into media ranges, preserving the order in which they appear.
+// Malformed entries are skipped.
+func parseAccept(values []string) []mediaRange {
+	var ranges []mediaRange
+	for _, v := range values {
+		for _, entry := range strings.Split(v, ",") {
+			parts := strings.Split(entry, ";")
+			typ, subtype, ok := strings.Cut(strings.TrimSpace(parts[0]), "/")
+			if !ok || typ == "" || subtype == "" || (typ == "*" && subtype != "*") {
+				continue
+			}
+			m := mediaRange{typ: strings.ToLower(typ), subtype: strings.ToLower(subtype), q: 1}
+			valid := true
+			for _, p := range parts[1:] {
+				name, value, ok := strings.Cut(strings.TrimSpace(p), "=")
+				if !ok || strings.ToLower(strings.TrimSpace(name)) != "q" {
+					continue
+				}
+				q, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+				if err != nil || q < 0 || q > 1 {
+					valid = false
+					break
+				}
+				m.q = q
+			}
+			if valid {
+				ranges = append(ranges, m)
+			}
+		}
+	}
+	return ranges
+}
+
+// acceptHeaderValues returns the non-empty Accept header values of the request.
+func acceptHeaderValues(req *http.Request) []string {
+	var values []string
+	for _, v := range req.Header.Values("Accept") {
+		if strings.TrimSpace(v) != "" {
+			values = append(values, v)
+		}
+	}
+	return values
+}
+
+// NegotiateContentType returns the media type from offered that best satisfies the request's Accept header.
+// The quality value of each offered type is taken from the most specific media range matching it, and the type
+// with the highest quality wins. Ties are resolved in favor of the range the client listed first, and then in
+// favor of the type the server offered first. When the request has no Accept header, the first offered type is
+// returned. The boolean is false if the client does not accept any of the offered types.
+func NegotiateContentType(req *http.Request, offered ...string) (string, bool) {
+	if len(offered) == 0 {
+		return "", false
+	}
+	values := acceptHeaderValues(req)
+	if len(values) == 0 {
+		return offered[0], true
+	}
+	ranges := parseAccept(values)
+
+	best, bestQ, bestPos := "", 0.0, 0
+	for _, o := range offered {
+		specificity, q, pos := 0, 0.0, 0
+		for i, m := range ranges {
+			if s := m.matches(strings.ToLower(o)); s > specificity {
+				specificity, q, pos = s, m.q, i
+			}
+		}
+		if specificity == 0 || q == 0 {
+			continue
+		}
+		if best == "" || q > bestQ || (q == bestQ && pos < bestPos) {
+			best, bestQ, bestPos = o, q, pos
+		}
+	}
+	return best, best != ""
+}
+
+// HandleNotAcceptable writes a 406 error listing the media types the endpoint is able to produce.
+func HandleNotAcceptable(w http.ResponseWriter, req *http.Request, offered []string) {
+	HandleError(
+		w,
+		fmt.Sprintf("Accept header %q is not supported, supported media types: %s", strings.Join(acceptHeaderValues(req), ","), strings.Join(offered, ", ")),
+		http.StatusNotAcceptable,
+	)
+}
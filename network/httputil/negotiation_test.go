@@ -0,0 +1,63 @@
+package httputil
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/api"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func TestNegotiateContentType(t *testing.T) {
+	offered := []string{api.JsonMediaType, api.OctetStreamMediaType}
+	tests := []struct {
+		name     string
+		accept   []string
+		expected string
+		ok       bool
+	}{
+		{name: "no header", accept: nil, expected: api.JsonMediaType, ok: true},
+		{name: "empty header", accept: []string{""}, expected: api.JsonMediaType, ok: true},
+		{name: "json", accept: []string{api.JsonMediaType}, expected: api.JsonMediaType, ok: true},
+		{name: "ssz", accept: []string{api.OctetStreamMediaType}, expected: api.OctetStreamMediaType, ok: true},
+		{name: "any type prefers server order", accept: []string{"*/*"}, expected: api.JsonMediaType, ok: true},
+		{name: "subtype wildcard", accept: []string{"application/*"}, expected: api.JsonMediaType, ok: true},
+		{name: "client order breaks ties", accept: []string{"application/octet-stream, application/json"}, expected: api.OctetStreamMediaType, ok: true},
+		{name: "quality wins", accept: []string{"application/json;q=0.5, application/octet-stream;q=0.8"}, expected: api.OctetStreamMediaType, ok: true},
+		{name: "specific range overrides wildcard", accept: []string{"*/*;q=0.9, application/json;q=0.1"}, expected: api.OctetStreamMediaType, ok: true},
+		{name: "q=0 excludes type", accept: []string{"application/octet-stream;q=0, */*"}, expected: api.JsonMediaType, ok: true},
+		{name: "multiple header values", accept: []string{"text/html", "application/octet-stream"}, expected: api.OctetStreamMediaType, ok: true},
+		{name: "case insensitive", accept: []string{"Application/Octet-Stream"}, expected: api.OctetStreamMediaType, ok: true},
+		{name: "unsupported", accept: []string{"text/plain"}, ok: false},
+		{name: "all excluded", accept: []string{"*/*;q=0"}, ok: false},
+		{name: "malformed", accept: []string{"application/"}, ok: false},
+		{name: "invalid quality", accept: []string{"application/json;q=2"}, ok: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "http://foo.example", nil)
+			req.Header["Accept"] = tt.accept
+			mediaType, ok := NegotiateContentType(req, offered...)
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.expected, mediaType)
+		})
+	}
+}
+
+func TestHandleNotAcceptable(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "http://foo.example", nil)
+	req.Header.Set("Accept", api.OctetStreamMediaType)
+	writer := httptest.NewRecorder()
+
+	HandleNotAcceptable(writer, req, []string{api.JsonMediaType})
+	require.Equal(t, http.StatusNotAcceptable, writer.Code)
+	assert.Equal(t, api.JsonMediaType, writer.Header().Get("Content-Type"))
+	e := &DefaultJsonError{}
+	require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+	assert.Equal(t, http.StatusNotAcceptable, e.Code)
+	assert.StringContains(t, api.OctetStreamMediaType, e.Message)
+	assert.StringContains(t, api.JsonMediaType, e.Message)
+}
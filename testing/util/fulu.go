@@ -0,0 +1,120 @@
+package util
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain/kzg"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/signing"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/crypto/bls"
+	"github.com/prysmaticlabs/prysm/v5/network/forks"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+)
+
+type FuluBlockGeneratorOption func(*fuluBlockGenerator)
+
+type fuluBlockGenerator struct {
+	parent   [32]byte
+	slot     primitives.Slot
+	nblobs   int
+	sign     bool
+	sk       bls.SecretKey
+	proposer primitives.ValidatorIndex
+	valRoot  []byte
+}
+
+func WithFuluProposerSigning(idx primitives.ValidatorIndex, sk bls.SecretKey, valRoot []byte) FuluBlockGeneratorOption {
+	return func(g *fuluBlockGenerator) {
+		g.sign = true
+		g.proposer = idx
+		g.sk = sk
+		g.valRoot = valRoot
+	}
+}
+
+var startKZG sync.Once
+
+// GenerateTestFuluBlockWithColumns generates a Fulu block committing to nblobs random blobs, along with every data
+// column sidecar of that block. The inclusion proofs, cells and cell proofs of the sidecars are all valid.
+func GenerateTestFuluBlockWithColumns(t *testing.T, parent [32]byte, slot primitives.Slot, nblobs int, opts ...FuluBlockGeneratorOption) (blocks.ROBlock, []blocks.RODataColumn) {
+	g := &fuluBlockGenerator{
+		parent: parent,
+		slot:   slot,
+		nblobs: nblobs,
+	}
+	for _, o := range opts {
+		o(g)
+	}
+
+	block := NewBeaconBlockFulu()
+	block.Block.Slot = g.slot
+	block.Block.ParentRoot = g.parent[:]
+	block.Block.ProposerIndex = g.proposer
+	startKZG.Do(func() {
+		require.NoError(t, kzg.Start())
+	})
+	block.Block.Body.BlobKzgCommitments = make([][]byte, g.nblobs)
+	extended := make([]kzg.CellsAndProofs, g.nblobs)
+	for i := range block.Block.Body.BlobKzgCommitments {
+		blob := GetRandBlob(int64(g.slot)<<16 + int64(i))
+		commitment, err := kzg.BlobToKZGCommitment(blob[:])
+		require.NoError(t, err)
+		block.Block.Body.BlobKzgCommitments[i] = commitment
+		extended[i], err = kzg.ComputeCellsAndKZGProofs(blob[:])
+		require.NoError(t, err)
+	}
+
+	body, err := blocks.NewBeaconBlockBody(block.Block.Body)
+	require.NoError(t, err)
+	inclusion, err := blocks.MerkleProofKZGCommitments(body)
+	require.NoError(t, err)
+	if g.sign {
+		epoch := slots.ToEpoch(block.Block.Slot)
+		schedule := forks.NewOrderedSchedule(params.BeaconConfig())
+		version, err := schedule.VersionForEpoch(epoch)
+		require.NoError(t, err)
+		fork, err := schedule.ForkFromVersion(version)
+		require.NoError(t, err)
+		domain := params.BeaconConfig().DomainBeaconProposer
+		sig, err := signing.ComputeDomainAndSignWithoutState(fork, epoch, domain, g.valRoot, block.Block, g.sk)
+		require.NoError(t, err)
+		block.Signature = sig
+	}
+
+	sbb, err := blocks.NewSignedBeaconBlock(block)
+	require.NoError(t, err)
+	sh, err := sbb.Header()
+	require.NoError(t, err)
+	root, err := block.Block.HashTreeRoot()
+	require.NoError(t, err)
+
+	numberOfColumns := params.BeaconConfig().NumberOfColumns
+	columns := make([]blocks.RODataColumn, numberOfColumns)
+	for i := range columns {
+		cells := make([][]byte, g.nblobs)
+		proofs := make([][]byte, g.nblobs)
+		for j := range cells {
+			cells[j] = extended[j].Cells[i]
+			proofs[j] = extended[j].Proofs[i]
+		}
+		column, err := blocks.NewRODataColumnWithRoot(&ethpb.DataColumnSidecar{
+			ColumnIndex:                  uint64(i),
+			DataColumn:                   cells,
+			KzgCommitments:               block.Block.Body.BlobKzgCommitments,
+			KzgProof:                     proofs,
+			SignedBlockHeader:            sh,
+			KzgCommitmentsInclusionProof: inclusion,
+		}, root)
+		require.NoError(t, err)
+		columns[i] = column
+	}
+
+	rob, err := blocks.NewROBlock(sbb)
+	require.NoError(t, err)
+	return rob, columns
+}
@@ -86,6 +86,20 @@ func (mr *MockNodeClientMockRecorder) Peers(arg0, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Peers", reflect.TypeOf((*MockNodeClient)(nil).Peers), arg0, arg1)
 }
 
+// SubmitBLSToExecutionChanges mocks base method.
+func (m *MockNodeClient) SubmitBLSToExecutionChanges(arg0 context.Context, arg1 []*eth.SignedBLSToExecutionChange) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubmitBLSToExecutionChanges", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SubmitBLSToExecutionChanges indicates an expected call of SubmitBLSToExecutionChanges.
+func (mr *MockNodeClientMockRecorder) SubmitBLSToExecutionChanges(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubmitBLSToExecutionChanges", reflect.TypeOf((*MockNodeClient)(nil).SubmitBLSToExecutionChanges), arg0, arg1)
+}
+
 // SyncStatus mocks base method.
 func (m *MockNodeClient) SyncStatus(arg0 context.Context, arg1 *emptypb.Empty) (*eth.SyncStatus, error) {
 	m.ctrl.T.Helper()
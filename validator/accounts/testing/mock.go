@@ -178,6 +178,10 @@ func (_ *Validator) UpdateDomainDataCaches(_ context.Context, _ primitives.Slot)
 	panic("implement me")
 }
 
+func (_ *Validator) SubmitExitIntents(_ context.Context, _ primitives.Slot) {
+	panic("implement me")
+}
+
 func (_ *Validator) WaitForKeymanagerInitialization(_ context.Context) error {
 	panic("implement me")
 }
@@ -0,0 +1,395 @@
+package audit
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/io/file"
+)
+
+const (
+	// CurrentFileName is the name of the file entries are appended to.
+	CurrentFileName = "audit.log"
+	// DefaultMaxFileSize is the size above which the current file is rotated.
+	DefaultMaxFileSize = 64 << 20
+
+	rotatedFilePrefix = "audit-"
+	rotatedFileSuffix = ".log"
+	// maxLineSize bounds the size of a single entry when reading the log.
+	maxLineSize = 1 << 20
+)
+
+// genesisHash is the previous hash of the first entry of the log.
+var genesisHash = "0x" + strings.Repeat("0", 64)
+
+// Log is an append-only, hash-chained audit log stored in a directory. Entries are appended to the current file,
+// as JSON lines. Once the current file grows above the maximum size, it is rotated: it is renamed after the sequence
+// number of its first entry, and a new current file is started. Rotated files are never deleted.
+//
+// Entries are synced to disk before Record returns, but the sync is not done under the lock entries are appended
+// with: an entry appended while a sync is in progress is synced along with the entries appended concurrently to it,
+// so that concurrent signers share a sync instead of waiting for one each.
+type Log struct {
+	dir         string
+	maxFileSize int64
+
+	lock     sync.Mutex
+	f        *os.File
+	size     int64
+	firstSeq uint64
+	nextSeq  uint64
+	lastHash string
+	closed   bool
+
+	// writtenSeq is the sequence number of the last entry written, which syncs read without acquiring lock.
+	writtenSeq atomic.Uint64
+	// syncLock is acquired after lock when both are held.
+	syncLock  sync.Mutex
+	syncedSeq uint64
+}
+
+// Open opens the audit log in the given directory, creating it if needed. The chain of the current file is verified,
+// and an entry which was only partially written, for instance because of a crash, is dropped.
+func Open(dir string, maxFileSize int64) (*Log, error) {
+	if maxFileSize <= 0 {
+		maxFileSize = DefaultMaxFileSize
+	}
+	if err := file.MkdirAll(dir); err != nil {
+		return nil, errors.Wrap(err, "could not create audit log directory")
+	}
+	l := &Log{dir: dir, maxFileSize: maxFileSize, nextSeq: 1, lastHash: genesisHash}
+
+	// Resume the chain from the last rotated file, which the current file continues.
+	rotated, err := rotatedFiles(dir)
+	if err != nil {
+		return nil, err
+	}
+	if len(rotated) > 0 {
+		last, err := lastEntry(filepath.Join(dir, rotated[len(rotated)-1].name))
+		if err != nil {
+			return nil, err
+		}
+		if last != nil {
+			l.nextSeq, l.lastHash = last.Seq+1, last.Hash
+		}
+	}
+
+	p := filepath.Join(dir, CurrentFileName)
+	exists, err := file.Exists(p, file.Regular)
+	if err != nil {
+		return nil, err
+	}
+	if exists {
+		entries, validSize, err := readFile(p)
+		if err != nil {
+			return nil, err
+		}
+		// Without rotated files, the log may start at any entry as older files may have been archived.
+		nextSeq, prevHash := l.nextSeq, l.lastHash
+		if len(rotated) == 0 && len(entries) > 0 {
+			nextSeq, prevHash = entries[0].Seq, entries[0].PrevHash
+		}
+		if err := verifyChain(entries, nextSeq, prevHash); err != nil {
+			return nil, errors.Wrapf(err, "audit log %s is corrupted", p)
+		}
+		if len(entries) > 0 {
+			l.firstSeq = entries[0].Seq
+			l.nextSeq, l.lastHash = entries[len(entries)-1].Seq+1, entries[len(entries)-1].Hash
+		}
+		if err := os.Truncate(p, validSize); err != nil {
+			return nil, errors.Wrap(err, "could not drop partially written audit log entry")
+		}
+	}
+	if err := l.openCurrent(); err != nil {
+		return nil, err
+	}
+	log.WithFields(map[string]interface{}{
+		"dir":     dir,
+		"nextSeq": l.nextSeq,
+	}).Info("Opened signing audit log")
+	return l, nil
+}
+
+func (l *Log) openCurrent() error {
+	p := filepath.Join(l.dir, CurrentFileName)
+	f, err := os.OpenFile(p, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0600) // #nosec G304 -- path is built from the log directory.
+	if err != nil {
+		return errors.Wrap(err, "could not open audit log")
+	}
+	info, err := f.Stat()
+	if err != nil {
+		return errors.Wrap(err, "could not stat audit log")
+	}
+	l.f, l.size = f, info.Size()
+	if l.size == 0 {
+		l.firstSeq = l.nextSeq
+	}
+	return nil
+}
+
+// Record appends the entry to the log, setting its sequence number, time and hashes. Errors are logged, as an
+// audit log failure must not prevent the validator from performing its duties. Record does nothing on a nil log.
+func (l *Log) Record(e *Entry) {
+	if l == nil {
+		return
+	}
+	if err := l.append(e); err != nil {
+		log.WithError(err).WithField("duty", e.Duty).Error("Could not record signing decision in audit log")
+	}
+}
+
+// append writes the entry to the current file, and waits for it to be synced to disk.
+func (l *Log) append(e *Entry) error {
+	f, err := l.write(e)
+	if err != nil {
+		return err
+	}
+	return l.sync(f, e.Seq)
+}
+
+// write writes the entry to the current file, which it returns.
+func (l *Log) write(e *Entry) (*os.File, error) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	if l.closed {
+		return nil, errors.New("audit log is closed")
+	}
+	e.Seq = l.nextSeq
+	if e.Time.IsZero() {
+		e.Time = time.Now()
+	}
+	e.Time = e.Time.UTC()
+	e.PrevHash = l.lastHash
+	h, err := e.computeHash()
+	if err != nil {
+		return nil, err
+	}
+	e.Hash = h
+	b, err := json.Marshal(e)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, '\n')
+	if l.size > 0 && l.size+int64(len(b)) > l.maxFileSize {
+		if err := l.rotate(); err != nil {
+			return nil, errors.Wrap(err, "could not rotate audit log")
+		}
+	}
+	if _, err := l.f.Write(b); err != nil {
+		return nil, err
+	}
+	l.size += int64(len(b))
+	l.nextSeq++
+	l.lastHash = e.Hash
+	l.writtenSeq.Store(e.Seq)
+	return l.f, nil
+}
+
+// sync syncs the file the entry with the given sequence number was written to, unless a sync which started after
+// the entry was written already did. A single sync covers every entry written to the file before it started.
+func (l *Log) sync(f *os.File, seq uint64) error {
+	l.syncLock.Lock()
+	defer l.syncLock.Unlock()
+	if l.syncedSeq >= seq {
+		return nil
+	}
+	// The file cannot be rotated while the sync lock is held, so every entry written so far is in the file and
+	// covered by the sync.
+	last := l.writtenSeq.Load()
+	if err := f.Sync(); err != nil {
+		return err
+	}
+	l.syncedSeq = last
+	return nil
+}
+
+// closeCurrent syncs and closes the current file. The lock must be held.
+func (l *Log) closeCurrent() error {
+	l.syncLock.Lock()
+	defer l.syncLock.Unlock()
+	if err := l.f.Sync(); err != nil {
+		return err
+	}
+	l.syncedSeq = l.nextSeq - 1
+	return l.f.Close()
+}
+
+// rotate renames the current file after the sequence number of its first entry, and starts a new current file.
+func (l *Log) rotate() error {
+	if err := l.closeCurrent(); err != nil {
+		return err
+	}
+	rotated := filepath.Join(l.dir, rotatedFileName(l.firstSeq))
+	if err := os.Rename(filepath.Join(l.dir, CurrentFileName), rotated); err != nil {
+		return err
+	}
+	log.WithField("file", rotated).Info("Rotated signing audit log")
+	return l.openCurrent()
+}
+
+// Close closes the log. Entries recorded afterwards are dropped.
+func (l *Log) Close() error {
+	if l == nil {
+		return nil
+	}
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	if l.closed {
+		return nil
+	}
+	l.closed = true
+	return l.closeCurrent()
+}
+
+// Dir returns the directory of the log.
+func (l *Log) Dir() string {
+	return l.dir
+}
+
+func rotatedFileName(firstSeq uint64) string {
+	return fmt.Sprintf("%s%020d%s", rotatedFilePrefix, firstSeq, rotatedFileSuffix)
+}
+
+type logFile struct {
+	name     string
+	firstSeq uint64
+}
+
+// rotatedFiles returns the rotated files of the log directory, in order.
+func rotatedFiles(dir string) ([]logFile, error) {
+	dirEntries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not list audit log directory")
+	}
+	var files []logFile
+	for _, de := range dirEntries {
+		name := de.Name()
+		if de.IsDir() || !strings.HasPrefix(name, rotatedFilePrefix) || !strings.HasSuffix(name, rotatedFileSuffix) {
+			continue
+		}
+		seq, err := strconv.ParseUint(strings.TrimSuffix(strings.TrimPrefix(name, rotatedFilePrefix), rotatedFileSuffix), 10, 64)
+		if err != nil {
+			continue
+		}
+		files = append(files, logFile{name: name, firstSeq: seq})
+	}
+	sort.Slice(files, func(i, j int) bool { return files[i].firstSeq < files[j].firstSeq })
+	return files, nil
+}
+
+// files returns all the files of the log directory, in order, starting with the rotated files.
+func files(dir string) ([]logFile, error) {
+	fs, err := rotatedFiles(dir)
+	if err != nil {
+		return nil, err
+	}
+	exists, err := file.Exists(filepath.Join(dir, CurrentFileName), file.Regular)
+	if err != nil {
+		return nil, err
+	}
+	if exists {
+		fs = append(fs, logFile{name: CurrentFileName})
+	}
+	return fs, nil
+}
+
+// scanFile calls fn on the entries of a log file until it returns false. At most maxSize bytes of the file are read
+// if maxSize is positive. It returns the size of the file up to the last complete entry.
+func scanFile(p string, maxSize int64, fn func(*Entry) bool) (int64, error) {
+	f, err := os.Open(p) // #nosec G304 -- path is built from the log directory.
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		if err := f.Close(); err != nil {
+			log.WithError(err).Debug("Could not close audit log file")
+		}
+	}()
+	var src io.Reader = f
+	if maxSize > 0 {
+		src = io.LimitReader(f, maxSize)
+	}
+	r := bufio.NewReaderSize(src, 64<<10)
+	size := int64(0)
+	for {
+		line, err := r.ReadBytes('\n')
+		if errors.Is(err, io.EOF) {
+			if len(bytes.TrimSpace(line)) > 0 {
+				log.WithField("file", p).Warn("Ignoring partially written audit log entry")
+			}
+			return size, nil
+		}
+		if err != nil {
+			return 0, err
+		}
+		if len(line) > maxLineSize {
+			return 0, fmt.Errorf("audit log entry at offset %d of %s is too large", size, p)
+		}
+		e := &Entry{}
+		if err := json.Unmarshal(line, e); err != nil {
+			return 0, errors.Wrapf(err, "invalid audit log entry at offset %d of %s", size, p)
+		}
+		size += int64(len(line))
+		if !fn(e) {
+			return size, nil
+		}
+	}
+}
+
+// readFile reads the entries of a log file. It also returns the size of the file up to the last complete entry.
+func readFile(p string) ([]*Entry, int64, error) {
+	var entries []*Entry
+	size, err := scanFile(p, 0, func(e *Entry) bool {
+		entries = append(entries, e)
+		return true
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+	return entries, size, nil
+}
+
+// lastEntry returns the last complete entry of a log file, or nil if it is empty.
+func lastEntry(p string) (*Entry, error) {
+	var last *Entry
+	if _, err := scanFile(p, 0, func(e *Entry) bool {
+		last = e
+		return true
+	}); err != nil {
+		return nil, err
+	}
+	return last, nil
+}
+
+// verifyChain verifies the hashes of the entries, and that they follow the given sequence number and hash.
+func verifyChain(entries []*Entry, nextSeq uint64, prevHash string) error {
+	for _, e := range entries {
+		if e.Seq != nextSeq {
+			return fmt.Errorf("entry %d is out of sequence, expected %d", e.Seq, nextSeq)
+		}
+		if e.PrevHash != prevHash {
+			return fmt.Errorf("entry %d does not follow the hash of entry %d", e.Seq, nextSeq-1)
+		}
+		h, err := e.computeHash()
+		if err != nil {
+			return err
+		}
+		if h != e.Hash {
+			return fmt.Errorf("entry %d does not match its hash", e.Seq)
+		}
+		nextSeq, prevHash = e.Seq+1, e.Hash
+	}
+	return nil
+}
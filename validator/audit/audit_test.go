@@ -0,0 +1,205 @@
+package audit
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/crypto/bls"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	validatorpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1/validator-client"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func recordEntries(t *testing.T, l *Log, n int) {
+	for i := 0; i < n; i++ {
+		l.Record(NewEntry(make([]byte, 48), DutyAttestation, 64, make([]byte, 32), VerdictSafe, nil, time.Millisecond))
+	}
+}
+
+func TestLog_RecordAndReopen(t *testing.T) {
+	dir := t.TempDir()
+	l, err := Open(dir, 0)
+	require.NoError(t, err)
+	recordEntries(t, l, 3)
+	l.Record(NewEntry(make([]byte, 48), DutyBlock, 65, make([]byte, 32), VerdictRefused, errors.New("double proposal"), time.Millisecond))
+	require.NoError(t, l.Close())
+
+	l, err = Open(dir, 0)
+	require.NoError(t, err)
+	recordEntries(t, l, 1)
+	entries, err := l.Entries(0, 100, nil)
+	require.NoError(t, err)
+	require.Equal(t, 5, len(entries))
+	for i, e := range entries {
+		assert.Equal(t, uint64(i+1), e.Seq)
+	}
+	assert.Equal(t, genesisHash, entries[0].PrevHash)
+	assert.Equal(t, entries[3].Hash, entries[4].PrevHash)
+	assert.Equal(t, VerdictRefused, entries[3].Verdict)
+	assert.Equal(t, "double proposal", entries[3].Reason)
+	assert.Equal(t, int64(1000), entries[3].LatencyUs)
+	assert.Equal(t, DutyBlock, entries[3].Duty)
+	require.NoError(t, l.Close())
+
+	n, err := Verify(dir)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(5), n)
+}
+
+func TestLog_Rotate(t *testing.T) {
+	dir := t.TempDir()
+	// Entries are around 400 bytes long, so that files are rotated every 2 entries.
+	l, err := Open(dir, 1000)
+	require.NoError(t, err)
+	recordEntries(t, l, 7)
+
+	rotated, err := rotatedFiles(dir)
+	require.NoError(t, err)
+	require.Equal(t, 3, len(rotated))
+	assert.Equal(t, uint64(1), rotated[0].firstSeq)
+	assert.Equal(t, uint64(3), rotated[1].firstSeq)
+
+	entries, err := l.Entries(4, 2, nil)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(entries))
+	assert.Equal(t, uint64(4), entries[0].Seq)
+	assert.Equal(t, uint64(5), entries[1].Seq)
+	require.NoError(t, l.Close())
+
+	// The chain continues from the last rotated file.
+	require.NoError(t, os.Remove(filepath.Join(dir, CurrentFileName)))
+	l, err = Open(dir, 1000)
+	require.NoError(t, err)
+	recordEntries(t, l, 1)
+	require.NoError(t, l.Close())
+	n, err := Verify(dir)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(7), n)
+}
+
+func TestLog_ConcurrentRecords(t *testing.T) {
+	dir := t.TempDir()
+	// Rotate every few entries, so that rotations race with the syncs of concurrent records.
+	l, err := Open(dir, 2000)
+	require.NoError(t, err)
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			recordEntries(t, l, 25)
+		}()
+	}
+	wg.Wait()
+	// Every entry is synced once recorded.
+	assert.Equal(t, uint64(200), l.syncedSeq)
+	require.NoError(t, l.Close())
+
+	n, err := Verify(dir)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(200), n)
+}
+
+func TestLog_Tampering(t *testing.T) {
+	dir := t.TempDir()
+	l, err := Open(dir, 0)
+	require.NoError(t, err)
+	recordEntries(t, l, 3)
+	require.NoError(t, l.Close())
+
+	p := filepath.Join(dir, CurrentFileName)
+	b, err := os.ReadFile(p)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(p, []byte(strings.Replace(string(b), `"verdict":"safe"`, `"verdict":"refused"`, 1)), 0600))
+	_, err = Verify(dir)
+	require.ErrorContains(t, "entry 1 does not match its hash", err)
+	_, err = Open(dir, 0)
+	require.ErrorContains(t, "is corrupted", err)
+
+	// Dropping an entry breaks the chain.
+	lines := strings.SplitAfter(string(b), "\n")
+	require.NoError(t, os.WriteFile(p, []byte(lines[0]+lines[2]), 0600))
+	_, err = Verify(dir)
+	require.ErrorContains(t, "entry 3 is out of sequence", err)
+}
+
+func TestLog_PartialEntry(t *testing.T) {
+	dir := t.TempDir()
+	l, err := Open(dir, 0)
+	require.NoError(t, err)
+	recordEntries(t, l, 2)
+	require.NoError(t, l.Close())
+
+	p := filepath.Join(dir, CurrentFileName)
+	f, err := os.OpenFile(p, os.O_APPEND|os.O_WRONLY, 0600)
+	require.NoError(t, err)
+	_, err = f.WriteString(`{"seq":"3","time":`)
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	l, err = Open(dir, 0)
+	require.NoError(t, err)
+	recordEntries(t, l, 1)
+	require.NoError(t, l.Close())
+	n, err := Verify(dir)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(3), n)
+}
+
+func TestLog_WrapSigner(t *testing.T) {
+	l, err := Open(t.TempDir(), 0)
+	require.NoError(t, err)
+	defer func() { require.NoError(t, l.Close()) }()
+	key, err := bls.RandKey()
+	require.NoError(t, err)
+	sign := l.WrapSigner(func(_ context.Context, req *validatorpb.SignRequest) (bls.Signature, error) {
+		if req.SigningSlot == 13 {
+			return nil, errors.New("remote signer unavailable")
+		}
+		return key.Sign(req.SigningRoot), nil
+	})
+
+	ctx := context.Background()
+	_, err = sign(ctx, &validatorpb.SignRequest{PublicKey: key.PublicKey().Marshal(), SigningRoot: make([]byte, 32), SigningSlot: 10, Object: &validatorpb.SignRequest_Slot{Slot: 10}})
+	require.NoError(t, err)
+	// Attestations are recorded with their slashing protection verdict, by the caller.
+	_, err = sign(ctx, &validatorpb.SignRequest{PublicKey: key.PublicKey().Marshal(), SigningRoot: make([]byte, 32), SigningSlot: 11, Object: &validatorpb.SignRequest_AttestationData{AttestationData: &ethpb.AttestationData{}}})
+	require.NoError(t, err)
+	_, err = sign(ctx, &validatorpb.SignRequest{PublicKey: key.PublicKey().Marshal(), SigningRoot: make([]byte, 32), SigningSlot: 13, Object: &validatorpb.SignRequest_AttestationData{AttestationData: &ethpb.AttestationData{}}})
+	require.ErrorContains(t, "remote signer unavailable", err)
+
+	entries, err := l.Entries(0, 10, nil)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(entries))
+	assert.Equal(t, DutyAggregationSlot, entries[0].Duty)
+	assert.Equal(t, VerdictSigned, entries[0].Verdict)
+	assert.Equal(t, DutyAttestation, entries[1].Duty)
+	assert.Equal(t, VerdictFailed, entries[1].Verdict)
+	assert.Equal(t, "remote signer unavailable", entries[1].Reason)
+
+	// The filter selects entries.
+	entries, err = l.Entries(0, 10, func(e *Entry) bool { return e.Verdict == VerdictFailed })
+	require.NoError(t, err)
+	require.Equal(t, 1, len(entries))
+
+	// A nil log does not wrap the signer.
+	var nilLog *Log
+	nilLog.Record(&Entry{})
+	require.NotNil(t, nilLog.WrapSigner(sign))
+}
+
+func TestDutyFromSignRequest(t *testing.T) {
+	assert.Equal(t, DutyVoluntaryExit, DutyFromSignRequest(&validatorpb.SignRequest{Object: &validatorpb.SignRequest_Exit{}}))
+	domainType := params.BeaconConfig().DomainBLSToExecutionChange
+	domain := append(domainType[:], make([]byte, 28)...)
+	assert.Equal(t, DutyBLSToExecutionChange, DutyFromSignRequest(&validatorpb.SignRequest{SignatureDomain: domain}))
+	assert.Equal(t, DutyUnknown, DutyFromSignRequest(&validatorpb.SignRequest{SignatureDomain: make([]byte, 32)}))
+}
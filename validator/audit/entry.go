@@ -0,0 +1,172 @@
+// Package audit implements an append-only, hash-chained log of the signing decisions of the validator client.
+//
+// Every entry records the validator public key, the duty, the slot and epoch, the signing root, the verdict of the
+// decision and the time it took. Entries are chained by including the hash of the previous entry in each entry, so
+// that any modification, deletion or reordering of the log is detected by Verify.
+package audit
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/crypto/bls"
+	validatorpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1/validator-client"
+)
+
+// Duty is the kind of object signed by the validator client.
+type Duty string
+
+const (
+	DutyAttestation               Duty = "attestation"
+	DutyBlock                     Duty = "block"
+	DutyRandaoReveal              Duty = "randao_reveal"
+	DutyAggregationSlot           Duty = "aggregation_slot"
+	DutyAggregateAndProof         Duty = "aggregate_and_proof"
+	DutySyncCommitteeMessage      Duty = "sync_committee_message"
+	DutySyncCommitteeSelection    Duty = "sync_committee_selection_proof"
+	DutySyncCommitteeContribution Duty = "sync_committee_contribution_and_proof"
+	DutyValidatorRegistration     Duty = "validator_registration"
+	DutyVoluntaryExit             Duty = "voluntary_exit"
+	DutyBLSToExecutionChange      Duty = "bls_to_execution_change"
+	DutyUnknown                   Duty = "unknown"
+)
+
+// Verdict is the outcome of a signing decision.
+type Verdict string
+
+const (
+	// VerdictSigned means the object was signed. No slashing protection applies to the duty.
+	VerdictSigned Verdict = "signed"
+	// VerdictSafe means the object was signed and passed the slashing protection check.
+	VerdictSafe Verdict = "safe"
+	// VerdictRefused means the signed object was refused by the slashing protection check, and not published.
+	VerdictRefused Verdict = "refused"
+	// VerdictFailed means the object could not be signed.
+	VerdictFailed Verdict = "failed"
+	// VerdictSkipped means the duty was skipped before anything was signed.
+	VerdictSkipped Verdict = "skipped"
+)
+
+// Entry is a signing decision recorded in the audit log.
+type Entry struct {
+	Seq         uint64           `json:"seq,string"`
+	Time        time.Time        `json:"time"`
+	Pubkey      string           `json:"pubkey"`
+	Duty        Duty             `json:"duty"`
+	Slot        primitives.Slot  `json:"slot,string"`
+	Epoch       primitives.Epoch `json:"epoch,string"`
+	SigningRoot string           `json:"signing_root,omitempty"`
+	Verdict     Verdict          `json:"verdict"`
+	Reason      string           `json:"reason,omitempty"`
+	LatencyUs   int64            `json:"latency_us,string"`
+	PrevHash    string           `json:"prev_hash"`
+	Hash        string           `json:"hash"`
+}
+
+// NewEntry returns an entry for a decision on signing the given root at the given slot.
+func NewEntry(pubkey []byte, duty Duty, slot primitives.Slot, signingRoot []byte, verdict Verdict, reason error, latency time.Duration) *Entry {
+	e := &Entry{
+		Pubkey:    hexutil.Encode(pubkey),
+		Duty:      duty,
+		Slot:      slot,
+		Epoch:     primitives.Epoch(slot / params.BeaconConfig().SlotsPerEpoch),
+		Verdict:   verdict,
+		LatencyUs: latency.Microseconds(),
+	}
+	if len(signingRoot) > 0 {
+		e.SigningRoot = hexutil.Encode(signingRoot)
+	}
+	if reason != nil {
+		e.Reason = reason.Error()
+	}
+	return e
+}
+
+// computeHash returns the hash of the entry, which covers all of its fields but the hash itself.
+func (e *Entry) computeHash() (string, error) {
+	c := *e
+	c.Hash = ""
+	b, err := json.Marshal(&c)
+	if err != nil {
+		return "", err
+	}
+	h := sha256.Sum256(b)
+	return hexutil.Encode(h[:]), nil
+}
+
+// DutyFromSignRequest returns the duty of a sign request, from the type of the object to sign.
+func DutyFromSignRequest(req *validatorpb.SignRequest) Duty {
+	switch req.Object.(type) {
+	case *validatorpb.SignRequest_AttestationData:
+		return DutyAttestation
+	case *validatorpb.SignRequest_Block, *validatorpb.SignRequest_BlockAltair, *validatorpb.SignRequest_BlockBellatrix,
+		*validatorpb.SignRequest_BlindedBlockBellatrix, *validatorpb.SignRequest_BlockCapella,
+		*validatorpb.SignRequest_BlindedBlockCapella, *validatorpb.SignRequest_BlockDeneb,
+		*validatorpb.SignRequest_BlindedBlockDeneb, *validatorpb.SignRequest_BlockElectra,
+		*validatorpb.SignRequest_BlindedBlockElectra, *validatorpb.SignRequest_BlockFulu,
+		*validatorpb.SignRequest_BlindedBlockFulu:
+		return DutyBlock
+	case *validatorpb.SignRequest_Epoch:
+		return DutyRandaoReveal
+	case *validatorpb.SignRequest_Slot:
+		return DutyAggregationSlot
+	case *validatorpb.SignRequest_AggregateAttestationAndProof, *validatorpb.SignRequest_AggregateAttestationAndProofElectra:
+		return DutyAggregateAndProof
+	case *validatorpb.SignRequest_SyncMessageBlockRoot:
+		return DutySyncCommitteeMessage
+	case *validatorpb.SignRequest_SyncAggregatorSelectionData:
+		return DutySyncCommitteeSelection
+	case *validatorpb.SignRequest_ContributionAndProof:
+		return DutySyncCommitteeContribution
+	case *validatorpb.SignRequest_Registration:
+		return DutyValidatorRegistration
+	case *validatorpb.SignRequest_Exit:
+		return DutyVoluntaryExit
+	case nil:
+		// BLS to execution changes have no sign request object, they are identified by their signature domain.
+		domainType := params.BeaconConfig().DomainBLSToExecutionChange
+		if bytes.HasPrefix(req.SignatureDomain, domainType[:]) {
+			return DutyBLSToExecutionChange
+		}
+		return DutyUnknown
+	default:
+		return DutyUnknown
+	}
+}
+
+// slashingProtected reports whether the slashing protection check is done after signing the duty. Such decisions
+// are recorded once the check is done, with its verdict.
+func (d Duty) slashingProtected() bool {
+	return d == DutyAttestation || d == DutyBlock
+}
+
+type signFunc = func(context.Context, *validatorpb.SignRequest) (bls.Signature, error)
+
+// WrapSigner returns a signing function which records the decisions of the given signing function. Signed
+// attestations and blocks are not recorded by it, as they are recorded with the verdict of their slashing protection
+// check. The signing function is returned as is when the log is nil.
+func (l *Log) WrapSigner(sign signFunc) signFunc {
+	if l == nil {
+		return sign
+	}
+	return func(ctx context.Context, req *validatorpb.SignRequest) (bls.Signature, error) {
+		start := time.Now()
+		sig, err := sign(ctx, req)
+		duty := DutyFromSignRequest(req)
+		if err == nil && duty.slashingProtected() {
+			return sig, nil
+		}
+		verdict := VerdictSigned
+		if err != nil {
+			verdict = VerdictFailed
+		}
+		l.Record(NewEntry(req.PublicKey, duty, req.SigningSlot, req.SigningRoot, verdict, err, time.Since(start)))
+		return sig, err
+	}
+}
@@ -0,0 +1,81 @@
+package audit
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+)
+
+// Entries returns up to limit entries of the log matching the filter, starting at sequence number from. A nil filter
+// matches all the entries.
+func (l *Log) Entries(from uint64, limit int, filter func(*Entry) bool) ([]*Entry, error) {
+	// Only the complete entries of the current file are read, as it may be written to concurrently.
+	l.lock.Lock()
+	currentSize := l.size
+	l.lock.Unlock()
+
+	fs, err := files(l.dir)
+	if err != nil {
+		return nil, err
+	}
+	var entries []*Entry
+	for i, f := range fs {
+		if len(entries) >= limit {
+			break
+		}
+		// Skip the files which only contain entries before the requested one.
+		if i+1 < len(fs) && fs[i+1].name != CurrentFileName && fs[i+1].firstSeq <= from {
+			continue
+		}
+		maxSize := int64(0)
+		if f.name == CurrentFileName {
+			maxSize = currentSize
+		}
+		if _, err := scanFile(filepath.Join(l.dir, f.name), maxSize, func(e *Entry) bool {
+			if e.Seq >= from && (filter == nil || filter(e)) {
+				entries = append(entries, e)
+			}
+			return len(entries) < limit
+		}); err != nil {
+			return nil, err
+		}
+	}
+	return entries, nil
+}
+
+// Verify verifies the hash chain of the audit log in the given directory, across all of its files. It returns the
+// number of entries of the log. The first entry of the log may follow entries which were archived, in which case its
+// previous hash can only be checked against the archive.
+func Verify(dir string) (uint64, error) {
+	fs, err := files(dir)
+	if err != nil {
+		return 0, err
+	}
+	count := uint64(0)
+	var nextSeq uint64
+	var prevHash string
+	var chainErr error
+	for _, f := range fs {
+		if f.name != CurrentFileName && count > 0 && f.firstSeq != nextSeq {
+			return count, fmt.Errorf("audit log file %s does not start at entry %d", f.name, nextSeq)
+		}
+		if _, err := scanFile(filepath.Join(dir, f.name), 0, func(e *Entry) bool {
+			if count == 0 {
+				nextSeq, prevHash = e.Seq, e.PrevHash
+			}
+			if chainErr = verifyChain([]*Entry{e}, nextSeq, prevHash); chainErr != nil {
+				return false
+			}
+			count++
+			nextSeq, prevHash = e.Seq+1, e.Hash
+			return true
+		}); err != nil {
+			return count, err
+		}
+		if chainErr != nil {
+			return count, errors.Wrapf(chainErr, "invalid audit log file %s", f.name)
+		}
+	}
+	return count, nil
+}
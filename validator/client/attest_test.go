@@ -10,6 +10,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/prysmaticlabs/go-bitfield"
 	"github.com/prysmaticlabs/prysm/v5/async/event"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/signing"
@@ -24,6 +25,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/testing/require"
 	"github.com/prysmaticlabs/prysm/v5/testing/util"
 	prysmTime "github.com/prysmaticlabs/prysm/v5/time"
+	"github.com/prysmaticlabs/prysm/v5/validator/audit"
 	logTest "github.com/sirupsen/logrus/hooks/test"
 	"go.uber.org/mock/gomock"
 	"gopkg.in/d4l3k/messagediff.v1"
@@ -322,6 +324,69 @@ func TestAttestToBlockHead_BlocksDoubleAtt(t *testing.T) {
 	}
 }
 
+func TestAttestToBlockHead_RecordsAuditLog(t *testing.T) {
+	validator, m, validatorKey, finish := setup(t, false)
+	defer finish()
+	auditLog, err := audit.Open(t.TempDir(), 0)
+	require.NoError(t, err)
+	defer func() { require.NoError(t, auditLog.Close()) }()
+	validator.auditLog = auditLog
+	validatorIndex := primitives.ValidatorIndex(7)
+	var pubKey [fieldparams.BLSPubkeyLength]byte
+	copy(pubKey[:], validatorKey.PublicKey().Marshal())
+	validator.duties = &ethpb.DutiesResponse{CurrentEpochDuties: []*ethpb.DutiesResponse_Duty{
+		{
+			PublicKey:      validatorKey.PublicKey().Marshal(),
+			CommitteeIndex: 5,
+			Committee:      []primitives.ValidatorIndex{0, validatorIndex},
+			ValidatorIndex: validatorIndex,
+		},
+	}}
+	targetRoot := bytesutil.ToBytes32([]byte("B"))
+	sourceRoot := bytesutil.ToBytes32([]byte("C"))
+	for _, blockRoot := range []string{"A", "D"} {
+		root := bytesutil.ToBytes32([]byte(blockRoot))
+		m.validatorClient.EXPECT().AttestationData(
+			gomock.Any(), // ctx
+			gomock.AssignableToTypeOf(&ethpb.AttestationDataRequest{}),
+		).Return(&ethpb.AttestationData{
+			BeaconBlockRoot: root[:],
+			Target:          &ethpb.Checkpoint{Root: targetRoot[:], Epoch: 4},
+			Source:          &ethpb.Checkpoint{Root: sourceRoot[:], Epoch: 3},
+		}, nil)
+	}
+	m.validatorClient.EXPECT().AttestationData(
+		gomock.Any(), // ctx
+		gomock.AssignableToTypeOf(&ethpb.AttestationDataRequest{}),
+	).Return(nil, errors.New("beacon node unavailable"))
+	m.validatorClient.EXPECT().DomainData(
+		gomock.Any(), // ctx
+		gomock.Any(), // epoch
+	).Times(4).Return(&ethpb.DomainResponse{SignatureDomain: make([]byte, 32)}, nil /*err*/)
+	m.validatorClient.EXPECT().ProposeAttestation(
+		gomock.Any(), // ctx
+		gomock.AssignableToTypeOf(&ethpb.Attestation{}),
+	).Return(&ethpb.AttestResponse{AttestationDataRoot: make([]byte, 32)}, nil /* error */)
+
+	validator.SubmitAttestation(context.Background(), 30, pubKey)
+	validator.SubmitAttestation(context.Background(), 30, pubKey)
+	validator.SubmitAttestation(context.Background(), 31, pubKey)
+
+	entries, err := auditLog.Entries(0, 10, nil)
+	require.NoError(t, err)
+	require.Equal(t, 3, len(entries))
+	for _, e := range entries {
+		assert.Equal(t, audit.DutyAttestation, e.Duty)
+		assert.Equal(t, hexutil.Encode(pubKey[:]), e.Pubkey)
+	}
+	assert.Equal(t, audit.VerdictSafe, entries[0].Verdict)
+	assert.Equal(t, audit.VerdictRefused, entries[1].Verdict)
+	assert.NotEqual(t, "", entries[1].Reason)
+	assert.Equal(t, audit.VerdictSkipped, entries[2].Verdict)
+	assert.Equal(t, primitives.Slot(31), entries[2].Slot)
+	assert.Equal(t, "beacon node unavailable", entries[2].Reason)
+}
+
 func TestAttestToBlockHead_BlocksSurroundAtt(t *testing.T) {
 	for _, isSlashingProtectionMinimal := range [...]bool{false, true} {
 		t.Run(fmt.Sprintf("SlashingProtectionMinimal:%v", isSlashingProtectionMinimal), func(t *testing.T) {
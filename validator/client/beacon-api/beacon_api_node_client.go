@@ -1,7 +1,9 @@
 package beacon_api
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"strconv"
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
@@ -103,6 +105,14 @@ func (c *beaconApiNodeClient) Peers(ctx context.Context, in *empty.Empty) (*ethp
 	panic("beaconApiNodeClient.Peers is not implemented. To use a fallback client, pass a fallback client as the last argument of NewBeaconApiNodeClientWithFallback.")
 }
 
+func (c *beaconApiNodeClient) SubmitBLSToExecutionChanges(ctx context.Context, changes []*ethpb.SignedBLSToExecutionChange) error {
+	body, err := json.Marshal(structs.SignedBLSChangesFromConsensus(changes))
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal BLS to execution changes")
+	}
+	return c.jsonRestHandler.Post(ctx, "/eth/v1/beacon/pool/bls_to_execution_changes", nil, bytes.NewBuffer(body), nil)
+}
+
 func (c *beaconApiNodeClient) IsHealthy(ctx context.Context) bool {
 	return c.jsonRestHandler.Get(ctx, "/eth/v1/node/health", nil) == nil
 }
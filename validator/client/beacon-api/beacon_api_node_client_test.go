@@ -1,7 +1,9 @@
 package beacon_api
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
 	"testing"
 
@@ -9,6 +11,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
 	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
 	"github.com/prysmaticlabs/prysm/v5/validator/client/beacon-api/mock"
 	"go.uber.org/mock/gomock"
 	"google.golang.org/protobuf/types/known/emptypb"
@@ -288,3 +291,36 @@ func TestGetVersion(t *testing.T) {
 		})
 	}
 }
+
+func TestSubmitBLSToExecutionChanges(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.Background()
+
+	changes := []*ethpb.SignedBLSToExecutionChange{
+		{
+			Message: &ethpb.BLSToExecutionChange{
+				ValidatorIndex:     1,
+				FromBlsPubkey:      make([]byte, 48),
+				ToExecutionAddress: make([]byte, 20),
+			},
+			Signature: make([]byte, 96),
+		},
+	}
+	marshalledChanges, err := json.Marshal(structs.SignedBLSChangesFromConsensus(changes))
+	require.NoError(t, err)
+
+	jsonRestHandler := mock.NewMockJsonRestHandler(ctrl)
+	jsonRestHandler.EXPECT().Post(
+		gomock.Any(),
+		"/eth/v1/beacon/pool/bls_to_execution_changes",
+		nil,
+		bytes.NewBuffer(marshalledChanges),
+		nil,
+	).Return(
+		nil,
+	).Times(1)
+
+	nodeClient := &beaconApiNodeClient{jsonRestHandler: jsonRestHandler}
+	require.NoError(t, nodeClient.SubmitBLSToExecutionChanges(ctx, changes))
+}
@@ -31,6 +31,7 @@ func (c *beaconApiValidatorClient) beaconBlock(ctx context.Context, slot primiti
 
 	var ver string
 	var blinded bool
+	var payloadValue string
 	var decoder *json.Decoder
 
 	// Try v3 endpoint first. If it's not supported, then we fall back to older endpoints.
@@ -67,9 +68,15 @@ func (c *beaconApiValidatorClient) beaconBlock(ctx context.Context, slot primiti
 	} else {
 		ver = produceBlockV3ResponseJson.Version
 		blinded = produceBlockV3ResponseJson.ExecutionPayloadBlinded
+		payloadValue = produceBlockV3ResponseJson.ExecutionPayloadValue
 		decoder = json.NewDecoder(bytes.NewReader(produceBlockV3ResponseJson.Data))
 	}
-	return processBlockResponse(ver, blinded, decoder)
+	response, err := processBlockResponse(ver, blinded, decoder)
+	if err != nil {
+		return nil, err
+	}
+	response.PayloadValue = payloadValue
+	return response, nil
 }
 
 // nolint: gocognit
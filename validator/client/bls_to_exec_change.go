@@ -0,0 +1,85 @@
+package client
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/signing"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/crypto/hash"
+	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing/trace"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	validatorpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1/validator-client"
+	"github.com/prysmaticlabs/prysm/v5/validator/client/iface"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+const signBLSToExecutionChangeErr = "could not sign BLS to execution change"
+
+// CreateSignedBLSToExecutionChange creates a BLS to execution change of the validator with the given public key,
+// setting its withdrawal credentials to the given execution address. The message is signed by the signer with
+// the BLS withdrawal key, which must match the current withdrawal credentials of the validator.
+func CreateSignedBLSToExecutionChange(
+	ctx context.Context,
+	chainClient iface.ChainClient,
+	nodeClient iface.NodeClient,
+	signer iface.SigningFunc,
+	pubKey []byte,
+	fromBLSPubkey []byte,
+	toExecutionAddress common.Address,
+) (*ethpb.SignedBLSToExecutionChange, error) {
+	ctx, span := trace.StartSpan(ctx, "validator.CreateSignedBLSToExecutionChange")
+	defer span.End()
+
+	validators, err := chainClient.Validators(ctx, &ethpb.ListValidatorsRequest{PublicKeys: [][]byte{pubKey}})
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get validator")
+	}
+	if len(validators.ValidatorList) != 1 || validators.ValidatorList[0].Validator == nil {
+		return nil, errors.Errorf("validator %#x not found", pubKey)
+	}
+	index := validators.ValidatorList[0].Index
+	credentials := validators.ValidatorList[0].Validator.WithdrawalCredentials
+	if len(credentials) == 0 || credentials[0] != params.BeaconConfig().BLSWithdrawalPrefixByte {
+		return nil, errors.Errorf("validator %d does not have BLS withdrawal credentials", index)
+	}
+	keyHash := hash.Hash(fromBLSPubkey)
+	if !bytes.Equal(credentials[1:], keyHash[1:]) {
+		return nil, errors.Errorf("withdrawal credentials %#x of validator %d do not match BLS withdrawal key %#x", credentials, index, fromBLSPubkey)
+	}
+
+	genesis, err := nodeClient.Genesis(ctx, &emptypb.Empty{})
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get genesis")
+	}
+	// BLS to execution changes are signed with the genesis fork version, so that they are valid across forks.
+	domain, err := signing.ComputeDomain(
+		params.BeaconConfig().DomainBLSToExecutionChange,
+		params.BeaconConfig().GenesisForkVersion,
+		genesis.GenesisValidatorsRoot,
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, domainDataErr)
+	}
+	change := &ethpb.BLSToExecutionChange{
+		ValidatorIndex:     index,
+		FromBlsPubkey:      fromBLSPubkey,
+		ToExecutionAddress: toExecutionAddress.Bytes(),
+	}
+	root, err := signing.ComputeSigningRoot(change, domain)
+	if err != nil {
+		return nil, errors.Wrap(err, signingRootErr)
+	}
+	sig, err := signer(ctx, &validatorpb.SignRequest{
+		PublicKey:       fromBLSPubkey,
+		SigningRoot:     root[:],
+		SignatureDomain: domain,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, signBLSToExecutionChangeErr)
+	}
+
+	return &ethpb.SignedBLSToExecutionChange{Message: change, Signature: sig.Marshal()}, nil
+}
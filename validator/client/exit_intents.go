@@ -0,0 +1,86 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing/trace"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+	"github.com/prysmaticlabs/prysm/v5/validator/db/common"
+	"github.com/sirupsen/logrus"
+)
+
+// SubmitExitIntents signs and submits the voluntary exits of the pending exit intents whose epoch is reached,
+// or whose validator balance dropped below their threshold. A failed submission is recorded on the intent
+// and retried at the next epoch.
+func (v *validator) SubmitExitIntents(ctx context.Context, slot primitives.Slot) {
+	ctx, span := trace.StartSpan(ctx, "validator.SubmitExitIntents")
+	defer span.End()
+
+	intents, err := v.db.ExitIntents(ctx)
+	if err != nil {
+		log.WithError(err).Error("Could not get exit intents")
+		return
+	}
+	epoch := slots.ToEpoch(slot)
+
+	pending := make([]*common.ExitIntent, 0, len(intents))
+	balancePubKeys := make([][]byte, 0, len(intents))
+	for _, intent := range intents {
+		if intent.Status != common.ExitIntentPending {
+			continue
+		}
+		pending = append(pending, intent)
+		if intent.BalanceBelowGwei > 0 && !exitEpochReached(intent, epoch) {
+			balancePubKeys = append(balancePubKeys, intent.PubKey[:])
+		}
+	}
+	if len(pending) == 0 {
+		return
+	}
+
+	balances := make(map[[fieldparams.BLSPubkeyLength]byte]uint64, len(balancePubKeys))
+	if len(balancePubKeys) > 0 {
+		resp, err := v.chainClient.ValidatorPerformance(ctx, &ethpb.ValidatorPerformanceRequest{PublicKeys: balancePubKeys})
+		if err != nil {
+			log.WithError(err).Warn("Could not get validator balances of exit intents")
+		} else {
+			for i, pubKey := range resp.PublicKeys {
+				if i < len(resp.BalancesAfterEpochTransition) {
+					balances[bytesutil.ToBytes48(pubKey)] = resp.BalancesAfterEpochTransition[i]
+				}
+			}
+		}
+	}
+
+	for _, intent := range pending {
+		balance, balanceKnown := balances[intent.PubKey]
+		if !exitEpochReached(intent, epoch) && (!balanceKnown || balance >= intent.BalanceBelowGwei) {
+			continue
+		}
+		log := log.WithFields(logrus.Fields{
+			"pubkey": fmt.Sprintf("%#x", bytesutil.Trunc(intent.PubKey[:])),
+			"epoch":  epoch,
+		})
+		if err := ProposeExit(ctx, v.validatorClient, v.sign, intent.PubKey[:], epoch); err != nil {
+			intent.LastError = err.Error()
+			log.WithError(err).Error("Could not submit voluntary exit of exit intent, retrying at the next epoch")
+		} else {
+			intent.Status = common.ExitIntentSubmitted
+			intent.SubmittedEpoch = epoch
+			intent.LastError = ""
+			log.Info("Submitted voluntary exit of exit intent")
+		}
+		if err := v.db.SaveExitIntent(ctx, intent); err != nil {
+			log.WithError(err).Error("Could not save exit intent")
+		}
+	}
+}
+
+func exitEpochReached(intent *common.ExitIntent, epoch primitives.Epoch) bool {
+	return intent.Epoch != 0 && epoch >= intent.Epoch
+}
@@ -0,0 +1,68 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	validatormock "github.com/prysmaticlabs/prysm/v5/testing/validator-mock"
+	"github.com/prysmaticlabs/prysm/v5/validator/db/common"
+	"go.uber.org/mock/gomock"
+)
+
+func TestSubmitExitIntents(t *testing.T) {
+	ctx := context.Background()
+	v, m, validatorKey, finish := setup(t, false)
+	defer finish()
+	chainClient := validatormock.NewMockChainClient(gomock.NewController(t))
+	v.chainClient = chainClient
+
+	scheduled := [fieldparams.BLSPubkeyLength]byte(validatorKey.PublicKey().Marshal())
+	lowBalance := [fieldparams.BLSPubkeyLength]byte{1}
+	highBalance := [fieldparams.BLSPubkeyLength]byte{2}
+	later := [fieldparams.BLSPubkeyLength]byte{3}
+	submitted := [fieldparams.BLSPubkeyLength]byte{4}
+	for _, intent := range []*common.ExitIntent{
+		{PubKey: scheduled, Epoch: 10, Status: common.ExitIntentPending},
+		{PubKey: lowBalance, BalanceBelowGwei: 31_000_000_000, Status: common.ExitIntentPending},
+		{PubKey: highBalance, Epoch: 20, BalanceBelowGwei: 31_000_000_000, Status: common.ExitIntentPending},
+		{PubKey: later, Epoch: 20, Status: common.ExitIntentPending},
+		{PubKey: submitted, Epoch: 5, Status: common.ExitIntentSubmitted, SubmittedEpoch: 5},
+	} {
+		require.NoError(t, v.db.SaveExitIntent(ctx, intent))
+	}
+
+	chainClient.EXPECT().ValidatorPerformance(gomock.Any(), gomock.Any()).Return(&ethpb.ValidatorPerformanceResponse{
+		PublicKeys:                   [][]byte{lowBalance[:], highBalance[:]},
+		BalancesAfterEpochTransition: []uint64{30_900_000_000, 32_000_000_000},
+	}, nil)
+	m.validatorClient.EXPECT().ValidatorIndex(gomock.Any(), gomock.Any()).Return(&ethpb.ValidatorIndexResponse{Index: 1}, nil).Times(2)
+	m.validatorClient.EXPECT().DomainData(gomock.Any(), gomock.Any()).Return(&ethpb.DomainResponse{SignatureDomain: make([]byte, 32)}, nil).Times(2)
+	// The key with the low balance is not managed by the keymanager, its exit cannot be signed.
+	m.validatorClient.EXPECT().ProposeExit(gomock.Any(), gomock.AssignableToTypeOf(&ethpb.SignedVoluntaryExit{})).Return(&ethpb.ProposeExitResponse{}, nil)
+
+	v.SubmitExitIntents(ctx, primitives.Slot(10)*params.BeaconConfig().SlotsPerEpoch)
+
+	intents, err := v.db.ExitIntents(ctx)
+	require.NoError(t, err)
+	byPubKey := make(map[[fieldparams.BLSPubkeyLength]byte]*common.ExitIntent, len(intents))
+	for _, intent := range intents {
+		byPubKey[intent.PubKey] = intent
+	}
+	assert.DeepEqual(t, &common.ExitIntent{PubKey: scheduled, Epoch: 10, Status: common.ExitIntentSubmitted, SubmittedEpoch: 10}, byPubKey[scheduled])
+	assert.Equal(t, common.ExitIntentPending, byPubKey[lowBalance].Status)
+	assert.NotEqual(t, "", byPubKey[lowBalance].LastError)
+	assert.DeepEqual(t, &common.ExitIntent{PubKey: highBalance, Epoch: 20, BalanceBelowGwei: 31_000_000_000, Status: common.ExitIntentPending}, byPubKey[highBalance])
+	assert.DeepEqual(t, &common.ExitIntent{PubKey: later, Epoch: 20, Status: common.ExitIntentPending}, byPubKey[later])
+	assert.Equal(t, primitives.Epoch(5), byPubKey[submitted].SubmittedEpoch)
+
+	// The balances cannot be fetched, only the intents whose epoch is reached are submitted.
+	chainClient.EXPECT().ValidatorPerformance(gomock.Any(), gomock.Any()).Return(nil, errors.New("bad"))
+	v.SubmitExitIntents(ctx, primitives.Slot(11)*params.BeaconConfig().SlotsPerEpoch)
+}
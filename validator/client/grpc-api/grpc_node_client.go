@@ -36,6 +36,12 @@ func (c *grpcNodeClient) Peers(ctx context.Context, in *empty.Empty) (*ethpb.Pee
 	return c.nodeClient.ListPeers(ctx, in)
 }
 
+// SubmitBLSToExecutionChanges is not supported, as the beacon node only accepts BLS to execution changes through
+// the beacon API.
+func (c *grpcNodeClient) SubmitBLSToExecutionChanges(context.Context, []*ethpb.SignedBLSToExecutionChange) error {
+	return iface.ErrNotSupported
+}
+
 func (c *grpcNodeClient) IsHealthy(ctx context.Context) bool {
 	_, err := c.nodeClient.GetHealth(ctx, &ethpb.HealthRequest{})
 	if err != nil {
@@ -13,5 +13,6 @@ type NodeClient interface {
 	Genesis(ctx context.Context, in *empty.Empty) (*ethpb.Genesis, error)
 	Version(ctx context.Context, in *empty.Empty) (*ethpb.Version, error)
 	Peers(ctx context.Context, in *empty.Empty) (*ethpb.Peers, error)
+	SubmitBLSToExecutionChanges(ctx context.Context, changes []*ethpb.SignedBLSToExecutionChange) error
 	HealthTracker() *beacon.NodeHealthTracker
 }
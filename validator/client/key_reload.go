@@ -3,15 +3,20 @@ package client
 import (
 	"context"
 
+	"github.com/pkg/errors"
 	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
 	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing/trace"
 )
 
 // HandleKeyReload makes sure the validator keeps operating correctly after a change to the underlying keys.
 // It is also responsible for logging out information about the new state of keys.
+// Slashing protection buckets are created for new keys before any of their duties are picked up.
 func (v *validator) HandleKeyReload(ctx context.Context, currentKeys [][fieldparams.BLSPubkeyLength]byte) (bool, error) {
 	ctx, span := trace.StartSpan(ctx, "validator.HandleKeyReload")
 	defer span.End()
+	if err := v.db.UpdatePublicKeysBuckets(currentKeys); err != nil {
+		return false, errors.Wrap(err, "could not update slashing protection buckets")
+	}
 	if err := v.updateValidatorStatusCache(ctx, currentKeys); err != nil {
 		return false, err
 	}
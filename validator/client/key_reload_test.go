@@ -11,6 +11,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/testing/require"
 	validatormock "github.com/prysmaticlabs/prysm/v5/testing/validator-mock"
 	"github.com/prysmaticlabs/prysm/v5/validator/client/testutil"
+	dbTest "github.com/prysmaticlabs/prysm/v5/validator/db/testing"
 	logTest "github.com/sirupsen/logrus/hooks/test"
 	"go.uber.org/mock/gomock"
 )
@@ -35,6 +36,7 @@ func TestValidator_HandleKeyReload(t *testing.T) {
 			chainClient:      chainClient,
 			prysmChainClient: prysmChainClient,
 			pubkeyToStatus:   make(map[[fieldparams.BLSPubkeyLength]byte]*validatorStatus),
+			db:               dbTest.SetupDB(t, nil, false),
 		}
 
 		resp := testutil.GenerateMultipleValidatorStatusResponse([][]byte{inactive.pub[:], active.pub[:]})
@@ -52,6 +54,9 @@ func TestValidator_HandleKeyReload(t *testing.T) {
 		assert.Equal(t, true, anyActive)
 		assert.LogsContain(t, hook, "Waiting for deposit to be observed by beacon node")
 		assert.LogsContain(t, hook, "Validator activated")
+		protectedKeys, err := v.db.ProposedPublicKeys(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, 2, len(protectedKeys))
 	})
 
 	t.Run("no active", func(t *testing.T) {
@@ -68,6 +73,7 @@ func TestValidator_HandleKeyReload(t *testing.T) {
 			chainClient:      chainClient,
 			prysmChainClient: prysmChainClient,
 			pubkeyToStatus:   make(map[[fieldparams.BLSPubkeyLength]byte]*validatorStatus),
+			db:               dbTest.SetupDB(t, nil, false),
 		}
 
 		resp := testutil.GenerateMultipleValidatorStatusResponse([][]byte{kp.pub[:]})
@@ -94,6 +100,7 @@ func TestValidator_HandleKeyReload(t *testing.T) {
 			km:              newMockKeymanager(t, kp),
 			genesisTime:     1,
 			pubkeyToStatus:  make(map[[fieldparams.BLSPubkeyLength]byte]*validatorStatus),
+			db:              dbTest.SetupDB(t, nil, false),
 		}
 
 		client.EXPECT().MultipleValidatorStatus(
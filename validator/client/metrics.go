@@ -208,13 +208,14 @@ var (
 // LogValidatorGainsAndLosses logs important metrics related to this validator client's
 // responsibilities throughout the beacon chain's lifecycle. It logs absolute accrued rewards
 // and penalties over time, percentage gain/loss, and gives the end user a better idea
-// of how the validator performs with respect to the rest.
+// of how the validator performs with respect to the rest. It also saves the performance
+// history of the validator keys in the database.
 func (v *validator) LogValidatorGainsAndLosses(ctx context.Context, slot primitives.Slot) error {
 	if !slots.IsEpochEnd(slot) || slot <= params.BeaconConfig().SlotsPerEpoch {
 		// Do nothing unless we are at the end of the epoch, and not in the first epoch.
 		return nil
 	}
-	if !v.logValidatorPerformance {
+	if !v.logValidatorPerformance && v.performanceRetention == 0 {
 		return nil
 	}
 
@@ -250,6 +251,13 @@ func (v *validator) LogValidatorGainsAndLosses(ctx context.Context, slot primiti
 			v.voteStats.startEpoch = prevEpoch
 		}
 	}
+	if err := v.savePerformanceHistory(ctx, resp, prevEpoch); err != nil {
+		log.WithError(err).Error("Could not save validator performance history")
+	}
+	if !v.logValidatorPerformance {
+		return nil
+	}
+
 	v.prevEpochBalancesLock.Lock()
 	for i, pubKey := range resp.PublicKeys {
 		v.logForEachValidator(i, pubKey, resp, slot, prevEpoch)
@@ -1,6 +1,7 @@
 package client
 
 import (
+	"context"
 	"testing"
 
 	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
@@ -8,8 +9,10 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
 	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
 	"github.com/prysmaticlabs/prysm/v5/testing/require"
 	"github.com/prysmaticlabs/prysm/v5/time/slots"
+	dbTest "github.com/prysmaticlabs/prysm/v5/validator/db/testing"
 	logTest "github.com/sirupsen/logrus/hooks/test"
 )
 
@@ -163,3 +166,50 @@ func TestUpdateLogAltairAggregateStats(t *testing.T) {
 		"correctlyVotedHeadPct=\"86%\" correctlyVotedSourcePct=\"100%\" "+
 		"correctlyVotedTargetPct=\"71%\" numberOfEpochs=3 pctChangeCombinedBalance=\"0.20555%\"")
 }
+
+func TestSavePerformanceHistory(t *testing.T) {
+	ctx := context.Background()
+	pubKeys := [][fieldparams.BLSPubkeyLength]byte{{1}, {2}}
+	v := &validator{
+		db:                   dbTest.SetupDB(t, pubKeys, false),
+		performanceRetention: 2,
+	}
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+
+	for epoch := primitives.Epoch(3); epoch <= 4; epoch++ {
+		slot := slotsPerEpoch * primitives.Slot(epoch)
+		v.recordProposalOutcome(pubKeys[0], slot+1, true)
+		v.recordProposalOutcome(pubKeys[1], slot+2, false)
+		v.recordSyncCommitteeMessageOutcome(pubKeys[0], slot, true)
+		v.recordSyncCommitteeMessageOutcome(pubKeys[0], slot+1, false)
+		require.NoError(t, v.savePerformanceHistory(ctx, &ethpb.ValidatorPerformanceResponse{
+			PublicKeys:                    [][]byte{pubKeys[0][:], pubKeys[1][:]},
+			CorrectlyVotedSource:          []bool{true, true},
+			CorrectlyVotedTarget:          []bool{true, false},
+			CorrectlyVotedHead:            []bool{false, false},
+			BalancesBeforeEpochTransition: []uint64{100, 200},
+			BalancesAfterEpochTransition:  []uint64{110},
+		}, epoch))
+	}
+	// The outcomes of the saved epochs are dropped.
+	assert.Equal(t, 0, len(v.dutyOutcomes))
+
+	// Only the last two epochs are kept.
+	records, err := v.db.PerformanceHistory(ctx, pubKeys[0], 0, 10, 10)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(records))
+	assert.Equal(t, primitives.Epoch(3), records[0].Epoch)
+	assert.DeepEqual(t, []primitives.Slot{slotsPerEpoch*4 + 1}, records[1].ProposedSlots)
+	assert.Equal(t, uint64(1), records[1].SyncCommitteeMessages)
+	assert.Equal(t, uint64(1), records[1].MissedSyncCommitteeMessages)
+	assert.Equal(t, true, records[1].CorrectlyVotedTarget)
+	assert.Equal(t, uint64(110), records[1].BalanceAfter)
+
+	records, err = v.db.PerformanceHistory(ctx, pubKeys[1], 0, 10, 10)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(records))
+	assert.DeepEqual(t, []primitives.Slot{slotsPerEpoch*4 + 2}, records[1].MissedProposalSlots)
+	assert.Equal(t, false, records[1].CorrectlyVotedTarget)
+	assert.Equal(t, uint64(200), records[1].BalanceBefore)
+	assert.Equal(t, uint64(0), records[1].BalanceAfter)
+}
@@ -0,0 +1,121 @@
+package client
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+	"github.com/prysmaticlabs/prysm/v5/validator/db/common"
+)
+
+// dutyOutcome returns the pending performance record of a validator key for the epoch of a slot,
+// holding the duty outcomes collected until the record is saved. The caller must hold dutyOutcomesLock.
+func (v *validator) dutyOutcome(pubKey [fieldparams.BLSPubkeyLength]byte, slot primitives.Slot) *common.PerformanceRecord {
+	epoch := slots.ToEpoch(slot)
+	if v.dutyOutcomes == nil {
+		v.dutyOutcomes = make(map[primitives.Epoch]map[[fieldparams.BLSPubkeyLength]byte]*common.PerformanceRecord)
+	}
+	if v.dutyOutcomes[epoch] == nil {
+		v.dutyOutcomes[epoch] = make(map[[fieldparams.BLSPubkeyLength]byte]*common.PerformanceRecord)
+	}
+	record, ok := v.dutyOutcomes[epoch][pubKey]
+	if !ok {
+		record = &common.PerformanceRecord{PubKey: pubKey, Epoch: epoch}
+		v.dutyOutcomes[epoch][pubKey] = record
+	}
+	return record
+}
+
+// recordProposalOutcome records whether a validator key proposed the block of a slot it was assigned to.
+func (v *validator) recordProposalOutcome(pubKey [fieldparams.BLSPubkeyLength]byte, slot primitives.Slot, proposed bool) {
+	if v.performanceRetention == 0 {
+		return
+	}
+	v.dutyOutcomesLock.Lock()
+	defer v.dutyOutcomesLock.Unlock()
+	record := v.dutyOutcome(pubKey, slot)
+	if proposed {
+		record.ProposedSlots = append(record.ProposedSlots, slot)
+	} else {
+		record.MissedProposalSlots = append(record.MissedProposalSlots, slot)
+	}
+}
+
+// recordSyncCommitteeMessageOutcome records whether a validator key submitted its sync committee message of a slot.
+func (v *validator) recordSyncCommitteeMessageOutcome(pubKey [fieldparams.BLSPubkeyLength]byte, slot primitives.Slot, submitted bool) {
+	if v.performanceRetention == 0 {
+		return
+	}
+	v.dutyOutcomesLock.Lock()
+	defer v.dutyOutcomesLock.Unlock()
+	record := v.dutyOutcome(pubKey, slot)
+	if submitted {
+		record.SyncCommitteeMessages++
+	} else {
+		record.MissedSyncCommitteeMessages++
+	}
+}
+
+// savePerformanceHistory saves the performance records of the validator keys for an epoch, combining the
+// performance reported by the beacon node with the duty outcomes collected during the epoch, and prunes
+// the records older than the retention period.
+func (v *validator) savePerformanceHistory(ctx context.Context, resp *ethpb.ValidatorPerformanceResponse, epoch primitives.Epoch) error {
+	if v.performanceRetention == 0 {
+		return nil
+	}
+
+	v.dutyOutcomesLock.Lock()
+	outcomes := v.dutyOutcomes[epoch]
+	for e := range v.dutyOutcomes {
+		if e <= epoch {
+			delete(v.dutyOutcomes, e)
+		}
+	}
+	v.dutyOutcomesLock.Unlock()
+
+	records := make([]*common.PerformanceRecord, 0, len(resp.PublicKeys))
+	for i, pubKey := range resp.PublicKeys {
+		pubKeyBytes := bytesutil.ToBytes48(pubKey)
+		record, ok := outcomes[pubKeyBytes]
+		if !ok {
+			record = &common.PerformanceRecord{PubKey: pubKeyBytes, Epoch: epoch}
+		}
+		// The beacon node should return all slices with the same length, but do not rely on it.
+		if i < len(resp.InclusionDistances) {
+			record.InclusionDistance = resp.InclusionDistances[i]
+		}
+		if i < len(resp.CorrectlyVotedSource) {
+			record.CorrectlyVotedSource = resp.CorrectlyVotedSource[i]
+		}
+		if i < len(resp.CorrectlyVotedTarget) {
+			record.CorrectlyVotedTarget = resp.CorrectlyVotedTarget[i]
+		}
+		if i < len(resp.CorrectlyVotedHead) {
+			record.CorrectlyVotedHead = resp.CorrectlyVotedHead[i]
+		}
+		if i < len(resp.BalancesBeforeEpochTransition) {
+			record.BalanceBefore = resp.BalancesBeforeEpochTransition[i]
+		}
+		if i < len(resp.BalancesAfterEpochTransition) {
+			record.BalanceAfter = resp.BalancesAfterEpochTransition[i]
+		}
+		if i < len(resp.InactivityScores) {
+			record.InactivityScore = resp.InactivityScores[i]
+		}
+		records = append(records, record)
+	}
+
+	if err := v.db.SavePerformanceRecords(ctx, records); err != nil {
+		return errors.Wrap(err, "could not save performance records")
+	}
+	if epoch >= v.performanceRetention {
+		if err := v.db.PrunePerformanceHistory(ctx, epoch-v.performanceRetention+1); err != nil {
+			return errors.Wrap(err, "could not prune performance history")
+		}
+	}
+	return nil
+}
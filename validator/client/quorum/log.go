@@ -0,0 +1,5 @@
+package quorum
+
+import "github.com/sirupsen/logrus"
+
+var log = logrus.WithField("prefix", "quorum")
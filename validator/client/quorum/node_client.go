@@ -0,0 +1,106 @@
+package quorum
+
+import (
+	"context"
+	"sync"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/prysmaticlabs/prysm/v5/api/client/beacon"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/validator/client/iface"
+)
+
+var _ = iface.NodeClient(&nodeClient{})
+
+type nodeClient struct {
+	nodes         []Node
+	healthTracker *beacon.NodeHealthTracker
+}
+
+// NewNodeClient returns a node client over all the given beacon nodes. Its health tracker checks the health of
+// every node, which the quorum validator client relies on to pick the nodes it sends requests to, and reports
+// healthy as long as any of the nodes is healthy.
+func NewNodeClient(nodes []Node) iface.NodeClient {
+	c := &nodeClient{nodes: nodes}
+	c.healthTracker = beacon.NewNodeHealthTracker(c)
+	return c
+}
+
+func (c *nodeClient) SyncStatus(ctx context.Context, in *empty.Empty) (*ethpb.SyncStatus, error) {
+	return firstHealthy(c.nodes, func(nc iface.NodeClient) (*ethpb.SyncStatus, error) {
+		return nc.SyncStatus(ctx, in)
+	})
+}
+
+func (c *nodeClient) Genesis(ctx context.Context, in *empty.Empty) (*ethpb.Genesis, error) {
+	return firstHealthy(c.nodes, func(nc iface.NodeClient) (*ethpb.Genesis, error) {
+		return nc.Genesis(ctx, in)
+	})
+}
+
+func (c *nodeClient) Version(ctx context.Context, in *empty.Empty) (*ethpb.Version, error) {
+	return firstHealthy(c.nodes, func(nc iface.NodeClient) (*ethpb.Version, error) {
+		return nc.Version(ctx, in)
+	})
+}
+
+func (c *nodeClient) Peers(ctx context.Context, in *empty.Empty) (*ethpb.Peers, error) {
+	return firstHealthy(c.nodes, func(nc iface.NodeClient) (*ethpb.Peers, error) {
+		return nc.Peers(ctx, in)
+	})
+}
+
+func (c *nodeClient) SubmitBLSToExecutionChanges(ctx context.Context, changes []*ethpb.SignedBLSToExecutionChange) error {
+	_, err := firstHealthy(c.nodes, func(nc iface.NodeClient) (struct{}, error) {
+		return struct{}{}, nc.SubmitBLSToExecutionChanges(ctx, changes)
+	})
+	return err
+}
+
+// IsHealthy checks the health of every node in parallel, and reports whether any of them is healthy.
+func (c *nodeClient) IsHealthy(ctx context.Context) bool {
+	healthy := make([]bool, len(c.nodes))
+	var wg sync.WaitGroup
+	for i, n := range c.nodes {
+		wg.Add(1)
+		go func(i int, n Node) {
+			defer wg.Done()
+			healthy[i] = n.NodeClient.HealthTracker().CheckHealth(ctx)
+			if !healthy[i] {
+				log.WithField("node", n.Name).Warn("Beacon node is not healthy")
+			}
+		}(i, n)
+	}
+	wg.Wait()
+	for _, h := range healthy {
+		if h {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *nodeClient) HealthTracker() *beacon.NodeHealthTracker {
+	return c.healthTracker
+}
+
+// firstHealthy calls f on the healthy nodes one at a time, in order, until it succeeds.
+func firstHealthy[T any](nodes []Node, f func(iface.NodeClient) (T, error)) (T, error) {
+	var resp T
+	var err error
+	tried := false
+	for _, n := range nodes {
+		if !isHealthy(n) {
+			continue
+		}
+		tried = true
+		if resp, err = f(n.NodeClient); err == nil {
+			return resp, nil
+		}
+	}
+	if !tried {
+		// No node is known to be healthy, for instance before the first health check.
+		return f(nodes[0].NodeClient)
+	}
+	return resp, err
+}
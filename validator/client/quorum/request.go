@@ -0,0 +1,72 @@
+package quorum
+
+import (
+	"context"
+	"sync"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/validator/client/iface"
+)
+
+type result[T any] struct {
+	node  int
+	value T
+	err   error
+}
+
+// fanOut calls f on the given nodes in parallel, and returns the results in the order of the nodes.
+func fanOut[T any](ctx context.Context, c *validatorClient, nodes []int, f func(context.Context, iface.ValidatorClient) (T, error)) []result[T] {
+	results := make([]result[T], len(nodes))
+	var wg sync.WaitGroup
+	for j, i := range nodes {
+		wg.Add(1)
+		go func(j, i int) {
+			defer wg.Done()
+			v, err := f(ctx, c.nodes[i].ValidatorClient)
+			results[j] = result[T]{node: i, value: v, err: err}
+		}(j, i)
+	}
+	wg.Wait()
+	return results
+}
+
+// broadcast calls f on the given nodes in parallel. It succeeds if any of the nodes succeeds, in which case the
+// response of the first of them, in the order of the nodes, is returned.
+func broadcast[T any](ctx context.Context, c *validatorClient, nodes []int, f func(context.Context, iface.ValidatorClient) (T, error)) (T, error) {
+	var resp T
+	var err error
+	succeeded := false
+	for _, r := range fanOut(ctx, c, nodes, f) {
+		if r.err != nil {
+			log.WithError(r.err).WithField("node", c.nodes[r.node].Name).Warn("Beacon node rejected a request")
+			if err == nil {
+				err = r.err
+			}
+			continue
+		}
+		if !succeeded {
+			resp, succeeded = r.value, true
+		}
+	}
+	if succeeded {
+		return resp, nil
+	}
+	return resp, errors.Wrapf(err, "request failed on all of the %d beacon nodes", len(nodes))
+}
+
+// failover calls f on the healthy nodes one at a time, starting with the primary node, until it succeeds.
+func failover[T any](ctx context.Context, c *validatorClient, f func(context.Context, iface.ValidatorClient) (T, error)) (T, error) {
+	var resp T
+	var err error
+	for _, i := range c.candidates() {
+		resp, err = f(ctx, c.nodes[i].ValidatorClient)
+		if err == nil {
+			return resp, nil
+		}
+		if ctx.Err() != nil {
+			return resp, err
+		}
+		log.WithError(err).WithField("node", c.nodes[i].Name).Debug("Beacon node request failed, trying the next node")
+	}
+	return resp, err
+}
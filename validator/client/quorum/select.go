@@ -0,0 +1,116 @@
+package quorum
+
+import (
+	"math/big"
+
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+)
+
+type attestationDataVote struct {
+	data  *ethpb.AttestationData
+	count int
+	// rank is the position, in the order of the nodes, of the first node which returned the data.
+	rank int
+}
+
+// better reports whether v should be preferred over other. Votes are compared by number of nodes, then by
+// the recency of the checkpoints, then by the order of the nodes.
+func (v *attestationDataVote) better(other *attestationDataVote) bool {
+	if v.count != other.count {
+		return v.count > other.count
+	}
+	if v.data.Target.Epoch != other.data.Target.Epoch {
+		return v.data.Target.Epoch > other.data.Target.Epoch
+	}
+	if v.data.Source.Epoch != other.data.Source.Epoch {
+		return v.data.Source.Epoch > other.data.Source.Epoch
+	}
+	return v.rank < other.rank
+}
+
+// selectAttestationData reads the responses of the given nodes and returns the attestation data returned
+// by most of them. It returns as soon as a strict majority of the nodes agree, otherwise it waits for all of
+// the responses.
+func selectAttestationData(nodes []Node, candidates []int, results <-chan result[*ethpb.AttestationData]) (*ethpb.AttestationData, error) {
+	rank := make(map[int]int, len(candidates))
+	for j, i := range candidates {
+		rank[i] = j
+	}
+	votes := make(map[[32]byte]*attestationDataVote)
+	var err error
+	for range candidates {
+		r := <-results
+		if r.err == nil && (r.value.Source == nil || r.value.Target == nil) {
+			r.err = errors.New("attestation data without checkpoints")
+		}
+		if r.err != nil {
+			log.WithError(r.err).WithField("node", nodes[r.node].Name).Warn("Could not get attestation data")
+			err = r.err
+			continue
+		}
+		root, hashErr := r.value.HashTreeRoot()
+		if hashErr != nil {
+			err = hashErr
+			continue
+		}
+		v, ok := votes[root]
+		if !ok {
+			v = &attestationDataVote{data: r.value, rank: rank[r.node]}
+			votes[root] = v
+		}
+		if rank[r.node] < v.rank {
+			v.rank = rank[r.node]
+		}
+		v.count++
+		if v.count*2 > len(candidates) {
+			return v.data, nil
+		}
+	}
+
+	var best *attestationDataVote
+	for _, v := range votes {
+		if best == nil || v.better(best) {
+			best = v
+		}
+	}
+	if best == nil {
+		return nil, errors.Wrapf(err, "could not get attestation data from any of the %d beacon nodes", len(candidates))
+	}
+	if len(votes) > 1 {
+		log.WithField("distinctResponses", len(votes)).WithField("votes", best.count).Warn("Beacon nodes disagree on attestation data, using the best supported one")
+	}
+	return best.data, nil
+}
+
+// selectBeaconBlock returns the block of highest payload value. Ties are resolved by the order of the nodes.
+func selectBeaconBlock(nodes []Node, results []result[*ethpb.GenericBeaconBlock]) (result[*ethpb.GenericBeaconBlock], error) {
+	var best result[*ethpb.GenericBeaconBlock]
+	var bestValue *big.Int
+	var err error
+	for _, r := range results {
+		if r.err != nil {
+			log.WithError(r.err).WithField("node", nodes[r.node].Name).Warn("Could not get block")
+			err = r.err
+			continue
+		}
+		value := payloadValue(r.value)
+		if bestValue == nil || value.Cmp(bestValue) > 0 {
+			best, bestValue = r, value
+		}
+	}
+	if bestValue == nil {
+		return best, errors.Wrapf(err, "could not get a block from any of the %d beacon nodes", len(results))
+	}
+	log.WithField("node", nodes[best.node].Name).WithField("payloadValue", bestValue.String()).Debug("Selected block")
+	return best, nil
+}
+
+// payloadValue parses the payload value of a block, in wei. Blocks without a valid payload value are worth 0.
+func payloadValue(b *ethpb.GenericBeaconBlock) *big.Int {
+	v, ok := new(big.Int).SetString(b.PayloadValue, 10)
+	if !ok {
+		return big.NewInt(0)
+	}
+	return v
+}
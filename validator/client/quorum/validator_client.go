@@ -0,0 +1,349 @@
+// Package quorum implements validator and node clients which use several beacon nodes at the same time,
+// instead of failing over from one node to the next.
+package quorum
+
+import (
+	"context"
+	"sync"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/api/client/event"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/validator/client/iface"
+)
+
+// maxTrackedBlindedBlocks bounds the number of blinded blocks whose producing node is remembered.
+const maxTrackedBlindedBlocks = 64
+
+var _ = iface.ValidatorClient(&validatorClient{})
+
+// Node is one of the beacon nodes used at the same time by the quorum clients.
+type Node struct {
+	// Name identifies the node in logs, typically its endpoint.
+	Name            string
+	ValidatorClient iface.ValidatorClient
+	NodeClient      iface.NodeClient
+}
+
+type validatorClient struct {
+	nodes   []Node
+	lock    sync.RWMutex
+	primary int
+	// blindedBlockNodes maps the root of the blinded blocks handed out to the index of the node which
+	// produced them, as only that node is able to have the block unblinded by its builder.
+	blindedBlockNodes map[[32]byte]int
+	blindedBlockRoots [][32]byte
+}
+
+// NewValidatorClient returns a validator client using all the given beacon nodes at the same time.
+// Attestation data and blocks are requested from every healthy node and the best response is
+// selected, signed objects are published to every healthy node, and the remaining requests are
+// sent to the primary node, falling back to the other healthy nodes when it fails.
+func NewValidatorClient(nodes []Node) iface.ValidatorClient {
+	return &validatorClient{
+		nodes:             nodes,
+		blindedBlockNodes: make(map[[32]byte]int),
+	}
+}
+
+// AttestationData requests attestation data from every healthy node and selects it by majority vote.
+func (c *validatorClient) AttestationData(ctx context.Context, in *ethpb.AttestationDataRequest) (*ethpb.AttestationData, error) {
+	candidates := c.candidates()
+	results := make(chan result[*ethpb.AttestationData], len(candidates))
+	for _, i := range candidates {
+		go func(i int) {
+			data, err := c.nodes[i].ValidatorClient.AttestationData(ctx, in)
+			if err == nil && data == nil {
+				err = errors.New("nil attestation data")
+			}
+			results <- result[*ethpb.AttestationData]{node: i, value: data, err: err}
+		}(i)
+	}
+	return selectAttestationData(c.nodes, candidates, results)
+}
+
+// BeaconBlock requests a block from every healthy node and selects the most valuable one.
+func (c *validatorClient) BeaconBlock(ctx context.Context, in *ethpb.BlockRequest) (*ethpb.GenericBeaconBlock, error) {
+	results := fanOut(ctx, c, c.candidates(), func(ctx context.Context, vc iface.ValidatorClient) (*ethpb.GenericBeaconBlock, error) {
+		b, err := vc.BeaconBlock(ctx, in)
+		if err == nil && b == nil {
+			err = errors.New("nil block")
+		}
+		return b, err
+	})
+	best, err := selectBeaconBlock(c.nodes, results)
+	if err != nil {
+		return nil, err
+	}
+	if best.value.IsBlinded {
+		if err := c.trackBlindedBlock(best.value, best.node); err != nil {
+			log.WithError(err).Warn("Could not track the node which produced a blinded block")
+		}
+	}
+	return best.value, nil
+}
+
+// ProposeBeaconBlock publishes the block to every healthy node. Blinded blocks are only sent to the node
+// which produced them.
+func (c *validatorClient) ProposeBeaconBlock(ctx context.Context, in *ethpb.GenericSignedBeaconBlock) (*ethpb.ProposeResponse, error) {
+	candidates := c.candidates()
+	if node, ok := c.blindedBlockNode(in); ok {
+		candidates = []int{node}
+	}
+	return broadcast(ctx, c, candidates, func(ctx context.Context, vc iface.ValidatorClient) (*ethpb.ProposeResponse, error) {
+		return vc.ProposeBeaconBlock(ctx, in)
+	})
+}
+
+func (c *validatorClient) ProposeAttestation(ctx context.Context, in *ethpb.Attestation) (*ethpb.AttestResponse, error) {
+	return broadcast(ctx, c, c.candidates(), func(ctx context.Context, vc iface.ValidatorClient) (*ethpb.AttestResponse, error) {
+		return vc.ProposeAttestation(ctx, in)
+	})
+}
+
+func (c *validatorClient) ProposeAttestationElectra(ctx context.Context, in *ethpb.SingleAttestation) (*ethpb.AttestResponse, error) {
+	return broadcast(ctx, c, c.candidates(), func(ctx context.Context, vc iface.ValidatorClient) (*ethpb.AttestResponse, error) {
+		return vc.ProposeAttestationElectra(ctx, in)
+	})
+}
+
+func (c *validatorClient) SubmitSignedAggregateSelectionProof(ctx context.Context, in *ethpb.SignedAggregateSubmitRequest) (*ethpb.SignedAggregateSubmitResponse, error) {
+	return broadcast(ctx, c, c.candidates(), func(ctx context.Context, vc iface.ValidatorClient) (*ethpb.SignedAggregateSubmitResponse, error) {
+		return vc.SubmitSignedAggregateSelectionProof(ctx, in)
+	})
+}
+
+func (c *validatorClient) SubmitSignedAggregateSelectionProofElectra(ctx context.Context, in *ethpb.SignedAggregateSubmitElectraRequest) (*ethpb.SignedAggregateSubmitResponse, error) {
+	return broadcast(ctx, c, c.candidates(), func(ctx context.Context, vc iface.ValidatorClient) (*ethpb.SignedAggregateSubmitResponse, error) {
+		return vc.SubmitSignedAggregateSelectionProofElectra(ctx, in)
+	})
+}
+
+func (c *validatorClient) ProposeExit(ctx context.Context, in *ethpb.SignedVoluntaryExit) (*ethpb.ProposeExitResponse, error) {
+	return broadcast(ctx, c, c.candidates(), func(ctx context.Context, vc iface.ValidatorClient) (*ethpb.ProposeExitResponse, error) {
+		return vc.ProposeExit(ctx, in)
+	})
+}
+
+func (c *validatorClient) SubmitSyncMessage(ctx context.Context, in *ethpb.SyncCommitteeMessage) (*empty.Empty, error) {
+	return broadcast(ctx, c, c.candidates(), func(ctx context.Context, vc iface.ValidatorClient) (*empty.Empty, error) {
+		return vc.SubmitSyncMessage(ctx, in)
+	})
+}
+
+func (c *validatorClient) SubmitSignedContributionAndProof(ctx context.Context, in *ethpb.SignedContributionAndProof) (*empty.Empty, error) {
+	return broadcast(ctx, c, c.candidates(), func(ctx context.Context, vc iface.ValidatorClient) (*empty.Empty, error) {
+		return vc.SubmitSignedContributionAndProof(ctx, in)
+	})
+}
+
+// SubmitValidatorRegistrations is sent to every healthy node, so that any of them can build blocks with the builder.
+func (c *validatorClient) SubmitValidatorRegistrations(ctx context.Context, in *ethpb.SignedValidatorRegistrationsV1) (*empty.Empty, error) {
+	return broadcast(ctx, c, c.candidates(), func(ctx context.Context, vc iface.ValidatorClient) (*empty.Empty, error) {
+		return vc.SubmitValidatorRegistrations(ctx, in)
+	})
+}
+
+// PrepareBeaconProposer is sent to every healthy node, so that any of them can build blocks for the validators.
+func (c *validatorClient) PrepareBeaconProposer(ctx context.Context, in *ethpb.PrepareBeaconProposerRequest) (*empty.Empty, error) {
+	return broadcast(ctx, c, c.candidates(), func(ctx context.Context, vc iface.ValidatorClient) (*empty.Empty, error) {
+		return vc.PrepareBeaconProposer(ctx, in)
+	})
+}
+
+// SubscribeCommitteeSubnets is sent to every healthy node, so that all of them can serve attestation data and aggregates.
+func (c *validatorClient) SubscribeCommitteeSubnets(ctx context.Context, in *ethpb.CommitteeSubnetsSubscribeRequest, duties []*ethpb.DutiesResponse_Duty) (*empty.Empty, error) {
+	return broadcast(ctx, c, c.candidates(), func(ctx context.Context, vc iface.ValidatorClient) (*empty.Empty, error) {
+		return vc.SubscribeCommitteeSubnets(ctx, in, duties)
+	})
+}
+
+func (c *validatorClient) Duties(ctx context.Context, in *ethpb.DutiesRequest) (*ethpb.DutiesResponse, error) {
+	return failover(ctx, c, func(ctx context.Context, vc iface.ValidatorClient) (*ethpb.DutiesResponse, error) {
+		return vc.Duties(ctx, in)
+	})
+}
+
+func (c *validatorClient) DomainData(ctx context.Context, in *ethpb.DomainRequest) (*ethpb.DomainResponse, error) {
+	return failover(ctx, c, func(ctx context.Context, vc iface.ValidatorClient) (*ethpb.DomainResponse, error) {
+		return vc.DomainData(ctx, in)
+	})
+}
+
+func (c *validatorClient) WaitForChainStart(ctx context.Context, in *empty.Empty) (*ethpb.ChainStartResponse, error) {
+	return failover(ctx, c, func(ctx context.Context, vc iface.ValidatorClient) (*ethpb.ChainStartResponse, error) {
+		return vc.WaitForChainStart(ctx, in)
+	})
+}
+
+func (c *validatorClient) ValidatorIndex(ctx context.Context, in *ethpb.ValidatorIndexRequest) (*ethpb.ValidatorIndexResponse, error) {
+	return failover(ctx, c, func(ctx context.Context, vc iface.ValidatorClient) (*ethpb.ValidatorIndexResponse, error) {
+		return vc.ValidatorIndex(ctx, in)
+	})
+}
+
+func (c *validatorClient) ValidatorStatus(ctx context.Context, in *ethpb.ValidatorStatusRequest) (*ethpb.ValidatorStatusResponse, error) {
+	return failover(ctx, c, func(ctx context.Context, vc iface.ValidatorClient) (*ethpb.ValidatorStatusResponse, error) {
+		return vc.ValidatorStatus(ctx, in)
+	})
+}
+
+func (c *validatorClient) MultipleValidatorStatus(ctx context.Context, in *ethpb.MultipleValidatorStatusRequest) (*ethpb.MultipleValidatorStatusResponse, error) {
+	return failover(ctx, c, func(ctx context.Context, vc iface.ValidatorClient) (*ethpb.MultipleValidatorStatusResponse, error) {
+		return vc.MultipleValidatorStatus(ctx, in)
+	})
+}
+
+func (c *validatorClient) FeeRecipientByPubKey(ctx context.Context, in *ethpb.FeeRecipientByPubKeyRequest) (*ethpb.FeeRecipientByPubKeyResponse, error) {
+	return failover(ctx, c, func(ctx context.Context, vc iface.ValidatorClient) (*ethpb.FeeRecipientByPubKeyResponse, error) {
+		return vc.FeeRecipientByPubKey(ctx, in)
+	})
+}
+
+func (c *validatorClient) SubmitAggregateSelectionProof(ctx context.Context, in *ethpb.AggregateSelectionRequest, index primitives.ValidatorIndex, committeeLength uint64) (*ethpb.AggregateSelectionResponse, error) {
+	return failover(ctx, c, func(ctx context.Context, vc iface.ValidatorClient) (*ethpb.AggregateSelectionResponse, error) {
+		return vc.SubmitAggregateSelectionProof(ctx, in, index, committeeLength)
+	})
+}
+
+func (c *validatorClient) SubmitAggregateSelectionProofElectra(ctx context.Context, in *ethpb.AggregateSelectionRequest, index primitives.ValidatorIndex, committeeLength uint64) (*ethpb.AggregateSelectionElectraResponse, error) {
+	return failover(ctx, c, func(ctx context.Context, vc iface.ValidatorClient) (*ethpb.AggregateSelectionElectraResponse, error) {
+		return vc.SubmitAggregateSelectionProofElectra(ctx, in, index, committeeLength)
+	})
+}
+
+func (c *validatorClient) CheckDoppelGanger(ctx context.Context, in *ethpb.DoppelGangerRequest) (*ethpb.DoppelGangerResponse, error) {
+	return failover(ctx, c, func(ctx context.Context, vc iface.ValidatorClient) (*ethpb.DoppelGangerResponse, error) {
+		return vc.CheckDoppelGanger(ctx, in)
+	})
+}
+
+func (c *validatorClient) SyncMessageBlockRoot(ctx context.Context, in *empty.Empty) (*ethpb.SyncMessageBlockRootResponse, error) {
+	return failover(ctx, c, func(ctx context.Context, vc iface.ValidatorClient) (*ethpb.SyncMessageBlockRootResponse, error) {
+		return vc.SyncMessageBlockRoot(ctx, in)
+	})
+}
+
+func (c *validatorClient) SyncSubcommitteeIndex(ctx context.Context, in *ethpb.SyncSubcommitteeIndexRequest) (*ethpb.SyncSubcommitteeIndexResponse, error) {
+	return failover(ctx, c, func(ctx context.Context, vc iface.ValidatorClient) (*ethpb.SyncSubcommitteeIndexResponse, error) {
+		return vc.SyncSubcommitteeIndex(ctx, in)
+	})
+}
+
+func (c *validatorClient) SyncCommitteeContribution(ctx context.Context, in *ethpb.SyncCommitteeContributionRequest) (*ethpb.SyncCommitteeContribution, error) {
+	return failover(ctx, c, func(ctx context.Context, vc iface.ValidatorClient) (*ethpb.SyncCommitteeContribution, error) {
+		return vc.SyncCommitteeContribution(ctx, in)
+	})
+}
+
+func (c *validatorClient) AggregatedSelections(ctx context.Context, selections []iface.BeaconCommitteeSelection) ([]iface.BeaconCommitteeSelection, error) {
+	return failover(ctx, c, func(ctx context.Context, vc iface.ValidatorClient) ([]iface.BeaconCommitteeSelection, error) {
+		return vc.AggregatedSelections(ctx, selections)
+	})
+}
+
+func (c *validatorClient) AggregatedSyncSelections(ctx context.Context, selections []iface.SyncCommitteeSelection) ([]iface.SyncCommitteeSelection, error) {
+	return failover(ctx, c, func(ctx context.Context, vc iface.ValidatorClient) ([]iface.SyncCommitteeSelection, error) {
+		return vc.AggregatedSyncSelections(ctx, selections)
+	})
+}
+
+// StartEventStream subscribes to the events of the primary node.
+func (c *validatorClient) StartEventStream(ctx context.Context, topics []string, eventsChannel chan<- *event.Event) {
+	c.primaryClient().StartEventStream(ctx, topics, eventsChannel)
+}
+
+func (c *validatorClient) EventStreamIsRunning() bool {
+	return c.primaryClient().EventStreamIsRunning()
+}
+
+// Host returns the host of the primary node.
+func (c *validatorClient) Host() string {
+	return c.primaryClient().Host()
+}
+
+// SetHost makes the node with the given host the primary node.
+func (c *validatorClient) SetHost(host string) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	for i, n := range c.nodes {
+		if n.ValidatorClient.Host() == host {
+			c.primary = i
+			return
+		}
+	}
+	log.WithField("host", host).Warn("Unknown beacon node host, keeping the current primary node")
+}
+
+func (c *validatorClient) primaryClient() iface.ValidatorClient {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	return c.nodes[c.primary].ValidatorClient
+}
+
+// candidates returns the indices of the healthy nodes, starting with the primary node. All the nodes are
+// returned when none is known to be healthy, for instance before the first health check.
+func (c *validatorClient) candidates() []int {
+	c.lock.RLock()
+	primary := c.primary
+	c.lock.RUnlock()
+
+	all := make([]int, 0, len(c.nodes))
+	healthy := make([]int, 0, len(c.nodes))
+	for j := range c.nodes {
+		i := (primary + j) % len(c.nodes)
+		all = append(all, i)
+		if isHealthy(c.nodes[i]) {
+			healthy = append(healthy, i)
+		}
+	}
+	if len(healthy) == 0 {
+		return all
+	}
+	return healthy
+}
+
+func (c *validatorClient) trackBlindedBlock(b *ethpb.GenericBeaconBlock, node int) error {
+	blk, err := blocks.NewBeaconBlock(b.Block)
+	if err != nil {
+		return err
+	}
+	root, err := blk.HashTreeRoot()
+	if err != nil {
+		return err
+	}
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if len(c.blindedBlockRoots) >= maxTrackedBlindedBlocks {
+		delete(c.blindedBlockNodes, c.blindedBlockRoots[0])
+		c.blindedBlockRoots = c.blindedBlockRoots[1:]
+	}
+	c.blindedBlockNodes[root] = node
+	c.blindedBlockRoots = append(c.blindedBlockRoots, root)
+	return nil
+}
+
+func (c *validatorClient) blindedBlockNode(in *ethpb.GenericSignedBeaconBlock) (int, bool) {
+	blk, err := blocks.NewSignedBeaconBlock(in.Block)
+	if err != nil || !blk.IsBlinded() {
+		return 0, false
+	}
+	root, err := blk.Block().HashTreeRoot()
+	if err != nil {
+		return 0, false
+	}
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	node, ok := c.blindedBlockNodes[root]
+	return node, ok
+}
+
+func isHealthy(n Node) bool {
+	if n.NodeClient == nil || n.NodeClient.HealthTracker() == nil {
+		return true
+	}
+	return n.NodeClient.HealthTracker().IsHealthy()
+}
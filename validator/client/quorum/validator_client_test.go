@@ -0,0 +1,188 @@
+package quorum
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/api/client/beacon"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+	validatormock "github.com/prysmaticlabs/prysm/v5/testing/validator-mock"
+	"github.com/prysmaticlabs/prysm/v5/validator/client/iface"
+	"go.uber.org/mock/gomock"
+)
+
+// fakeNodeClient is a node client whose health is set by the test.
+type fakeNodeClient struct {
+	iface.NodeClient
+	healthy bool
+	tracker *beacon.NodeHealthTracker
+}
+
+func newFakeNodeClient(healthy bool) *fakeNodeClient {
+	n := &fakeNodeClient{healthy: healthy}
+	n.tracker = beacon.NewNodeHealthTracker(n)
+	return n
+}
+
+func (n *fakeNodeClient) IsHealthy(context.Context) bool {
+	return n.healthy
+}
+
+func (n *fakeNodeClient) HealthTracker() *beacon.NodeHealthTracker {
+	return n.tracker
+}
+
+func setupNodes(t *testing.T, health ...bool) ([]Node, []*validatormock.MockValidatorClient) {
+	ctrl := gomock.NewController(t)
+	nodes := make([]Node, len(health))
+	mocks := make([]*validatormock.MockValidatorClient, len(health))
+	for i, h := range health {
+		mocks[i] = validatormock.NewMockValidatorClient(ctrl)
+		nc := newFakeNodeClient(h)
+		nc.tracker.CheckHealth(context.Background())
+		nodes[i] = Node{Name: string(rune('a' + i)), ValidatorClient: mocks[i], NodeClient: nc}
+	}
+	return nodes, mocks
+}
+
+func attestationData(source, target primitives.Epoch, root byte) *ethpb.AttestationData {
+	d := util.HydrateAttestationData(&ethpb.AttestationData{})
+	d.Source.Epoch = source
+	d.Target.Epoch = target
+	d.BeaconBlockRoot[0] = root
+	return d
+}
+
+func TestValidatorClient_AttestationData(t *testing.T) {
+	ctx := context.Background()
+	req := &ethpb.AttestationDataRequest{Slot: 1}
+
+	t.Run("majority", func(t *testing.T) {
+		nodes, mocks := setupNodes(t, true, true, true)
+		a, b := attestationData(1, 2, 'a'), attestationData(1, 2, 'b')
+		mocks[0].EXPECT().AttestationData(gomock.Any(), req).Return(b, nil).MaxTimes(1)
+		mocks[1].EXPECT().AttestationData(gomock.Any(), req).Return(a, nil).MaxTimes(1)
+		mocks[2].EXPECT().AttestationData(gomock.Any(), req).Return(a, nil).MaxTimes(1)
+		got, err := NewValidatorClient(nodes).AttestationData(ctx, req)
+		require.NoError(t, err)
+		require.DeepEqual(t, a, got)
+	})
+	t.Run("no majority, most recent checkpoints", func(t *testing.T) {
+		nodes, mocks := setupNodes(t, true, true, true)
+		a, b := attestationData(1, 2, 'a'), attestationData(2, 3, 'b')
+		mocks[0].EXPECT().AttestationData(gomock.Any(), req).Return(a, nil)
+		mocks[1].EXPECT().AttestationData(gomock.Any(), req).Return(nil, errors.New("unavailable"))
+		mocks[2].EXPECT().AttestationData(gomock.Any(), req).Return(b, nil)
+		got, err := NewValidatorClient(nodes).AttestationData(ctx, req)
+		require.NoError(t, err)
+		require.DeepEqual(t, b, got)
+	})
+	t.Run("unhealthy nodes are skipped", func(t *testing.T) {
+		nodes, mocks := setupNodes(t, false, true, true)
+		a := attestationData(1, 2, 'a')
+		mocks[1].EXPECT().AttestationData(gomock.Any(), req).Return(a, nil)
+		mocks[2].EXPECT().AttestationData(gomock.Any(), req).Return(a, nil)
+		got, err := NewValidatorClient(nodes).AttestationData(ctx, req)
+		require.NoError(t, err)
+		require.DeepEqual(t, a, got)
+	})
+	t.Run("all failed", func(t *testing.T) {
+		nodes, mocks := setupNodes(t, true, true)
+		for _, m := range mocks {
+			m.EXPECT().AttestationData(gomock.Any(), req).Return(nil, errors.New("unavailable"))
+		}
+		_, err := NewValidatorClient(nodes).AttestationData(ctx, req)
+		require.ErrorContains(t, "unavailable", err)
+	})
+}
+
+func TestValidatorClient_BeaconBlock(t *testing.T) {
+	ctx := context.Background()
+	req := &ethpb.BlockRequest{Slot: 1}
+	nodes, mocks := setupNodes(t, true, true, true)
+
+	full := &ethpb.GenericBeaconBlock{
+		Block:        &ethpb.GenericBeaconBlock_Bellatrix{Bellatrix: util.NewBeaconBlockBellatrix().Block},
+		PayloadValue: "10",
+	}
+	blindedBlock := util.NewBlindedBeaconBlockBellatrix()
+	blindedBlock.Block.Slot = 1
+	blinded := &ethpb.GenericBeaconBlock{
+		Block:        &ethpb.GenericBeaconBlock_BlindedBellatrix{BlindedBellatrix: blindedBlock.Block},
+		IsBlinded:    true,
+		PayloadValue: "30",
+	}
+	mocks[0].EXPECT().BeaconBlock(gomock.Any(), req).Return(full, nil)
+	mocks[1].EXPECT().BeaconBlock(gomock.Any(), req).Return(blinded, nil)
+	mocks[2].EXPECT().BeaconBlock(gomock.Any(), req).Return(nil, errors.New("unavailable"))
+
+	c := NewValidatorClient(nodes)
+	got, err := c.BeaconBlock(ctx, req)
+	require.NoError(t, err)
+	require.DeepEqual(t, blinded, got)
+
+	// The blinded block is only published to the node which produced it.
+	signed := &ethpb.GenericSignedBeaconBlock{Block: &ethpb.GenericSignedBeaconBlock_BlindedBellatrix{BlindedBellatrix: blindedBlock}}
+	mocks[1].EXPECT().ProposeBeaconBlock(gomock.Any(), signed).Return(&ethpb.ProposeResponse{BlockRoot: []byte{1}}, nil)
+	resp, err := c.ProposeBeaconBlock(ctx, signed)
+	require.NoError(t, err)
+	require.DeepEqual(t, []byte{1}, resp.BlockRoot)
+}
+
+func TestValidatorClient_Broadcast(t *testing.T) {
+	ctx := context.Background()
+	att := util.NewAttestation()
+
+	t.Run("published to every healthy node", func(t *testing.T) {
+		nodes, mocks := setupNodes(t, true, false, true)
+		mocks[0].EXPECT().ProposeAttestation(gomock.Any(), att).Return(nil, errors.New("rejected"))
+		mocks[2].EXPECT().ProposeAttestation(gomock.Any(), att).Return(&ethpb.AttestResponse{AttestationDataRoot: []byte{2}}, nil)
+		resp, err := NewValidatorClient(nodes).ProposeAttestation(ctx, att)
+		require.NoError(t, err)
+		require.DeepEqual(t, []byte{2}, resp.AttestationDataRoot)
+	})
+	t.Run("all failed", func(t *testing.T) {
+		nodes, mocks := setupNodes(t, true, true)
+		for _, m := range mocks {
+			m.EXPECT().ProposeAttestation(gomock.Any(), att).Return(nil, errors.New("rejected"))
+		}
+		_, err := NewValidatorClient(nodes).ProposeAttestation(ctx, att)
+		require.ErrorContains(t, "request failed on all of the 2 beacon nodes", err)
+	})
+}
+
+func TestValidatorClient_Failover(t *testing.T) {
+	ctx := context.Background()
+	req := &ethpb.DutiesRequest{Epoch: 1}
+	nodes, mocks := setupNodes(t, true, true, true)
+	mocks[0].EXPECT().Duties(gomock.Any(), req).Return(nil, errors.New("unavailable"))
+	mocks[1].EXPECT().Duties(gomock.Any(), req).Return(&ethpb.DutiesResponse{}, nil)
+	_, err := NewValidatorClient(nodes).Duties(ctx, req)
+	require.NoError(t, err)
+
+	// The primary node can be changed by host.
+	c := NewValidatorClient(nodes)
+	for i, m := range mocks {
+		m.EXPECT().Host().Return(nodes[i].Name).AnyTimes()
+	}
+	c.SetHost("c")
+	mocks[2].EXPECT().Duties(gomock.Any(), req).Return(&ethpb.DutiesResponse{}, nil)
+	_, err = c.Duties(ctx, req)
+	require.NoError(t, err)
+	require.Equal(t, "c", c.Host())
+}
+
+func TestNodeClient_IsHealthy(t *testing.T) {
+	nodes, _ := setupNodes(t, false, false)
+	c := NewNodeClient(nodes)
+	require.Equal(t, false, c.HealthTracker().CheckHealth(context.Background()))
+	nodes[1].NodeClient.(*fakeNodeClient).healthy = true
+	require.Equal(t, true, c.HealthTracker().CheckHealth(context.Background()))
+	// The health of every node is updated along the way.
+	require.Equal(t, false, nodes[0].NodeClient.HealthTracker().IsHealthy())
+	require.Equal(t, true, nodes[1].NodeClient.HealthTracker().IsHealthy())
+}
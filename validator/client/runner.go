@@ -108,6 +108,11 @@ func run(ctx context.Context, v iface.Validator) {
 				go v.UpdateDomainDataCaches(slotCtx, slot+1)
 			}
 
+			// Submit the scheduled and conditional voluntary exits whose conditions are met.
+			if slots.IsEpochStart(slot) {
+				go v.SubmitExitIntents(slotCtx, slot)
+			}
+
 			var wg sync.WaitGroup
 
 			allRoles, err := v.RolesAt(slotCtx, slot)
@@ -15,16 +15,19 @@ import (
 	grpcutil "github.com/prysmaticlabs/prysm/v5/api/grpc"
 	"github.com/prysmaticlabs/prysm/v5/async/event"
 	lruwrpr "github.com/prysmaticlabs/prysm/v5/cache/lru"
+	"github.com/prysmaticlabs/prysm/v5/config/features"
 	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
 	"github.com/prysmaticlabs/prysm/v5/config/params"
 	"github.com/prysmaticlabs/prysm/v5/config/proposer"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
 	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v5/validator/accounts/wallet"
+	"github.com/prysmaticlabs/prysm/v5/validator/audit"
 	beaconApi "github.com/prysmaticlabs/prysm/v5/validator/client/beacon-api"
 	beaconChainClientFactory "github.com/prysmaticlabs/prysm/v5/validator/client/beacon-chain-client-factory"
 	"github.com/prysmaticlabs/prysm/v5/validator/client/iface"
 	nodeclientfactory "github.com/prysmaticlabs/prysm/v5/validator/client/node-client-factory"
+	"github.com/prysmaticlabs/prysm/v5/validator/client/quorum"
 	validatorclientfactory "github.com/prysmaticlabs/prysm/v5/validator/client/validator-client-factory"
 	"github.com/prysmaticlabs/prysm/v5/validator/db"
 	"github.com/prysmaticlabs/prysm/v5/validator/graffiti"
@@ -45,6 +48,7 @@ type ValidatorService struct {
 	validator               iface.Validator
 	db                      db.Database
 	conn                    validatorHelpers.NodeConnection
+	quorumConns             []validatorHelpers.NodeConnection
 	wallet                  *wallet.Wallet
 	walletInitializedFeed   *event.Feed
 	graffiti                []byte
@@ -57,6 +61,9 @@ type ValidatorService struct {
 	emitAccountMetrics      bool
 	logValidatorPerformance bool
 	distributed             bool
+	beaconNodeQuorum        bool
+	auditLog                *audit.Log
+	performanceRetention    primitives.Epoch
 }
 
 // Config for the validator service.
@@ -83,6 +90,9 @@ type Config struct {
 	LogValidatorPerformance bool
 	EmitAccountMetrics      bool
 	Distributed             bool
+	BeaconNodeQuorum        bool
+	AuditLog                *audit.Log
+	PerformanceRetention    primitives.Epoch
 }
 
 // NewValidatorService creates a new validator service for the service
@@ -106,6 +116,9 @@ func NewValidatorService(ctx context.Context, cfg *Config) (*ValidatorService, e
 		emitAccountMetrics:      cfg.EmitAccountMetrics,
 		logValidatorPerformance: cfg.LogValidatorPerformance,
 		distributed:             cfg.Distributed,
+		beaconNodeQuorum:        cfg.BeaconNodeQuorum,
+		auditLog:                cfg.AuditLog,
+		performanceRetention:    cfg.PerformanceRetention,
 	}
 
 	dialOpts := ConstructDialOptions(
@@ -133,6 +146,18 @@ func NewValidatorService(ctx context.Context, cfg *Config) (*ValidatorService, e
 		cfg.BeaconApiTimeout,
 	)
 
+	// In quorum mode, every beacon node needs its own connection instead of a single connection
+	// picking one of the endpoints.
+	if cfg.BeaconNodeQuorum && !features.Get().EnableBeaconRESTApi {
+		for _, endpoint := range strings.Split(cfg.BeaconNodeGRPCEndpoint, ",") {
+			conn, err := grpc.DialContext(ctx, endpoint, dialOpts...)
+			if err != nil {
+				return s, errors.Wrapf(err, "could not dial beacon node %s", endpoint)
+			}
+			s.quorumConns = append(s.quorumConns, validatorHelpers.NewNodeConnection(conn, cfg.BeaconApiEndpoint, cfg.BeaconApiTimeout))
+		}
+	}
+
 	return s, nil
 }
 
@@ -178,6 +203,13 @@ func (v *ValidatorService) Start() {
 	)
 
 	validatorClient := validatorclientfactory.NewValidatorClient(v.conn, restHandler)
+	nodeClient := nodeclientfactory.NewNodeClient(v.conn, restHandler)
+	if v.beaconNodeQuorum {
+		nodes := v.quorumNodes(hosts, restHandler)
+		log.WithField("beaconNodes", len(nodes)).Info("Using beacon nodes in quorum mode")
+		validatorClient = quorum.NewValidatorClient(nodes)
+		nodeClient = quorum.NewNodeClient(nodes)
+	}
 
 	valStruct := &validator{
 		slotFeed:                       new(event.Feed),
@@ -195,10 +227,11 @@ func (v *ValidatorService) Start() {
 		currentHostIndex:               0,
 		validatorClient:                validatorClient,
 		chainClient:                    beaconChainClientFactory.NewChainClient(v.conn, restHandler),
-		nodeClient:                     nodeclientfactory.NewNodeClient(v.conn, restHandler),
+		nodeClient:                     nodeClient,
 		prysmChainClient:               beaconChainClientFactory.NewPrysmChainClient(v.conn, restHandler),
 		db:                             v.db,
 		km:                             nil,
+		auditLog:                       v.auditLog,
 		web3SignerConfig:               v.web3SignerConfig,
 		proposerSettings:               v.proposerSettings,
 		signedValidatorRegistrations:   make(map[[fieldparams.BLSPubkeyLength]byte]*ethpb.SignedValidatorRegistrationV1),
@@ -212,6 +245,7 @@ func (v *ValidatorService) Start() {
 		submittedAtts:                  make(map[submittedAttKey]*submittedAtt),
 		submittedAggregates:            make(map[submittedAttKey]*submittedAtt),
 		logValidatorPerformance:        v.logValidatorPerformance,
+		performanceRetention:           v.performanceRetention,
 		emitAccountMetrics:             v.emitAccountMetrics,
 		useWeb:                         v.useWeb,
 		distributed:                    v.distributed,
@@ -221,10 +255,43 @@ func (v *ValidatorService) Start() {
 	go run(v.ctx, v.validator)
 }
 
+// quorumNodes builds the clients of every beacon node used in quorum mode. The first REST API host reuses
+// the given handler, which is shared with the other clients of the validator.
+func (v *ValidatorService) quorumNodes(hosts []string, restHandler beaconApi.JsonRestHandler) []quorum.Node {
+	var nodes []quorum.Node
+	if features.Get().EnableBeaconRESTApi {
+		for i, host := range hosts {
+			handler := restHandler
+			if i > 0 {
+				handler = beaconApi.NewBeaconApiJsonRestHandler(http.Client{Timeout: v.conn.GetBeaconApiTimeout()}, host)
+			}
+			nodes = append(nodes, quorum.Node{
+				Name:            host,
+				ValidatorClient: validatorclientfactory.NewValidatorClient(v.conn, handler),
+				NodeClient:      nodeclientfactory.NewNodeClient(v.conn, handler),
+			})
+		}
+		return nodes
+	}
+	for _, conn := range v.quorumConns {
+		nodes = append(nodes, quorum.Node{
+			Name:            conn.GetGrpcClientConn().Target(),
+			ValidatorClient: validatorclientfactory.NewValidatorClient(conn, restHandler),
+			NodeClient:      nodeclientfactory.NewNodeClient(conn, restHandler),
+		})
+	}
+	return nodes
+}
+
 // Stop the validator service.
 func (v *ValidatorService) Stop() error {
 	v.cancel()
 	log.Info("Stopping service")
+	for _, conn := range v.quorumConns {
+		if err := conn.GetGrpcClientConn().Close(); err != nil {
+			log.WithError(err).Error("Could not close beacon node connection")
+		}
+	}
 	if v.conn != nil {
 		return v.conn.GetGrpcClientConn().Close()
 	}
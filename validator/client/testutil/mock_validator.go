@@ -203,6 +203,9 @@ func (*FakeValidator) LogSubmittedAtts(_ primitives.Slot) {}
 // UpdateDomainDataCaches for mocking.
 func (*FakeValidator) UpdateDomainDataCaches(context.Context, primitives.Slot) {}
 
+// SubmitExitIntents for mocking.
+func (*FakeValidator) SubmitExitIntents(context.Context, primitives.Slot) {}
+
 // BalancesByPubkeys for mocking.
 func (fv *FakeValidator) BalancesByPubkeys(_ context.Context) map[[fieldparams.BLSPubkeyLength]byte]uint64 {
 	return fv.Balances
@@ -26,3 +26,44 @@ type AttestationRecord struct {
 	Target      primitives.Epoch
 	SigningRoot []byte
 }
+
+// PerformanceRecord holds the duty outcomes and the balance change of a validator public key during an epoch.
+type PerformanceRecord struct {
+	PubKey [fieldparams.BLSPubkeyLength]byte `json:"-"`
+	Epoch  primitives.Epoch                  `json:"epoch"`
+	// InclusionDistance is 0 when the beacon node does not report it.
+	InclusionDistance           primitives.Slot   `json:"inclusion_distance,omitempty"`
+	CorrectlyVotedSource        bool              `json:"correctly_voted_source"`
+	CorrectlyVotedTarget        bool              `json:"correctly_voted_target"`
+	CorrectlyVotedHead          bool              `json:"correctly_voted_head"`
+	ProposedSlots               []primitives.Slot `json:"proposed_slots,omitempty"`
+	MissedProposalSlots         []primitives.Slot `json:"missed_proposal_slots,omitempty"`
+	SyncCommitteeMessages       uint64            `json:"sync_committee_messages,omitempty"`
+	MissedSyncCommitteeMessages uint64            `json:"missed_sync_committee_messages,omitempty"`
+	BalanceBefore               uint64            `json:"balance_before"`
+	BalanceAfter                uint64            `json:"balance_after"`
+	InactivityScore             uint64            `json:"inactivity_score,omitempty"`
+}
+
+// ExitIntentStatus is the processing status of an exit intent.
+type ExitIntentStatus string
+
+const (
+	// ExitIntentPending means the exit conditions are not met yet, or the last submission failed.
+	ExitIntentPending ExitIntentStatus = "pending"
+	// ExitIntentSubmitted means the signed voluntary exit was accepted by the beacon node.
+	ExitIntentSubmitted ExitIntentStatus = "submitted"
+)
+
+// ExitIntent is a voluntary exit of a validator public key to submit once its epoch is reached,
+// or once its balance drops below a threshold, whichever comes first. Zero values are unset conditions.
+type ExitIntent struct {
+	PubKey           [fieldparams.BLSPubkeyLength]byte `json:"-"`
+	Epoch            primitives.Epoch                  `json:"epoch,omitempty"`
+	BalanceBelowGwei uint64                            `json:"balance_below_gwei,omitempty"`
+	Status           ExitIntentStatus                  `json:"status"`
+	// SubmittedEpoch is the epoch of the submitted voluntary exit.
+	SubmittedEpoch primitives.Epoch `json:"submitted_epoch,omitempty"`
+	// LastError is the reason the last submission failed, it is retried at the next epoch.
+	LastError string `json:"last_error,omitempty"`
+}
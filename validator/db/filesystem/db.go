@@ -24,6 +24,8 @@ const (
 	backupsDirectoryName      = "backups"
 	configurationFileName     = "configuration.yaml"
 	slashingProtectionDirName = "slashing-protection"
+	performanceHistoryDirName = "performance-history"
+	exitIntentsFileName       = "exit-intents.json"
 
 	DatabaseDirName = "validator-client-data"
 )
@@ -34,6 +36,8 @@ type (
 		configurationMu    sync.RWMutex
 		pkToSlashingMu     map[[fieldparams.BLSPubkeyLength]byte]*sync.RWMutex
 		slashingMuMapMu    sync.Mutex
+		performanceMu      sync.RWMutex
+		exitIntentsMu      sync.RWMutex
 		databaseParentPath string
 		databasePath       string
 	}
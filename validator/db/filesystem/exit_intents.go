@@ -0,0 +1,152 @@
+package filesystem
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/io/file"
+	"github.com/prysmaticlabs/prysm/v5/validator/db/common"
+)
+
+// exitIntent is the stored representation of an exit intent, with its public key.
+type exitIntent struct {
+	PubKey string `json:"pubkey"`
+	*common.ExitIntent
+}
+
+// SaveExitIntent saves the exit intent of a validator public key, overriding any existing one.
+func (s *Store) SaveExitIntent(_ context.Context, intent *common.ExitIntent) error {
+	s.exitIntentsMu.Lock()
+	defer s.exitIntentsMu.Unlock()
+
+	// Get the existing intents.
+	intents, err := s.exitIntents()
+	if err != nil {
+		return err
+	}
+
+	// Replace the intent of the public key.
+	filtered := make([]*common.ExitIntent, 0, len(intents)+1)
+	for _, existing := range intents {
+		if existing.PubKey != intent.PubKey {
+			filtered = append(filtered, existing)
+		}
+	}
+	filtered = append(filtered, intent)
+
+	return s.saveExitIntents(filtered)
+}
+
+// ExitIntents returns the exit intents of all validator public keys.
+func (s *Store) ExitIntents(_ context.Context) ([]*common.ExitIntent, error) {
+	s.exitIntentsMu.RLock()
+	defer s.exitIntentsMu.RUnlock()
+
+	return s.exitIntents()
+}
+
+// DeleteExitIntent deletes the exit intent of a validator public key, if any.
+func (s *Store) DeleteExitIntent(_ context.Context, pubKey [fieldparams.BLSPubkeyLength]byte) error {
+	s.exitIntentsMu.Lock()
+	defer s.exitIntentsMu.Unlock()
+
+	// Get the existing intents.
+	intents, err := s.exitIntents()
+	if err != nil {
+		return err
+	}
+
+	// Drop the intent of the public key.
+	filtered := make([]*common.ExitIntent, 0, len(intents))
+	for _, existing := range intents {
+		if existing.PubKey != pubKey {
+			filtered = append(filtered, existing)
+		}
+	}
+	if len(filtered) == len(intents) {
+		return nil
+	}
+
+	return s.saveExitIntents(filtered)
+}
+
+// exitIntentsFilePath returns the path of the exit intents file.
+func (s *Store) exitIntentsFilePath() string {
+	return path.Join(s.databasePath, exitIntentsFileName)
+}
+
+// exitIntents returns the exit intents sorted by public key.
+// The caller must hold the exit intents mutex.
+func (s *Store) exitIntents() ([]*common.ExitIntent, error) {
+	cleanedPath := filepath.Clean(s.exitIntentsFilePath())
+
+	// Check if the file exists.
+	exists, err := file.Exists(cleanedPath, file.Regular)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not check if %s exists", cleanedPath)
+	}
+
+	intents := make([]*common.ExitIntent, 0)
+	if !exists {
+		return intents, nil
+	}
+
+	// Read the file and unmarshal it into exit intents.
+	data, err := os.ReadFile(cleanedPath)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not read %s", cleanedPath)
+	}
+
+	var stored []*exitIntent
+	if err := json.Unmarshal(data, &stored); err != nil {
+		return nil, errors.Wrapf(err, "could not unmarshal %s", cleanedPath)
+	}
+
+	for _, item := range stored {
+		pubKeyBytes, err := hexutil.Decode(item.PubKey)
+		if err != nil || len(pubKeyBytes) != fieldparams.BLSPubkeyLength || item.ExitIntent == nil {
+			return nil, errors.Errorf("invalid exit intent for public key %s in %s", item.PubKey, cleanedPath)
+		}
+		item.ExitIntent.PubKey = [fieldparams.BLSPubkeyLength]byte(pubKeyBytes)
+		intents = append(intents, item.ExitIntent)
+	}
+
+	return intents, nil
+}
+
+// saveExitIntents sorts the exit intents by public key and saves them.
+// The caller must hold the exit intents mutex.
+func (s *Store) saveExitIntents(intents []*common.ExitIntent) error {
+	sort.Slice(intents, func(i, j int) bool {
+		return hexutil.Encode(intents[i].PubKey[:]) < hexutil.Encode(intents[j].PubKey[:])
+	})
+
+	// Create the directory if needed.
+	if err := file.MkdirAll(s.databasePath); err != nil {
+		return errors.Wrapf(err, "could not create directory %s", s.databasePath)
+	}
+
+	stored := make([]*exitIntent, len(intents))
+	for i, intent := range intents {
+		stored[i] = &exitIntent{PubKey: hexutil.Encode(intent.PubKey[:]), ExitIntent: intent}
+	}
+
+	data, err := json.Marshal(stored)
+	if err != nil {
+		return errors.Wrap(err, "could not marshal exit intents")
+	}
+
+	path := s.exitIntentsFilePath()
+	if err := file.WriteFile(path, data); err != nil {
+		return errors.Wrapf(err, "could not write into %s", path)
+	}
+
+	return nil
+}
@@ -0,0 +1,44 @@
+package filesystem
+
+import (
+	"context"
+	"testing"
+
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/validator/db/common"
+)
+
+func TestStore_ExitIntents(t *testing.T) {
+	ctx := context.Background()
+	pubkeys := [][fieldparams.BLSPubkeyLength]byte{{1}, {2}}
+
+	// Create a new store.
+	store, err := NewStore(t.TempDir(), nil)
+	require.NoError(t, err)
+
+	// No intents yet.
+	intents, err := store.ExitIntents(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(intents))
+	require.NoError(t, store.DeleteExitIntent(ctx, pubkeys[0]))
+
+	// Save intents, the last one overriding the first.
+	require.NoError(t, store.SaveExitIntent(ctx, &common.ExitIntent{PubKey: pubkeys[1], BalanceBelowGwei: 31_000_000_000, Status: common.ExitIntentPending}))
+	require.NoError(t, store.SaveExitIntent(ctx, &common.ExitIntent{PubKey: pubkeys[0], Epoch: 100, Status: common.ExitIntentPending}))
+	require.NoError(t, store.SaveExitIntent(ctx, &common.ExitIntent{PubKey: pubkeys[0], Epoch: 100, Status: common.ExitIntentSubmitted, SubmittedEpoch: 101}))
+
+	intents, err = store.ExitIntents(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(intents))
+	assert.DeepEqual(t, &common.ExitIntent{PubKey: pubkeys[0], Epoch: 100, Status: common.ExitIntentSubmitted, SubmittedEpoch: 101}, intents[0])
+	assert.DeepEqual(t, &common.ExitIntent{PubKey: pubkeys[1], BalanceBelowGwei: 31_000_000_000, Status: common.ExitIntentPending}, intents[1])
+
+	// Delete an intent.
+	require.NoError(t, store.DeleteExitIntent(ctx, pubkeys[0]))
+	intents, err = store.ExitIntents(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(intents))
+	assert.Equal(t, pubkeys[1], intents[0].PubKey)
+}
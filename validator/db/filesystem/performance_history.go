@@ -0,0 +1,197 @@
+package filesystem
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/io/file"
+	"github.com/prysmaticlabs/prysm/v5/validator/db/common"
+)
+
+// SavePerformanceRecords saves the performance records of validator public keys, overriding
+// any existing record of the same public key and epoch.
+func (s *Store) SavePerformanceRecords(_ context.Context, records []*common.PerformanceRecord) error {
+	// Group the records by public key.
+	recordsByPubKey := make(map[[fieldparams.BLSPubkeyLength]byte][]*common.PerformanceRecord)
+	for _, record := range records {
+		recordsByPubKey[record.PubKey] = append(recordsByPubKey[record.PubKey], record)
+	}
+
+	s.performanceMu.Lock()
+	defer s.performanceMu.Unlock()
+
+	for pubKey, newRecords := range recordsByPubKey {
+		// Get the existing records.
+		existing, err := s.performanceRecords(pubKey)
+		if err != nil {
+			return err
+		}
+
+		// Merge the new records into the existing ones, the new ones taking precedence.
+		byEpoch := make(map[primitives.Epoch]*common.PerformanceRecord, len(existing)+len(newRecords))
+		for _, record := range existing {
+			byEpoch[record.Epoch] = record
+		}
+		for _, record := range newRecords {
+			byEpoch[record.Epoch] = record
+		}
+		merged := make([]*common.PerformanceRecord, 0, len(byEpoch))
+		for _, record := range byEpoch {
+			merged = append(merged, record)
+		}
+
+		// Save the records.
+		if err := s.savePerformanceRecords(pubKey, merged); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// PerformanceHistory returns at most limit performance records of a validator public key,
+// with epochs between startEpoch and endEpoch included, in increasing epoch order.
+func (s *Store) PerformanceHistory(
+	_ context.Context, pubKey [fieldparams.BLSPubkeyLength]byte, startEpoch, endEpoch primitives.Epoch, limit int,
+) ([]*common.PerformanceRecord, error) {
+	s.performanceMu.RLock()
+	defer s.performanceMu.RUnlock()
+
+	// Get the records of the public key, sorted by epoch.
+	existing, err := s.performanceRecords(pubKey)
+	if err != nil {
+		return nil, err
+	}
+
+	// Select the records in the range.
+	records := make([]*common.PerformanceRecord, 0)
+	for _, record := range existing {
+		if len(records) >= limit || record.Epoch > endEpoch {
+			break
+		}
+		if record.Epoch >= startEpoch {
+			records = append(records, record)
+		}
+	}
+
+	return records, nil
+}
+
+// PrunePerformanceHistory deletes the performance records of all validator public keys
+// with epochs lower than beforeEpoch.
+func (s *Store) PrunePerformanceHistory(_ context.Context, beforeEpoch primitives.Epoch) error {
+	s.performanceMu.Lock()
+	defer s.performanceMu.Unlock()
+
+	// Get the performance history files.
+	entries, err := os.ReadDir(s.performanceHistoryDirPath())
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return errors.Wrap(err, "could not read performance history directory")
+	}
+
+	for _, entry := range entries {
+		// Get the public key from the file name.
+		pubKeyBytes, err := hexutil.Decode(strings.TrimSuffix(entry.Name(), ".json"))
+		if err != nil || len(pubKeyBytes) != fieldparams.BLSPubkeyLength {
+			continue
+		}
+		pubKey := [fieldparams.BLSPubkeyLength]byte(pubKeyBytes)
+
+		// Get the records of the public key, sorted by epoch.
+		existing, err := s.performanceRecords(pubKey)
+		if err != nil {
+			return err
+		}
+
+		// Drop the records before the epoch.
+		index := sort.Search(len(existing), func(i int) bool { return existing[i].Epoch >= beforeEpoch })
+		if index == 0 {
+			continue
+		}
+
+		if err := s.savePerformanceRecords(pubKey, existing[index:]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// performanceHistoryDirPath returns the path of the performance history directory.
+func (s *Store) performanceHistoryDirPath() string {
+	return path.Join(s.databasePath, performanceHistoryDirName)
+}
+
+// pubkeyPerformanceHistoryFilePath returns the path of the performance history file for a public key.
+func (s *Store) pubkeyPerformanceHistoryFilePath(pubKey [fieldparams.BLSPubkeyLength]byte) string {
+	return path.Join(s.performanceHistoryDirPath(), fmt.Sprintf("%s.json", hexutil.Encode(pubKey[:])))
+}
+
+// performanceRecords returns the performance records of a public key, sorted by epoch.
+// The caller must hold the performance mutex.
+func (s *Store) performanceRecords(pubKey [fieldparams.BLSPubkeyLength]byte) ([]*common.PerformanceRecord, error) {
+	cleanedPath := filepath.Clean(s.pubkeyPerformanceHistoryFilePath(pubKey))
+
+	// Check if the public key has a file in the database.
+	exists, err := file.Exists(cleanedPath, file.Regular)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not check if %s exists", cleanedPath)
+	}
+
+	if !exists {
+		return nil, nil
+	}
+
+	// Read the file and unmarshal it into performance records.
+	data, err := os.ReadFile(cleanedPath)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not read %s", cleanedPath)
+	}
+
+	var records []*common.PerformanceRecord
+	if err := json.Unmarshal(data, &records); err != nil {
+		return nil, errors.Wrapf(err, "could not unmarshal %s", cleanedPath)
+	}
+
+	for _, record := range records {
+		record.PubKey = pubKey
+	}
+
+	return records, nil
+}
+
+// savePerformanceRecords sorts the performance records of a public key by epoch and saves them.
+// The caller must hold the performance mutex.
+func (s *Store) savePerformanceRecords(pubKey [fieldparams.BLSPubkeyLength]byte, records []*common.PerformanceRecord) error {
+	sort.Slice(records, func(i, j int) bool { return records[i].Epoch < records[j].Epoch })
+
+	// Create the directory if needed.
+	if err := file.MkdirAll(s.performanceHistoryDirPath()); err != nil {
+		return errors.Wrapf(err, "could not create directory %s", s.performanceHistoryDirPath())
+	}
+
+	data, err := json.Marshal(records)
+	if err != nil {
+		return errors.Wrap(err, "could not marshal performance records")
+	}
+
+	path := s.pubkeyPerformanceHistoryFilePath(pubKey)
+	if err := file.WriteFile(path, data); err != nil {
+		return errors.Wrapf(err, "could not write into %s", path)
+	}
+
+	return nil
+}
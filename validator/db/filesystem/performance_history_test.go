@@ -0,0 +1,69 @@
+package filesystem
+
+import (
+	"context"
+	"testing"
+
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/validator/db/common"
+)
+
+func TestStore_PerformanceHistory(t *testing.T) {
+	ctx := context.Background()
+	pubkeys := [][fieldparams.BLSPubkeyLength]byte{{1}, {2}}
+
+	// Create a new store.
+	store, err := NewStore(t.TempDir(), nil)
+	require.NoError(t, err)
+
+	// No history yet.
+	got, err := store.PerformanceHistory(ctx, pubkeys[0], 0, 10, 100)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(got))
+	require.NoError(t, store.PrunePerformanceHistory(ctx, 5))
+
+	// Save the records of the odd epochs, then of the even ones.
+	var records []*common.PerformanceRecord
+	for _, parity := range []primitives.Epoch{1, 0} {
+		for epoch := primitives.Epoch(1); epoch <= 10; epoch++ {
+			if epoch%2 != parity {
+				continue
+			}
+			for _, pubkey := range pubkeys {
+				records = append(records, &common.PerformanceRecord{
+					PubKey:        pubkey,
+					Epoch:         epoch,
+					BalanceBefore: uint64(epoch),
+				})
+			}
+		}
+		require.NoError(t, store.SavePerformanceRecords(ctx, records))
+		records = nil
+	}
+
+	// Override a record.
+	require.NoError(t, store.SavePerformanceRecords(ctx, []*common.PerformanceRecord{
+		{PubKey: pubkeys[0], Epoch: 3, ProposedSlots: []primitives.Slot{100}},
+	}))
+
+	got, err = store.PerformanceHistory(ctx, pubkeys[0], 2, 8, 4)
+	require.NoError(t, err)
+	require.Equal(t, 4, len(got))
+	for i, record := range got {
+		assert.Equal(t, primitives.Epoch(i+2), record.Epoch)
+		assert.Equal(t, pubkeys[0], record.PubKey)
+	}
+	assert.DeepEqual(t, []primitives.Slot{100}, got[1].ProposedSlots)
+
+	// Pruning.
+	require.NoError(t, store.PrunePerformanceHistory(ctx, 6))
+	for _, pubkey := range pubkeys {
+		got, err = store.PerformanceHistory(ctx, pubkey, 0, 10, 100)
+		require.NoError(t, err)
+		require.Equal(t, 5, len(got))
+		assert.Equal(t, primitives.Epoch(6), got[0].Epoch)
+	}
+}
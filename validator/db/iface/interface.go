@@ -82,4 +82,16 @@ type ValidatorDB interface {
 
 	// EIP-3076 slashing protection related methods
 	ImportStandardProtectionJSON(ctx context.Context, r io.Reader) error
+
+	// Performance history related methods
+	SavePerformanceRecords(ctx context.Context, records []*common.PerformanceRecord) error
+	PerformanceHistory(
+		ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte, startEpoch, endEpoch primitives.Epoch, limit int,
+	) ([]*common.PerformanceRecord, error)
+	PrunePerformanceHistory(ctx context.Context, beforeEpoch primitives.Epoch) error
+
+	// Exit intents related methods
+	SaveExitIntent(ctx context.Context, intent *common.ExitIntent) error
+	ExitIntents(ctx context.Context) ([]*common.ExitIntent, error)
+	DeleteExitIntent(ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte) error
 }
@@ -160,6 +160,8 @@ func NewKVStore(ctx context.Context, dirPath string, config *Config) (*Store, er
 			migrationsBucket,
 			graffitiBucket,
 			proposerSettingsBucket,
+			performanceHistoryBucket,
+			exitIntentsBucket,
 		)
 	}); err != nil {
 		return nil, err
@@ -0,0 +1,54 @@
+package kv
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/pkg/errors"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing/trace"
+	"github.com/prysmaticlabs/prysm/v5/validator/db/common"
+	bolt "go.etcd.io/bbolt"
+)
+
+// SaveExitIntent saves the exit intent of a validator public key, overriding any existing one.
+func (s *Store) SaveExitIntent(ctx context.Context, intent *common.ExitIntent) error {
+	_, span := trace.StartSpan(ctx, "validator.db.SaveExitIntent")
+	defer span.End()
+	enc, err := json.Marshal(intent)
+	if err != nil {
+		return errors.Wrap(err, "could not marshal exit intent")
+	}
+	return s.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(exitIntentsBucket).Put(intent.PubKey[:], enc)
+	})
+}
+
+// ExitIntents returns the exit intents of all validator public keys.
+func (s *Store) ExitIntents(ctx context.Context) ([]*common.ExitIntent, error) {
+	_, span := trace.StartSpan(ctx, "validator.db.ExitIntents")
+	defer span.End()
+	intents := make([]*common.ExitIntent, 0)
+	err := s.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(exitIntentsBucket).ForEach(func(pubKey, enc []byte) error {
+			intent := &common.ExitIntent{}
+			if err := json.Unmarshal(enc, intent); err != nil {
+				return errors.Wrap(err, "could not unmarshal exit intent")
+			}
+			intent.PubKey = bytesutil.ToBytes48(pubKey)
+			intents = append(intents, intent)
+			return nil
+		})
+	})
+	return intents, err
+}
+
+// DeleteExitIntent deletes the exit intent of a validator public key, if any.
+func (s *Store) DeleteExitIntent(ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte) error {
+	_, span := trace.StartSpan(ctx, "validator.db.DeleteExitIntent")
+	defer span.End()
+	return s.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(exitIntentsBucket).Delete(pubKey[:])
+	})
+}
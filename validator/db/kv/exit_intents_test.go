@@ -0,0 +1,38 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/validator/db/common"
+)
+
+func TestStore_ExitIntents(t *testing.T) {
+	ctx := context.Background()
+	pubkeys := [][fieldparams.BLSPubkeyLength]byte{{1}, {2}}
+	db := setupDB(t, pubkeys)
+
+	intents, err := db.ExitIntents(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(intents))
+
+	require.NoError(t, db.SaveExitIntent(ctx, &common.ExitIntent{PubKey: pubkeys[0], Epoch: 100, Status: common.ExitIntentPending}))
+	require.NoError(t, db.SaveExitIntent(ctx, &common.ExitIntent{PubKey: pubkeys[1], BalanceBelowGwei: 31_000_000_000, Status: common.ExitIntentPending}))
+	require.NoError(t, db.SaveExitIntent(ctx, &common.ExitIntent{PubKey: pubkeys[0], Epoch: 100, Status: common.ExitIntentSubmitted, SubmittedEpoch: 101}))
+
+	intents, err = db.ExitIntents(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(intents))
+	assert.DeepEqual(t, &common.ExitIntent{PubKey: pubkeys[0], Epoch: 100, Status: common.ExitIntentSubmitted, SubmittedEpoch: 101}, intents[0])
+	assert.DeepEqual(t, &common.ExitIntent{PubKey: pubkeys[1], BalanceBelowGwei: 31_000_000_000, Status: common.ExitIntentPending}, intents[1])
+
+	require.NoError(t, db.DeleteExitIntent(ctx, pubkeys[0]))
+	require.NoError(t, db.DeleteExitIntent(ctx, [fieldparams.BLSPubkeyLength]byte{3}))
+	intents, err = db.ExitIntents(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(intents))
+	assert.Equal(t, pubkeys[1], intents[0].PubKey)
+}
@@ -0,0 +1,91 @@
+package kv
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/pkg/errors"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing/trace"
+	"github.com/prysmaticlabs/prysm/v5/validator/db/common"
+	bolt "go.etcd.io/bbolt"
+)
+
+// SavePerformanceRecords saves the performance records of validator public keys, overriding
+// any existing record of the same public key and epoch.
+func (s *Store) SavePerformanceRecords(ctx context.Context, records []*common.PerformanceRecord) error {
+	_, span := trace.StartSpan(ctx, "validator.db.SavePerformanceRecords")
+	defer span.End()
+	return s.db.Update(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(performanceHistoryBucket)
+		for _, record := range records {
+			pkBucket, err := bkt.CreateBucketIfNotExists(record.PubKey[:])
+			if err != nil {
+				return errors.Wrap(err, "could not create performance history bucket")
+			}
+			enc, err := json.Marshal(record)
+			if err != nil {
+				return errors.Wrap(err, "could not marshal performance record")
+			}
+			if err := pkBucket.Put(bytesutil.EpochToBytesBigEndian(record.Epoch), enc); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// PerformanceHistory returns at most limit performance records of a validator public key,
+// with epochs between startEpoch and endEpoch included, in increasing epoch order.
+func (s *Store) PerformanceHistory(
+	ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte, startEpoch, endEpoch primitives.Epoch, limit int,
+) ([]*common.PerformanceRecord, error) {
+	_, span := trace.StartSpan(ctx, "validator.db.PerformanceHistory")
+	defer span.End()
+	records := make([]*common.PerformanceRecord, 0)
+	err := s.db.View(func(tx *bolt.Tx) error {
+		pkBucket := tx.Bucket(performanceHistoryBucket).Bucket(pubKey[:])
+		if pkBucket == nil {
+			return nil
+		}
+		c := pkBucket.Cursor()
+		for k, v := c.Seek(bytesutil.EpochToBytesBigEndian(startEpoch)); k != nil && len(records) < limit; k, v = c.Next() {
+			if bytesutil.BytesToEpochBigEndian(k) > endEpoch {
+				break
+			}
+			record := &common.PerformanceRecord{}
+			if err := json.Unmarshal(v, record); err != nil {
+				return errors.Wrap(err, "could not unmarshal performance record")
+			}
+			record.PubKey = pubKey
+			records = append(records, record)
+		}
+		return nil
+	})
+	return records, err
+}
+
+// PrunePerformanceHistory deletes the performance records of all validator public keys
+// with epochs lower than beforeEpoch.
+func (s *Store) PrunePerformanceHistory(ctx context.Context, beforeEpoch primitives.Epoch) error {
+	_, span := trace.StartSpan(ctx, "validator.db.PrunePerformanceHistory")
+	defer span.End()
+	return s.db.Update(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(performanceHistoryBucket)
+		return bkt.ForEach(func(pubKey, _ []byte) error {
+			pkBucket := bkt.Bucket(pubKey)
+			if pkBucket == nil {
+				return nil
+			}
+			c := pkBucket.Cursor()
+			for k, _ := c.First(); k != nil && bytesutil.BytesToEpochBigEndian(k) < beforeEpoch; k, _ = c.First() {
+				if err := c.Delete(); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+	})
+}
@@ -0,0 +1,64 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/validator/db/common"
+)
+
+func TestStore_PerformanceHistory(t *testing.T) {
+	ctx := context.Background()
+	pubkeys := [][fieldparams.BLSPubkeyLength]byte{{1}, {2}}
+	db := setupDB(t, pubkeys)
+
+	var records []*common.PerformanceRecord
+	for epoch := primitives.Epoch(1); epoch <= 10; epoch++ {
+		for _, pubkey := range pubkeys {
+			records = append(records, &common.PerformanceRecord{
+				PubKey:               pubkey,
+				Epoch:                epoch,
+				CorrectlyVotedTarget: true,
+				BalanceBefore:        32_000_000_000 + uint64(epoch),
+				BalanceAfter:         32_000_000_001 + uint64(epoch),
+			})
+		}
+	}
+	records[0].ProposedSlots = []primitives.Slot{40}
+	require.NoError(t, db.SavePerformanceRecords(ctx, records))
+
+	// Unknown public key.
+	got, err := db.PerformanceHistory(ctx, [fieldparams.BLSPubkeyLength]byte{3}, 0, 10, 100)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(got))
+
+	got, err = db.PerformanceHistory(ctx, pubkeys[0], 0, 10, 100)
+	require.NoError(t, err)
+	require.Equal(t, 10, len(got))
+	assert.DeepEqual(t, records[0], got[0])
+	assert.Equal(t, primitives.Epoch(10), got[9].Epoch)
+
+	// Range and limit.
+	got, err = db.PerformanceHistory(ctx, pubkeys[1], 3, 8, 4)
+	require.NoError(t, err)
+	require.Equal(t, 4, len(got))
+	assert.Equal(t, primitives.Epoch(3), got[0].Epoch)
+	assert.Equal(t, pubkeys[1], got[0].PubKey)
+	got, err = db.PerformanceHistory(ctx, pubkeys[1], 7, 8, 4)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(got))
+	assert.Equal(t, primitives.Epoch(8), got[1].Epoch)
+
+	// Pruning.
+	require.NoError(t, db.PrunePerformanceHistory(ctx, 6))
+	for _, pubkey := range pubkeys {
+		got, err = db.PerformanceHistory(ctx, pubkey, 0, 10, 100)
+		require.NoError(t, err)
+		require.Equal(t, 5, len(got))
+		assert.Equal(t, primitives.Epoch(6), got[0].Epoch)
+	}
+}
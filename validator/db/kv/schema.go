@@ -41,6 +41,12 @@ var (
 	// ProposerSettings stores the encoded proposer settings file
 	proposerSettingsBucket = []byte("proposer-settings-bucket")
 	proposerSettingsKey    = []byte("proposer-settings")
+
+	// Per epoch duty outcomes and balance changes of the validator keys.
+	performanceHistoryBucket = []byte("performance-history-bucket")
+
+	// Scheduled and conditional voluntary exits of the validator keys.
+	exitIntentsBucket = []byte("exit-intents-bucket")
 )
 
 // Attestations:
@@ -55,3 +61,11 @@ var (
 // Proposals:
 // ----------
 // proposal-history-bucket-interchange -> <pubkey> --> <slot> --> <signing root>
+
+// Performance history:
+// --------------------
+// performance-history-bucket --> <pubkey> --> <epoch> --> <performance record>
+
+// Exit intents:
+// -------------
+// exit-intents-bucket --> <pubkey> --> <exit intent>
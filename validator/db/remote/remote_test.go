@@ -0,0 +1,190 @@
+package remote
+
+import (
+	"context"
+	"encoding/pem"
+	"fmt"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	dbtest "github.com/prysmaticlabs/prysm/v5/validator/db/testing"
+)
+
+func createAttestation(source, target primitives.Epoch) *ethpb.IndexedAttestation {
+	return &ethpb.IndexedAttestation{
+		Data: &ethpb.AttestationData{
+			BeaconBlockRoot: make([]byte, fieldparams.RootLength),
+			Source:          &ethpb.Checkpoint{Epoch: source, Root: make([]byte, fieldparams.RootLength)},
+			Target:          &ethpb.Checkpoint{Epoch: target, Root: make([]byte, fieldparams.RootLength)},
+		},
+		Signature: make([]byte, fieldparams.BLSSignatureLength),
+	}
+}
+
+func setupStores(t *testing.T, minimal bool, leaseDuration time.Duration, token string, clientIDs ...string) []*Store {
+	pubkeys := [][fieldparams.BLSPubkeyLength]byte{{1}, {2}}
+	srv := httptest.NewServer(NewServer(dbtest.SetupDB(t, pubkeys, minimal), leaseDuration, token))
+	t.Cleanup(srv.Close)
+	stores := make([]*Store, len(clientIDs))
+	for i, id := range clientIDs {
+		// The local databases of the validator clients are minimal, as they hold no slashing protection data.
+		s, err := NewStore(dbtest.SetupDB(t, nil, true), srv.URL, id, token, "")
+		require.NoError(t, err)
+		stores[i] = s
+	}
+	return stores
+}
+
+func TestStore_SlashingProtection(t *testing.T) {
+	for _, minimal := range []bool{false, true} {
+		t.Run(fmt.Sprintf("minimal:%v", minimal), func(t *testing.T) {
+			ctx := context.Background()
+			stores := setupStores(t, minimal, time.Minute, "secret", "a")
+			s := stores[0]
+			pubkey := [fieldparams.BLSPubkeyLength]byte{1}
+
+			genesisRoot := bytesutil.PadTo([]byte("genesis"), fieldparams.RootLength)
+			require.NoError(t, s.SaveGenesisValidatorsRoot(ctx, genesisRoot))
+			root, err := s.GenesisValidatorsRoot(ctx)
+			require.NoError(t, err)
+			assert.DeepEqual(t, genesisRoot, root)
+
+			// Attestations.
+			require.NoError(t, s.SlashableAttestationCheck(ctx, createAttestation(1, 2), pubkey, [32]byte{1}, false, nil))
+			require.NotNil(t, s.SlashableAttestationCheck(ctx, createAttestation(1, 2), pubkey, [32]byte{2}, false, nil))
+			require.NotNil(t, s.SlashableAttestationCheck(ctx, createAttestation(0, 3), pubkey, [32]byte{3}, false, nil))
+			epoch, exists, err := s.LowestSignedTargetEpoch(ctx, pubkey)
+			require.NoError(t, err)
+			assert.Equal(t, true, exists)
+			assert.Equal(t, primitives.Epoch(2), epoch)
+			attested, err := s.AttestedPublicKeys(ctx)
+			require.NoError(t, err)
+			assert.DeepEqual(t, [][fieldparams.BLSPubkeyLength]byte{pubkey}, attested)
+
+			// Blocks.
+			blk, err := blockAtSlot(10)
+			require.NoError(t, err)
+			require.NoError(t, s.SlashableProposalCheck(ctx, pubkey, blk, [32]byte{1}, false, nil))
+			require.NotNil(t, s.SlashableProposalCheck(ctx, pubkey, blk, [32]byte{2}, false, nil))
+			if !minimal {
+				slot, exists, err := s.LowestSignedProposal(ctx, pubkey)
+				require.NoError(t, err)
+				assert.Equal(t, true, exists)
+				assert.Equal(t, primitives.Slot(10), slot)
+			} else {
+				_, _, err := s.LowestSignedProposal(ctx, pubkey)
+				require.ErrorContains(t, "method not supported", err)
+			}
+		})
+	}
+}
+
+func TestStore_ConcurrentChecks(t *testing.T) {
+	for _, minimal := range []bool{false, true} {
+		t.Run(fmt.Sprintf("minimal:%v", minimal), func(t *testing.T) {
+			ctx := context.Background()
+			s := setupStores(t, minimal, time.Minute, "", "a")[0]
+
+			// Conflicting attestations checked concurrently with the same key: exactly one of them can be signed.
+			// The checks with another key are not held up.
+			const n = 8
+			var wg sync.WaitGroup
+			var signed atomic.Int32
+			errs := make(chan error, n)
+			for i := 0; i < n; i++ {
+				wg.Add(2)
+				go func(i int) {
+					defer wg.Done()
+					if s.SlashableAttestationCheck(ctx, createAttestation(1, 2), [fieldparams.BLSPubkeyLength]byte{1}, [32]byte{byte(i + 1)}, false, nil) == nil {
+						signed.Add(1)
+					}
+				}(i)
+				go func(i int) {
+					defer wg.Done()
+					errs <- s.SlashableAttestationCheck(ctx, createAttestation(primitives.Epoch(i), primitives.Epoch(i+1)), [fieldparams.BLSPubkeyLength]byte{2}, [32]byte{byte(i + 1)}, false, nil)
+				}(i)
+			}
+			wg.Wait()
+			close(errs)
+			assert.Equal(t, int32(1), signed.Load())
+			for err := range errs {
+				// Attestations of increasing epochs may still be checked out of order, and then be refused.
+				if err != nil {
+					require.ErrorContains(t, "could not sign attestation", err)
+				}
+			}
+		})
+	}
+}
+
+func TestStore_Leases(t *testing.T) {
+	ctx := context.Background()
+	stores := setupStores(t, false, time.Minute, "", "active", "passive")
+	active, passive := stores[0], stores[1]
+	pubkey := [fieldparams.BLSPubkeyLength]byte{1}
+
+	require.NoError(t, active.SlashableAttestationCheck(ctx, createAttestation(1, 2), pubkey, [32]byte{1}, false, nil))
+	// The passive validator client can not sign with the key leased to the active one, even if it is safe.
+	err := passive.SlashableAttestationCheck(ctx, createAttestation(2, 3), pubkey, [32]byte{2}, false, nil)
+	require.ErrorContains(t, "is leased to validator client active", err)
+	// Other keys can be used.
+	require.NoError(t, passive.SlashableAttestationCheck(ctx, createAttestation(2, 3), [fieldparams.BLSPubkeyLength]byte{2}, [32]byte{2}, false, nil))
+
+	// Once released, the key can be taken over.
+	require.NoError(t, active.Close())
+	require.NoError(t, passive.SlashableAttestationCheck(ctx, createAttestation(2, 3), pubkey, [32]byte{2}, false, nil))
+	// Slashing protection still applies across validator clients.
+	require.NotNil(t, passive.SlashableAttestationCheck(ctx, createAttestation(1, 2), pubkey, [32]byte{3}, false, nil))
+}
+
+func TestStore_LeaseExpiry(t *testing.T) {
+	ctx := context.Background()
+	stores := setupStores(t, false, time.Second, "", "active", "passive")
+	pubkey := [fieldparams.BLSPubkeyLength]byte{1}
+
+	require.NoError(t, stores[0].SlashableAttestationCheck(ctx, createAttestation(1, 2), pubkey, [32]byte{1}, false, nil))
+	require.ErrorContains(t, "is leased", stores[1].SlashableAttestationCheck(ctx, createAttestation(2, 3), pubkey, [32]byte{2}, false, nil))
+	time.Sleep(1100 * time.Millisecond)
+	require.NoError(t, stores[1].SlashableAttestationCheck(ctx, createAttestation(2, 3), pubkey, [32]byte{2}, false, nil))
+}
+
+func TestStore_TLS(t *testing.T) {
+	srv := httptest.NewTLSServer(NewServer(dbtest.SetupDB(t, [][fieldparams.BLSPubkeyLength]byte{{1}}, false), time.Minute, "secret"))
+	t.Cleanup(srv.Close)
+	caCert := filepath.Join(t.TempDir(), "ca.crt")
+	require.NoError(t, os.WriteFile(caCert, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: srv.Certificate().Raw}), 0600))
+
+	s, err := NewStore(dbtest.SetupDB(t, nil, true), srv.URL, "a", "secret", caCert)
+	require.NoError(t, err)
+	require.NoError(t, s.SlashableAttestationCheck(context.Background(), createAttestation(1, 2), [fieldparams.BLSPubkeyLength]byte{1}, [32]byte{1}, false, nil))
+
+	// The certificate of the server is not trusted without the CA certificate.
+	s, err = NewStore(dbtest.SetupDB(t, nil, true), srv.URL, "b", "secret", "")
+	require.NoError(t, err)
+	_, err = s.GenesisValidatorsRoot(context.Background())
+	require.ErrorContains(t, "certificate", err)
+
+	_, err = NewStore(nil, srv.URL, "c", "secret", filepath.Join(t.TempDir(), "missing.crt"))
+	require.ErrorContains(t, "could not read slashing protection server CA certificate", err)
+}
+
+func TestStore_Unauthorized(t *testing.T) {
+	stores := setupStores(t, false, time.Minute, "secret", "a")
+	stores[0].token = "wrong"
+	_, err := stores[0].GenesisValidatorsRoot(context.Background())
+	require.ErrorContains(t, "Unauthorized", err)
+
+	_, err = NewStore(nil, "http://localhost:7600", "", "", "")
+	require.ErrorContains(t, "client ID is required", err)
+}
@@ -0,0 +1,499 @@
+package remote
+
+import (
+	"bytes"
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/interfaces"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v5/io/file"
+	"github.com/prysmaticlabs/prysm/v5/network/httputil"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/validator/db/iface"
+)
+
+// maxRequestSize bounds the size of a request, which may hold a whole EIP-3076 interchange file.
+const maxRequestSize = 1 << 28
+
+type method func(ctx context.Context, clientID string, req *request) (*response, error)
+
+type lease struct {
+	owner  string
+	expiry time.Time
+}
+
+// Server serves a slashing protection database to validator clients. The operations on the same key are serialized,
+// so that a slashing check and the save of the signed message are atomic, while the operations on different keys run
+// concurrently. Importing an interchange file excludes every other operation.
+type Server struct {
+	db            iface.ValidatorDB
+	token         string
+	leaseDuration time.Duration
+	methods       map[string]method
+
+	dbLock    sync.RWMutex
+	keyLocks  sync.Map // [fieldparams.BLSPubkeyLength]byte -> *sync.Mutex
+	leaseLock sync.Mutex
+	leases    map[[fieldparams.BLSPubkeyLength]byte]*lease
+}
+
+// NewServer returns a server of the given database. Keys used to sign by a validator client are leased to it for the
+// given duration. Requests must hold the given bearer token, if it is not empty.
+func NewServer(db iface.ValidatorDB, leaseDuration time.Duration, token string) *Server {
+	s := &Server{
+		db:            db,
+		token:         token,
+		leaseDuration: leaseDuration,
+		leases:        make(map[[fieldparams.BLSPubkeyLength]byte]*lease),
+	}
+	s.methods = map[string]method{
+		methodGenesisValidatorsRoot:              s.genesisValidatorsRoot,
+		methodSaveGenesisValidatorsRoot:          s.saveGenesisValidatorsRoot,
+		methodUpdatePublicKeysBuckets:            s.updatePublicKeysBuckets,
+		methodHighestSignedProposal:              s.highestSignedProposal,
+		methodLowestSignedProposal:               s.lowestSignedProposal,
+		methodProposalHistoryForPubKey:           s.proposalHistoryForPubKey,
+		methodProposalHistoryForSlot:             s.proposalHistoryForSlot,
+		methodSaveProposalHistoryForSlot:         s.saveProposalHistoryForSlot,
+		methodProposedPublicKeys:                 s.proposedPublicKeys,
+		methodSlashableProposalCheck:             s.slashableProposalCheck,
+		methodEIPImportBlacklistedPublicKeys:     s.eipImportBlacklistedPublicKeys,
+		methodSaveEIPImportBlacklistedPublicKeys: s.saveEIPImportBlacklistedPublicKeys,
+		methodSigningRootAtTargetEpoch:           s.signingRootAtTargetEpoch,
+		methodLowestSignedTargetEpoch:            s.lowestSignedTargetEpoch,
+		methodLowestSignedSourceEpoch:            s.lowestSignedSourceEpoch,
+		methodAttestedPublicKeys:                 s.attestedPublicKeys,
+		methodSlashableAttestationCheck:          s.slashableAttestationCheck,
+		methodSaveAttestationForPubKey:           s.saveAttestationForPubKey,
+		methodSaveAttestationsForPubKey:          s.saveAttestationsForPubKey,
+		methodAttestationHistoryForPubKey:        s.attestationHistoryForPubKey,
+		methodImportStandardProtectionJSON:       s.importStandardProtectionJSON,
+		methodReleaseLeases:                      s.releaseLeases,
+	}
+	return s
+}
+
+// ServeHTTP serves the methods of the server.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost || !strings.HasPrefix(r.URL.Path, PathPrefix) {
+		httputil.HandleError(w, "Not found", http.StatusNotFound)
+		return
+	}
+	name := strings.TrimPrefix(r.URL.Path, PathPrefix)
+	m, ok := s.methods[name]
+	if !ok {
+		httputil.HandleError(w, "Not found", http.StatusNotFound)
+		return
+	}
+	if s.token != "" {
+		token := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if subtle.ConstantTimeCompare([]byte(token), []byte(s.token)) != 1 {
+			httputil.HandleError(w, "Unauthorized", http.StatusUnauthorized)
+			return
+		}
+	}
+	clientID := r.Header.Get(ClientIDHeader)
+	if clientID == "" {
+		httputil.HandleError(w, "Missing "+ClientIDHeader+" header", http.StatusBadRequest)
+		return
+	}
+	req := &request{}
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxRequestSize)).Decode(req); err != nil {
+		httputil.HandleError(w, "Could not decode request body: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	resp, err := s.call(r.Context(), m, clientID, req, name == methodImportStandardProtectionJSON)
+	if err != nil {
+		httputil.HandleError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	httputil.WriteJson(w, resp)
+}
+
+// call calls the method while holding the lock of the key of the request, if any. Exclusive methods hold the lock
+// of the whole database instead. Some methods are not implemented by every database and panic, in which case an
+// error is returned.
+func (s *Server) call(ctx context.Context, m method, clientID string, req *request, exclusive bool) (resp *response, err error) {
+	if exclusive {
+		s.dbLock.Lock()
+		defer s.dbLock.Unlock()
+	} else {
+		s.dbLock.RLock()
+		defer s.dbLock.RUnlock()
+	}
+	if req.Pubkey != "" {
+		pubkey, err := decodePubkey(req.Pubkey)
+		if err != nil {
+			return nil, err
+		}
+		l := s.keyLock(pubkey)
+		l.Lock()
+		defer l.Unlock()
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			resp, err = nil, fmt.Errorf("method not supported by the slashing protection database: %v", r)
+		}
+	}()
+	return m(ctx, clientID, req)
+}
+
+func (s *Server) keyLock(pubkey [fieldparams.BLSPubkeyLength]byte) *sync.Mutex {
+	l, _ := s.keyLocks.LoadOrStore(pubkey, &sync.Mutex{})
+	return l.(*sync.Mutex)
+}
+
+// acquire leases the key to the validator client, unless another validator client holds a live lease on it.
+func (s *Server) acquire(pubkey [fieldparams.BLSPubkeyLength]byte, clientID string) error {
+	s.leaseLock.Lock()
+	defer s.leaseLock.Unlock()
+	now := time.Now()
+	if l, ok := s.leases[pubkey]; ok && l.owner != clientID && now.Before(l.expiry) {
+		return fmt.Errorf("validator key %#x is leased to validator client %s until %s", pubkey, l.owner, l.expiry.Format(time.RFC3339))
+	}
+	if l, ok := s.leases[pubkey]; !ok || l.owner != clientID {
+		log.WithField("pubkey", fmt.Sprintf("%#x", bytesutil.Trunc(pubkey[:]))).WithField("client", clientID).Info("Leased validator key")
+	}
+	s.leases[pubkey] = &lease{owner: clientID, expiry: now.Add(s.leaseDuration)}
+	return nil
+}
+
+func (s *Server) releaseLeases(_ context.Context, clientID string, _ *request) (*response, error) {
+	s.leaseLock.Lock()
+	defer s.leaseLock.Unlock()
+	for pubkey, l := range s.leases {
+		if l.owner == clientID {
+			delete(s.leases, pubkey)
+		}
+	}
+	log.WithField("client", clientID).Info("Released validator keys")
+	return &response{}, nil
+}
+
+func (s *Server) genesisValidatorsRoot(ctx context.Context, _ string, _ *request) (*response, error) {
+	root, err := s.db.GenesisValidatorsRoot(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if root == nil {
+		return &response{}, nil
+	}
+	return &response{Exists: true, Root: hexutil.Encode(root)}, nil
+}
+
+func (s *Server) saveGenesisValidatorsRoot(ctx context.Context, _ string, req *request) (*response, error) {
+	root, err := bytesutil.DecodeHexWithLength(req.Root, fieldparams.RootLength)
+	if err != nil {
+		return nil, err
+	}
+	return &response{}, s.db.SaveGenesisValidatorsRoot(ctx, root)
+}
+
+func (s *Server) updatePublicKeysBuckets(_ context.Context, _ string, req *request) (*response, error) {
+	pubkeys, err := decodePubkeys(req.Pubkeys)
+	if err != nil {
+		return nil, err
+	}
+	return &response{}, s.db.UpdatePublicKeysBuckets(pubkeys)
+}
+
+func (s *Server) highestSignedProposal(ctx context.Context, _ string, req *request) (*response, error) {
+	pubkey, err := decodePubkey(req.Pubkey)
+	if err != nil {
+		return nil, err
+	}
+	slot, exists, err := s.db.HighestSignedProposal(ctx, pubkey)
+	if err != nil {
+		return nil, err
+	}
+	return &response{Slot: slot, Exists: exists}, nil
+}
+
+func (s *Server) lowestSignedProposal(ctx context.Context, _ string, req *request) (*response, error) {
+	pubkey, err := decodePubkey(req.Pubkey)
+	if err != nil {
+		return nil, err
+	}
+	slot, exists, err := s.db.LowestSignedProposal(ctx, pubkey)
+	if err != nil {
+		return nil, err
+	}
+	return &response{Slot: slot, Exists: exists}, nil
+}
+
+func (s *Server) proposalHistoryForPubKey(ctx context.Context, _ string, req *request) (*response, error) {
+	pubkey, err := decodePubkey(req.Pubkey)
+	if err != nil {
+		return nil, err
+	}
+	proposals, err := s.db.ProposalHistoryForPubKey(ctx, pubkey)
+	if err != nil {
+		return nil, err
+	}
+	return &response{Proposals: proposals}, nil
+}
+
+func (s *Server) proposalHistoryForSlot(ctx context.Context, _ string, req *request) (*response, error) {
+	pubkey, err := decodePubkey(req.Pubkey)
+	if err != nil {
+		return nil, err
+	}
+	root, exists, rootExists, err := s.db.ProposalHistoryForSlot(ctx, pubkey, req.Slot)
+	if err != nil {
+		return nil, err
+	}
+	return &response{Exists: exists, RootExists: rootExists, Root: hexutil.Encode(root[:])}, nil
+}
+
+func (s *Server) saveProposalHistoryForSlot(ctx context.Context, _ string, req *request) (*response, error) {
+	pubkey, err := decodePubkey(req.Pubkey)
+	if err != nil {
+		return nil, err
+	}
+	var root []byte
+	if req.Root != "" {
+		if root, err = hexutil.Decode(req.Root); err != nil {
+			return nil, err
+		}
+	}
+	return &response{}, s.db.SaveProposalHistoryForSlot(ctx, pubkey, req.Slot, root)
+}
+
+func (s *Server) proposedPublicKeys(ctx context.Context, _ string, _ *request) (*response, error) {
+	pubkeys, err := s.db.ProposedPublicKeys(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &response{Pubkeys: encodePubkeys(pubkeys)}, nil
+}
+
+func (s *Server) slashableProposalCheck(ctx context.Context, clientID string, req *request) (*response, error) {
+	pubkey, err := decodePubkey(req.Pubkey)
+	if err != nil {
+		return nil, err
+	}
+	root, err := bytesutil.DecodeHexWithLength(req.Root, fieldparams.RootLength)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.acquire(pubkey, clientID); err != nil {
+		return nil, err
+	}
+	blk, err := blockAtSlot(req.Slot)
+	if err != nil {
+		return nil, err
+	}
+	return &response{}, s.db.SlashableProposalCheck(ctx, pubkey, blk, bytesutil.ToBytes32(root), false, nil)
+}
+
+func (s *Server) eipImportBlacklistedPublicKeys(ctx context.Context, _ string, _ *request) (*response, error) {
+	pubkeys, err := s.db.EIPImportBlacklistedPublicKeys(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &response{Pubkeys: encodePubkeys(pubkeys)}, nil
+}
+
+func (s *Server) saveEIPImportBlacklistedPublicKeys(ctx context.Context, _ string, req *request) (*response, error) {
+	pubkeys, err := decodePubkeys(req.Pubkeys)
+	if err != nil {
+		return nil, err
+	}
+	return &response{}, s.db.SaveEIPImportBlacklistedPublicKeys(ctx, pubkeys)
+}
+
+func (s *Server) signingRootAtTargetEpoch(ctx context.Context, _ string, req *request) (*response, error) {
+	pubkey, err := decodePubkey(req.Pubkey)
+	if err != nil {
+		return nil, err
+	}
+	root, err := s.db.SigningRootAtTargetEpoch(ctx, pubkey, req.Epoch)
+	if err != nil {
+		return nil, err
+	}
+	if root == nil {
+		return &response{}, nil
+	}
+	return &response{Exists: true, Root: hexutil.Encode(root)}, nil
+}
+
+func (s *Server) lowestSignedTargetEpoch(ctx context.Context, _ string, req *request) (*response, error) {
+	pubkey, err := decodePubkey(req.Pubkey)
+	if err != nil {
+		return nil, err
+	}
+	epoch, exists, err := s.db.LowestSignedTargetEpoch(ctx, pubkey)
+	if err != nil {
+		return nil, err
+	}
+	return &response{Epoch: epoch, Exists: exists}, nil
+}
+
+func (s *Server) lowestSignedSourceEpoch(ctx context.Context, _ string, req *request) (*response, error) {
+	pubkey, err := decodePubkey(req.Pubkey)
+	if err != nil {
+		return nil, err
+	}
+	epoch, exists, err := s.db.LowestSignedSourceEpoch(ctx, pubkey)
+	if err != nil {
+		return nil, err
+	}
+	return &response{Epoch: epoch, Exists: exists}, nil
+}
+
+func (s *Server) attestedPublicKeys(ctx context.Context, _ string, _ *request) (*response, error) {
+	pubkeys, err := s.db.AttestedPublicKeys(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &response{Pubkeys: encodePubkeys(pubkeys)}, nil
+}
+
+func (s *Server) slashableAttestationCheck(ctx context.Context, clientID string, req *request) (*response, error) {
+	pubkey, root, atts, err := decodeAttestations(req)
+	if err != nil {
+		return nil, err
+	}
+	if len(atts) != 1 {
+		return nil, errors.New("exactly one attestation must be checked")
+	}
+	if err := s.acquire(pubkey, clientID); err != nil {
+		return nil, err
+	}
+	return &response{}, s.db.SlashableAttestationCheck(ctx, atts[0], pubkey, bytesutil.ToBytes32(root[0]), false, nil)
+}
+
+func (s *Server) saveAttestationForPubKey(ctx context.Context, _ string, req *request) (*response, error) {
+	pubkey, root, atts, err := decodeAttestations(req)
+	if err != nil {
+		return nil, err
+	}
+	if len(atts) != 1 {
+		return nil, errors.New("exactly one attestation must be saved")
+	}
+	return &response{}, s.db.SaveAttestationForPubKey(ctx, pubkey, bytesutil.ToBytes32(root[0]), atts[0])
+}
+
+func (s *Server) saveAttestationsForPubKey(ctx context.Context, _ string, req *request) (*response, error) {
+	pubkey, roots, atts, err := decodeAttestations(req)
+	if err != nil {
+		return nil, err
+	}
+	return &response{}, s.db.SaveAttestationsForPubKey(ctx, pubkey, roots, atts)
+}
+
+func (s *Server) attestationHistoryForPubKey(ctx context.Context, _ string, req *request) (*response, error) {
+	pubkey, err := decodePubkey(req.Pubkey)
+	if err != nil {
+		return nil, err
+	}
+	records, err := s.db.AttestationHistoryForPubKey(ctx, pubkey)
+	if err != nil {
+		return nil, err
+	}
+	return &response{Attestations: records}, nil
+}
+
+func (s *Server) importStandardProtectionJSON(ctx context.Context, _ string, req *request) (*response, error) {
+	return &response{}, s.db.ImportStandardProtectionJSON(ctx, bytes.NewReader(req.Interchange))
+}
+
+// blockAtSlot returns a block at the given slot. Only the slot of a block is used by slashing protection.
+func blockAtSlot(slot primitives.Slot) (interfaces.ReadOnlySignedBeaconBlock, error) {
+	return blocks.NewSignedBeaconBlock(&ethpb.SignedBeaconBlock{
+		Block: &ethpb.BeaconBlock{
+			Slot:       slot,
+			ParentRoot: make([]byte, fieldparams.RootLength),
+			StateRoot:  make([]byte, fieldparams.RootLength),
+			Body: &ethpb.BeaconBlockBody{
+				RandaoReveal: make([]byte, fieldparams.BLSSignatureLength),
+				Eth1Data: &ethpb.Eth1Data{
+					DepositRoot: make([]byte, fieldparams.RootLength),
+					BlockHash:   make([]byte, fieldparams.RootLength),
+				},
+				Graffiti: make([]byte, fieldparams.RootLength),
+			},
+		},
+		Signature: make([]byte, fieldparams.BLSSignatureLength),
+	})
+}
+
+func decodeAttestations(req *request) ([fieldparams.BLSPubkeyLength]byte, [][]byte, []*ethpb.IndexedAttestation, error) {
+	pubkey, err := decodePubkey(req.Pubkey)
+	if err != nil {
+		return pubkey, nil, nil, err
+	}
+	if len(req.Data) != len(req.SigningRoots) {
+		return pubkey, nil, nil, errors.New("number of signing roots does not match number of attestations")
+	}
+	roots := make([][]byte, len(req.SigningRoots))
+	atts := make([]*ethpb.IndexedAttestation, len(req.Data))
+	for i := range req.Data {
+		if roots[i], err = bytesutil.DecodeHexWithLength(req.SigningRoots[i], fieldparams.RootLength); err != nil {
+			return pubkey, nil, nil, err
+		}
+		if req.Data[i] == nil {
+			return pubkey, nil, nil, errors.New("missing attestation data")
+		}
+		data, err := req.Data[i].ToConsensus()
+		if err != nil {
+			return pubkey, nil, nil, err
+		}
+		atts[i] = &ethpb.IndexedAttestation{Data: data, Signature: make([]byte, fieldparams.BLSSignatureLength)}
+	}
+	return pubkey, roots, atts, nil
+}
+
+func decodePubkey(s string) ([fieldparams.BLSPubkeyLength]byte, error) {
+	b, err := bytesutil.DecodeHexWithLength(s, fieldparams.BLSPubkeyLength)
+	if err != nil {
+		return [fieldparams.BLSPubkeyLength]byte{}, err
+	}
+	return bytesutil.ToBytes48(b), nil
+}
+
+func decodePubkeys(s []string) ([][fieldparams.BLSPubkeyLength]byte, error) {
+	pubkeys := make([][fieldparams.BLSPubkeyLength]byte, len(s))
+	for i := range s {
+		pubkey, err := decodePubkey(s[i])
+		if err != nil {
+			return nil, err
+		}
+		pubkeys[i] = pubkey
+	}
+	return pubkeys, nil
+}
+
+func encodePubkeys(pubkeys [][fieldparams.BLSPubkeyLength]byte) []string {
+	s := make([]string, len(pubkeys))
+	for i := range pubkeys {
+		s[i] = hexutil.Encode(pubkeys[i][:])
+	}
+	return s
+}
+
+// ReadToken reads the bearer token shared by the server and the validator clients from a file.
+func ReadToken(path string) (string, error) {
+	b, err := file.ReadFileAsBytes(path)
+	if err != nil {
+		return "", errors.Wrap(err, "could not read slashing protection token file")
+	}
+	token := strings.TrimSpace(string(b))
+	if token == "" {
+		return "", errors.New("slashing protection token file is empty")
+	}
+	return token, nil
+}
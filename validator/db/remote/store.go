@@ -0,0 +1,370 @@
+package remote
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prysmaticlabs/prysm/v5/api/client"
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/interfaces"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing/trace"
+	"github.com/prysmaticlabs/prysm/v5/network/httputil"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/validator/db/common"
+	"github.com/prysmaticlabs/prysm/v5/validator/db/iface"
+)
+
+const (
+	requestTimeout = 10 * time.Second
+	releaseTimeout = 5 * time.Second
+)
+
+var _ iface.ValidatorDB = (*Store)(nil)
+
+// Store is a validator database whose slashing protection data is held by a remote server, shared with other
+// validator clients. The other data, such as proposer settings and graffiti, is kept in the local database.
+type Store struct {
+	iface.ValidatorDB
+	client   *client.Client
+	token    string
+	clientID string
+}
+
+// NewStore returns a store using the slashing protection server at the given URL. The local database holds the data
+// which is not shared. The client ID identifies the validator client to the server, and must be unique among the
+// validator clients sharing the server. The server certificate is verified against the given CA certificate file,
+// if any, instead of the system's root CAs.
+func NewStore(local iface.ValidatorDB, serverURL, clientID, token, caCertPath string) (*Store, error) {
+	if clientID == "" {
+		return nil, errors.New("a validator client ID is required to use a remote slashing protection database")
+	}
+	opts := []client.ClientOpt{client.WithTimeout(requestTimeout)}
+	if caCertPath != "" {
+		caCert, err := os.ReadFile(caCertPath) // #nosec G304 -- path is provided by the operator.
+		if err != nil {
+			return nil, errors.Wrap(err, "could not read slashing protection server CA certificate")
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(caCert) {
+			return nil, fmt.Errorf("no valid certificate found in %s", caCertPath)
+		}
+		transport := http.DefaultTransport.(*http.Transport).Clone()
+		transport.TLSClientConfig = &tls.Config{RootCAs: pool, MinVersion: tls.VersionTLS12}
+		opts = append(opts, client.WithRoundTripper(transport))
+	}
+	c, err := client.NewClient(serverURL, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid slashing protection server URL")
+	}
+	return &Store{ValidatorDB: local, client: c, token: token, clientID: clientID}, nil
+}
+
+// Close releases the leases of the validator client on its keys, so that another validator client can take over
+// without waiting for them to expire, and closes the local database.
+func (s *Store) Close() error {
+	ctx, cancel := context.WithTimeout(context.Background(), releaseTimeout)
+	defer cancel()
+	if _, err := s.call(ctx, methodReleaseLeases, &request{}); err != nil {
+		log.WithError(err).Error("Could not release validator keys on slashing protection server")
+	}
+	return s.ValidatorDB.Close()
+}
+
+// call calls the method of the server with the given request.
+func (s *Store) call(ctx context.Context, method string, req *request) (*response, error) {
+	ctx, span := trace.StartSpan(ctx, "remote.call")
+	defer span.End()
+	span.SetAttributes(trace.StringAttribute("method", method))
+
+	body, err := json.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+	u := s.client.BaseURL().ResolveReference(&url.URL{Path: PathPrefix + method})
+	r, err := http.NewRequestWithContext(ctx, http.MethodPost, u.String(), bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	r.Header.Set("Content-Type", "application/json")
+	r.Header.Set(ClientIDHeader, s.clientID)
+	if s.token != "" {
+		client.WithAuthorizationToken(s.token)(r)
+	}
+	httpResp, err := s.client.Do(r)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not reach slashing protection server")
+	}
+	defer func() {
+		if err := httpResp.Body.Close(); err != nil {
+			log.WithError(err).Debug("Could not close response body")
+		}
+	}()
+	b, err := io.ReadAll(io.LimitReader(httpResp.Body, client.MaxBodySizeState))
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read slashing protection server response")
+	}
+	if httpResp.StatusCode != http.StatusOK {
+		errJson := &httputil.DefaultJsonError{}
+		if err := json.Unmarshal(b, errJson); err != nil || errJson.Message == "" {
+			return nil, fmt.Errorf("slashing protection server returned status %d", httpResp.StatusCode)
+		}
+		return nil, errors.New(errJson.Message)
+	}
+	resp := &response{}
+	if err := json.Unmarshal(b, resp); err != nil {
+		return nil, errors.Wrap(err, "could not decode slashing protection server response")
+	}
+	return resp, nil
+}
+
+// GenesisValidatorsRoot returns the genesis validators root of the slashing protection database.
+func (s *Store) GenesisValidatorsRoot(ctx context.Context) ([]byte, error) {
+	resp, err := s.call(ctx, methodGenesisValidatorsRoot, &request{})
+	if err != nil {
+		return nil, err
+	}
+	if !resp.Exists {
+		return nil, nil
+	}
+	return hexutil.Decode(resp.Root)
+}
+
+// SaveGenesisValidatorsRoot saves the genesis validators root of the slashing protection database.
+func (s *Store) SaveGenesisValidatorsRoot(ctx context.Context, genValRoot []byte) error {
+	_, err := s.call(ctx, methodSaveGenesisValidatorsRoot, &request{Root: hexutil.Encode(genValRoot)})
+	return err
+}
+
+// UpdatePublicKeysBuckets creates the buckets of the given public keys in the slashing protection database.
+func (s *Store) UpdatePublicKeysBuckets(publicKeys [][fieldparams.BLSPubkeyLength]byte) error {
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	defer cancel()
+	_, err := s.call(ctx, methodUpdatePublicKeysBuckets, &request{Pubkeys: encodePubkeys(publicKeys)})
+	return err
+}
+
+// HighestSignedProposal returns the highest signed proposal slot of the public key.
+func (s *Store) HighestSignedProposal(ctx context.Context, publicKey [fieldparams.BLSPubkeyLength]byte) (primitives.Slot, bool, error) {
+	resp, err := s.call(ctx, methodHighestSignedProposal, &request{Pubkey: hexutil.Encode(publicKey[:])})
+	if err != nil {
+		return 0, false, err
+	}
+	return resp.Slot, resp.Exists, nil
+}
+
+// LowestSignedProposal returns the lowest signed proposal slot of the public key.
+func (s *Store) LowestSignedProposal(ctx context.Context, publicKey [fieldparams.BLSPubkeyLength]byte) (primitives.Slot, bool, error) {
+	resp, err := s.call(ctx, methodLowestSignedProposal, &request{Pubkey: hexutil.Encode(publicKey[:])})
+	if err != nil {
+		return 0, false, err
+	}
+	return resp.Slot, resp.Exists, nil
+}
+
+// ProposalHistoryForPubKey returns the signed proposals of the public key.
+func (s *Store) ProposalHistoryForPubKey(ctx context.Context, publicKey [fieldparams.BLSPubkeyLength]byte) ([]*common.Proposal, error) {
+	resp, err := s.call(ctx, methodProposalHistoryForPubKey, &request{Pubkey: hexutil.Encode(publicKey[:])})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Proposals, nil
+}
+
+// ProposalHistoryForSlot returns the signing root of the proposal of the public key at the slot, whether a proposal
+// exists at the slot, and whether its signing root exists.
+func (s *Store) ProposalHistoryForSlot(ctx context.Context, publicKey [fieldparams.BLSPubkeyLength]byte, slot primitives.Slot) ([32]byte, bool, bool, error) {
+	resp, err := s.call(ctx, methodProposalHistoryForSlot, &request{Pubkey: hexutil.Encode(publicKey[:]), Slot: slot})
+	if err != nil {
+		return [32]byte{}, false, false, err
+	}
+	root, err := bytesutil.DecodeHexWithLength(resp.Root, fieldparams.RootLength)
+	if err != nil {
+		return [32]byte{}, false, false, err
+	}
+	return bytesutil.ToBytes32(root), resp.Exists, resp.RootExists, nil
+}
+
+// SaveProposalHistoryForSlot saves the signing root of the proposal of the public key at the slot.
+func (s *Store) SaveProposalHistoryForSlot(ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte, slot primitives.Slot, signingRoot []byte) error {
+	req := &request{Pubkey: hexutil.Encode(pubKey[:]), Slot: slot}
+	if signingRoot != nil {
+		req.Root = hexutil.Encode(signingRoot)
+	}
+	_, err := s.call(ctx, methodSaveProposalHistoryForSlot, req)
+	return err
+}
+
+// ProposedPublicKeys returns the public keys which signed proposals.
+func (s *Store) ProposedPublicKeys(ctx context.Context) ([][fieldparams.BLSPubkeyLength]byte, error) {
+	resp, err := s.call(ctx, methodProposedPublicKeys, &request{})
+	if err != nil {
+		return nil, err
+	}
+	return decodePubkeys(resp.Pubkeys)
+}
+
+// SlashableProposalCheck checks that the block is not slashable and saves it, atomically on the server. It fails
+// if another validator client holds the lease on the public key.
+func (s *Store) SlashableProposalCheck(
+	ctx context.Context,
+	pubKey [fieldparams.BLSPubkeyLength]byte,
+	signedBlock interfaces.ReadOnlySignedBeaconBlock,
+	signingRoot [fieldparams.RootLength]byte,
+	emitAccountMetrics bool,
+	validatorProposeFailVec *prometheus.CounterVec,
+) error {
+	_, err := s.call(ctx, methodSlashableProposalCheck, &request{
+		Pubkey: hexutil.Encode(pubKey[:]),
+		Slot:   signedBlock.Block().Slot(),
+		Root:   hexutil.Encode(signingRoot[:]),
+	})
+	if err != nil && emitAccountMetrics {
+		validatorProposeFailVec.WithLabelValues(fmt.Sprintf("%#x", pubKey)).Inc()
+	}
+	return err
+}
+
+// EIPImportBlacklistedPublicKeys returns the public keys which are blacklisted after an EIP-3076 import.
+func (s *Store) EIPImportBlacklistedPublicKeys(ctx context.Context) ([][fieldparams.BLSPubkeyLength]byte, error) {
+	resp, err := s.call(ctx, methodEIPImportBlacklistedPublicKeys, &request{})
+	if err != nil {
+		return nil, err
+	}
+	return decodePubkeys(resp.Pubkeys)
+}
+
+// SaveEIPImportBlacklistedPublicKeys blacklists the public keys.
+func (s *Store) SaveEIPImportBlacklistedPublicKeys(ctx context.Context, publicKeys [][fieldparams.BLSPubkeyLength]byte) error {
+	_, err := s.call(ctx, methodSaveEIPImportBlacklistedPublicKeys, &request{Pubkeys: encodePubkeys(publicKeys)})
+	return err
+}
+
+// SigningRootAtTargetEpoch returns the signing root of the attestation of the public key at the target epoch.
+func (s *Store) SigningRootAtTargetEpoch(ctx context.Context, publicKey [fieldparams.BLSPubkeyLength]byte, target primitives.Epoch) ([]byte, error) {
+	resp, err := s.call(ctx, methodSigningRootAtTargetEpoch, &request{Pubkey: hexutil.Encode(publicKey[:]), Epoch: target})
+	if err != nil {
+		return nil, err
+	}
+	if !resp.Exists {
+		return nil, nil
+	}
+	return hexutil.Decode(resp.Root)
+}
+
+// LowestSignedTargetEpoch returns the lowest signed target epoch of the public key.
+func (s *Store) LowestSignedTargetEpoch(ctx context.Context, publicKey [fieldparams.BLSPubkeyLength]byte) (primitives.Epoch, bool, error) {
+	resp, err := s.call(ctx, methodLowestSignedTargetEpoch, &request{Pubkey: hexutil.Encode(publicKey[:])})
+	if err != nil {
+		return 0, false, err
+	}
+	return resp.Epoch, resp.Exists, nil
+}
+
+// LowestSignedSourceEpoch returns the lowest signed source epoch of the public key.
+func (s *Store) LowestSignedSourceEpoch(ctx context.Context, publicKey [fieldparams.BLSPubkeyLength]byte) (primitives.Epoch, bool, error) {
+	resp, err := s.call(ctx, methodLowestSignedSourceEpoch, &request{Pubkey: hexutil.Encode(publicKey[:])})
+	if err != nil {
+		return 0, false, err
+	}
+	return resp.Epoch, resp.Exists, nil
+}
+
+// AttestedPublicKeys returns the public keys which signed attestations.
+func (s *Store) AttestedPublicKeys(ctx context.Context) ([][fieldparams.BLSPubkeyLength]byte, error) {
+	resp, err := s.call(ctx, methodAttestedPublicKeys, &request{})
+	if err != nil {
+		return nil, err
+	}
+	return decodePubkeys(resp.Pubkeys)
+}
+
+// SlashableAttestationCheck checks that the attestation is not slashable and saves it, atomically on the server.
+// It fails if another validator client holds the lease on the public key.
+func (s *Store) SlashableAttestationCheck(
+	ctx context.Context, indexedAtt ethpb.IndexedAtt, pubKey [fieldparams.BLSPubkeyLength]byte,
+	signingRoot32 [32]byte,
+	emitAccountMetrics bool,
+	validatorAttestFailVec *prometheus.CounterVec,
+) error {
+	if indexedAtt == nil || indexedAtt.IsNil() {
+		return errors.New("nil attestation")
+	}
+	_, err := s.call(ctx, methodSlashableAttestationCheck, &request{
+		Pubkey:       hexutil.Encode(pubKey[:]),
+		SigningRoots: []string{hexutil.Encode(signingRoot32[:])},
+		Data:         []*structs.AttestationData{structs.AttDataFromConsensus(indexedAtt.GetData())},
+	})
+	if err != nil && emitAccountMetrics {
+		validatorAttestFailVec.WithLabelValues(fmt.Sprintf("%#x", pubKey)).Inc()
+	}
+	return err
+}
+
+// SaveAttestationForPubKey saves the attestation of the public key.
+func (s *Store) SaveAttestationForPubKey(
+	ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte, signingRoot [fieldparams.RootLength]byte, att ethpb.IndexedAtt,
+) error {
+	if att == nil || att.IsNil() {
+		return errors.New("nil attestation")
+	}
+	_, err := s.call(ctx, methodSaveAttestationForPubKey, &request{
+		Pubkey:       hexutil.Encode(pubKey[:]),
+		SigningRoots: []string{hexutil.Encode(signingRoot[:])},
+		Data:         []*structs.AttestationData{structs.AttDataFromConsensus(att.GetData())},
+	})
+	return err
+}
+
+// SaveAttestationsForPubKey saves the attestations of the public key.
+func (s *Store) SaveAttestationsForPubKey(
+	ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte, signingRoots [][]byte, atts []*ethpb.IndexedAttestation,
+) error {
+	req := &request{
+		Pubkey:       hexutil.Encode(pubKey[:]),
+		SigningRoots: make([]string, len(signingRoots)),
+		Data:         make([]*structs.AttestationData, len(atts)),
+	}
+	for i := range signingRoots {
+		req.SigningRoots[i] = hexutil.Encode(signingRoots[i])
+	}
+	for i := range atts {
+		req.Data[i] = structs.AttDataFromConsensus(atts[i].Data)
+	}
+	_, err := s.call(ctx, methodSaveAttestationsForPubKey, req)
+	return err
+}
+
+// AttestationHistoryForPubKey returns the signed attestations of the public key.
+func (s *Store) AttestationHistoryForPubKey(ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte) ([]*common.AttestationRecord, error) {
+	resp, err := s.call(ctx, methodAttestationHistoryForPubKey, &request{Pubkey: hexutil.Encode(pubKey[:])})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Attestations, nil
+}
+
+// ImportStandardProtectionJSON imports an EIP-3076 slashing protection interchange file into the slashing
+// protection database.
+func (s *Store) ImportStandardProtectionJSON(ctx context.Context, r io.Reader) error {
+	b, err := io.ReadAll(r)
+	if err != nil {
+		return errors.Wrap(err, "could not read slashing protection interchange file")
+	}
+	_, err = s.call(ctx, methodImportStandardProtectionJSON, &request{Interchange: b})
+	return err
+}
@@ -0,0 +1,76 @@
+// Package remote implements a slashing protection backend shared by several validator clients.
+//
+// A Server owns the slashing protection database and serializes all the operations on it, so that the check and
+// save of a signing operation is atomic across validator clients. It also grants each validator client a lease on
+// the keys it signs with: as long as the lease is live, the other validator clients are refused to sign with the same
+// keys. This makes active/passive handovers safe, as the passive validator client can only take over once the active
+// one has released its keys or stopped renewing its lease.
+//
+// A Store implements the validator database on top of a Server, and is used by the validator client in place of its
+// local slashing protection database.
+package remote
+
+import (
+	"encoding/json"
+
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/validator/db/common"
+)
+
+const (
+	// PathPrefix is the path prefix of the methods served by the server.
+	PathPrefix = "/prysm/v1/slashing_protection/"
+	// ClientIDHeader is the header identifying the validator client, which holds the leases on its keys.
+	ClientIDHeader = "Prysm-Validator-Client-Id"
+)
+
+// Methods of the server. Each method is called with a POST request to PathPrefix followed by its name.
+const (
+	methodGenesisValidatorsRoot              = "genesis_validators_root"
+	methodSaveGenesisValidatorsRoot          = "save_genesis_validators_root"
+	methodUpdatePublicKeysBuckets            = "update_public_keys_buckets"
+	methodHighestSignedProposal              = "highest_signed_proposal"
+	methodLowestSignedProposal               = "lowest_signed_proposal"
+	methodProposalHistoryForPubKey           = "proposal_history_for_pubkey"
+	methodProposalHistoryForSlot             = "proposal_history_for_slot"
+	methodSaveProposalHistoryForSlot         = "save_proposal_history_for_slot"
+	methodProposedPublicKeys                 = "proposed_public_keys"
+	methodSlashableProposalCheck             = "slashable_proposal_check"
+	methodEIPImportBlacklistedPublicKeys     = "eip_import_blacklisted_public_keys"
+	methodSaveEIPImportBlacklistedPublicKeys = "save_eip_import_blacklisted_public_keys"
+	methodSigningRootAtTargetEpoch           = "signing_root_at_target_epoch"
+	methodLowestSignedTargetEpoch            = "lowest_signed_target_epoch"
+	methodLowestSignedSourceEpoch            = "lowest_signed_source_epoch"
+	methodAttestedPublicKeys                 = "attested_public_keys"
+	methodSlashableAttestationCheck          = "slashable_attestation_check"
+	methodSaveAttestationForPubKey           = "save_attestation_for_pubkey"
+	methodSaveAttestationsForPubKey          = "save_attestations_for_pubkey"
+	methodAttestationHistoryForPubKey        = "attestation_history_for_pubkey"
+	methodImportStandardProtectionJSON       = "import_standard_protection_json"
+	methodReleaseLeases                      = "release_leases"
+)
+
+// request holds the parameters of a method call. Each method only uses the parameters it needs.
+type request struct {
+	Pubkey       string                     `json:"pubkey,omitempty"`
+	Pubkeys      []string                   `json:"pubkeys,omitempty"`
+	Slot         primitives.Slot            `json:"slot,omitempty"`
+	Epoch        primitives.Epoch           `json:"epoch,omitempty"`
+	Root         string                     `json:"root,omitempty"`
+	SigningRoots []string                   `json:"signing_roots,omitempty"`
+	Data         []*structs.AttestationData `json:"data,omitempty"`
+	Interchange  json.RawMessage            `json:"interchange,omitempty"`
+}
+
+// response holds the results of a method call. Each method only sets the results it returns.
+type response struct {
+	Slot         primitives.Slot             `json:"slot,omitempty"`
+	Epoch        primitives.Epoch            `json:"epoch,omitempty"`
+	Exists       bool                        `json:"exists,omitempty"`
+	RootExists   bool                        `json:"root_exists,omitempty"`
+	Root         string                      `json:"root,omitempty"`
+	Pubkeys      []string                    `json:"pubkeys,omitempty"`
+	Proposals    []*common.Proposal          `json:"proposals,omitempty"`
+	Attestations []*common.AttestationRecord `json:"attestations,omitempty"`
+}
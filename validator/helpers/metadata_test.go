@@ -162,6 +162,30 @@ func (db *ValidatorDBMock) ImportStandardProtectionJSON(ctx context.Context, r i
 	panic("not implemented")
 }
 
+// Performance history related methods
+func (db *ValidatorDBMock) SavePerformanceRecords(ctx context.Context, records []*common.PerformanceRecord) error {
+	panic("not implemented")
+}
+func (db *ValidatorDBMock) PerformanceHistory(
+	ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte, startEpoch, endEpoch primitives.Epoch, limit int,
+) ([]*common.PerformanceRecord, error) {
+	panic("not implemented")
+}
+func (db *ValidatorDBMock) PrunePerformanceHistory(ctx context.Context, beforeEpoch primitives.Epoch) error {
+	panic("not implemented")
+}
+
+// Exit intent related methods
+func (db *ValidatorDBMock) SaveExitIntent(ctx context.Context, intent *common.ExitIntent) error {
+	panic("not implemented")
+}
+func (db *ValidatorDBMock) ExitIntents(ctx context.Context) ([]*common.ExitIntent, error) {
+	panic("not implemented")
+}
+func (db *ValidatorDBMock) DeleteExitIntent(ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte) error {
+	panic("not implemented")
+}
+
 func Test_validateMetadata(t *testing.T) {
 	goodRoot := [32]byte{1}
 	goodStr := make([]byte, hex.EncodedLen(len(goodRoot)))
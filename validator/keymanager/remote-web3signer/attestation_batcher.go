@@ -0,0 +1,106 @@
+package remote_web3signer
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/crypto/bls"
+	"github.com/prysmaticlabs/prysm/v5/validator/keymanager/remote-web3signer/internal"
+)
+
+const (
+	// attestationBatchWindow is how long the first attestation signing request of a slot waits for the
+	// other attestations of the slot before the batch is sent.
+	attestationBatchWindow = 5 * time.Millisecond
+	// maxAttestationBatchSize sends a batch as soon as it holds as many requests as the signer
+	// connections carry at once.
+	maxAttestationBatchSize = 128
+)
+
+type signFunc func(ctx context.Context, pubKey string, request internal.SignRequestJson) (bls.Signature, error)
+
+type signResult struct {
+	signature bls.Signature
+	err       error
+}
+
+type batchedSignRequest struct {
+	ctx     context.Context
+	pubKey  string
+	request internal.SignRequestJson
+	result  chan signResult
+}
+
+type attestationBatch struct {
+	requests []*batchedSignRequest
+	timer    *time.Timer
+}
+
+// attestationBatcher groups the attestation signing requests of a slot, which the validator client issues
+// all at once at a third of the slot, and sends every batch to the web3signer in one go. The web3signer
+// signing api takes a single request per call, so the requests of a batch are sent concurrently and
+// pipelined over the idle connections of the signer instead of trickling in as each duty is processed.
+type attestationBatcher struct {
+	sign    signFunc
+	window  time.Duration
+	maxSize int
+	lock    sync.Mutex
+	batches map[primitives.Slot]*attestationBatch
+}
+
+func newAttestationBatcher(sign signFunc, window time.Duration, maxSize int) *attestationBatcher {
+	return &attestationBatcher{
+		sign:    sign,
+		window:  window,
+		maxSize: maxSize,
+		batches: make(map[primitives.Slot]*attestationBatch),
+	}
+}
+
+// Sign adds the request to the batch of the slot and waits for its signature. The batch is sent once the
+// window elapsed since its first request, or right away once it is full.
+func (b *attestationBatcher) Sign(ctx context.Context, slot primitives.Slot, pubKey string, request internal.SignRequestJson) (bls.Signature, error) {
+	r := &batchedSignRequest{ctx: ctx, pubKey: pubKey, request: request, result: make(chan signResult, 1)}
+	b.lock.Lock()
+	batch, ok := b.batches[slot]
+	if !ok {
+		batch = &attestationBatch{}
+		batch.timer = time.AfterFunc(b.window, func() { b.send(slot, batch) })
+		b.batches[slot] = batch
+	}
+	batch.requests = append(batch.requests, r)
+	full := len(batch.requests) >= b.maxSize
+	b.lock.Unlock()
+	if full {
+		b.send(slot, batch)
+	}
+
+	select {
+	case res := <-r.result:
+		return res.signature, res.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// send sends the requests of the batch, unless it has been sent already.
+func (b *attestationBatcher) send(slot primitives.Slot, batch *attestationBatch) {
+	b.lock.Lock()
+	if b.batches[slot] != batch {
+		b.lock.Unlock()
+		return
+	}
+	delete(b.batches, slot)
+	b.lock.Unlock()
+	batch.timer.Stop()
+
+	attestationBatchSize.Observe(float64(len(batch.requests)))
+	for _, r := range batch.requests {
+		go func(r *batchedSignRequest) {
+			sig, err := b.sign(r.ctx, r.pubKey, r.request)
+			r.result <- signResult{signature: sig, err: err}
+		}(r)
+	}
+}
@@ -0,0 +1,68 @@
+package remote_web3signer
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/v5/crypto/bls"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/validator/keymanager/remote-web3signer/internal"
+)
+
+func TestAttestationBatcher_Sign(t *testing.T) {
+	keys := make(map[string]bls.SecretKey)
+	for _, pubKey := range []string{"0x01", "0x02", "0x03", "0x04"} {
+		key, err := bls.RandKey()
+		require.NoError(t, err)
+		keys[pubKey] = key
+	}
+	var calls atomic.Int32
+	sign := func(_ context.Context, pubKey string, request internal.SignRequestJson) (bls.Signature, error) {
+		calls.Add(1)
+		return keys[pubKey].Sign(request), nil
+	}
+
+	t.Run("full batch", func(t *testing.T) {
+		calls.Store(0)
+		b := newAttestationBatcher(sign, time.Hour, 3)
+		var wg sync.WaitGroup
+		signAsync := func(pubKey string) {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				sig, err := b.Sign(context.Background(), 1, pubKey, internal.SignRequestJson(pubKey))
+				assert.NoError(t, err)
+				if err == nil {
+					assert.DeepEqual(t, keys[pubKey].Sign([]byte(pubKey)).Marshal(), sig.Marshal())
+				}
+			}()
+		}
+		signAsync("0x01")
+		signAsync("0x02")
+		// The requests of another slot are batched apart.
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+		_, err := b.Sign(ctx, 2, "0x04", internal.SignRequestJson("0x04"))
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+		// Nothing is sent before the batch is full.
+		require.Equal(t, int32(0), calls.Load())
+		signAsync("0x03")
+		wg.Wait()
+		require.Equal(t, int32(3), calls.Load())
+	})
+	t.Run("window", func(t *testing.T) {
+		calls.Store(0)
+		b := newAttestationBatcher(sign, 10*time.Millisecond, 3)
+		sig, err := b.Sign(context.Background(), 1, "0x01", internal.SignRequestJson("0x01"))
+		require.NoError(t, err)
+		require.DeepEqual(t, keys["0x01"].Sign([]byte("0x01")).Marshal(), sig.Marshal())
+		require.Equal(t, int32(1), calls.Load())
+		b.lock.Lock()
+		require.Equal(t, 0, len(b.batches))
+		b.lock.Unlock()
+	})
+}
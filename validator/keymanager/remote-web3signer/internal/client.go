@@ -3,6 +3,7 @@ package internal
 import (
 	"bytes"
 	"context"
+	"crypto/tls"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -24,8 +25,15 @@ import (
 
 const (
 	ethApiNamespace = "/api/v1/eth2/sign/"
+	// maxIdleConnsPerHost keeps enough warm connections to a signer for all the attestations
+	// of a slot to be signed concurrently instead of queueing behind a handful of sockets.
+	maxIdleConnsPerHost = 128
 )
 
+// ErrSignerUnavailable is returned when the web3signer could not be reached or failed
+// with a server side error, as opposed to rejecting the request itself.
+var ErrSignerUnavailable = errors.New("web3signer unavailable")
+
 type SignRequestJson []byte
 
 // SignatureResponse is the struct representing the signing request response in json format
@@ -45,8 +53,19 @@ type ApiClient struct {
 	RestClient *http.Client
 }
 
+// ClientOption is a functional option for the ApiClient.
+type ClientOption func(*ApiClient)
+
+// WithTLSConfig sets the TLS configuration used to connect to the web3signer, for example
+// to present a client certificate or to pin the certificate authority of the signer.
+func WithTLSConfig(cfg *tls.Config) ClientOption {
+	return func(c *ApiClient) {
+		c.RestClient.Transport.(*http.Transport).TLSClientConfig = cfg
+	}
+}
+
 // NewApiClient method instantiates a new ApiClient object.
-func NewApiClient(baseEndpoint string) (*ApiClient, error) {
+func NewApiClient(baseEndpoint string, opts ...ClientOption) (*ApiClient, error) {
 	u, err := url.ParseRequestURI(baseEndpoint)
 	if err != nil {
 		return nil, errors.Wrap(err, "invalid format, unable to parse url")
@@ -54,10 +73,16 @@ func NewApiClient(baseEndpoint string) (*ApiClient, error) {
 	if u.Scheme == "" || u.Host == "" {
 		return nil, fmt.Errorf("web3signer url must be in the format of http(s)://host:port url used: %v", baseEndpoint)
 	}
-	return &ApiClient{
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.MaxIdleConnsPerHost = maxIdleConnsPerHost
+	client := &ApiClient{
 		BaseURL:    u,
-		RestClient: &http.Client{},
-	}, nil
+		RestClient: &http.Client{Transport: transport},
+	}
+	for _, opt := range opts {
+		opt(client)
+	}
+	return client, nil
 }
 
 // Sign is a wrapper method around the web3signer sign api.
@@ -133,6 +158,20 @@ func (client *ApiClient) GetServerStatus(ctx context.Context) (string, error) {
 	return status, nil
 }
 
+// UpCheck reports whether the web3signer answers its upcheck api with a 200 status.
+func (client *ApiClient) UpCheck(ctx context.Context) error {
+	const requestPath = "/upcheck"
+	resp, err := client.doRequest(ctx, http.MethodGet, client.BaseURL.String()+requestPath, nil /* no body needed on get request */)
+	if err != nil {
+		return err
+	}
+	closeBody(resp.Body)
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("%w: upcheck returned status %d", ErrSignerUnavailable, resp.StatusCode)
+	}
+	return nil
+}
+
 // doRequest is a utility method for requests.
 func (client *ApiClient) doRequest(ctx context.Context, httpMethod, fullPath string, body io.Reader) (*http.Response, error) {
 	var requestDump []byte
@@ -154,14 +193,15 @@ func (client *ApiClient) doRequest(ctx context.Context, httpMethod, fullPath str
 	duration := time.Since(start)
 	if err != nil {
 		signRequestDurationSeconds.WithLabelValues(req.Method, "error").Observe(duration.Seconds())
-		err = errors.Wrap(err, "failed to execute json request")
+		err = fmt.Errorf("%w: failed to execute json request: %w", ErrSignerUnavailable, err)
 		tracing.AnnotateError(span, err)
 		return resp, err
 	} else {
 		signRequestDurationSeconds.WithLabelValues(req.Method, strconv.Itoa(resp.StatusCode)).Observe(duration.Seconds())
 	}
 	if resp.StatusCode != http.StatusOK {
-		requestDump, err = httputil.DumpRequestOut(req, true)
+		// The request body has already been sent at this point, only the headers can be dumped.
+		requestDump, err = httputil.DumpRequestOut(req, false)
 		if err != nil {
 			return nil, err
 		}
@@ -175,13 +215,15 @@ func (client *ApiClient) doRequest(ctx context.Context, httpMethod, fullPath str
 			"response": string(responseDump),
 		}).Error("web3signer request failed")
 	}
-	if resp.StatusCode == http.StatusInternalServerError {
-		err = fmt.Errorf("internal Web3Signer server error, Signing Request URL: %v Status: %v", fullPath, resp.StatusCode)
+	if resp.StatusCode >= http.StatusInternalServerError {
+		err = fmt.Errorf("%w: internal Web3Signer server error, Signing Request URL: %v Status: %v", ErrSignerUnavailable, fullPath, resp.StatusCode)
 		tracing.AnnotateError(span, err)
+		closeBody(resp.Body)
 		return nil, err
 	} else if resp.StatusCode == http.StatusBadRequest {
 		err = fmt.Errorf("bad request format, Signing Request URL: %v Status: %v", fullPath, resp.StatusCode)
 		tracing.AnnotateError(span, err)
+		closeBody(resp.Body)
 		return nil, err
 	}
 	return resp, nil
@@ -6,9 +6,14 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"net"
 	"net/http"
+	"net/http/httptest"
 	"net/url"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/prysmaticlabs/prysm/v5/testing/require"
@@ -184,3 +189,77 @@ func TestClient_GetServerStatus_HappyPath(t *testing.T) {
 	assert.NotNil(t, resp)
 	assert.Nil(t, err)
 }
+
+func TestClient_UpCheck(t *testing.T) {
+	u, err := url.Parse("http://example.com")
+	require.NoError(t, err)
+
+	mock := &mockTransport{mockResponse: &http.Response{
+		StatusCode: 200,
+		Body:       io.NopCloser(bytes.NewReader([]byte("OK"))),
+	}}
+	cl := internal.ApiClient{BaseURL: u, RestClient: &http.Client{Transport: mock}}
+	require.NoError(t, cl.UpCheck(context.Background()))
+
+	mock = &mockTransport{mockResponse: &http.Response{
+		StatusCode: 503,
+		Body:       io.NopCloser(bytes.NewReader([]byte("loading"))),
+	}}
+	cl = internal.ApiClient{BaseURL: u, RestClient: &http.Client{Transport: mock}}
+	assert.ErrorIs(t, cl.UpCheck(context.Background()), internal.ErrSignerUnavailable)
+}
+
+// TestClient_Sign_Concurrent checks that the signing requests of a slot are all in flight at the same
+// time, and that a following slot reuses the connections instead of dialing the signer again.
+func TestClient_Sign_Concurrent(t *testing.T) {
+	const requests = 32
+	sig := `0xb3baa751d0a9132cfe93e4e3d5ff9075111100e3789dca219ade5a24d27e19d16b3353149da1833e9b691bb38634e8dc04469be7032132906c927d7e1a49b414730612877bc6b2810c8f202daf793d1ab0d6b5cb21d52f9e52e883859887a5d9`
+	arrived := make(chan struct{}, requests)
+	release := make(chan struct{})
+	var newConns atomic.Int32
+	srv := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		arrived <- struct{}{}
+		<-release
+		_, err := w.Write([]byte(sig))
+		require.NoError(t, err)
+	}))
+	srv.Config.ConnState = func(_ net.Conn, state http.ConnState) {
+		if state == http.StateNew {
+			newConns.Add(1)
+		}
+	}
+	srv.Start()
+	defer srv.Close()
+
+	cl, err := internal.NewApiClient(srv.URL)
+	require.NoError(t, err)
+	pubKey := "a2b5aaad9c6efefe7bb9b1243a043404f3362937cfb6b31833929833173f476630ea2cfeb0d9ddf15f97ca8685948820"
+	for slot := 0; slot < 2; slot++ {
+		var wg sync.WaitGroup
+		errs := make(chan error, requests)
+		for i := 0; i < requests; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				_, err := cl.Sign(context.Background(), pubKey, []byte(`{}`))
+				errs <- err
+			}()
+		}
+		for i := 0; i < requests; i++ {
+			select {
+			case <-arrived:
+			case <-time.After(5 * time.Second):
+				t.Fatalf("Only %d of %d signing requests were in flight at the same time", i, requests)
+			}
+		}
+		for i := 0; i < requests; i++ {
+			release <- struct{}{}
+		}
+		wg.Wait()
+		close(errs)
+		for err := range errs {
+			require.NoError(t, err)
+		}
+	}
+	assert.Equal(t, int32(requests), newConns.Load())
+}
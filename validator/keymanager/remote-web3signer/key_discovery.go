@@ -0,0 +1,87 @@
+package remote_web3signer
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+)
+
+// signerPublicKeysPath is the web3signer api listing the BLS keys loaded in the signer.
+const signerPublicKeysPath = "/api/v1/eth2/publicKeys"
+
+// errKeysManagedBySigner is returned by the keymanager api when the keys are discovered from the signer.
+var errKeysManagedBySigner = errors.New("public keys are discovered from the web3signer and cannot be changed through the validator client")
+
+// defaultPublicKeysURL returns the public keys api of the given web3signer endpoint.
+func defaultPublicKeysURL(baseEndpoint string) string {
+	return strings.TrimSuffix(baseEndpoint, "/") + signerPublicKeysPath
+}
+
+// pollPublicKeys fetches the public keys from the web3signer on every interval until the context is
+// canceled. Subscribers of the account changes feed are notified whenever the set of keys changes.
+func (km *Keymanager) pollPublicKeys(ctx context.Context, interval time.Duration) {
+	log.WithField("url", km.publicKeysURL).WithField("interval", interval).Info("Polling web3signer for public key changes")
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := km.refreshPublicKeysFromSigner(ctx); err != nil {
+				erroredResponsesTotal.Inc()
+				log.WithError(err).Warn("Could not refresh public keys from web3signer")
+			}
+		}
+	}
+}
+
+func (km *Keymanager) refreshPublicKeysFromSigner(ctx context.Context) error {
+	encodedKeys, err := km.client.GetPublicKeys(ctx, km.publicKeysURL)
+	if err != nil {
+		return errors.Wrapf(err, "could not get public keys from %s", km.publicKeysURL)
+	}
+	keys := make([][fieldparams.BLSPubkeyLength]byte, 0, len(encodedKeys))
+	fetched := make(map[[fieldparams.BLSPubkeyLength]byte]bool, len(encodedKeys))
+	for _, encodedKey := range encodedKeys {
+		decodedKey, err := hexutil.Decode(encodedKey)
+		if err != nil {
+			return errors.Wrapf(err, "could not decode public key %s", encodedKey)
+		}
+		if len(decodedKey) != fieldparams.BLSPubkeyLength {
+			return fmt.Errorf("public key %s has invalid length (expected %d, got %d)", encodedKey, fieldparams.BLSPubkeyLength, len(decodedKey))
+		}
+		key := bytesutil.ToBytes48(decodedKey)
+		if fetched[key] {
+			continue
+		}
+		fetched[key] = true
+		keys = append(keys, key)
+	}
+
+	km.lock.RLock()
+	var removed int
+	for _, key := range km.providedPublicKeys {
+		if !fetched[key] {
+			removed++
+		}
+	}
+	added := len(keys) - (len(km.providedPublicKeys) - removed)
+	km.lock.RUnlock()
+	if added == 0 && removed == 0 {
+		return nil
+	}
+
+	log.WithField("added", added).WithField("removed", removed).WithField("total", len(keys)).Info("Web3signer public keys changed")
+	if len(keys) == 0 {
+		log.Warn("Web3signer no longer reports any public keys, all duties will stop")
+	}
+	km.updatePublicKeys(keys)
+	return nil
+}
@@ -0,0 +1,102 @@
+package remote_web3signer
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+const (
+	discoveredKey1 = "0xa2b5aaad9c6efefe7bb9b1243a043404f3362937cfb6b31833929833173f476630ea2cfeb0d9ddf15f97ca8685948820"
+	discoveredKey2 = "0x8000a9a6d3f5e22d783eefaadbcf0298146adb5d95b04db910a0d4e16976b30229d0b1e7b9cda6c7e0bfa11f72efe055"
+)
+
+func TestKeymanager_PollPublicKeys(t *testing.T) {
+	var mu sync.Mutex
+	signerKeys := []string{discoveredKey1}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, signerPublicKeysPath, r.URL.Path)
+		mu.Lock()
+		defer mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		require.NoError(t, json.NewEncoder(w).Encode(signerKeys))
+	}))
+	defer srv.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	root, err := hexutil.Decode("0x270d43e74ce340de4bca2b1936beca0f4f5408d9e78aec4850920baf659d5b69")
+	require.NoError(t, err)
+	km, err := NewKeymanager(ctx, &SetupConfig{
+		BaseEndpoint:           srv.URL,
+		GenesisValidatorsRoot:  root,
+		PublicKeysPollInterval: 10 * time.Millisecond,
+	})
+	require.NoError(t, err)
+	keys, err := km.FetchValidatingPublicKeys(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(keys))
+	require.Equal(t, discoveredKey1, hexutil.Encode(keys[0][:]))
+
+	changes := make(chan [][fieldparams.BLSPubkeyLength]byte, 1)
+	sub := km.SubscribeAccountChanges(changes)
+	defer sub.Unsubscribe()
+
+	mu.Lock()
+	signerKeys = []string{discoveredKey2}
+	mu.Unlock()
+	select {
+	case keys = <-changes:
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for key change")
+	}
+	require.Equal(t, 1, len(keys))
+	require.Equal(t, discoveredKey2, hexutil.Encode(keys[0][:]))
+
+	_, err = km.AddPublicKeys([]string{discoveredKey1})
+	require.ErrorIs(t, err, errKeysManagedBySigner)
+	_, err = km.DeletePublicKeys([]string{discoveredKey2})
+	require.ErrorIs(t, err, errKeysManagedBySigner)
+}
+
+func TestKeymanager_RefreshPublicKeysFromSigner_NoChange(t *testing.T) {
+	root, err := hexutil.Decode("0x270d43e74ce340de4bca2b1936beca0f4f5408d9e78aec4850920baf659d5b69")
+	require.NoError(t, err)
+	km, err := NewKeymanager(context.Background(), &SetupConfig{
+		BaseEndpoint:          "http://example.com",
+		GenesisValidatorsRoot: root,
+		ProvidedPublicKeys:    []string{discoveredKey1, discoveredKey2},
+	})
+	require.NoError(t, err)
+	km.client = &MockClient{PublicKeys: []string{discoveredKey2, discoveredKey1, discoveredKey1}}
+
+	changes := make(chan [][fieldparams.BLSPubkeyLength]byte, 1)
+	sub := km.SubscribeAccountChanges(changes)
+	defer sub.Unsubscribe()
+	require.NoError(t, km.refreshPublicKeysFromSigner(context.Background()))
+	select {
+	case <-changes:
+		t.Fatal("unexpected key change")
+	default:
+	}
+}
+
+func TestNewKeymanager_PollPublicKeysConflicts(t *testing.T) {
+	root, err := hexutil.Decode("0x270d43e74ce340de4bca2b1936beca0f4f5408d9e78aec4850920baf659d5b69")
+	require.NoError(t, err)
+	_, err = NewKeymanager(context.Background(), &SetupConfig{
+		BaseEndpoint:           "http://example.com",
+		GenesisValidatorsRoot:  root,
+		ProvidedPublicKeys:     []string{discoveredKey1},
+		PublicKeysPollInterval: time.Second,
+	})
+	require.ErrorContains(t, "cannot be combined", err)
+}
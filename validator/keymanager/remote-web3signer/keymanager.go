@@ -55,11 +55,26 @@ type SetupConfig struct {
 	// a static list of public keys to be passed by the user to determine what accounts should sign.
 	// This will provide a layer of safety against slashing if the web3signer is shared across validators.
 	ProvidedPublicKeys []string
+
+	// PublicKeysPollInterval enables polling the web3signer (or the PublicKeysURL if set) for added and removed keys.
+	// It cannot be combined with a static list of public keys or a key file.
+	PublicKeysPollInterval time.Duration
+
+	// FailoverEndpoints are additional web3signer instances, tried in order when the signers
+	// before them are unhealthy. They must share the slashing protection database of the BaseEndpoint.
+	FailoverEndpoints []string
+
+	// TLS options used for every web3signer connection. A client certificate and key enable mutual TLS,
+	// a CA certificate pins the authority the signers' certificates must be issued by.
+	TLSClientCertPath string
+	TLSClientKeyPath  string
+	TLSCACertPath     string
 }
 
 // Keymanager defines the web3signer keymanager.
 type Keymanager struct {
 	client                internal.HttpSignerClient
+	attestations          *attestationBatcher
 	genesisValidatorsRoot []byte
 	providedPublicKeys    [][48]byte          // (source of truth) flag loaded + file loaded + api loaded keys
 	flagLoadedKeysMap     map[string][48]byte // stores what was provided from flag ( as opposed to from file )
@@ -67,6 +82,8 @@ type Keymanager struct {
 	validator             *validator.Validate
 	retriesRemaining      int
 	keyFilePath           string
+	publicKeysURL         string
+	publicKeysPollPeriod  time.Duration
 	lock                  sync.RWMutex
 }
 
@@ -77,19 +94,44 @@ func NewKeymanager(ctx context.Context, cfg *SetupConfig) (*Keymanager, error) {
 	if cfg.BaseEndpoint == "" || !bytesutil.IsValidRoot(cfg.GenesisValidatorsRoot) {
 		return nil, fmt.Errorf("invalid setup config, one or more configs are empty: BaseEndpoint: %v, GenesisValidatorsRoot: %#x", cfg.BaseEndpoint, cfg.GenesisValidatorsRoot)
 	}
-	client, err := internal.NewApiClient(cfg.BaseEndpoint)
+	publicKeysURL := cfg.PublicKeysURL
+	if cfg.PublicKeysPollInterval > 0 {
+		if len(cfg.ProvidedPublicKeys) != 0 || cfg.KeyFilePath != "" {
+			return nil, errors.New("polling the web3signer for public keys cannot be combined with a static list of public keys or a key file")
+		}
+		if publicKeysURL == "" {
+			publicKeysURL = defaultPublicKeysURL(cfg.BaseEndpoint)
+		}
+	}
+	tlsCfg, err := tlsConfig(cfg)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not set up web3signer TLS")
+	}
+	var opts []internal.ClientOption
+	if tlsCfg != nil {
+		opts = append(opts, internal.WithTLSConfig(tlsCfg))
+	}
+	pool, err := newSignerPool(append([]string{cfg.BaseEndpoint}, cfg.FailoverEndpoints...), opts...)
 	if err != nil {
 		return nil, errors.Wrap(err, "could not create apiClient")
 	}
+	if len(pool.signers) > 1 {
+		go pool.monitorHealth(ctx, healthCheckInterval)
+	}
 
 	km := &Keymanager{
-		client:                internal.HttpSignerClient(client),
+		client:                internal.HttpSignerClient(pool),
 		genesisValidatorsRoot: cfg.GenesisValidatorsRoot,
 		accountsChangedFeed:   new(event.Feed),
 		validator:             validator.New(),
 		retriesRemaining:      maxRetries,
 		keyFilePath:           cfg.KeyFilePath,
+		publicKeysURL:         publicKeysURL,
+		publicKeysPollPeriod:  cfg.PublicKeysPollInterval,
 	}
+	km.attestations = newAttestationBatcher(func(ctx context.Context, pubKey string, request internal.SignRequestJson) (bls.Signature, error) {
+		return km.client.Sign(ctx, pubKey, request)
+	}, attestationBatchWindow, maxAttestationBatchSize)
 
 	keyFileExists := false
 	if km.keyFilePath != "" {
@@ -104,11 +146,11 @@ func NewKeymanager(ctx context.Context, cfg *SetupConfig) (*Keymanager, error) {
 
 	var ppk []string
 	// load key values
-	if cfg.PublicKeysURL != "" {
-		providedPublicKeys, err := km.client.GetPublicKeys(ctx, cfg.PublicKeysURL)
+	if km.publicKeysURL != "" {
+		providedPublicKeys, err := km.client.GetPublicKeys(ctx, km.publicKeysURL)
 		if err != nil {
 			erroredResponsesTotal.Inc()
-			return nil, errors.Wrapf(err, "could not get public keys from remote server URL %v", cfg.PublicKeysURL)
+			return nil, errors.Wrapf(err, "could not get public keys from remote server URL %v", km.publicKeysURL)
 		}
 		ppk = providedPublicKeys
 	} else if len(cfg.ProvidedPublicKeys) != 0 {
@@ -160,6 +202,9 @@ func NewKeymanager(ctx context.Context, cfg *SetupConfig) (*Keymanager, error) {
 		km.providedPublicKeys = slices.Collect(maps.Values(flagLoadedKeys))
 		km.lock.Unlock()
 	}
+	if km.publicKeysPollPeriod > 0 {
+		go km.pollPublicKeys(ctx, km.publicKeysPollPeriod)
+	}
 
 	return km, nil
 }
@@ -376,13 +421,19 @@ func (km *Keymanager) FetchValidatingPublicKeys(_ context.Context) ([][fieldpara
 }
 
 // Sign signs the message by using a remote web3signer server.
+// The attestations of a slot are batched together, the other requests are sent right away.
 func (km *Keymanager) Sign(ctx context.Context, request *validatorpb.SignRequest) (bls.Signature, error) {
 	signRequest, err := getSignRequestJson(ctx, km.validator, request, km.genesisValidatorsRoot)
 	if err != nil {
 		erroredResponsesTotal.Inc()
 		return nil, err
 	}
-	signature, err := km.client.Sign(ctx, hexutil.Encode(request.PublicKey), signRequest)
+	var signature bls.Signature
+	if _, ok := request.Object.(*validatorpb.SignRequest_AttestationData); ok {
+		signature, err = km.attestations.Sign(ctx, request.SigningSlot, hexutil.Encode(request.PublicKey), signRequest)
+	} else {
+		signature, err = km.client.Sign(ctx, hexutil.Encode(request.PublicKey), signRequest)
+	}
 	if err != nil {
 		erroredResponsesTotal.Inc()
 		return nil, errors.Wrap(err, "failed to sign the request")
@@ -754,6 +805,9 @@ func DisplayRemotePublicKeys(validatingPubKeys [][48]byte) {
 
 // AddPublicKeys imports a list of public keys into the keymanager for web3signer use. Returns status with message.
 func (km *Keymanager) AddPublicKeys(pubKeys []string) ([]*keymanager.KeyStatus, error) {
+	if km.publicKeysPollPeriod > 0 {
+		return nil, errKeysManagedBySigner
+	}
 	importedRemoteKeysStatuses := make([]*keymanager.KeyStatus, len(pubKeys))
 	// Using a map to track both existing and new public keys efficiently
 	combinedKeys := make(map[string][48]byte)
@@ -817,6 +871,9 @@ func (km *Keymanager) AddPublicKeys(pubKeys []string) ([]*keymanager.KeyStatus,
 
 // DeletePublicKeys removes a list of public keys from the keymanager for web3signer use. Returns status with message.
 func (km *Keymanager) DeletePublicKeys(publicKeys []string) ([]*keymanager.KeyStatus, error) {
+	if km.publicKeysPollPeriod > 0 {
+		return nil, errKeysManagedBySigner
+	}
 	deletedRemoteKeysStatuses := make([]*keymanager.KeyStatus, len(publicKeys))
 	// Using a map to track both existing and new public keys efficiently
 	combinedKeys := make(map[string][48]byte)
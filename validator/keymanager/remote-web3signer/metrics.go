@@ -91,4 +91,30 @@ var (
 		Name: "remote_web3signer_validator_registration_sign_requests_total",
 		Help: "Total number of validator registration sign requests",
 	})
+
+	signerRequestDurationSeconds = promauto.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "remote_web3signer_signer_request_duration_seconds",
+			Help:    "Time (in seconds) spent waiting for a sign request, per web3signer instance",
+			Buckets: prometheus.DefBuckets,
+		},
+		[]string{"signer"},
+	)
+	signerErrorsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "remote_web3signer_signer_errors_total",
+		Help: "Total number of failed sign requests, per web3signer instance",
+	}, []string{"signer"})
+	signerFailoversTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "remote_web3signer_signer_failovers_total",
+		Help: "Total number of sign requests moved to another instance because a web3signer was unavailable",
+	}, []string{"signer"})
+	signerHealthy = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "remote_web3signer_signer_healthy",
+		Help: "Whether a web3signer instance is considered healthy (1) or not (0)",
+	}, []string{"signer"})
+	attestationBatchSize = promauto.NewHistogram(prometheus.HistogramOpts{
+		Name:    "remote_web3signer_attestation_batch_size",
+		Help:    "Number of attestation sign requests of a slot sent to web3signer together",
+		Buckets: prometheus.ExponentialBuckets(1, 2, 8),
+	})
 )
@@ -0,0 +1,178 @@
+package remote_web3signer
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+	"sync/atomic"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/crypto/bls"
+	"github.com/prysmaticlabs/prysm/v5/validator/keymanager/remote-web3signer/internal"
+)
+
+const (
+	// healthCheckInterval is how often every configured web3signer is polled on its upcheck api.
+	healthCheckInterval = 6 * time.Second
+	// failoverRequestTimeout bounds a single attempt when another signer is available to fail
+	// over to, so a hanging instance cannot hold a duty past its deadline.
+	failoverRequestTimeout = 2 * time.Second
+)
+
+// signer is a single web3signer instance in a signerPool.
+type signer struct {
+	name    string
+	client  *internal.ApiClient
+	healthy atomic.Bool
+}
+
+func (s *signer) markHealthy() {
+	if !s.healthy.Swap(true) {
+		log.WithField("signer", s.name).Info("Web3Signer instance is healthy")
+	}
+	signerHealthy.WithLabelValues(s.name).Set(1)
+}
+
+func (s *signer) markUnhealthy(err error) {
+	if s.healthy.Swap(false) {
+		log.WithError(err).WithField("signer", s.name).Warn("Web3Signer instance is unhealthy")
+	}
+	signerHealthy.WithLabelValues(s.name).Set(0)
+}
+
+// signerPool sends requests to the first healthy web3signer, in the order the instances were
+// configured, and fails over to the next one when an instance cannot be reached or returns a
+// server error. Rejections such as slashing protection refusals are never retried elsewhere.
+type signerPool struct {
+	signers []*signer
+}
+
+func newSignerPool(endpoints []string, opts ...internal.ClientOption) (*signerPool, error) {
+	if len(endpoints) == 0 {
+		return nil, errors.New("no web3signer endpoints provided")
+	}
+	pool := &signerPool{signers: make([]*signer, 0, len(endpoints))}
+	for _, endpoint := range endpoints {
+		client, err := internal.NewApiClient(endpoint, opts...)
+		if err != nil {
+			return nil, err
+		}
+		s := &signer{name: client.BaseURL.Host, client: client}
+		s.healthy.Store(true)
+		signerHealthy.WithLabelValues(s.name).Set(1)
+		pool.signers = append(pool.signers, s)
+	}
+	return pool, nil
+}
+
+// ordered returns the healthy signers followed by the unhealthy ones, each in configuration
+// order, so that requests still go out when every instance is marked as down.
+func (p *signerPool) ordered() []*signer {
+	healthy := make([]*signer, 0, len(p.signers))
+	var unhealthy []*signer
+	for _, s := range p.signers {
+		if s.healthy.Load() {
+			healthy = append(healthy, s)
+		} else {
+			unhealthy = append(unhealthy, s)
+		}
+	}
+	return append(healthy, unhealthy...)
+}
+
+// Sign sends the request to the preferred signer, failing over on unavailability.
+func (p *signerPool) Sign(ctx context.Context, pubKey string, request internal.SignRequestJson) (bls.Signature, error) {
+	var lastErr error
+	for _, s := range p.ordered() {
+		attemptCtx, cancel := ctx, context.CancelFunc(func() {})
+		if len(p.signers) > 1 {
+			attemptCtx, cancel = context.WithTimeout(ctx, failoverRequestTimeout)
+		}
+		start := time.Now()
+		sig, err := s.client.Sign(attemptCtx, pubKey, request)
+		cancel()
+		signerRequestDurationSeconds.WithLabelValues(s.name).Observe(time.Since(start).Seconds())
+		if err == nil {
+			s.markHealthy()
+			return sig, nil
+		}
+		signerErrorsTotal.WithLabelValues(s.name).Inc()
+		if !errors.Is(err, internal.ErrSignerUnavailable) || ctx.Err() != nil {
+			return nil, err
+		}
+		s.markUnhealthy(err)
+		signerFailoversTotal.WithLabelValues(s.name).Inc()
+		lastErr = err
+	}
+	return nil, errors.Wrap(lastErr, "no web3signer instance could serve the request")
+}
+
+// GetPublicKeys fetches the public keys from the given url using the preferred signer's client.
+func (p *signerPool) GetPublicKeys(ctx context.Context, url string) ([]string, error) {
+	return p.ordered()[0].client.GetPublicKeys(ctx, url)
+}
+
+// monitorHealth polls the upcheck api of every signer until the context is canceled.
+func (p *signerPool) monitorHealth(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			p.checkHealth(ctx, interval)
+		}
+	}
+}
+
+func (p *signerPool) checkHealth(ctx context.Context, timeout time.Duration) {
+	for _, s := range p.signers {
+		checkCtx, cancel := context.WithTimeout(ctx, timeout)
+		err := s.client.UpCheck(checkCtx)
+		cancel()
+		if ctx.Err() != nil {
+			return
+		}
+		if err != nil {
+			s.markUnhealthy(err)
+			continue
+		}
+		s.markHealthy()
+	}
+}
+
+// tlsConfig builds the TLS configuration for the web3signer clients. A client certificate
+// enables mutual TLS and a CA certificate replaces the system roots, pinning the authority the
+// signers' certificates must chain to. It returns nil when no TLS option is set.
+func tlsConfig(cfg *SetupConfig) (*tls.Config, error) {
+	if cfg.TLSClientCertPath == "" && cfg.TLSClientKeyPath == "" && cfg.TLSCACertPath == "" {
+		return nil, nil
+	}
+	if (cfg.TLSClientCertPath == "") != (cfg.TLSClientKeyPath == "") {
+		return nil, errors.New("web3signer client certificate and key must be provided together")
+	}
+	c := &tls.Config{MinVersion: tls.VersionTLS12}
+	if cfg.TLSClientCertPath != "" {
+		cert, err := tls.LoadX509KeyPair(cfg.TLSClientCertPath, cfg.TLSClientKeyPath)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not load web3signer client certificate")
+		}
+		c.Certificates = []tls.Certificate{cert}
+	}
+	if cfg.TLSCACertPath != "" {
+		caCert, err := os.ReadFile(cfg.TLSCACertPath) // #nosec G304 -- path is provided by the operator.
+		if err != nil {
+			return nil, errors.Wrap(err, "could not read web3signer CA certificate")
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(caCert) {
+			return nil, fmt.Errorf("no valid certificate found in %s", cfg.TLSCACertPath)
+		}
+		c.RootCAs = pool
+	}
+	return c, nil
+}
@@ -0,0 +1,156 @@
+package remote_web3signer
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/validator/keymanager/remote-web3signer/internal"
+)
+
+const testSignature = "0xb3baa751d0a9132cfe93e4e3d5ff9075111100e3789dca219ade5a24d27e19d16b3353149da1833e9b691bb38634e8dc04469be7032132906c927d7e1a49b414730612877bc6b2810c8f202daf793d1ab0d6b5cb21d52f9e52e883859887a5d9"
+
+func signerServer(t *testing.T, status *atomic.Int32, calls *atomic.Int32) *httptest.Server {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(int(status.Load()))
+		if status.Load() == http.StatusOK && r.URL.Path != "/upcheck" {
+			_, err := w.Write([]byte(testSignature))
+			require.NoError(t, err)
+		}
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestSignerPool_Sign_FailsOver(t *testing.T) {
+	var primaryStatus, secondaryStatus, primaryCalls, secondaryCalls atomic.Int32
+	primaryStatus.Store(http.StatusServiceUnavailable)
+	secondaryStatus.Store(http.StatusOK)
+	primary := signerServer(t, &primaryStatus, &primaryCalls)
+	secondary := signerServer(t, &secondaryStatus, &secondaryCalls)
+
+	pool, err := newSignerPool([]string{primary.URL, secondary.URL})
+	require.NoError(t, err)
+
+	sig, err := pool.Sign(context.Background(), "0xa2b5", internal.SignRequestJson(`{}`))
+	require.NoError(t, err)
+	require.Equal(t, testSignature, hexutil.Encode(sig.Marshal()))
+	require.Equal(t, false, pool.signers[0].healthy.Load())
+	require.Equal(t, int32(1), primaryCalls.Load())
+	require.Equal(t, int32(1), secondaryCalls.Load())
+
+	// The unhealthy primary is skipped until a health check marks it healthy again.
+	_, err = pool.Sign(context.Background(), "0xa2b5", internal.SignRequestJson(`{}`))
+	require.NoError(t, err)
+	require.Equal(t, int32(1), primaryCalls.Load())
+
+	primaryStatus.Store(http.StatusOK)
+	pool.checkHealth(context.Background(), time.Second)
+	require.Equal(t, true, pool.signers[0].healthy.Load())
+	_, err = pool.Sign(context.Background(), "0xa2b5", internal.SignRequestJson(`{}`))
+	require.NoError(t, err)
+	// Both instances served an upcheck, only the primary served the last sign request.
+	require.Equal(t, int32(3), primaryCalls.Load())
+	require.Equal(t, int32(3), secondaryCalls.Load())
+}
+
+func TestSignerPool_Sign_DoesNotFailOverOnRejection(t *testing.T) {
+	var primaryStatus, secondaryStatus, primaryCalls, secondaryCalls atomic.Int32
+	primaryStatus.Store(http.StatusPreconditionFailed)
+	secondaryStatus.Store(http.StatusOK)
+	primary := signerServer(t, &primaryStatus, &primaryCalls)
+	secondary := signerServer(t, &secondaryStatus, &secondaryCalls)
+
+	pool, err := newSignerPool([]string{primary.URL, secondary.URL})
+	require.NoError(t, err)
+
+	_, err = pool.Sign(context.Background(), "0xa2b5", internal.SignRequestJson(`{}`))
+	require.ErrorContains(t, "slashing protection", err)
+	require.Equal(t, true, pool.signers[0].healthy.Load())
+	require.Equal(t, int32(0), secondaryCalls.Load())
+}
+
+func TestSignerPool_Sign_AllUnavailable(t *testing.T) {
+	var status, calls atomic.Int32
+	status.Store(http.StatusInternalServerError)
+	first := signerServer(t, &status, &calls)
+	second := signerServer(t, &status, &calls)
+
+	pool, err := newSignerPool([]string{first.URL, second.URL})
+	require.NoError(t, err)
+	_, err = pool.Sign(context.Background(), "0xa2b5", internal.SignRequestJson(`{}`))
+	require.ErrorContains(t, "no web3signer instance could serve the request", err)
+	require.Equal(t, int32(2), calls.Load())
+}
+
+func writePEM(t *testing.T, path, blockType string, der []byte) {
+	f, err := os.Create(path)
+	require.NoError(t, err)
+	require.NoError(t, pem.Encode(f, &pem.Block{Type: blockType, Bytes: der}))
+	require.NoError(t, f.Close())
+}
+
+func TestSignerPool_MutualTLS(t *testing.T) {
+	dir := t.TempDir()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "validator"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	certDER, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+	certPath, keyPath, caPath := filepath.Join(dir, "client.crt"), filepath.Join(dir, "client.key"), filepath.Join(dir, "ca.crt")
+	writePEM(t, certPath, "CERTIFICATE", certDER)
+	writePEM(t, keyPath, "EC PRIVATE KEY", keyDER)
+
+	var presented atomic.Bool
+	srv := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		presented.Store(len(r.TLS.PeerCertificates) == 1)
+		_, err := w.Write([]byte(testSignature))
+		require.NoError(t, err)
+	}))
+	srv.TLS = &tls.Config{ClientAuth: tls.RequireAnyClientCert, MinVersion: tls.VersionTLS12}
+	srv.StartTLS()
+	defer srv.Close()
+	writePEM(t, caPath, "CERTIFICATE", srv.Certificate().Raw)
+
+	// Without the pinned CA the server certificate is not trusted.
+	pool, err := newSignerPool([]string{srv.URL})
+	require.NoError(t, err)
+	_, err = pool.Sign(context.Background(), "0xa2b5", internal.SignRequestJson(`{}`))
+	require.ErrorContains(t, "certificate", err)
+
+	cfg, err := tlsConfig(&SetupConfig{TLSClientCertPath: certPath, TLSClientKeyPath: keyPath, TLSCACertPath: caPath})
+	require.NoError(t, err)
+	pool, err = newSignerPool([]string{srv.URL}, internal.WithTLSConfig(cfg))
+	require.NoError(t, err)
+	sig, err := pool.Sign(context.Background(), "0xa2b5", internal.SignRequestJson(`{}`))
+	require.NoError(t, err)
+	require.Equal(t, testSignature, hexutil.Encode(sig.Marshal()))
+	require.Equal(t, true, presented.Load())
+
+	_, err = tlsConfig(&SetupConfig{TLSClientCertPath: certPath})
+	require.ErrorContains(t, "must be provided together", err)
+}
@@ -27,6 +27,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/config/params"
 	"github.com/prysmaticlabs/prysm/v5/config/proposer"
 	"github.com/prysmaticlabs/prysm/v5/config/proposer/loader"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v5/io/file"
 	"github.com/prysmaticlabs/prysm/v5/monitoring/backup"
 	"github.com/prysmaticlabs/prysm/v5/monitoring/prometheus"
@@ -36,11 +37,13 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/runtime/prereqs"
 	"github.com/prysmaticlabs/prysm/v5/runtime/version"
 	"github.com/prysmaticlabs/prysm/v5/validator/accounts/wallet"
+	"github.com/prysmaticlabs/prysm/v5/validator/audit"
 	"github.com/prysmaticlabs/prysm/v5/validator/client"
 	"github.com/prysmaticlabs/prysm/v5/validator/db"
 	"github.com/prysmaticlabs/prysm/v5/validator/db/filesystem"
 	"github.com/prysmaticlabs/prysm/v5/validator/db/iface"
 	"github.com/prysmaticlabs/prysm/v5/validator/db/kv"
+	"github.com/prysmaticlabs/prysm/v5/validator/db/remote"
 	g "github.com/prysmaticlabs/prysm/v5/validator/graffiti"
 	"github.com/prysmaticlabs/prysm/v5/validator/keymanager/local"
 	remoteweb3signer "github.com/prysmaticlabs/prysm/v5/validator/keymanager/remote-web3signer"
@@ -56,6 +59,7 @@ type ValidatorClient struct {
 	ctx                   context.Context
 	cancel                context.CancelFunc
 	db                    iface.ValidatorDB
+	auditLog              *audit.Log
 	services              *runtime.ServiceRegistry // Lifecycle and service store.
 	lock                  sync.RWMutex
 	wallet                *wallet.Wallet
@@ -175,6 +179,9 @@ func (c *ValidatorClient) Close() {
 	defer c.lock.Unlock()
 
 	c.services.StopAll()
+	if err := c.auditLog.Close(); err != nil {
+		log.WithError(err).Error("Could not close signing audit log")
+	}
 	log.Info("Stopping Prysm validator")
 	c.cancel()
 	close(c.stop)
@@ -430,9 +437,47 @@ func (c *ValidatorClient) initializeDB(cliCtx *cli.Context) error {
 		return errors.Wrap(err, "could not run database migration")
 	}
 
+	// Share the slashing protection data with other validator clients through a remote server.
+	if cliCtx.IsSet(flags.SlashingProtectionURLFlag.Name) {
+		remoteDB, err := remoteSlashingProtectionDB(cliCtx, valDB)
+		if err != nil {
+			return err
+		}
+		c.db = remoteDB
+	}
+
 	return nil
 }
 
+func remoteSlashingProtectionDB(cliCtx *cli.Context, local iface.ValidatorDB) (iface.ValidatorDB, error) {
+	clientID := cliCtx.String(flags.SlashingProtectionClientIDFlag.Name)
+	if clientID == "" {
+		hostname, err := os.Hostname()
+		if err != nil {
+			return nil, errors.Wrap(err, "could not get host name to identify the validator client")
+		}
+		clientID = hostname
+	}
+	var token string
+	if cliCtx.IsSet(flags.SlashingProtectionTokenFileFlag.Name) {
+		var err error
+		token, err = remote.ReadToken(cliCtx.String(flags.SlashingProtectionTokenFileFlag.Name))
+		if err != nil {
+			return nil, err
+		}
+	}
+	serverURL := cliCtx.String(flags.SlashingProtectionURLFlag.Name)
+	db, err := remote.NewStore(local, serverURL, clientID, token, cliCtx.String(flags.SlashingProtectionCACertFlag.Name))
+	if err != nil {
+		return nil, errors.Wrap(err, "could not create remote slashing protection database")
+	}
+	log.WithFields(logrus.Fields{
+		"url":      serverURL,
+		"clientID": clientID,
+	}).Info("Using remote slashing protection database")
+	return db, nil
+}
+
 func (c *ValidatorClient) registerPrometheusService(cliCtx *cli.Context) error {
 	var additionalHandlers []prometheus.Handler
 	if cliCtx.IsSet(cmd.EnableBackupWebhookFlag.Name) {
@@ -488,6 +533,13 @@ func (c *ValidatorClient) registerValidatorService(cliCtx *cli.Context) error {
 		return err
 	}
 
+	if c.cliCtx.IsSet(flags.AuditLogDirFlag.Name) {
+		c.auditLog, err = audit.Open(c.cliCtx.String(flags.AuditLogDirFlag.Name), int64(c.cliCtx.Int(flags.AuditLogMaxSizeFlag.Name))<<20)
+		if err != nil {
+			return errors.Wrap(err, "could not open signing audit log")
+		}
+	}
+
 	validatorService, err := client.NewValidatorService(c.cliCtx.Context, &client.Config{
 		DB:                      c.db,
 		Wallet:                  c.wallet,
@@ -508,8 +560,11 @@ func (c *ValidatorClient) registerValidatorService(cliCtx *cli.Context) error {
 		ValidatorsRegBatchSize:  c.cliCtx.Int(flags.ValidatorsRegistrationBatchSizeFlag.Name),
 		UseWeb:                  c.cliCtx.Bool(flags.EnableWebFlag.Name),
 		LogValidatorPerformance: !c.cliCtx.Bool(flags.DisablePenaltyRewardLogFlag.Name),
+		PerformanceRetention:    primitives.Epoch(c.cliCtx.Uint64(flags.PerformanceHistoryRetentionFlag.Name)),
 		EmitAccountMetrics:      !c.cliCtx.Bool(flags.DisableAccountMetricsFlag.Name),
 		Distributed:             c.cliCtx.Bool(flags.EnableDistributed.Name),
+		BeaconNodeQuorum:        c.cliCtx.Bool(flags.BeaconNodeQuorumFlag.Name),
+		AuditLog:                c.auditLog,
 	})
 	if err != nil {
 		return errors.Wrap(err, "could not initialize validator service")
@@ -521,17 +576,27 @@ func (c *ValidatorClient) registerValidatorService(cliCtx *cli.Context) error {
 func Web3SignerConfig(cliCtx *cli.Context) (*remoteweb3signer.SetupConfig, error) {
 	var web3signerConfig *remoteweb3signer.SetupConfig
 	if cliCtx.IsSet(flags.Web3SignerURLFlag.Name) {
-		urlStr := cliCtx.String(flags.Web3SignerURLFlag.Name)
-		u, err := url.ParseRequestURI(urlStr)
-		if err != nil {
-			return nil, errors.Wrapf(err, "web3signer url %s is invalid", urlStr)
-		}
-		if u.Scheme == "" || u.Host == "" {
-			return nil, fmt.Errorf("web3signer url must be in the format of http(s)://host:port url used: %v", urlStr)
+		var endpoints []string
+		for _, urlStr := range strings.Split(cliCtx.String(flags.Web3SignerURLFlag.Name), ",") {
+			urlStr = strings.TrimSpace(urlStr)
+			u, err := url.ParseRequestURI(urlStr)
+			if err != nil {
+				return nil, errors.Wrapf(err, "web3signer url %s is invalid", urlStr)
+			}
+			if u.Scheme == "" || u.Host == "" {
+				return nil, fmt.Errorf("web3signer url must be in the format of http(s)://host:port url used: %v", urlStr)
+			}
+			endpoints = append(endpoints, u.String())
 		}
 		web3signerConfig = &remoteweb3signer.SetupConfig{
-			BaseEndpoint:          u.String(),
+			BaseEndpoint:          endpoints[0],
 			GenesisValidatorsRoot: nil,
+			TLSClientCertPath:     cliCtx.String(flags.Web3SignerTLSClientCertFlag.Name),
+			TLSClientKeyPath:      cliCtx.String(flags.Web3SignerTLSClientKeyFlag.Name),
+			TLSCACertPath:         cliCtx.String(flags.Web3SignerTLSCACertFlag.Name),
+		}
+		if len(endpoints) > 1 {
+			web3signerConfig.FailoverEndpoints = endpoints[1:]
 		}
 		if cliCtx.IsSet(flags.WalletPasswordFileFlag.Name) {
 			log.Warnf("%s was provided while using web3signer and will be ignored", flags.WalletPasswordFileFlag.Name)
@@ -552,6 +617,9 @@ func Web3SignerConfig(cliCtx *cli.Context) (*remoteweb3signer.SetupConfig, error
 		if cliCtx.IsSet(flags.Web3SignerKeyFileFlag.Name) {
 			web3signerConfig.KeyFilePath = cliCtx.String(flags.Web3SignerKeyFileFlag.Name)
 		}
+		if cliCtx.IsSet(flags.Web3SignerPublicKeysPollIntervalFlag.Name) {
+			web3signerConfig.PublicKeysPollInterval = cliCtx.Duration(flags.Web3SignerPublicKeysPollIntervalFlag.Name)
+		}
 	}
 	return web3signerConfig, nil
 }
@@ -619,6 +687,7 @@ func (c *ValidatorClient) registerRPCService(router *http.ServeMux) error {
 		WalletDir:              walletDir,
 		WalletInitializedFeed:  c.walletInitializedFeed,
 		ValidatorService:       vs,
+		AuditLog:               c.auditLog,
 		AuthTokenPath:          authTokenPath,
 		Middlewares:            middlewares,
 		Router:                 router,
@@ -250,6 +250,20 @@ func TestWeb3SignerConfig(t *testing.T) {
 				ProvidedPublicKeys:    nil,
 			},
 		},
+		{
+			name: "happy path with failover urls",
+			args: &args{
+				baseURL:          "https://signer-a:9000, https://signer-b:9000",
+				publicKeysOrURLs: []string{"http://localhost:8545/api/v1/eth2/publicKeys"},
+			},
+			want: &remoteweb3signer.SetupConfig{
+				BaseEndpoint:          "https://signer-a:9000",
+				FailoverEndpoints:     []string{"https://signer-b:9000"},
+				GenesisValidatorsRoot: nil,
+				PublicKeysURL:         "http://localhost:8545/api/v1/eth2/publicKeys",
+				ProvidedPublicKeys:    nil,
+			},
+		},
 		{
 			name: "Bad base URL",
 			args: &args{
@@ -258,7 +272,7 @@ func TestWeb3SignerConfig(t *testing.T) {
 					"0xb89bebc699769726a318c8e9971bd3171297c61aea4a6578a7a4f94b547dcba5bac16a89108b6b6a1fe3695d1a874a0b"},
 			},
 			want:       nil,
-			wantErrMsg: "web3signer url 0xa99a76ed7796f7be22d5b7e85deeb7c5677e88, is invalid: parse \"0xa99a76ed7796f7be22d5b7e85deeb7c5677e88,\": invalid URI for request",
+			wantErrMsg: "web3signer url 0xa99a76ed7796f7be22d5b7e85deeb7c5677e88 is invalid: parse \"0xa99a76ed7796f7be22d5b7e85deeb7c5677e88\": invalid URI for request",
 		},
 		{
 			name: "Base URL missing scheme or host",
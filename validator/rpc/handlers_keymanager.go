@@ -23,7 +23,11 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
 	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing/trace"
 	"github.com/prysmaticlabs/prysm/v5/network/httputil"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/validator/audit"
 	"github.com/prysmaticlabs/prysm/v5/validator/client"
+	"github.com/prysmaticlabs/prysm/v5/validator/client/iface"
+	dbCommon "github.com/prysmaticlabs/prysm/v5/validator/db/common"
 	"github.com/prysmaticlabs/prysm/v5/validator/keymanager"
 	"github.com/prysmaticlabs/prysm/v5/validator/keymanager/derived"
 	slashingprotection "github.com/prysmaticlabs/prysm/v5/validator/slashing-protection-history"
@@ -366,7 +370,7 @@ func (s *Server) SetVoluntaryExit(w http.ResponseWriter, r *http.Request) {
 	sve, err := client.CreateSignedVoluntaryExit(
 		ctx,
 		s.beaconNodeValidatorClient,
-		km.Sign,
+		s.auditLog.WrapSigner(km.Sign),
 		pubkey,
 		epoch,
 	)
@@ -387,6 +391,103 @@ func (s *Server) SetVoluntaryExit(w http.ResponseWriter, r *http.Request) {
 	httputil.WriteJson(w, response)
 }
 
+// SetBLSToExecutionChange signs a BLS to execution change of a validator, setting its withdrawal credentials to
+// an execution address. The change is signed with the BLS withdrawal key given by from_bls_pubkey, which defaults to
+// the validator key and must be managed by the validator client. The signed change is only submitted to the beacon
+// node when broadcast is set, otherwise it is returned for the caller to submit.
+func (s *Server) SetBLSToExecutionChange(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "validator.keymanagerAPI.SetBLSToExecutionChange")
+	defer span.End()
+
+	if s.validatorService == nil {
+		httputil.HandleError(w, "Validator service not ready", http.StatusServiceUnavailable)
+		return
+	}
+
+	if !s.walletInitialized {
+		httputil.HandleError(w, "No wallet found", http.StatusServiceUnavailable)
+		return
+	}
+
+	km, err := s.validatorService.Keymanager()
+	if err != nil {
+		httputil.HandleError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	_, pubkey, ok := shared.HexFromRoute(w, r, "pubkey", fieldparams.BLSPubkeyLength)
+	if !ok {
+		return
+	}
+
+	var req SetBLSToExecutionChangeRequest
+	err = json.NewDecoder(r.Body).Decode(&req)
+	switch {
+	case errors.Is(err, io.EOF):
+		httputil.HandleError(w, "No data submitted", http.StatusBadRequest)
+		return
+	case err != nil:
+		httputil.HandleError(w, "Could not decode request body: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	toExecutionAddress, valid := shared.ValidateHex(w, "to_execution_address", req.ToExecutionAddress, fieldparams.FeeRecipientLength)
+	if !valid {
+		return
+	}
+	fromBLSPubkey := pubkey
+	if req.FromBLSPubkey != "" {
+		fromBLSPubkey, valid = shared.ValidateHex(w, "from_bls_pubkey", req.FromBLSPubkey, fieldparams.BLSPubkeyLength)
+		if !valid {
+			return
+		}
+	}
+
+	pubkeys, err := km.FetchValidatingPublicKeys(ctx)
+	if err != nil {
+		httputil.HandleError(w, errors.Wrap(err, "Could not get public keys from keymanager").Error(), http.StatusInternalServerError)
+		return
+	}
+	managed := false
+	for _, pk := range pubkeys {
+		if bytes.Equal(pk[:], fromBLSPubkey) {
+			managed = true
+			break
+		}
+	}
+	if !managed {
+		httputil.HandleError(w, fmt.Sprintf("BLS withdrawal key %#x is not managed by the validator client", fromBLSPubkey), http.StatusBadRequest)
+		return
+	}
+
+	change, err := client.CreateSignedBLSToExecutionChange(
+		ctx,
+		s.chainClient,
+		s.nodeClient,
+		s.auditLog.WrapSigner(km.Sign),
+		pubkey,
+		fromBLSPubkey,
+		common.BytesToAddress(toExecutionAddress),
+	)
+	if err != nil {
+		httputil.HandleError(w, errors.Wrap(err, "Could not create BLS to execution change").Error(), http.StatusInternalServerError)
+		return
+	}
+	data := structs.SignedBLSChangeFromConsensus(change)
+
+	if req.Broadcast {
+		err := s.nodeClient.SubmitBLSToExecutionChanges(ctx, []*ethpb.SignedBLSToExecutionChange{change})
+		if errors.Is(err, iface.ErrNotSupported) {
+			httputil.HandleError(w, "Broadcasting BLS to execution changes requires the beacon REST API, enabled with --enable-beacon-rest-api", http.StatusNotImplemented)
+			return
+		}
+		if err != nil {
+			httputil.HandleError(w, errors.Wrap(err, "Could not submit BLS to execution change").Error(), http.StatusInternalServerError)
+			return
+		}
+	}
+
+	httputil.WriteJson(w, &SetBLSToExecutionChangeResponse{Data: data})
+}
+
 // ListRemoteKeys returns a list of all public keys defined for web3signer keymanager type.
 func (s *Server) ListRemoteKeys(w http.ResponseWriter, r *http.Request) {
 	ctx, span := trace.StartSpan(r.Context(), "validator.keymanagerAPI.ListRemoteKeys")
@@ -940,3 +1041,247 @@ func (s *Server) DeleteGraffiti(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 }
+
+const (
+	defaultAuditLogLimit = 100
+	maxAuditLogLimit     = 1000
+)
+
+// GetAuditLog returns the entries of the signing audit log, starting at the sequence number given by the from
+// query parameter. Entries can be filtered by validator public key. The next_seq field of the response is the
+// sequence number to request the following page from.
+func (s *Server) GetAuditLog(w http.ResponseWriter, r *http.Request) {
+	_, span := trace.StartSpan(r.Context(), "validator.keymanagerAPI.GetAuditLog")
+	defer span.End()
+
+	if s.auditLog == nil {
+		httputil.HandleError(w, "Signing audit log is not enabled", http.StatusNotFound)
+		return
+	}
+	_, from, ok := shared.UintFromQuery(w, r, "from", false)
+	if !ok {
+		return
+	}
+	_, limit, ok := shared.UintFromQuery(w, r, "limit", false)
+	if !ok {
+		return
+	}
+	if limit == 0 {
+		limit = defaultAuditLogLimit
+	}
+	if limit > maxAuditLogLimit {
+		httputil.HandleError(w, fmt.Sprintf("Limit must be at most %d", maxAuditLogLimit), http.StatusBadRequest)
+		return
+	}
+	_, pubkey, ok := shared.HexFromQuery(w, r, "pubkey", fieldparams.BLSPubkeyLength, false)
+	if !ok {
+		return
+	}
+	var filter func(*audit.Entry) bool
+	if pubkey != nil {
+		encoded := hexutil.Encode(pubkey)
+		filter = func(e *audit.Entry) bool { return e.Pubkey == encoded }
+	}
+
+	entries, err := s.auditLog.Entries(from, int(limit), filter)
+	if err != nil {
+		httputil.HandleError(w, errors.Wrap(err, "Could not read signing audit log").Error(), http.StatusInternalServerError)
+		return
+	}
+	if entries == nil {
+		entries = []*audit.Entry{}
+	}
+	nextSeq := from
+	if len(entries) > 0 {
+		nextSeq = entries[len(entries)-1].Seq + 1
+	}
+	httputil.WriteJson(w, &GetAuditLogResponse{
+		Data:    entries,
+		NextSeq: fmt.Sprintf("%d", nextSeq),
+	})
+}
+
+const (
+	defaultPerformanceHistoryLimit = 100
+	maxPerformanceHistoryLimit     = 1000
+)
+
+// GetPerformanceHistory returns the per epoch duty outcomes and balance changes of a validator public key,
+// with epochs between the start_epoch and end_epoch query parameters. The next_epoch field of the response
+// is the start epoch to request the following page from, and is empty when there are no more records.
+func (s *Server) GetPerformanceHistory(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "validator.keymanagerAPI.GetPerformanceHistory")
+	defer span.End()
+
+	if s.db == nil {
+		httputil.HandleError(w, "Validator database not ready", http.StatusServiceUnavailable)
+		return
+	}
+	_, pubkey, ok := shared.HexFromRoute(w, r, "pubkey", fieldparams.BLSPubkeyLength)
+	if !ok {
+		return
+	}
+	_, startEpoch, ok := shared.UintFromQuery(w, r, "start_epoch", false)
+	if !ok {
+		return
+	}
+	rawEndEpoch, endEpoch, ok := shared.UintFromQuery(w, r, "end_epoch", false)
+	if !ok {
+		return
+	}
+	if rawEndEpoch == "" {
+		endEpoch = uint64(params.BeaconConfig().FarFutureEpoch)
+	}
+	if endEpoch < startEpoch {
+		httputil.HandleError(w, "End epoch must not be lower than start epoch", http.StatusBadRequest)
+		return
+	}
+	_, limit, ok := shared.UintFromQuery(w, r, "limit", false)
+	if !ok {
+		return
+	}
+	if limit == 0 {
+		limit = defaultPerformanceHistoryLimit
+	}
+	if limit > maxPerformanceHistoryLimit {
+		httputil.HandleError(w, fmt.Sprintf("Limit must be at most %d", maxPerformanceHistoryLimit), http.StatusBadRequest)
+		return
+	}
+
+	records, err := s.db.PerformanceHistory(ctx, bytesutil.ToBytes48(pubkey), primitives.Epoch(startEpoch), primitives.Epoch(endEpoch), int(limit))
+	if err != nil {
+		httputil.HandleError(w, errors.Wrap(err, "Could not read performance history").Error(), http.StatusInternalServerError)
+		return
+	}
+	data := make([]*PerformanceRecord, len(records))
+	for i, record := range records {
+		data[i] = PerformanceRecordFromDB(record)
+	}
+	var nextEpoch string
+	if len(records) == int(limit) && records[len(records)-1].Epoch < primitives.Epoch(endEpoch) {
+		nextEpoch = fmt.Sprintf("%d", records[len(records)-1].Epoch+1)
+	}
+	httputil.WriteJson(w, &GetPerformanceHistoryResponse{
+		Data:      data,
+		NextEpoch: nextEpoch,
+	})
+}
+
+// ListExitIntents returns the scheduled and conditional voluntary exits registered in the validator client,
+// with their processing status.
+func (s *Server) ListExitIntents(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "validator.keymanagerAPI.ListExitIntents")
+	defer span.End()
+
+	if s.db == nil {
+		httputil.HandleError(w, "Validator database not ready", http.StatusServiceUnavailable)
+		return
+	}
+	intents, err := s.db.ExitIntents(ctx)
+	if err != nil {
+		httputil.HandleError(w, errors.Wrap(err, "Could not read exit intents").Error(), http.StatusInternalServerError)
+		return
+	}
+	data := make([]*ExitIntent, len(intents))
+	for i, intent := range intents {
+		data[i] = ExitIntentFromDB(intent)
+	}
+	httputil.WriteJson(w, &ListExitIntentsResponse{Data: data})
+}
+
+// SetExitIntent registers a voluntary exit of a validator public key, submitted by the validator client
+// once the epoch is reached or once the balance of the validator drops below balance_below_gwei,
+// whichever comes first. It replaces any pending exit intent of the key.
+func (s *Server) SetExitIntent(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "validator.keymanagerAPI.SetExitIntent")
+	defer span.End()
+
+	if s.db == nil {
+		httputil.HandleError(w, "Validator database not ready", http.StatusServiceUnavailable)
+		return
+	}
+	_, pubkey, ok := shared.HexFromRoute(w, r, "pubkey", fieldparams.BLSPubkeyLength)
+	if !ok {
+		return
+	}
+
+	var req SetExitIntentRequest
+	err := json.NewDecoder(r.Body).Decode(&req)
+	switch {
+	case errors.Is(err, io.EOF):
+		httputil.HandleError(w, "No data submitted", http.StatusBadRequest)
+		return
+	case err != nil:
+		httputil.HandleError(w, "Could not decode request body: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	intent := &dbCommon.ExitIntent{PubKey: bytesutil.ToBytes48(pubkey), Status: dbCommon.ExitIntentPending}
+	if req.Epoch != "" {
+		epoch, ok := shared.ValidateUint(w, "epoch", req.Epoch)
+		if !ok {
+			return
+		}
+		intent.Epoch = primitives.Epoch(epoch)
+	}
+	if req.BalanceBelowGwei != "" {
+		intent.BalanceBelowGwei, ok = shared.ValidateUint(w, "balance_below_gwei", req.BalanceBelowGwei)
+		if !ok {
+			return
+		}
+	}
+	if intent.Epoch == 0 && intent.BalanceBelowGwei == 0 {
+		httputil.HandleError(w, "At least one of epoch and balance_below_gwei must be a positive number", http.StatusBadRequest)
+		return
+	}
+
+	intents, err := s.db.ExitIntents(ctx)
+	if err != nil {
+		httputil.HandleError(w, errors.Wrap(err, "Could not read exit intents").Error(), http.StatusInternalServerError)
+		return
+	}
+	for _, existing := range intents {
+		if existing.PubKey == intent.PubKey && existing.Status == dbCommon.ExitIntentSubmitted {
+			httputil.HandleError(w, "The voluntary exit of this validator was already submitted", http.StatusConflict)
+			return
+		}
+	}
+	if err := s.db.SaveExitIntent(ctx, intent); err != nil {
+		httputil.HandleError(w, errors.Wrap(err, "Could not save exit intent").Error(), http.StatusInternalServerError)
+		return
+	}
+}
+
+// DeleteExitIntent removes the exit intent of a validator public key.
+func (s *Server) DeleteExitIntent(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "validator.keymanagerAPI.DeleteExitIntent")
+	defer span.End()
+
+	if s.db == nil {
+		httputil.HandleError(w, "Validator database not ready", http.StatusServiceUnavailable)
+		return
+	}
+	_, pubkey, ok := shared.HexFromRoute(w, r, "pubkey", fieldparams.BLSPubkeyLength)
+	if !ok {
+		return
+	}
+	intents, err := s.db.ExitIntents(ctx)
+	if err != nil {
+		httputil.HandleError(w, errors.Wrap(err, "Could not read exit intents").Error(), http.StatusInternalServerError)
+		return
+	}
+	found := false
+	for _, existing := range intents {
+		if existing.PubKey == bytesutil.ToBytes48(pubkey) {
+			found = true
+			break
+		}
+	}
+	if !found {
+		httputil.HandleError(w, "No exit intent found for this validator", http.StatusNotFound)
+		return
+	}
+	if err := s.db.DeleteExitIntent(ctx, bytesutil.ToBytes48(pubkey)); err != nil {
+		httputil.HandleError(w, errors.Wrap(err, "Could not delete exit intent").Error(), http.StatusInternalServerError)
+		return
+	}
+}
@@ -16,12 +16,16 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/signing"
 	"github.com/prysmaticlabs/prysm/v5/cmd/validator/flags"
 	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
 	"github.com/prysmaticlabs/prysm/v5/config/params"
 	"github.com/prysmaticlabs/prysm/v5/config/proposer"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/validator"
+	"github.com/prysmaticlabs/prysm/v5/crypto/bls"
+	"github.com/prysmaticlabs/prysm/v5/crypto/hash"
 	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
 	eth "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v5/testing/assert"
@@ -31,7 +35,9 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/validator/accounts/iface"
 	mock "github.com/prysmaticlabs/prysm/v5/validator/accounts/testing"
 	"github.com/prysmaticlabs/prysm/v5/validator/accounts/wallet"
+	"github.com/prysmaticlabs/prysm/v5/validator/audit"
 	"github.com/prysmaticlabs/prysm/v5/validator/client"
+	clientIface "github.com/prysmaticlabs/prysm/v5/validator/client/iface"
 	dbCommon "github.com/prysmaticlabs/prysm/v5/validator/db/common"
 	"github.com/prysmaticlabs/prysm/v5/validator/db/filesystem"
 	DBIface "github.com/prysmaticlabs/prysm/v5/validator/db/iface"
@@ -897,6 +903,161 @@ func TestServer_SetVoluntaryExit(t *testing.T) {
 	}
 }
 
+func TestServer_SetBLSToExecutionChange(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+	defaultWalletPath = setupWalletDir(t)
+	opts := []accounts.Option{
+		accounts.WithWalletDir(defaultWalletPath),
+		accounts.WithKeymanagerType(keymanager.Derived),
+		accounts.WithWalletPassword(strongPass),
+		accounts.WithSkipMnemonicConfirm(true),
+	}
+	acc, err := accounts.NewCLIManager(opts...)
+	require.NoError(t, err)
+	w, err := acc.WalletCreate(ctx)
+	require.NoError(t, err)
+	km, err := w.InitializeKeymanager(ctx, iface.InitKeymanagerConfig{ListenForChanges: false})
+	require.NoError(t, err)
+	vs, err := client.NewValidatorService(ctx, &client.Config{
+		Validator: &mock.Validator{Km: km},
+	})
+	require.NoError(t, err)
+	dr, ok := km.(*derived.Keymanager)
+	require.Equal(t, true, ok)
+	require.NoError(t, dr.RecoverAccountsFromMnemonic(ctx, mocks.TestMnemonic, derived.DefaultMnemonicLanguage, "", 2))
+	pubKeys, err := dr.FetchValidatingPublicKeys(ctx)
+	require.NoError(t, err)
+
+	// The first key is used as the BLS withdrawal key of the validator.
+	keyHash := hash.Hash(pubKeys[0][:])
+	credentials := append([]byte{params.BeaconConfig().BLSWithdrawalPrefixByte}, keyHash[1:]...)
+	chainClient := validatormock.NewMockChainClient(ctrl)
+	chainClient.EXPECT().Validators(gomock.Any(), &eth.ListValidatorsRequest{PublicKeys: [][]byte{pubKeys[0][:]}}).
+		AnyTimes().
+		Return(&eth.Validators{ValidatorList: []*eth.Validators_ValidatorContainer{
+			{Index: 5, Validator: &eth.Validator{PublicKey: pubKeys[0][:], WithdrawalCredentials: credentials}},
+		}}, nil)
+	genesisValidatorsRoot := bytesutil.PadTo([]byte("genesis"), fieldparams.RootLength)
+	nodeClient := validatormock.NewMockNodeClient(ctrl)
+	nodeClient.EXPECT().Genesis(gomock.Any(), gomock.Any()).
+		AnyTimes().
+		Return(&eth.Genesis{GenesisValidatorsRoot: genesisValidatorsRoot}, nil)
+
+	var submitted []*eth.SignedBLSToExecutionChange
+	nodeClient.EXPECT().SubmitBLSToExecutionChanges(gomock.Any(), gomock.Any()).
+		AnyTimes().
+		DoAndReturn(func(_ context.Context, changes []*eth.SignedBLSToExecutionChange) error {
+			submitted = changes
+			return nil
+		})
+
+	s := &Server{
+		validatorService:  vs,
+		chainClient:       chainClient,
+		nodeClient:        nodeClient,
+		wallet:            w,
+		walletInitialized: true,
+	}
+	address := "0x" + strings.Repeat("ab", 20)
+
+	tests := []struct {
+		name      string
+		body      string
+		code      int
+		errMsg    string
+		broadcast bool
+	}{
+		{
+			name: "dry run",
+			body: fmt.Sprintf(`{"to_execution_address":"%s"}`, address),
+			code: http.StatusOK,
+		},
+		{
+			name:      "broadcast",
+			body:      fmt.Sprintf(`{"to_execution_address":"%s","from_bls_pubkey":"%s","broadcast":true}`, address, hexutil.Encode(pubKeys[0][:])),
+			code:      http.StatusOK,
+			broadcast: true,
+		},
+		{
+			name:   "invalid address",
+			body:   `{"to_execution_address":"0x1234"}`,
+			code:   http.StatusBadRequest,
+			errMsg: "to_execution_address",
+		},
+		{
+			name:   "unmanaged withdrawal key",
+			body:   fmt.Sprintf(`{"to_execution_address":"%s","from_bls_pubkey":"0x%s"}`, address, strings.Repeat("01", 48)),
+			code:   http.StatusBadRequest,
+			errMsg: "is not managed by the validator client",
+		},
+		{
+			name:   "withdrawal key not matching the credentials",
+			body:   fmt.Sprintf(`{"to_execution_address":"%s","from_bls_pubkey":"%s"}`, address, hexutil.Encode(pubKeys[1][:])),
+			code:   http.StatusInternalServerError,
+			errMsg: "do not match BLS withdrawal key",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			submitted = nil
+			req := httptest.NewRequest(http.MethodPost, "/eth/v1/validator/{pubkey}/bls_to_execution_change", strings.NewReader(tt.body))
+			req.SetPathValue("pubkey", hexutil.Encode(pubKeys[0][:]))
+			w := httptest.NewRecorder()
+			w.Body = &bytes.Buffer{}
+			s.SetBLSToExecutionChange(w, req)
+			require.Equal(t, tt.code, w.Code)
+			if tt.errMsg != "" {
+				require.StringContains(t, tt.errMsg, w.Body.String())
+				return
+			}
+			resp := &SetBLSToExecutionChangeResponse{}
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), resp))
+			assert.Equal(t, "5", resp.Data.Message.ValidatorIndex)
+			assert.Equal(t, address, resp.Data.Message.ToExecutionAddress)
+
+			// The signature is valid for the genesis fork version.
+			change, err := resp.Data.ToConsensus()
+			require.NoError(t, err)
+			domain, err := signing.ComputeDomain(params.BeaconConfig().DomainBLSToExecutionChange, params.BeaconConfig().GenesisForkVersion, genesisValidatorsRoot)
+			require.NoError(t, err)
+			root, err := signing.ComputeSigningRoot(change.Message, domain)
+			require.NoError(t, err)
+			pk, err := bls.PublicKeyFromBytes(pubKeys[0][:])
+			require.NoError(t, err)
+			sig, err := bls.SignatureFromBytes(change.Signature)
+			require.NoError(t, err)
+			assert.Equal(t, true, sig.Verify(pk, root[:]))
+
+			if tt.broadcast {
+				require.Equal(t, 1, len(submitted))
+				assert.DeepEqual(t, resp.Data, structs.SignedBLSChangeFromConsensus(submitted[0]))
+			} else {
+				assert.Equal(t, 0, len(submitted))
+			}
+		})
+	}
+
+	t.Run("broadcast not supported by the node client", func(t *testing.T) {
+		grpcNodeClient := validatormock.NewMockNodeClient(ctrl)
+		grpcNodeClient.EXPECT().Genesis(gomock.Any(), gomock.Any()).
+			Return(&eth.Genesis{GenesisValidatorsRoot: genesisValidatorsRoot}, nil)
+		grpcNodeClient.EXPECT().SubmitBLSToExecutionChanges(gomock.Any(), gomock.Any()).
+			Return(clientIface.ErrNotSupported)
+		s.nodeClient = grpcNodeClient
+		body := fmt.Sprintf(`{"to_execution_address":"%s","broadcast":true}`, address)
+		req := httptest.NewRequest(http.MethodPost, "/eth/v1/validator/{pubkey}/bls_to_execution_change", strings.NewReader(body))
+		req.SetPathValue("pubkey", hexutil.Encode(pubKeys[0][:]))
+		w := httptest.NewRecorder()
+		w.Body = &bytes.Buffer{}
+		s.SetBLSToExecutionChange(w, req)
+		require.Equal(t, http.StatusNotImplemented, w.Code)
+		require.StringContains(t, "--enable-beacon-rest-api", w.Body.String())
+	})
+}
+
 func TestServer_GetGasLimit(t *testing.T) {
 	ctx := context.Background()
 	byteval, err := hexutil.Decode("0xaf2e7ba294e03438ea819bd4033c6c1bf6b04320ee2075b77273c08d02f8a61bcc303c2c06bd3713cb442072ae591493")
@@ -1981,3 +2142,182 @@ func TestServer_Graffiti(t *testing.T) {
 	s.DeleteGraffiti(w, req)
 	require.Equal(t, http.StatusOK, w.Code)
 }
+
+func TestServer_GetAuditLog(t *testing.T) {
+	s := &Server{}
+	req := httptest.NewRequest(http.MethodGet, "/eth/v1/validator/audit_log", nil)
+	w := httptest.NewRecorder()
+	w.Body = &bytes.Buffer{}
+	s.GetAuditLog(w, req)
+	require.Equal(t, http.StatusNotFound, w.Code)
+
+	auditLog, err := audit.Open(t.TempDir(), 0)
+	require.NoError(t, err)
+	defer func() { require.NoError(t, auditLog.Close()) }()
+	s.auditLog = auditLog
+	pubkey1, pubkey2 := make([]byte, fieldparams.BLSPubkeyLength), make([]byte, fieldparams.BLSPubkeyLength)
+	pubkey2[0] = 1
+	for i := 0; i < 5; i++ {
+		pubkey := pubkey1
+		if i%2 == 1 {
+			pubkey = pubkey2
+		}
+		auditLog.Record(audit.NewEntry(pubkey, audit.DutyAttestation, primitives.Slot(i), make([]byte, 32), audit.VerdictSafe, nil, time.Millisecond))
+	}
+
+	tests := []struct {
+		name    string
+		query   string
+		seqs    []uint64
+		nextSeq string
+	}{
+		{name: "all", query: "", seqs: []uint64{1, 2, 3, 4, 5}, nextSeq: "6"},
+		{name: "paged", query: "?from=2&limit=2", seqs: []uint64{2, 3}, nextSeq: "4"},
+		{name: "by pubkey", query: "?from=2&pubkey=" + hexutil.Encode(pubkey1), seqs: []uint64{3, 5}, nextSeq: "6"},
+		{name: "past the end", query: "?from=10", seqs: []uint64{}, nextSeq: "10"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/eth/v1/validator/audit_log"+tt.query, nil)
+			w := httptest.NewRecorder()
+			w.Body = &bytes.Buffer{}
+			s.GetAuditLog(w, req)
+			require.Equal(t, http.StatusOK, w.Code)
+			resp := &GetAuditLogResponse{}
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), resp))
+			require.Equal(t, len(tt.seqs), len(resp.Data))
+			for i, e := range resp.Data {
+				assert.Equal(t, tt.seqs[i], e.Seq)
+			}
+			assert.Equal(t, tt.nextSeq, resp.NextSeq)
+		})
+	}
+
+	req = httptest.NewRequest(http.MethodGet, "/eth/v1/validator/audit_log?limit=1001", nil)
+	w = httptest.NewRecorder()
+	w.Body = &bytes.Buffer{}
+	s.GetAuditLog(w, req)
+	require.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestServer_GetPerformanceHistory(t *testing.T) {
+	ctx := context.Background()
+	pubkey := [fieldparams.BLSPubkeyLength]byte{1}
+	validatorDB := dbtest.SetupDB(t, [][fieldparams.BLSPubkeyLength]byte{pubkey}, false)
+	records := make([]*dbCommon.PerformanceRecord, 0)
+	for epoch := primitives.Epoch(1); epoch <= 5; epoch++ {
+		records = append(records, &dbCommon.PerformanceRecord{
+			PubKey:        pubkey,
+			Epoch:         epoch,
+			ProposedSlots: []primitives.Slot{primitives.Slot(epoch) * 32},
+			BalanceBefore: 32_000_000_000,
+			BalanceAfter:  31_999_999_990,
+		})
+	}
+	require.NoError(t, validatorDB.SavePerformanceRecords(ctx, records))
+	s := &Server{db: validatorDB}
+
+	tests := []struct {
+		name      string
+		query     string
+		epochs    []string
+		nextEpoch string
+	}{
+		{name: "all", query: "", epochs: []string{"1", "2", "3", "4", "5"}, nextEpoch: ""},
+		{name: "paged", query: "?start_epoch=2&limit=2", epochs: []string{"2", "3"}, nextEpoch: "4"},
+		{name: "range", query: "?start_epoch=2&end_epoch=3&limit=2", epochs: []string{"2", "3"}, nextEpoch: ""},
+		{name: "past the end", query: "?start_epoch=10", epochs: []string{}, nextEpoch: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/eth/v1/validator/{pubkey}/performance_history"+tt.query, nil)
+			req.SetPathValue("pubkey", hexutil.Encode(pubkey[:]))
+			w := httptest.NewRecorder()
+			w.Body = &bytes.Buffer{}
+			s.GetPerformanceHistory(w, req)
+			require.Equal(t, http.StatusOK, w.Code)
+			resp := &GetPerformanceHistoryResponse{}
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), resp))
+			require.Equal(t, len(tt.epochs), len(resp.Data))
+			for i, record := range resp.Data {
+				assert.Equal(t, tt.epochs[i], record.Epoch)
+				assert.Equal(t, "-10", record.BalanceChange)
+				assert.Equal(t, 1, len(record.ProposedSlots))
+			}
+			assert.Equal(t, tt.nextEpoch, resp.NextEpoch)
+		})
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/eth/v1/validator/{pubkey}/performance_history?start_epoch=3&end_epoch=2", nil)
+	req.SetPathValue("pubkey", hexutil.Encode(pubkey[:]))
+	w := httptest.NewRecorder()
+	w.Body = &bytes.Buffer{}
+	s.GetPerformanceHistory(w, req)
+	require.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestServer_ExitIntents(t *testing.T) {
+	ctx := context.Background()
+	pubkey := [fieldparams.BLSPubkeyLength]byte{1}
+	submittedPubkey := [fieldparams.BLSPubkeyLength]byte{2}
+	validatorDB := dbtest.SetupDB(t, [][fieldparams.BLSPubkeyLength]byte{pubkey, submittedPubkey}, false)
+	require.NoError(t, validatorDB.SaveExitIntent(ctx, &dbCommon.ExitIntent{
+		PubKey:         submittedPubkey,
+		Epoch:          10,
+		Status:         dbCommon.ExitIntentSubmitted,
+		SubmittedEpoch: 10,
+	}))
+	s := &Server{db: validatorDB}
+
+	setIntent := func(pubkey [fieldparams.BLSPubkeyLength]byte, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/eth/v1/validator/{pubkey}/exit_intent", bytes.NewBufferString(body))
+		req.SetPathValue("pubkey", hexutil.Encode(pubkey[:]))
+		w := httptest.NewRecorder()
+		w.Body = &bytes.Buffer{}
+		s.SetExitIntent(w, req)
+		return w
+	}
+	deleteIntent := func(pubkey [fieldparams.BLSPubkeyLength]byte) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodDelete, "/eth/v1/validator/{pubkey}/exit_intent", nil)
+		req.SetPathValue("pubkey", hexutil.Encode(pubkey[:]))
+		w := httptest.NewRecorder()
+		w.Body = &bytes.Buffer{}
+		s.DeleteExitIntent(w, req)
+		return w
+	}
+	listIntents := func() *ListExitIntentsResponse {
+		req := httptest.NewRequest(http.MethodGet, "/eth/v1/validator/exit_intents", nil)
+		w := httptest.NewRecorder()
+		w.Body = &bytes.Buffer{}
+		s.ListExitIntents(w, req)
+		require.Equal(t, http.StatusOK, w.Code)
+		resp := &ListExitIntentsResponse{}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), resp))
+		return resp
+	}
+
+	assert.Equal(t, http.StatusBadRequest, setIntent(pubkey, `{}`).Code)
+	assert.Equal(t, http.StatusBadRequest, setIntent(pubkey, `{"epoch":"abc"}`).Code)
+	assert.Equal(t, http.StatusConflict, setIntent(submittedPubkey, `{"epoch":"20"}`).Code)
+	require.Equal(t, http.StatusOK, setIntent(pubkey, `{"epoch":"20","balance_below_gwei":"31000000000"}`).Code)
+
+	resp := listIntents()
+	require.Equal(t, 2, len(resp.Data))
+	assert.DeepEqual(t, &ExitIntent{
+		Pubkey:           hexutil.Encode(pubkey[:]),
+		Epoch:            "20",
+		BalanceBelowGwei: "31000000000",
+		Status:           "pending",
+	}, resp.Data[0])
+	assert.DeepEqual(t, &ExitIntent{
+		Pubkey:           hexutil.Encode(submittedPubkey[:]),
+		Epoch:            "10",
+		BalanceBelowGwei: "0",
+		Status:           "submitted",
+		SubmittedEpoch:   "10",
+	}, resp.Data[1])
+
+	require.Equal(t, http.StatusOK, deleteIntent(pubkey).Code)
+	assert.Equal(t, http.StatusNotFound, deleteIntent(pubkey).Code)
+	assert.Equal(t, 1, len(listIntents().Data))
+}
@@ -3,10 +3,14 @@ package rpc
 import (
 	"bytes"
 	"encoding/json"
+	"fmt"
 	"io"
 	"net/http"
 
 	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/eth/shared"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
 	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing/trace"
 	"github.com/prysmaticlabs/prysm/v5/network/httputil"
 	slashing "github.com/prysmaticlabs/prysm/v5/validator/slashing-protection-history"
@@ -16,10 +20,14 @@ import (
 // The format of the export follows the EIP-3076 standard which makes it
 // easy to migrate machines or Ethereum consensus clients.
 //
+// The export can be restricted with the public_keys query parameter, and reduced to the
+// latest signed block and attestation of every key with minimal=true.
+//
 // Steps:
 //  1. Call the function which exports the data from
 //     the validator's db into an EIP standard slashing protection format.
-//  2. Format and send JSON in the response.
+//  2. Minimize the export if requested.
+//  3. Format and send JSON in the response.
 func (s *Server) ExportSlashingProtection(w http.ResponseWriter, r *http.Request) {
 	ctx, span := trace.StartSpan(r.Context(), "validator.ExportSlashingProtection")
 	defer span.End()
@@ -29,11 +37,28 @@ func (s *Server) ExportSlashingProtection(w http.ResponseWriter, r *http.Request
 		return
 	}
 
-	eipJSON, err := slashing.ExportStandardProtectionJSON(ctx, s.db)
+	publicKeys := r.URL.Query()["public_keys"]
+	pubkeys := make([][]byte, len(publicKeys))
+	for i, key := range publicKeys {
+		k, ok := shared.ValidateHex(w, fmt.Sprintf("PublicKeys[%d]", i), key, fieldparams.BLSPubkeyLength)
+		if !ok {
+			return
+		}
+		pubkeys[i] = bytesutil.SafeCopyBytes(k)
+	}
+
+	eipJSON, err := slashing.ExportStandardProtectionJSON(ctx, s.db, pubkeys...)
 	if err != nil {
 		httputil.HandleError(w, errors.Wrap(err, "could not export slashing protection history").Error(), http.StatusInternalServerError)
 		return
 	}
+	if r.URL.Query().Get("minimal") == "true" {
+		eipJSON, err = slashing.MinimizeStandardProtectionJSON(eipJSON)
+		if err != nil {
+			httputil.HandleError(w, errors.Wrap(err, "could not minimize slashing protection history").Error(), http.StatusInternalServerError)
+			return
+		}
+	}
 
 	encoded, err := json.MarshalIndent(eipJSON, "", "\t")
 	if err != nil {
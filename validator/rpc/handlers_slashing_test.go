@@ -234,3 +234,40 @@ func TestImportExportSlashingProtection_RoundTrip(t *testing.T) {
 
 	require.DeepEqual(t, mockJSON.Metadata, receivedJSON.Metadata)
 }
+
+func TestExportSlashingProtection_FilteredMinimal(t *testing.T) {
+	ctx := context.Background()
+	pubKeys, err := mocks.CreateRandomPubKeys(3)
+	require.NoError(t, err)
+	validatorDB, err := kv.NewKVStore(ctx, t.TempDir(), &kv.Config{
+		PubKeys: pubKeys,
+	})
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, validatorDB.Close())
+	}()
+	attestingHistory, proposalHistory := mocks.MockAttestingAndProposalHistories(pubKeys)
+	mockJSON, err := mocks.MockSlashingProtectionJSON(pubKeys, attestingHistory, proposalHistory)
+	require.NoError(t, err)
+	encoded, err := json.Marshal(mockJSON)
+	require.NoError(t, err)
+	require.NoError(t, validatorDB.ImportStandardProtectionJSON(ctx, bytes.NewBuffer(encoded)))
+	s := &Server{db: validatorDB}
+
+	url := fmt.Sprintf("/v2/validator/slashing-protection/export?public_keys=%#x&minimal=true", pubKeys[1])
+	wr := httptest.NewRecorder()
+	s.ExportSlashingProtection(wr, httptest.NewRequest(http.MethodGet, url, nil))
+	require.Equal(t, http.StatusOK, wr.Code)
+	resp := &ExportSlashingProtectionResponse{}
+	require.NoError(t, json.Unmarshal(wr.Body.Bytes(), resp))
+	receivedJSON := &format.EIPSlashingProtectionFormat{}
+	require.NoError(t, json.Unmarshal([]byte(resp.File), receivedJSON))
+	require.Equal(t, 1, len(receivedJSON.Data))
+	require.Equal(t, fmt.Sprintf("%#x", pubKeys[1]), receivedJSON.Data[0].Pubkey)
+	require.Equal(t, true, len(receivedJSON.Data[0].SignedBlocks) <= 1)
+	require.Equal(t, 1, len(receivedJSON.Data[0].SignedAttestations))
+
+	wr = httptest.NewRecorder()
+	s.ExportSlashingProtection(wr, httptest.NewRequest(http.MethodGet, "/v2/validator/slashing-protection/export?public_keys=0x01", nil))
+	require.Equal(t, http.StatusBadRequest, wr.Code)
+}
@@ -17,6 +17,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/io/logs"
 	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v5/validator/accounts/wallet"
+	"github.com/prysmaticlabs/prysm/v5/validator/audit"
 	"github.com/prysmaticlabs/prysm/v5/validator/client"
 	iface "github.com/prysmaticlabs/prysm/v5/validator/client/iface"
 	"github.com/prysmaticlabs/prysm/v5/validator/db"
@@ -43,6 +44,7 @@ type Config struct {
 	AuthTokenPath          string
 	Middlewares            []middleware.Middleware
 	Router                 *http.ServeMux
+	AuditLog               *audit.Log
 }
 
 // Server defining a HTTP server for the remote signer API and registering clients
@@ -77,6 +79,7 @@ type Server struct {
 	logStreamer               logs.Streamer
 	logStreamerBufferSize     int
 	startFailure              error
+	auditLog                  *audit.Log
 }
 
 // NewServer instantiates a new HTTP server.
@@ -104,6 +107,7 @@ func NewServer(ctx context.Context, cfg *Config) *Server {
 		beaconApiEndpoint:      cfg.BeaconApiEndpoint,
 		beaconNodeEndpoint:     cfg.BeaconNodeGRPCEndpoint,
 		router:                 cfg.Router,
+		auditLog:               cfg.AuditLog,
 	}
 
 	if server.authTokenPath == "" && server.walletDir != "" {
@@ -188,9 +192,15 @@ func (s *Server) InitializeRoutes() error {
 	s.router.HandleFunc("POST /eth/v1/validator/{pubkey}/feerecipient", s.SetFeeRecipientByPubkey)
 	s.router.HandleFunc("DELETE /eth/v1/validator/{pubkey}/feerecipient", s.DeleteFeeRecipientByPubkey)
 	s.router.HandleFunc("POST /eth/v1/validator/{pubkey}/voluntary_exit", s.SetVoluntaryExit)
+	s.router.HandleFunc("POST /eth/v1/validator/{pubkey}/bls_to_execution_change", s.SetBLSToExecutionChange)
 	s.router.HandleFunc("GET /eth/v1/validator/{pubkey}/graffiti", s.GetGraffiti)
 	s.router.HandleFunc("POST /eth/v1/validator/{pubkey}/graffiti", s.SetGraffiti)
 	s.router.HandleFunc("DELETE /eth/v1/validator/{pubkey}/graffiti", s.DeleteGraffiti)
+	s.router.HandleFunc("GET /eth/v1/validator/audit_log", s.GetAuditLog)
+	s.router.HandleFunc("GET /eth/v1/validator/{pubkey}/performance_history", s.GetPerformanceHistory)
+	s.router.HandleFunc("GET /eth/v1/validator/exit_intents", s.ListExitIntents)
+	s.router.HandleFunc("POST /eth/v1/validator/{pubkey}/exit_intent", s.SetExitIntent)
+	s.router.HandleFunc("DELETE /eth/v1/validator/{pubkey}/exit_intent", s.DeleteExitIntent)
 
 	// auth endpoint
 	s.router.HandleFunc("GET "+api.WebUrlPrefix+"initialize", s.Initialize)
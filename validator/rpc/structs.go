@@ -11,6 +11,8 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
 	eth "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/validator/audit"
+	"github.com/prysmaticlabs/prysm/v5/validator/db/common"
 	"github.com/prysmaticlabs/prysm/v5/validator/keymanager"
 )
 
@@ -48,6 +50,17 @@ type SetVoluntaryExitResponse struct {
 	Data *structs.SignedVoluntaryExit `json:"data"`
 }
 
+// bls to execution change keymanager api
+type SetBLSToExecutionChangeRequest struct {
+	FromBLSPubkey      string `json:"from_bls_pubkey"`
+	ToExecutionAddress string `json:"to_execution_address"`
+	Broadcast          bool   `json:"broadcast"`
+}
+
+type SetBLSToExecutionChangeResponse struct {
+	Data *structs.SignedBLSToExecutionChange `json:"data"`
+}
+
 // gas limit keymanager api
 type GasLimitMetaData struct {
 	Pubkey   string `json:"pubkey"`
@@ -109,6 +122,94 @@ type GraffitiData struct {
 	Graffiti string `json:"graffiti"`
 }
 
+// Audit log api
+type GetAuditLogResponse struct {
+	Data    []*audit.Entry `json:"data"`
+	NextSeq string         `json:"next_seq"`
+}
+
+// Performance history api
+type GetPerformanceHistoryResponse struct {
+	Data      []*PerformanceRecord `json:"data"`
+	NextEpoch string               `json:"next_epoch"`
+}
+
+type PerformanceRecord struct {
+	Epoch                       string   `json:"epoch"`
+	InclusionDistance           string   `json:"inclusion_distance"`
+	CorrectlyVotedSource        bool     `json:"correctly_voted_source"`
+	CorrectlyVotedTarget        bool     `json:"correctly_voted_target"`
+	CorrectlyVotedHead          bool     `json:"correctly_voted_head"`
+	ProposedSlots               []string `json:"proposed_slots"`
+	MissedProposalSlots         []string `json:"missed_proposal_slots"`
+	SyncCommitteeMessages       string   `json:"sync_committee_messages"`
+	MissedSyncCommitteeMessages string   `json:"missed_sync_committee_messages"`
+	BalanceBefore               string   `json:"balance_before"`
+	BalanceAfter                string   `json:"balance_after"`
+	BalanceChange               string   `json:"balance_change"`
+	InactivityScore             string   `json:"inactivity_score"`
+}
+
+func PerformanceRecordFromDB(r *common.PerformanceRecord) *PerformanceRecord {
+	proposedSlots := make([]string, len(r.ProposedSlots))
+	for i, slot := range r.ProposedSlots {
+		proposedSlots[i] = fmt.Sprintf("%d", slot)
+	}
+	missedProposalSlots := make([]string, len(r.MissedProposalSlots))
+	for i, slot := range r.MissedProposalSlots {
+		missedProposalSlots[i] = fmt.Sprintf("%d", slot)
+	}
+	return &PerformanceRecord{
+		Epoch:                       fmt.Sprintf("%d", r.Epoch),
+		InclusionDistance:           fmt.Sprintf("%d", r.InclusionDistance),
+		CorrectlyVotedSource:        r.CorrectlyVotedSource,
+		CorrectlyVotedTarget:        r.CorrectlyVotedTarget,
+		CorrectlyVotedHead:          r.CorrectlyVotedHead,
+		ProposedSlots:               proposedSlots,
+		MissedProposalSlots:         missedProposalSlots,
+		SyncCommitteeMessages:       fmt.Sprintf("%d", r.SyncCommitteeMessages),
+		MissedSyncCommitteeMessages: fmt.Sprintf("%d", r.MissedSyncCommitteeMessages),
+		BalanceBefore:               fmt.Sprintf("%d", r.BalanceBefore),
+		BalanceAfter:                fmt.Sprintf("%d", r.BalanceAfter),
+		BalanceChange:               fmt.Sprintf("%d", int64(r.BalanceAfter)-int64(r.BalanceBefore)),
+		InactivityScore:             fmt.Sprintf("%d", r.InactivityScore),
+	}
+}
+
+// Exit intents api
+type SetExitIntentRequest struct {
+	Epoch            string `json:"epoch"`
+	BalanceBelowGwei string `json:"balance_below_gwei"`
+}
+
+type ListExitIntentsResponse struct {
+	Data []*ExitIntent `json:"data"`
+}
+
+type ExitIntent struct {
+	Pubkey           string `json:"pubkey"`
+	Epoch            string `json:"epoch"`
+	BalanceBelowGwei string `json:"balance_below_gwei"`
+	Status           string `json:"status"`
+	SubmittedEpoch   string `json:"submitted_epoch"`
+	LastError        string `json:"last_error"`
+}
+
+func ExitIntentFromDB(i *common.ExitIntent) *ExitIntent {
+	var submittedEpoch string
+	if i.Status == common.ExitIntentSubmitted {
+		submittedEpoch = fmt.Sprintf("%d", i.SubmittedEpoch)
+	}
+	return &ExitIntent{
+		Pubkey:           hexutil.Encode(i.PubKey[:]),
+		Epoch:            fmt.Sprintf("%d", i.Epoch),
+		BalanceBelowGwei: fmt.Sprintf("%d", i.BalanceBelowGwei),
+		Status:           string(i.Status),
+		SubmittedEpoch:   submittedEpoch,
+		LastError:        i.LastError,
+	}
+}
+
 type BeaconStatusResponse struct {
 	BeaconNodeEndpoint     string     `json:"beacon_node_endpoint"`
 	Connected              bool       `json:"connected"`
@@ -0,0 +1,89 @@
+package history
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/validator/helpers"
+	"github.com/prysmaticlabs/prysm/v5/validator/slashing-protection-history/format"
+)
+
+// MinimizeStandardProtectionJSON reduces an EIP-3076 interchange file to its minimal form, as
+// described in the EIP: for every public key, only the block with the highest slot and a single
+// attestation with the highest source and target epochs are kept. If no signed attestation has
+// both the highest source and the highest target, the attestation is synthesized without a
+// signing root, which importers treat as a conservative lower bound.
+func MinimizeStandardProtectionJSON(interchangeJSON *format.EIPSlashingProtectionFormat) (*format.EIPSlashingProtectionFormat, error) {
+	minimal := &format.EIPSlashingProtectionFormat{
+		Metadata: interchangeJSON.Metadata,
+		Data:     make([]*format.ProtectionData, 0, len(interchangeJSON.Data)),
+	}
+	for _, item := range interchangeJSON.Data {
+		blocks, err := latestSignedBlock(item.SignedBlocks)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not minimize signed blocks of public key %s", item.Pubkey)
+		}
+		atts, err := latestSignedAttestation(item.SignedAttestations)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not minimize signed attestations of public key %s", item.Pubkey)
+		}
+		minimal.Data = append(minimal.Data, &format.ProtectionData{
+			Pubkey:             item.Pubkey,
+			SignedBlocks:       blocks,
+			SignedAttestations: atts,
+		})
+	}
+	return minimal, nil
+}
+
+func latestSignedBlock(blocks []*format.SignedBlock) ([]*format.SignedBlock, error) {
+	var (
+		latest     *format.SignedBlock
+		latestSlot primitives.Slot
+	)
+	for _, blk := range blocks {
+		slot, err := helpers.SlotFromString(blk.Slot)
+		if err != nil {
+			return nil, err
+		}
+		if latest == nil || slot > latestSlot {
+			latest, latestSlot = blk, slot
+		}
+	}
+	if latest == nil {
+		return make([]*format.SignedBlock, 0), nil
+	}
+	return []*format.SignedBlock{latest}, nil
+}
+
+func latestSignedAttestation(atts []*format.SignedAttestation) ([]*format.SignedAttestation, error) {
+	if len(atts) == 0 {
+		return make([]*format.SignedAttestation, 0), nil
+	}
+	var maxSource, maxTarget primitives.Epoch
+	sources := make([]primitives.Epoch, len(atts))
+	targets := make([]primitives.Epoch, len(atts))
+	for i, att := range atts {
+		source, err := helpers.EpochFromString(att.SourceEpoch)
+		if err != nil {
+			return nil, err
+		}
+		target, err := helpers.EpochFromString(att.TargetEpoch)
+		if err != nil {
+			return nil, err
+		}
+		sources[i], targets[i] = source, target
+		maxSource = max(maxSource, source)
+		maxTarget = max(maxTarget, target)
+	}
+	for i, att := range atts {
+		if sources[i] == maxSource && targets[i] == maxTarget {
+			return []*format.SignedAttestation{att}, nil
+		}
+	}
+	return []*format.SignedAttestation{{
+		SourceEpoch: fmt.Sprintf("%d", maxSource),
+		TargetEpoch: fmt.Sprintf("%d", maxTarget),
+	}}, nil
+}
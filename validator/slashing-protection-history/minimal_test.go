@@ -0,0 +1,55 @@
+package history
+
+import (
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/validator/slashing-protection-history/format"
+)
+
+func TestMinimizeStandardProtectionJSON(t *testing.T) {
+	complete := &format.EIPSlashingProtectionFormat{}
+	complete.Metadata.InterchangeFormatVersion = format.InterchangeFormatVersion
+	complete.Metadata.GenesisValidatorsRoot = "0x0100000000000000000000000000000000000000000000000000000000000000"
+	complete.Data = []*format.ProtectionData{
+		{
+			Pubkey: "0x01",
+			SignedBlocks: []*format.SignedBlock{
+				{Slot: "12", SigningRoot: "0x12"},
+				{Slot: "40", SigningRoot: "0x40"},
+				{Slot: "33", SigningRoot: "0x33"},
+			},
+			SignedAttestations: []*format.SignedAttestation{
+				{SourceEpoch: "1", TargetEpoch: "2", SigningRoot: "0x02"},
+				{SourceEpoch: "2", TargetEpoch: "3", SigningRoot: "0x03"},
+			},
+		},
+		{
+			Pubkey: "0x02",
+			SignedAttestations: []*format.SignedAttestation{
+				{SourceEpoch: "5", TargetEpoch: "6", SigningRoot: "0x06"},
+				{SourceEpoch: "1", TargetEpoch: "9", SigningRoot: "0x09"},
+			},
+		},
+	}
+
+	minimal, err := MinimizeStandardProtectionJSON(complete)
+	require.NoError(t, err)
+	assert.DeepEqual(t, complete.Metadata, minimal.Metadata)
+	require.Equal(t, 2, len(minimal.Data))
+
+	require.Equal(t, 1, len(minimal.Data[0].SignedBlocks))
+	assert.DeepEqual(t, &format.SignedBlock{Slot: "40", SigningRoot: "0x40"}, minimal.Data[0].SignedBlocks[0])
+	require.Equal(t, 1, len(minimal.Data[0].SignedAttestations))
+	assert.DeepEqual(t, &format.SignedAttestation{SourceEpoch: "2", TargetEpoch: "3", SigningRoot: "0x03"}, minimal.Data[0].SignedAttestations[0])
+
+	// No single attestation has both the highest source and target, one without signing root is synthesized.
+	assert.Equal(t, 0, len(minimal.Data[1].SignedBlocks))
+	require.Equal(t, 1, len(minimal.Data[1].SignedAttestations))
+	assert.DeepEqual(t, &format.SignedAttestation{SourceEpoch: "5", TargetEpoch: "9"}, minimal.Data[1].SignedAttestations[0])
+
+	complete.Data[0].SignedBlocks[0].Slot = "abc"
+	_, err = MinimizeStandardProtectionJSON(complete)
+	require.ErrorContains(t, "could not minimize signed blocks of public key 0x01", err)
+}
@@ -0,0 +1,271 @@
+package history
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1/slashings"
+	"github.com/prysmaticlabs/prysm/v5/validator/db"
+	"github.com/prysmaticlabs/prysm/v5/validator/helpers"
+	"github.com/prysmaticlabs/prysm/v5/validator/slashing-protection-history/format"
+)
+
+// Conflict is an entry of an interchange file which is slashable with respect to another
+// entry of the same public key in that file, or in the local slashing protection history when
+// verifying against a validator database. Importing such a key blacklists it.
+type Conflict struct {
+	Pubkey string
+	Reason string
+}
+
+type verifiedAttestation struct {
+	source, target primitives.Epoch
+	signingRoot    []byte
+}
+
+// VerifyStandardProtectionJSON checks an EIP-3076 interchange file before it is imported. An error
+// is returned if the file is malformed or was not produced for the given genesis validators root,
+// otherwise the conflicting entries, if any, are returned sorted by public key.
+func VerifyStandardProtectionJSON(
+	interchangeJSON *format.EIPSlashingProtectionFormat,
+	genesisValidatorsRoot []byte,
+) ([]*Conflict, error) {
+	blocksByPubKey, attsByPubKey, err := parseProtectionJSON(interchangeJSON, genesisValidatorsRoot)
+	if err != nil {
+		return nil, err
+	}
+	return findConflicts(blocksByPubKey, attsByPubKey)
+}
+
+// VerifyStandardProtectionJSONAgainstDB checks an EIP-3076 interchange file before it is imported
+// into the given validator database. On top of the checks of VerifyStandardProtectionJSON, the
+// entries of each public key are checked against its slashing protection history in the database.
+// Conflicts with the history are reported as such, unless the file is slashable on its own.
+func VerifyStandardProtectionJSONAgainstDB(
+	ctx context.Context,
+	interchangeJSON *format.EIPSlashingProtectionFormat,
+	genesisValidatorsRoot []byte,
+	validatorDB db.Database,
+) ([]*Conflict, error) {
+	dbRoot, err := validatorDB.GenesisValidatorsRoot(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get genesis validators root from DB")
+	}
+	if len(dbRoot) != 0 && !bytes.Equal(dbRoot, genesisValidatorsRoot) {
+		return nil, fmt.Errorf(
+			"genesis validators root %#x of the database does not match the expected %#x",
+			dbRoot, genesisValidatorsRoot,
+		)
+	}
+	blocksByPubKey, attsByPubKey, err := parseProtectionJSON(interchangeJSON, genesisValidatorsRoot)
+	if err != nil {
+		return nil, err
+	}
+	fileConflicts, err := findConflicts(blocksByPubKey, attsByPubKey)
+	if err != nil {
+		return nil, err
+	}
+	conflicting := make(map[string]bool, len(fileConflicts))
+	for _, c := range fileConflicts {
+		conflicting[c.Pubkey] = true
+	}
+
+	// Only the public keys which are not slashable on their own are checked against the database.
+	withHistoryBlocks := make(map[string][]*format.SignedBlock)
+	withHistoryAtts := make(map[string][]*format.SignedAttestation)
+	for pubKeyHex := range blocksByPubKey {
+		if conflicting[pubKeyHex] {
+			continue
+		}
+		pubKey, err := helpers.PubKeyFromHex(pubKeyHex)
+		if err != nil {
+			return nil, err
+		}
+		blocks, err := signedBlocksByPubKey(ctx, validatorDB, pubKey)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not retrieve signed blocks of public key %s", pubKeyHex)
+		}
+		atts, err := signedAttestationsByPubKey(ctx, validatorDB, pubKey)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not retrieve signed attestations of public key %s", pubKeyHex)
+		}
+		withHistoryBlocks[pubKeyHex] = append(blocks, blocksByPubKey[pubKeyHex]...)
+		withHistoryAtts[pubKeyHex] = append(atts, attsByPubKey[pubKeyHex]...)
+	}
+	historyConflicts, err := findConflicts(withHistoryBlocks, withHistoryAtts)
+	if err != nil {
+		return nil, err
+	}
+	for _, c := range historyConflicts {
+		c.Reason = "conflicts with the local slashing protection history: " + c.Reason
+	}
+	all := append(fileConflicts, historyConflicts...)
+	sort.SliceStable(all, func(i, j int) bool {
+		return all[i].Pubkey < all[j].Pubkey
+	})
+	return all, nil
+}
+
+// parseProtectionJSON checks the metadata of an interchange file, and groups its entries by public key.
+func parseProtectionJSON(
+	interchangeJSON *format.EIPSlashingProtectionFormat,
+	genesisValidatorsRoot []byte,
+) (map[string][]*format.SignedBlock, map[string][]*format.SignedAttestation, error) {
+	if interchangeJSON.Metadata.InterchangeFormatVersion != format.InterchangeFormatVersion {
+		return nil, nil, fmt.Errorf(
+			"slashing protection JSON version '%s' is not supported, wanted '%s'",
+			interchangeJSON.Metadata.InterchangeFormatVersion,
+			format.InterchangeFormatVersion,
+		)
+	}
+	fileRoot, err := helpers.RootFromHex(interchangeJSON.Metadata.GenesisValidatorsRoot)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "could not parse genesis validators root of the file")
+	}
+	if !bytes.Equal(fileRoot[:], genesisValidatorsRoot) {
+		return nil, nil, fmt.Errorf(
+			"genesis validators root %#x of the file does not match the expected %#x",
+			fileRoot, genesisValidatorsRoot,
+		)
+	}
+
+	// A public key may appear several times in a file, its entries are checked together.
+	blocksByPubKey := make(map[string][]*format.SignedBlock)
+	attsByPubKey := make(map[string][]*format.SignedAttestation)
+	for _, item := range interchangeJSON.Data {
+		pubKey, err := helpers.PubKeyFromHex(item.Pubkey)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "could not parse public key %s", item.Pubkey)
+		}
+		pubKeyHex := fmt.Sprintf("%#x", pubKey)
+		blocksByPubKey[pubKeyHex] = append(blocksByPubKey[pubKeyHex], item.SignedBlocks...)
+		attsByPubKey[pubKeyHex] = append(attsByPubKey[pubKeyHex], item.SignedAttestations...)
+	}
+	return blocksByPubKey, attsByPubKey, nil
+}
+
+// findConflicts returns the conflicts of the entries of each public key, sorted by public key.
+func findConflicts(
+	blocksByPubKey map[string][]*format.SignedBlock,
+	attsByPubKey map[string][]*format.SignedAttestation,
+) ([]*Conflict, error) {
+	conflicts := make([]*Conflict, 0)
+	for pubKey, blocks := range blocksByPubKey {
+		reason, err := blockConflict(blocks)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not verify signed blocks of public key %s", pubKey)
+		}
+		if reason != "" {
+			conflicts = append(conflicts, &Conflict{Pubkey: pubKey, Reason: reason})
+		}
+	}
+	for pubKey, atts := range attsByPubKey {
+		reason, err := attestationConflict(atts)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not verify signed attestations of public key %s", pubKey)
+		}
+		if reason != "" {
+			conflicts = append(conflicts, &Conflict{Pubkey: pubKey, Reason: reason})
+		}
+	}
+	sort.SliceStable(conflicts, func(i, j int) bool {
+		return conflicts[i].Pubkey < conflicts[j].Pubkey
+	})
+	return conflicts, nil
+}
+
+// blockConflict follows the import rules: two blocks at the same slot are a double proposal unless
+// both carry the same signing root.
+func blockConflict(blocks []*format.SignedBlock) (string, error) {
+	signingRootsBySlot := make(map[primitives.Slot][]byte, len(blocks))
+	for _, blk := range blocks {
+		slot, err := helpers.SlotFromString(blk.Slot)
+		if err != nil {
+			return "", err
+		}
+		signingRoot, err := optionalRoot(blk.SigningRoot)
+		if err != nil {
+			return "", err
+		}
+		if seen, ok := signingRootsBySlot[slot]; ok && slashings.SigningRootsDiffer(seen, signingRoot) {
+			return fmt.Sprintf("double proposal at slot %d", slot), nil
+		}
+		signingRootsBySlot[slot] = signingRoot
+	}
+	return "", nil
+}
+
+// attestationConflict finds double and surround votes after sorting the attestations, rather than
+// comparing every pair of them, as histories of long running validators have many entries.
+func attestationConflict(atts []*format.SignedAttestation) (string, error) {
+	verified := make([]*verifiedAttestation, 0, len(atts))
+	for _, att := range atts {
+		source, err := helpers.EpochFromString(att.SourceEpoch)
+		if err != nil {
+			return "", err
+		}
+		target, err := helpers.EpochFromString(att.TargetEpoch)
+		if err != nil {
+			return "", err
+		}
+		signingRoot, err := optionalRoot(att.SigningRoot)
+		if err != nil {
+			return "", err
+		}
+		if source > target {
+			return fmt.Sprintf("attestation source epoch %d is greater than its target epoch %d", source, target), nil
+		}
+		verified = append(verified, &verifiedAttestation{source: source, target: target, signingRoot: signingRoot})
+	}
+
+	// Attestations with the same target epoch are adjacent once sorted by target epoch.
+	sort.SliceStable(verified, func(i, j int) bool {
+		return verified[i].target < verified[j].target
+	})
+	for i := 1; i < len(verified); i++ {
+		a, b := verified[i-1], verified[i]
+		if a.target == b.target && slashings.SigningRootsDiffer(a.signingRoot, b.signingRoot) {
+			return fmt.Sprintf("double vote for target epoch %d", b.target), nil
+		}
+	}
+
+	// Once sorted by source epoch, an attestation is surrounded if the highest target epoch of the
+	// attestations with a lower source epoch is greater than its own.
+	sort.SliceStable(verified, func(i, j int) bool {
+		return verified[i].source < verified[j].source
+	})
+	var highest *verifiedAttestation
+	for i := 0; i < len(verified); {
+		j := i
+		for ; j < len(verified) && verified[j].source == verified[i].source; j++ {
+			if highest != nil && highest.target > verified[j].target {
+				return fmt.Sprintf(
+					"surround vote: source %d target %d surrounds source %d target %d",
+					highest.source, highest.target, verified[j].source, verified[j].target,
+				), nil
+			}
+		}
+		for _, att := range verified[i:j] {
+			if highest == nil || att.target > highest.target {
+				highest = att
+			}
+		}
+		i = j
+	}
+	return "", nil
+}
+
+func optionalRoot(hexRoot string) ([]byte, error) {
+	if hexRoot == "" {
+		return nil, nil
+	}
+	root, err := helpers.RootFromHex(hexRoot)
+	if err != nil {
+		return nil, err
+	}
+	return root[:], nil
+}
@@ -0,0 +1,167 @@
+package history
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	dbtest "github.com/prysmaticlabs/prysm/v5/validator/db/testing"
+	"github.com/prysmaticlabs/prysm/v5/validator/helpers"
+	"github.com/prysmaticlabs/prysm/v5/validator/slashing-protection-history/format"
+)
+
+const (
+	verifyPubKey1 = "0xa2b5aaad9c6efefe7bb9b1243a043404f3362937cfb6b31833929833173f476630ea2cfeb0d9ddf15f97ca8685948820"
+	verifyPubKey2 = "0x8000a9a6d3f5e22d783eefaadbcf0298146adb5d95b04db910a0d4e16976b30229d0b1e7b9cda6c7e0bfa11f72efe055"
+	verifyRootA   = "0x0a00000000000000000000000000000000000000000000000000000000000000"
+	verifyRootB   = "0x0b00000000000000000000000000000000000000000000000000000000000000"
+)
+
+func newVerifyFile(data ...*format.ProtectionData) *format.EIPSlashingProtectionFormat {
+	f := &format.EIPSlashingProtectionFormat{Data: data}
+	f.Metadata.InterchangeFormatVersion = format.InterchangeFormatVersion
+	f.Metadata.GenesisValidatorsRoot = "0x0100000000000000000000000000000000000000000000000000000000000000"
+	return f
+}
+
+func TestVerifyStandardProtectionJSON(t *testing.T) {
+	genesisValidatorsRoot := [32]byte{1}
+	newFile := newVerifyFile
+
+	t.Run("metadata", func(t *testing.T) {
+		f := newFile()
+		_, err := VerifyStandardProtectionJSON(f, []byte{2})
+		require.ErrorContains(t, "does not match the expected", err)
+		f.Metadata.InterchangeFormatVersion = "4"
+		_, err = VerifyStandardProtectionJSON(f, genesisValidatorsRoot[:])
+		require.ErrorContains(t, "is not supported", err)
+	})
+
+	t.Run("no conflicts", func(t *testing.T) {
+		conflicts, err := VerifyStandardProtectionJSON(newFile(
+			&format.ProtectionData{
+				Pubkey:       verifyPubKey1,
+				SignedBlocks: []*format.SignedBlock{{Slot: "1", SigningRoot: verifyRootA}},
+				SignedAttestations: []*format.SignedAttestation{
+					{SourceEpoch: "1", TargetEpoch: "2"},
+					{SourceEpoch: "2", TargetEpoch: "3"},
+				},
+			},
+			// The same block repeated with the same signing root is not a double proposal.
+			&format.ProtectionData{
+				Pubkey:       verifyPubKey1,
+				SignedBlocks: []*format.SignedBlock{{Slot: "1", SigningRoot: verifyRootA}},
+			},
+		), genesisValidatorsRoot[:])
+		require.NoError(t, err)
+		assert.Equal(t, 0, len(conflicts))
+	})
+
+	t.Run("conflicts", func(t *testing.T) {
+		conflicts, err := VerifyStandardProtectionJSON(newFile(
+			&format.ProtectionData{
+				Pubkey: verifyPubKey2,
+				SignedAttestations: []*format.SignedAttestation{
+					{SourceEpoch: "2", TargetEpoch: "3"},
+					{SourceEpoch: "1", TargetEpoch: "4"},
+				},
+			},
+			&format.ProtectionData{
+				Pubkey:             verifyPubKey1,
+				SignedBlocks:       []*format.SignedBlock{{Slot: "1", SigningRoot: verifyRootA}},
+				SignedAttestations: []*format.SignedAttestation{{SourceEpoch: "1", TargetEpoch: "2", SigningRoot: verifyRootA}},
+			},
+			&format.ProtectionData{
+				Pubkey:             verifyPubKey1,
+				SignedBlocks:       []*format.SignedBlock{{Slot: "1", SigningRoot: verifyRootB}},
+				SignedAttestations: []*format.SignedAttestation{{SourceEpoch: "0", TargetEpoch: "2", SigningRoot: verifyRootB}},
+			},
+		), genesisValidatorsRoot[:])
+		require.NoError(t, err)
+		require.Equal(t, 3, len(conflicts))
+		reasons := map[string]bool{}
+		for _, c := range conflicts {
+			reasons[c.Pubkey+" "+c.Reason] = true
+		}
+		assert.Equal(t, true, reasons[verifyPubKey1+" double proposal at slot 1"])
+		assert.Equal(t, true, reasons[verifyPubKey1+" double vote for target epoch 2"])
+		assert.Equal(t, true, reasons[verifyPubKey2+" surround vote: source 1 target 4 surrounds source 2 target 3"])
+	})
+}
+
+func TestAttestationConflict(t *testing.T) {
+	tests := []struct {
+		name   string
+		atts   [][2]int
+		reason string
+	}{
+		{name: "increasing", atts: [][2]int{{0, 1}, {1, 2}, {1, 3}, {3, 4}}},
+		{name: "same source", atts: [][2]int{{1, 5}, {1, 3}, {1, 4}}},
+		{name: "double vote", atts: [][2]int{{1, 5}, {2, 3}, {0, 5}}, reason: "double vote for target epoch 5"},
+		{name: "surrounding", atts: [][2]int{{3, 4}, {5, 6}, {2, 7}}, reason: "surround vote: source 2 target 7 surrounds source 3 target 4"},
+		{name: "surrounded", atts: [][2]int{{2, 7}, {0, 1}, {5, 6}}, reason: "surround vote: source 2 target 7 surrounds source 5 target 6"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			atts := make([]*format.SignedAttestation, 0, len(tt.atts))
+			for _, a := range tt.atts {
+				atts = append(atts, &format.SignedAttestation{SourceEpoch: fmt.Sprint(a[0]), TargetEpoch: fmt.Sprint(a[1])})
+			}
+			reason, err := attestationConflict(atts)
+			require.NoError(t, err)
+			assert.Equal(t, tt.reason, reason)
+		})
+	}
+}
+
+func TestVerifyStandardProtectionJSONAgainstDB(t *testing.T) {
+	ctx := context.Background()
+	genesisValidatorsRoot := [32]byte{1}
+	pubKey1, err := helpers.PubKeyFromHex(verifyPubKey1)
+	require.NoError(t, err)
+	pubKey2, err := helpers.PubKeyFromHex(verifyPubKey2)
+	require.NoError(t, err)
+
+	for _, isSlashingProtectionMinimal := range []bool{false, true} {
+		t.Run(fmt.Sprintf("minimal:%v", isSlashingProtectionMinimal), func(t *testing.T) {
+			validatorDB := dbtest.SetupDB(t, [][fieldparams.BLSPubkeyLength]byte{pubKey1, pubKey2}, isSlashingProtectionMinimal)
+			require.NoError(t, validatorDB.SaveGenesisValidatorsRoot(ctx, genesisValidatorsRoot[:]))
+			require.NoError(t, validatorDB.SaveAttestationForPubKey(ctx, pubKey1, [32]byte{0x0a}, createAttestation(3, 4)))
+			require.NoError(t, validatorDB.SaveProposalHistoryForSlot(ctx, pubKey2, 10, []byte{0x0a}))
+
+			_, err := VerifyStandardProtectionJSONAgainstDB(ctx, newVerifyFile(), []byte{2}, validatorDB)
+			require.ErrorContains(t, "of the database does not match the expected", err)
+
+			conflicts, err := VerifyStandardProtectionJSONAgainstDB(ctx, newVerifyFile(
+				&format.ProtectionData{
+					Pubkey:             verifyPubKey1,
+					SignedAttestations: []*format.SignedAttestation{{SourceEpoch: "4", TargetEpoch: "5"}},
+				},
+			), genesisValidatorsRoot[:], validatorDB)
+			require.NoError(t, err)
+			assert.Equal(t, 0, len(conflicts))
+
+			conflicts, err = VerifyStandardProtectionJSONAgainstDB(ctx, newVerifyFile(
+				&format.ProtectionData{
+					Pubkey:             verifyPubKey1,
+					SignedAttestations: []*format.SignedAttestation{{SourceEpoch: "2", TargetEpoch: "5"}},
+				},
+				&format.ProtectionData{
+					Pubkey:       verifyPubKey2,
+					SignedBlocks: []*format.SignedBlock{{Slot: "10", SigningRoot: verifyRootB}},
+				},
+			), genesisValidatorsRoot[:], validatorDB)
+			require.NoError(t, err)
+			require.Equal(t, 2, len(conflicts))
+			reasons := map[string]bool{}
+			for _, c := range conflicts {
+				reasons[c.Pubkey+" "+c.Reason] = true
+			}
+			assert.Equal(t, true, reasons[verifyPubKey1+" conflicts with the local slashing protection history: surround vote: source 2 target 5 surrounds source 3 target 4"])
+			assert.Equal(t, true, reasons[verifyPubKey2+" conflicts with the local slashing protection history: double proposal at slot 10"])
+		})
+	}
+}